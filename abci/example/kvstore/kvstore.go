@@ -316,7 +316,7 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.FinalizeBloc
 
 	// Punish validators who committed equivocation.
 	for _, ev := range req.Misbehavior {
-		if ev.Type == types.MISBEHAVIOR_TYPE_DUPLICATE_VOTE {
+		if ev.Type == types.MISBEHAVIOR_TYPE_DUPLICATE_VOTE || ev.Type == types.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL {
 			addr := string(ev.Validator.Address)
 			//nolint:revive // this is a false positive from early-return
 			if pubKey, ok := app.valAddrToPubKeyMap[addr]; ok {
@@ -133,6 +133,7 @@ const (
 	MISBEHAVIOR_TYPE_UNKNOWN             MisbehaviorType = v2.MISBEHAVIOR_TYPE_UNKNOWN
 	MISBEHAVIOR_TYPE_DUPLICATE_VOTE      MisbehaviorType = v2.MISBEHAVIOR_TYPE_DUPLICATE_VOTE
 	MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK MisbehaviorType = v2.MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK
+	MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL  MisbehaviorType = v2.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL
 )
 
 type ApplySnapshotChunkResult = v2.ApplySnapshotChunkResult
@@ -231,18 +231,22 @@ const (
 	MISBEHAVIOR_TYPE_DUPLICATE_VOTE MisbehaviorType = 1
 	// Light client attack
 	MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK MisbehaviorType = 2
+	// Duplicate proposal
+	MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL MisbehaviorType = 3
 )
 
 var MisbehaviorType_name = map[int32]string{
 	0: "MISBEHAVIOR_TYPE_UNKNOWN",
 	1: "MISBEHAVIOR_TYPE_DUPLICATE_VOTE",
 	2: "MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK",
+	3: "MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL",
 }
 
 var MisbehaviorType_value = map[string]int32{
 	"MISBEHAVIOR_TYPE_UNKNOWN":             0,
 	"MISBEHAVIOR_TYPE_DUPLICATE_VOTE":      1,
 	"MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK": 2,
+	"MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL":  3,
 }
 
 func (x MisbehaviorType) String() string {
@@ -1646,7 +1650,6 @@ type Response struct {
 	// Sum of all possible messages.
 	//
 	// Types that are valid to be assigned to Value:
-	//
 	//	*Response_Exception
 	//	*Response_Echo
 	//	*Response_Flush
@@ -2329,6 +2332,16 @@ type CheckTxResponse struct {
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
 	LaneId    string  `protobuf:"bytes,12,opt,name=lane_id,json=laneId,proto3" json:"lane_id,omitempty"`
+	// Priority of the transaction within its lane. Transactions with higher
+	// priority are reaped first, and may evict lower-priority transactions of
+	// the same lane when the mempool is full.
+	TxPriority int64 `protobuf:"varint,13,opt,name=tx_priority,json=txPriority,proto3" json:"tx_priority,omitempty"`
+	// Key (hash) of a transaction in the mempool that this transaction replaces.
+	ReplacedTxKey []byte `protobuf:"bytes,14,opt,name=replaced_tx_key,json=replacedTxKey,proto3" json:"replaced_tx_key,omitempty"`
+	// Application-defined key shared by a transaction and its replacements. A
+	// transaction in the mempool with the same replacement key is replaced by
+	// this transaction.
+	ReplacementKey string `protobuf:"bytes,15,opt,name=replacement_key,json=replacementKey,proto3" json:"replacement_key,omitempty"`
 }
 
 func (m *CheckTxResponse) Reset()         { *m = CheckTxResponse{} }
@@ -2427,6 +2440,27 @@ func (m *CheckTxResponse) GetLaneId() string {
 	return ""
 }
 
+func (m *CheckTxResponse) GetTxPriority() int64 {
+	if m != nil {
+		return m.TxPriority
+	}
+	return 0
+}
+
+func (m *CheckTxResponse) GetReplacedTxKey() []byte {
+	if m != nil {
+		return m.ReplacedTxKey
+	}
+	return nil
+}
+
+func (m *CheckTxResponse) GetReplacementKey() string {
+	if m != nil {
+		return m.ReplacementKey
+	}
+	return ""
+}
+
 // CommitResponse indicates how much blocks should CometBFT retain.
 type CommitResponse struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
@@ -2881,6 +2915,14 @@ type FinalizeBlockResponse struct {
 	// delay between the time when this block is committed and the next height is started.
 	// previously `timeout_commit` in config.toml
 	NextBlockDelay time.Duration `protobuf:"bytes,6,opt,name=next_block_delay,json=nextBlockDelay,proto3,stdduration" json:"next_block_delay"`
+	// if not empty, the new lanes of the mempool and their priorities, replacing
+	// the lanes set in InfoResponse once this block is committed. Transactions
+	// in lanes that are removed are moved to the new default lane. The last
+	// lanes set are kept by the node, and used instead of the ones set in
+	// InfoResponse when it restarts.
+	LanePriorities map[string]uint32 `protobuf:"bytes,7,rep,name=lane_priorities,json=lanePriorities,proto3" json:"lane_priorities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// the new default lane, when lane_priorities is not empty.
+	DefaultLane string `protobuf:"bytes,8,opt,name=default_lane,json=defaultLane,proto3" json:"default_lane,omitempty"`
 }
 
 func (m *FinalizeBlockResponse) Reset()         { *m = FinalizeBlockResponse{} }
@@ -2958,6 +3000,20 @@ func (m *FinalizeBlockResponse) GetNextBlockDelay() time.Duration {
 	return 0
 }
 
+func (m *FinalizeBlockResponse) GetLanePriorities() map[string]uint32 {
+	if m != nil {
+		return m.LanePriorities
+	}
+	return nil
+}
+
+func (m *FinalizeBlockResponse) GetDefaultLane() string {
+	if m != nil {
+		return m.DefaultLane
+	}
+	return ""
+}
+
 // CommitInfo contains votes for the particular round.
 type CommitInfo struct {
 	Round int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
@@ -3823,6 +3879,7 @@ func init() {
 	proto.RegisterType((*ExtendVoteResponse)(nil), "cometbft.abci.v2.ExtendVoteResponse")
 	proto.RegisterType((*VerifyVoteExtensionResponse)(nil), "cometbft.abci.v2.VerifyVoteExtensionResponse")
 	proto.RegisterType((*FinalizeBlockResponse)(nil), "cometbft.abci.v2.FinalizeBlockResponse")
+	proto.RegisterMapType((map[string]uint32)(nil), "cometbft.abci.v2.FinalizeBlockResponse.LanePrioritiesEntry")
 	proto.RegisterType((*CommitInfo)(nil), "cometbft.abci.v2.CommitInfo")
 	proto.RegisterType((*ExtendedCommitInfo)(nil), "cometbft.abci.v2.ExtendedCommitInfo")
 	proto.RegisterType((*Event)(nil), "cometbft.abci.v2.Event")
@@ -3840,218 +3897,222 @@ func init() {
 func init() { proto.RegisterFile("cometbft/abci/v2/types.proto", fileDescriptor_6f0a5b1025f81964) }
 
 var fileDescriptor_6f0a5b1025f81964 = []byte{
-	// 3365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6c, 0x1b, 0xd7,
-	0xb9, 0xf6, 0xf0, 0x25, 0xf2, 0xe7, 0x43, 0xa3, 0x23, 0xc9, 0xa6, 0x15, 0x47, 0x92, 0xc7, 0x71,
-	0xec, 0xd8, 0x89, 0x74, 0xad, 0xe4, 0xe6, 0x79, 0x93, 0x80, 0xa2, 0xa9, 0x48, 0xb2, 0x2c, 0x31,
-	0x43, 0x5a, 0x37, 0xf6, 0x7d, 0x4c, 0x46, 0xe4, 0xa1, 0x38, 0x31, 0x39, 0x33, 0x99, 0x39, 0x54,
-	0xa4, 0x7b, 0x57, 0x2d, 0x9a, 0xa2, 0xc8, 0x2a, 0x9b, 0x02, 0x45, 0x81, 0x02, 0x05, 0x8a, 0x6e,
-	0xbb, 0xe8, 0xbe, 0xbb, 0xa2, 0xc8, 0xaa, 0xc9, 0xb2, 0xab, 0xb4, 0x48, 0xd0, 0x4d, 0xf7, 0x05,
-	0x0a, 0x74, 0x53, 0x9c, 0xc7, 0xbc, 0xc8, 0x19, 0xc9, 0x76, 0xd2, 0x45, 0xd1, 0xee, 0x78, 0xce,
-	0xf9, 0xfe, 0x7f, 0xce, 0xf9, 0xcf, 0x39, 0xff, 0xe3, 0x3b, 0x84, 0x4b, 0x1d, 0x6b, 0x88, 0xc9,
-	0x41, 0x8f, 0xac, 0xea, 0x07, 0x1d, 0x63, 0xf5, 0x68, 0x6d, 0x95, 0x9c, 0xd8, 0xd8, 0x5d, 0xb1,
-	0x1d, 0x8b, 0x58, 0x48, 0xf6, 0x46, 0x57, 0xe8, 0xe8, 0xca, 0xd1, 0xda, 0xc2, 0xa2, 0x8f, 0xef,
-	0x38, 0x27, 0x36, 0xb1, 0x56, 0x8f, 0x6e, 0xad, 0xda, 0x8e, 0x65, 0xf5, 0xb8, 0x44, 0x68, 0x9c,
-	0xe9, 0xa1, 0x0a, 0x6d, 0xdd, 0xd1, 0x87, 0x42, 0xe3, 0xc2, 0xe5, 0xc9, 0xf1, 0x23, 0x7d, 0x60,
-	0x74, 0x75, 0x62, 0x39, 0x02, 0x32, 0x77, 0x68, 0x1d, 0x5a, 0xec, 0xe7, 0x2a, 0xfd, 0x25, 0x7a,
-	0x97, 0x0e, 0x2d, 0xeb, 0x70, 0x80, 0x57, 0x59, 0xeb, 0x60, 0xd4, 0x5b, 0x25, 0xc6, 0x10, 0xbb,
-	0x44, 0x1f, 0xda, 0xde, 0x97, 0xc7, 0x01, 0xdd, 0x91, 0xa3, 0x13, 0xc3, 0x32, 0xf9, 0xb8, 0xf2,
-	0x79, 0x01, 0xa6, 0x54, 0xfc, 0xe1, 0x08, 0xbb, 0x04, 0xbd, 0x08, 0x19, 0xdc, 0xe9, 0x5b, 0x55,
-	0x69, 0x59, 0xba, 0x5e, 0x5c, 0x7b, 0x7a, 0x65, 0x7c, 0x99, 0x2b, 0x8d, 0x4e, 0xdf, 0x12, 0xe0,
-	0xcd, 0x73, 0x2a, 0x03, 0xa3, 0x97, 0x21, 0xdb, 0x1b, 0x8c, 0xdc, 0x7e, 0x35, 0xc5, 0xa4, 0x16,
-	0x27, 0xa5, 0x36, 0xe8, 0x70, 0x20, 0xc6, 0xe1, 0xf4, 0x63, 0x86, 0xd9, 0xb3, 0xaa, 0xe9, 0xa4,
-	0x8f, 0x6d, 0x99, 0xbd, 0xf0, 0xc7, 0x28, 0x18, 0xd5, 0x01, 0x0c, 0xd3, 0x20, 0x5a, 0xa7, 0xaf,
-	0x1b, 0x66, 0x35, 0xcb, 0x44, 0x95, 0x38, 0x51, 0x83, 0xd4, 0x29, 0x24, 0x90, 0x2f, 0x18, 0x5e,
-	0x1f, 0x9d, 0xf1, 0x87, 0x23, 0xec, 0x9c, 0x54, 0x73, 0x49, 0x33, 0x7e, 0x97, 0x0e, 0x87, 0x66,
-	0xcc, 0xe0, 0xe8, 0x4d, 0xc8, 0x77, 0xfa, 0xb8, 0xf3, 0x50, 0x23, 0xc7, 0xd5, 0x3c, 0x13, 0x5d,
-	0x9e, 0x14, 0xad, 0x53, 0x44, 0xfb, 0x38, 0x10, 0x9e, 0xea, 0xf0, 0x1e, 0xf4, 0x1a, 0xe4, 0x3a,
-	0xd6, 0x70, 0x68, 0x90, 0x6a, 0x91, 0x09, 0x2f, 0xc5, 0x08, 0xb3, 0xf1, 0x40, 0x56, 0x08, 0xa0,
-	0x3d, 0xa8, 0x0c, 0x0c, 0x97, 0x68, 0xae, 0xa9, 0xdb, 0x6e, 0xdf, 0x22, 0x6e, 0xb5, 0xc4, 0x54,
-	0x3c, 0x3b, 0xa9, 0x62, 0xc7, 0x70, 0x49, 0xcb, 0x83, 0x05, 0x9a, 0xca, 0x83, 0x70, 0x3f, 0x55,
-	0x68, 0xf5, 0x7a, 0xd8, 0xf1, 0x35, 0x56, 0xcb, 0x49, 0x0a, 0xf7, 0x28, 0xce, 0x93, 0x0c, 0x29,
-	0xb4, 0xc2, 0xfd, 0xe8, 0xbf, 0x61, 0x76, 0x60, 0xe9, 0x5d, 0x5f, 0x9f, 0xd6, 0xe9, 0x8f, 0xcc,
-	0x87, 0xd5, 0x0a, 0xd3, 0x7a, 0x23, 0x66, 0x9a, 0x96, 0xde, 0xf5, 0x84, 0xeb, 0x14, 0x1a, 0x68,
-	0x9e, 0x19, 0x8c, 0x8f, 0x21, 0x0d, 0xe6, 0x74, 0xdb, 0x1e, 0x9c, 0x8c, 0xab, 0x9f, 0x66, 0xea,
-	0x6f, 0x4e, 0xaa, 0xaf, 0x51, 0x74, 0x82, 0x7e, 0xa4, 0x4f, 0x0c, 0xa2, 0x7b, 0x20, 0xdb, 0x0e,
-	0xb6, 0x75, 0x07, 0x6b, 0xb6, 0x63, 0xd9, 0x96, 0xab, 0x0f, 0xaa, 0x32, 0x53, 0x7e, 0x7d, 0x52,
-	0x79, 0x93, 0x23, 0x9b, 0x02, 0x18, 0x68, 0x9e, 0xb6, 0xa3, 0x23, 0x5c, 0xad, 0xd5, 0xc1, 0xae,
-	0x1b, 0xa8, 0x9d, 0x49, 0x56, 0xcb, 0x90, 0xb1, 0x6a, 0x23, 0x23, 0x68, 0x03, 0x8a, 0xf8, 0x98,
-	0x60, 0xb3, 0xab, 0x1d, 0x59, 0x04, 0x57, 0x11, 0xd3, 0x78, 0x25, 0xe6, 0xba, 0x32, 0xd0, 0xbe,
-	0x45, 0x70, 0xa0, 0x0c, 0xb0, 0xdf, 0x89, 0x0e, 0x60, 0xfe, 0x08, 0x3b, 0x46, 0xef, 0x84, 0xe9,
-	0xd1, 0xd8, 0x88, 0x6b, 0x58, 0x66, 0x75, 0x96, 0x69, 0x7c, 0x7e, 0x52, 0xe3, 0x3e, 0x83, 0x53,
-	0xe1, 0x86, 0x07, 0x0e, 0x54, 0xcf, 0x1e, 0x4d, 0x8e, 0xd2, 0x93, 0xd6, 0x33, 0x4c, 0x7d, 0x60,
-	0xfc, 0x1f, 0xd6, 0x0e, 0x06, 0x56, 0xe7, 0x61, 0x75, 0x2e, 0xe9, 0xa4, 0x6d, 0x08, 0xdc, 0x3a,
-	0x85, 0x85, 0x4e, 0x5a, 0x2f, 0xdc, 0xbf, 0x3e, 0x05, 0xd9, 0x23, 0x7d, 0x30, 0xc2, 0xdb, 0x99,
-	0x7c, 0x46, 0xce, 0x6e, 0x67, 0xf2, 0x53, 0x72, 0x7e, 0x3b, 0x93, 0x2f, 0xc8, 0xb0, 0x9d, 0xc9,
-	0x83, 0x5c, 0x54, 0xae, 0x41, 0x31, 0xe4, 0xa7, 0x50, 0x15, 0xa6, 0x86, 0xd8, 0x75, 0xf5, 0x43,
-	0xcc, 0xfc, 0x5a, 0x41, 0xf5, 0x9a, 0x4a, 0x05, 0x4a, 0x61, 0xd7, 0xa4, 0x7c, 0x2a, 0x41, 0x31,
-	0xe4, 0x74, 0xa8, 0xe4, 0x11, 0x76, 0x98, 0x41, 0x84, 0xa4, 0x68, 0xa2, 0x2b, 0x50, 0x66, 0x6b,
-	0xd1, 0xbc, 0x71, 0xea, 0xfb, 0x32, 0x6a, 0x89, 0x75, 0xee, 0x0b, 0xd0, 0x12, 0x14, 0xed, 0x35,
-	0xdb, 0x87, 0xa4, 0x19, 0x04, 0xec, 0x35, 0xdb, 0x03, 0x5c, 0x86, 0x12, 0x5d, 0xba, 0x8f, 0xc8,
-	0xb0, 0x8f, 0x14, 0x69, 0x9f, 0x80, 0x28, 0xbf, 0x4d, 0x81, 0x3c, 0xee, 0xcc, 0xd0, 0xab, 0x90,
-	0xa1, 0x5e, 0x5e, 0xb8, 0xe9, 0x85, 0x15, 0xee, 0xe1, 0x57, 0x3c, 0x0f, 0xbf, 0xd2, 0xf6, 0x42,
-	0xc0, 0x7a, 0xfe, 0xb3, 0x2f, 0x97, 0xce, 0x7d, 0xfa, 0xfb, 0x25, 0x49, 0x65, 0x12, 0xe8, 0x22,
-	0xf5, 0x60, 0xba, 0x61, 0x6a, 0x46, 0x97, 0x4d, 0xb9, 0x40, 0xbd, 0x93, 0x6e, 0x98, 0x5b, 0x5d,
-	0x74, 0x17, 0xe4, 0x8e, 0x65, 0xba, 0xd8, 0x74, 0x47, 0xae, 0xc6, 0x63, 0x93, 0x70, 0xcd, 0x21,
-	0xff, 0xca, 0x83, 0x20, 0x73, 0x54, 0x02, 0xda, 0x64, 0x48, 0x75, 0xba, 0x13, 0xed, 0x40, 0xef,
-	0x00, 0xf8, 0x01, 0xcc, 0xad, 0x66, 0x96, 0xd3, 0xd7, 0x8b, 0x6b, 0x97, 0x63, 0xce, 0x93, 0x87,
-	0xb9, 0x67, 0x77, 0x75, 0x82, 0xd7, 0x33, 0x74, 0xc2, 0x6a, 0x48, 0x14, 0x3d, 0x0b, 0xd3, 0xba,
-	0x6d, 0x6b, 0x2e, 0xd1, 0x09, 0xd6, 0x0e, 0x4e, 0x08, 0x76, 0x99, 0xdb, 0x2f, 0xa9, 0x65, 0xdd,
-	0xb6, 0x5b, 0xb4, 0x77, 0x9d, 0x76, 0xa2, 0xab, 0x50, 0xa1, 0x1e, 0xde, 0xd0, 0x07, 0x5a, 0x1f,
-	0x1b, 0x87, 0x7d, 0xc2, 0xbc, 0x7b, 0x5a, 0x2d, 0x8b, 0xde, 0x4d, 0xd6, 0xa9, 0x74, 0xa1, 0x14,
-	0x76, 0xee, 0x08, 0x41, 0xa6, 0xab, 0x13, 0x9d, 0xd9, 0xb2, 0xa4, 0xb2, 0xdf, 0xb4, 0xcf, 0xd6,
-	0x49, 0x5f, 0x58, 0x88, 0xfd, 0x46, 0xe7, 0x21, 0x27, 0xd4, 0xa6, 0x99, 0x5a, 0xd1, 0x42, 0x73,
-	0x90, 0xb5, 0x1d, 0xeb, 0x08, 0xb3, 0xcd, 0xcb, 0xab, 0xbc, 0xa1, 0xdc, 0x87, 0x4a, 0x34, 0x0e,
-	0xa0, 0x0a, 0xa4, 0xc8, 0xb1, 0xf8, 0x4a, 0x8a, 0x1c, 0xa3, 0x5b, 0x90, 0xa1, 0xc6, 0x64, 0xda,
-	0x2a, 0x71, 0xd1, 0x4f, 0xc8, 0xb7, 0x4f, 0x6c, 0xac, 0x32, 0xe8, 0x76, 0x26, 0x9f, 0x92, 0xd3,
-	0xca, 0x34, 0x94, 0x23, 0x51, 0x42, 0x39, 0x0f, 0x73, 0x71, 0x3e, 0x5f, 0x31, 0x60, 0x2e, 0xce,
-	0x75, 0xa3, 0x97, 0x21, 0xef, 0x3b, 0x7d, 0xef, 0x04, 0x4d, 0x7c, 0xdd, 0x17, 0xf2, 0xb1, 0xf4,
-	0xec, 0xd0, 0x8d, 0xe8, 0xeb, 0x22, 0xd4, 0x97, 0xd4, 0x29, 0xdd, 0xb6, 0x37, 0x75, 0xb7, 0xaf,
-	0xbc, 0x0f, 0xd5, 0x24, 0x7f, 0x1e, 0x32, 0x9c, 0xc4, 0x2e, 0x80, 0x67, 0xb8, 0xf3, 0x90, 0xeb,
-	0x59, 0xce, 0x50, 0x27, 0x4c, 0x59, 0x59, 0x15, 0x2d, 0x6a, 0x50, 0xee, 0xdb, 0xd3, 0xac, 0x9b,
-	0x37, 0x14, 0x0d, 0x2e, 0x26, 0xba, 0x74, 0x2a, 0x62, 0x98, 0x5d, 0xcc, 0xcd, 0x5b, 0x56, 0x79,
-	0x23, 0x50, 0xc4, 0x27, 0xcb, 0x1b, 0xf4, 0xb3, 0x2e, 0x36, 0xbb, 0xd8, 0x61, 0xfa, 0x0b, 0xaa,
-	0x68, 0x29, 0x3f, 0x4e, 0xc3, 0xf9, 0x78, 0xbf, 0x8e, 0x96, 0xa1, 0x34, 0xd4, 0x8f, 0x35, 0x72,
-	0x2c, 0x8e, 0x9f, 0xc4, 0x0e, 0x00, 0x0c, 0xf5, 0xe3, 0xf6, 0x31, 0x3f, 0x7b, 0x32, 0xa4, 0xc9,
-	0xb1, 0x5b, 0x4d, 0x2d, 0xa7, 0xaf, 0x97, 0x54, 0xfa, 0x13, 0xed, 0xc3, 0xcc, 0xc0, 0xea, 0xe8,
-	0x03, 0x6d, 0xa0, 0xbb, 0x44, 0x13, 0x61, 0x9f, 0x5f, 0xa7, 0x67, 0x92, 0xfc, 0x34, 0xee, 0xf2,
-	0x8d, 0xa5, 0x2e, 0x48, 0x5c, 0x84, 0x69, 0xa6, 0x64, 0x47, 0x77, 0x09, 0x1f, 0x42, 0x0d, 0x28,
-	0x0e, 0x0d, 0xf7, 0x00, 0xf7, 0xf5, 0x23, 0xc3, 0x72, 0xc4, 0xbd, 0x8a, 0x39, 0x3d, 0x77, 0x03,
-	0x90, 0x50, 0x15, 0x96, 0x0b, 0x6d, 0x4a, 0x36, 0x72, 0x9a, 0x3d, 0xcf, 0x92, 0x7b, 0x6c, 0xcf,
-	0xf2, 0x6f, 0x30, 0x67, 0xe2, 0x63, 0xa2, 0x05, 0x37, 0x97, 0x9f, 0x94, 0x29, 0x66, 0x7c, 0x44,
-	0xc7, 0xfc, 0xbb, 0xee, 0xd2, 0x43, 0x83, 0x9e, 0x63, 0xb1, 0xd1, 0xb6, 0x5c, 0xec, 0x68, 0x7a,
-	0xb7, 0xeb, 0x60, 0xd7, 0x65, 0x59, 0x55, 0x89, 0xc5, 0x3b, 0xd6, 0x5f, 0xe3, 0xdd, 0xca, 0x27,
-	0x6c, 0x73, 0xe2, 0xa2, 0xa3, 0x67, 0x7a, 0x29, 0x30, 0x7d, 0x1b, 0xe6, 0x84, 0x7c, 0x37, 0x62,
-	0x7d, 0x9e, 0x9e, 0x5e, 0x4a, 0x4a, 0xba, 0x42, 0x56, 0x47, 0x9e, 0x7c, 0xb2, 0xe1, 0xd3, 0x4f,
-	0x68, 0x78, 0x04, 0x19, 0x66, 0x96, 0x0c, 0x77, 0x37, 0xf4, 0xf7, 0x3f, 0xda, 0x66, 0x7c, 0x9c,
-	0x86, 0x99, 0x89, 0xc4, 0xc2, 0x5f, 0x98, 0x14, 0xbb, 0xb0, 0x54, 0xec, 0xc2, 0xd2, 0x8f, 0xbd,
-	0x30, 0xb1, 0xdb, 0x99, 0xb3, 0x77, 0x3b, 0xfb, 0x6d, 0xee, 0x76, 0xee, 0x09, 0x77, 0xfb, 0xef,
-	0xba, 0x0f, 0x9f, 0x4b, 0xb0, 0x90, 0x9c, 0x8e, 0xc5, 0x6e, 0xc8, 0x4d, 0x98, 0xf1, 0xa7, 0xe2,
-	0xab, 0xe7, 0xee, 0x51, 0xf6, 0x07, 0x84, 0xfe, 0xc4, 0x88, 0x77, 0x15, 0x2a, 0x63, 0xd9, 0x22,
-	0x3f, 0xcc, 0xe5, 0xa3, 0x48, 0xde, 0x77, 0x0b, 0xe6, 0x4d, 0xcb, 0xd4, 0x1c, 0x7b, 0x3c, 0xb7,
-	0xcc, 0x8a, 0xc5, 0x5b, 0xa6, 0x6a, 0x47, 0x66, 0xae, 0xfc, 0x32, 0x0d, 0x73, 0x71, 0x39, 0x60,
-	0xcc, 0x25, 0x57, 0x61, 0xb6, 0x8b, 0x3b, 0x46, 0xf7, 0x89, 0xef, 0xf8, 0x8c, 0x10, 0xff, 0xd7,
-	0x15, 0x9f, 0x3c, 0x5a, 0xe8, 0x06, 0xcc, 0xb8, 0x27, 0x66, 0xc7, 0x30, 0x0f, 0x35, 0x62, 0x79,
-	0xe9, 0x54, 0x81, 0xcd, 0x7c, 0x5a, 0x0c, 0xb4, 0x2d, 0x91, 0x50, 0xfd, 0x1c, 0x20, 0xaf, 0x62,
-	0xd7, 0xa6, 0xf9, 0x1f, 0xaa, 0x43, 0x01, 0x1f, 0x77, 0xb0, 0x4d, 0xbc, 0x9c, 0x39, 0xa1, 0x2c,
-	0x11, 0x10, 0x4f, 0x8e, 0x96, 0xe7, 0xbe, 0x1c, 0x7a, 0x49, 0xb0, 0x10, 0x89, 0x7c, 0x02, 0xcf,
-	0xee, 0x7d, 0x51, 0x4e, 0x43, 0xbc, 0xe2, 0xd1, 0x10, 0xe9, 0xa4, 0xe2, 0x5a, 0xe4, 0xfa, 0xbe,
-	0x9c, 0xe0, 0x21, 0x5e, 0x12, 0x3c, 0x44, 0x26, 0xe9, 0x73, 0xbc, 0x24, 0x08, 0x3e, 0xc7, 0x88,
-	0x88, 0xdb, 0x11, 0x22, 0x22, 0x97, 0xb4, 0xd4, 0x50, 0xee, 0x1e, 0x2c, 0x35, 0x60, 0x22, 0x5e,
-	0xf1, 0x98, 0x88, 0xa9, 0xa4, 0x49, 0x8b, 0x64, 0x35, 0x98, 0x34, 0xa7, 0x22, 0xde, 0x0a, 0x51,
-	0x11, 0x05, 0x26, 0x7b, 0xf9, 0x14, 0x2a, 0xc2, 0x97, 0xf6, 0xb9, 0x88, 0xd7, 0x7d, 0x2e, 0xa2,
-	0x94, 0x48, 0x64, 0x88, 0x2c, 0xd3, 0x17, 0xf6, 0xc8, 0x88, 0xe6, 0x04, 0x19, 0xc1, 0xb9, 0x83,
-	0x6b, 0x67, 0x92, 0x11, 0xbe, 0xaa, 0x31, 0x36, 0xa2, 0x39, 0xc1, 0x46, 0x54, 0x92, 0x34, 0x8e,
-	0xa5, 0xb4, 0x81, 0xc6, 0x28, 0x1d, 0xf1, 0x3f, 0xf1, 0x74, 0x44, 0x22, 0x5f, 0x10, 0x93, 0xbe,
-	0xfa, 0xaa, 0x63, 0xf8, 0x88, 0xf7, 0x13, 0xf8, 0x08, 0x39, 0xa9, 0x6e, 0x8e, 0x4b, 0x5e, 0xfd,
-	0x0f, 0xc4, 0x11, 0x12, 0xfb, 0x31, 0x84, 0x04, 0x67, 0x0e, 0x9e, 0x7b, 0x04, 0x42, 0xc2, 0x57,
-	0x3d, 0xc1, 0x48, 0xec, 0xc7, 0x30, 0x12, 0x28, 0x59, 0xef, 0x58, 0xce, 0x15, 0xd6, 0x1b, 0xa5,
-	0x24, 0xde, 0x89, 0x52, 0x12, 0xb3, 0xa7, 0xa7, 0xba, 0x3c, 0x73, 0xf0, 0xb5, 0x85, 0x39, 0x89,
-	0x4e, 0x12, 0x27, 0xc1, 0x69, 0x83, 0x17, 0x1e, 0x91, 0x93, 0xf0, 0x75, 0xc7, 0x92, 0x12, 0xcd,
-	0x09, 0x52, 0x62, 0x3e, 0xe9, 0xc0, 0x8d, 0x05, 0xa4, 0xe0, 0xc0, 0x25, 0xb2, 0x12, 0x59, 0x39,
-	0xb7, 0x9d, 0xc9, 0xe7, 0xe5, 0x02, 0xe7, 0x23, 0xb6, 0x33, 0xf9, 0xa2, 0x5c, 0x52, 0x9e, 0xa3,
-	0x59, 0xd3, 0x98, 0xdf, 0xa3, 0x35, 0x0a, 0x76, 0x1c, 0xcb, 0x11, 0xfc, 0x02, 0x6f, 0x28, 0xd7,
-	0xa1, 0x14, 0x76, 0x71, 0xa7, 0x30, 0x18, 0xd3, 0x50, 0x8e, 0x78, 0x35, 0xe5, 0xaf, 0x29, 0x28,
-	0x85, 0xfd, 0x55, 0xa4, 0xbe, 0x2d, 0x88, 0xfa, 0x36, 0xc4, 0x6b, 0xa4, 0xa2, 0xbc, 0xc6, 0x12,
-	0x14, 0x69, 0x8d, 0x37, 0x46, 0x59, 0xe8, 0xb6, 0x4f, 0x59, 0xdc, 0x80, 0x19, 0x16, 0x6f, 0x39,
-	0xfb, 0x21, 0x22, 0x43, 0x86, 0x47, 0x06, 0x3a, 0xc0, 0x8c, 0xc1, 0x23, 0x03, 0x7a, 0x01, 0x66,
-	0x43, 0x58, 0xbf, 0x76, 0xe4, 0xf1, 0x5f, 0xf6, 0xd1, 0x35, 0x5e, 0x44, 0xa2, 0xff, 0x82, 0xe9,
-	0x81, 0x6e, 0xd2, 0xe3, 0x6e, 0x58, 0x8e, 0x41, 0x0c, 0xec, 0x8a, 0xbc, 0x6b, 0xed, 0x74, 0x97,
-	0xbc, 0xb2, 0xa3, 0x9b, 0xb8, 0xe9, 0x0b, 0x35, 0x4c, 0xe2, 0x9c, 0xa8, 0x95, 0x41, 0xa4, 0x13,
-	0x5d, 0x86, 0x52, 0x17, 0xf7, 0xf4, 0xd1, 0x80, 0x68, 0x74, 0x84, 0xf9, 0xdb, 0x82, 0x5a, 0x14,
-	0x7d, 0x54, 0xc3, 0x42, 0x0d, 0x66, 0x63, 0x34, 0xd1, 0xdc, 0xe3, 0x21, 0x3e, 0x11, 0xf6, 0xa3,
-	0x3f, 0xe9, 0xa6, 0xb1, 0xad, 0x16, 0x85, 0x2b, 0x6f, 0xbc, 0x9e, 0x7a, 0x55, 0x52, 0x7e, 0x23,
-	0xc1, 0xcc, 0x84, 0xc7, 0x8f, 0x65, 0x56, 0xa4, 0x6f, 0x8b, 0x59, 0x49, 0x3d, 0x39, 0xb3, 0x12,
-	0x2e, 0xe8, 0xd3, 0xd1, 0x82, 0xfe, 0x2f, 0x12, 0x94, 0x23, 0x91, 0x87, 0x9e, 0xa3, 0x8e, 0xd5,
-	0xc5, 0xa2, 0xc4, 0x66, 0xbf, 0xa9, 0x69, 0x06, 0xd6, 0xa1, 0x28, 0xa4, 0xe9, 0x4f, 0x8a, 0xf2,
-	0x63, 0x69, 0x41, 0x44, 0x4a, 0xbf, 0x3a, 0xe7, 0xa9, 0x8f, 0xa8, 0xce, 0x85, 0x59, 0x73, 0xec,
-	0xbb, 0x51, 0xb3, 0xf2, 0x14, 0x86, 0x37, 0xd0, 0x6b, 0x50, 0x60, 0xef, 0x28, 0x9a, 0x65, 0xbb,
-	0x82, 0x74, 0x0f, 0xa5, 0x77, 0xfc, 0xb1, 0x65, 0xe5, 0xe8, 0x16, 0x75, 0x55, 0x56, 0x6f, 0xcf,
-	0x76, 0xd5, 0xbc, 0x2d, 0x7e, 0x85, 0x92, 0xae, 0x42, 0x24, 0xe9, 0xba, 0x04, 0x05, 0x3a, 0x7d,
-	0xd7, 0xd6, 0x3b, 0xb8, 0x0a, 0x6c, 0xa6, 0x41, 0x87, 0xf2, 0xeb, 0x14, 0x4c, 0x8f, 0x05, 0xce,
-	0xd8, 0xc5, 0x7b, 0x17, 0x2b, 0x15, 0x22, 0x8e, 0x1e, 0xcd, 0x20, 0x8b, 0x00, 0x87, 0xba, 0xab,
-	0x7d, 0xa4, 0x9b, 0x04, 0x77, 0x85, 0x55, 0x42, 0x3d, 0x68, 0x01, 0xf2, 0xb4, 0x35, 0x72, 0x71,
-	0x57, 0x70, 0x58, 0x7e, 0x1b, 0x6d, 0x41, 0x0e, 0x1f, 0x61, 0x93, 0xb8, 0xd5, 0x29, 0xb6, 0xf1,
-	0x17, 0x62, 0x3c, 0x2c, 0x1d, 0x5f, 0xaf, 0xd2, 0xed, 0xfe, 0xd3, 0x97, 0x4b, 0x32, 0x87, 0x3f,
-	0x6f, 0x0d, 0x0d, 0x82, 0x87, 0x36, 0x39, 0x51, 0x85, 0x82, 0xa8, 0x19, 0xf2, 0x63, 0x66, 0x40,
-	0x17, 0x60, 0x8a, 0xdd, 0x46, 0xa3, 0xcb, 0x32, 0x84, 0x82, 0x9a, 0xa3, 0xcd, 0xad, 0x2e, 0x63,
-	0x5a, 0x4b, 0x1e, 0x6d, 0x42, 0xad, 0xcd, 0xae, 0xcb, 0x89, 0x5a, 0x1e, 0xe2, 0xa1, 0x6d, 0x59,
-	0x03, 0x8d, 0xfb, 0xb0, 0x1a, 0x54, 0xa2, 0x09, 0x04, 0xba, 0x02, 0x65, 0x07, 0x13, 0xdd, 0x30,
-	0xb5, 0x48, 0x59, 0x51, 0xe2, 0x9d, 0xdc, 0x67, 0x6c, 0x67, 0xf2, 0x92, 0x9c, 0x12, 0x4c, 0xd7,
-	0xbb, 0x30, 0x1f, 0x9b, 0x3f, 0xa0, 0x57, 0xa1, 0x10, 0xe4, 0x1e, 0x12, 0xb3, 0xc3, 0x69, 0x14,
-	0x56, 0x00, 0x56, 0xf6, 0x61, 0x3e, 0x36, 0x81, 0x40, 0x6f, 0x42, 0xce, 0xc1, 0xee, 0x68, 0xc0,
-	0x59, 0xaa, 0xca, 0xda, 0xd5, 0xb3, 0x33, 0x8f, 0xd1, 0x80, 0xa8, 0x42, 0x48, 0xb9, 0x05, 0x17,
-	0x13, 0x33, 0x88, 0x80, 0x88, 0x92, 0x42, 0x44, 0x94, 0xf2, 0x0b, 0x09, 0x16, 0x92, 0xb3, 0x02,
-	0xb4, 0x3e, 0x36, 0xa1, 0x1b, 0x8f, 0x98, 0x53, 0x84, 0x66, 0x45, 0x2b, 0x35, 0x07, 0xf7, 0x30,
-	0xe9, 0xf4, 0x79, 0x7a, 0xc2, 0xbd, 0x45, 0x59, 0x2d, 0x8b, 0x5e, 0x26, 0xe3, 0x72, 0xd8, 0x07,
-	0xb8, 0x43, 0x34, 0xbe, 0xa9, 0x2e, 0x2b, 0x7d, 0x0a, 0x14, 0x46, 0x7b, 0x5b, 0xbc, 0x53, 0xb9,
-	0x09, 0x17, 0x12, 0xf2, 0x8c, 0xc9, 0xfa, 0x4c, 0x79, 0x40, 0xc1, 0xb1, 0xc9, 0x03, 0x7a, 0x1b,
-	0x72, 0x2e, 0xd1, 0xc9, 0xc8, 0x15, 0x2b, 0xbb, 0x76, 0x66, 0xde, 0xd1, 0x62, 0x70, 0x55, 0x88,
-	0x29, 0x18, 0xd0, 0x64, 0x16, 0x11, 0x53, 0x96, 0x4a, 0x71, 0x65, 0xe9, 0x75, 0x90, 0x45, 0x59,
-	0x1a, 0x00, 0xf9, 0x15, 0xae, 0xb0, 0x8a, 0x34, 0xa8, 0x46, 0x0f, 0xe0, 0xa9, 0x53, 0x32, 0x0b,
-	0x54, 0x1f, 0x5b, 0xc6, 0xcd, 0x47, 0x4a, 0x4c, 0xc6, 0x96, 0xf2, 0xab, 0x34, 0xcc, 0xc7, 0x26,
-	0x18, 0xa1, 0x8b, 0x2e, 0x7d, 0xd3, 0x8b, 0xfe, 0x26, 0x00, 0x39, 0xd6, 0xf8, 0x99, 0xf0, 0x02,
-	0x46, 0x5c, 0x55, 0x75, 0x8c, 0x3b, 0xcc, 0xe7, 0xd1, 0x23, 0x54, 0x20, 0xe2, 0x97, 0x8b, 0xda,
-	0x61, 0xd2, 0x60, 0xc4, 0x82, 0x89, 0x2b, 0x8a, 0xe3, 0x47, 0x0e, 0x3b, 0x01, 0xbb, 0xc0, 0xbb,
-	0x5d, 0xf4, 0x00, 0x2e, 0x8c, 0x05, 0x45, 0x5f, 0x77, 0xe6, 0x91, 0x63, 0xe3, 0x7c, 0x34, 0x36,
-	0x7a, 0xba, 0xc3, 0x81, 0x2d, 0x1b, 0x09, 0x6c, 0x34, 0x16, 0xb3, 0xb2, 0x99, 0xe7, 0x24, 0x5d,
-	0x3c, 0xd0, 0xbd, 0x57, 0xe0, 0x8b, 0x13, 0xc5, 0xf7, 0x6d, 0xf1, 0x50, 0xce, 0x6b, 0xef, 0x1f,
-	0xd1, 0xda, 0xbb, 0x42, 0x85, 0xd9, 0x46, 0xdd, 0xa6, 0xa2, 0xca, 0x03, 0x80, 0x80, 0x59, 0xa0,
-	0x17, 0xdd, 0xb1, 0x46, 0x66, 0x97, 0x9d, 0x88, 0xac, 0xca, 0x1b, 0xe8, 0x65, 0xc8, 0xd2, 0x23,
-	0xe8, 0x59, 0x3e, 0xc6, 0x53, 0xd1, 0x13, 0x12, 0xa2, 0x26, 0x38, 0x5c, 0xf9, 0xc0, 0x3b, 0xe6,
-	0x61, 0x5e, 0x38, 0xe1, 0x1b, 0x6f, 0x45, 0xbf, 0xa1, 0x24, 0x53, 0xcc, 0xf1, 0xdf, 0xfa, 0x7f,
-	0xc8, 0xb2, 0xd3, 0x44, 0xe3, 0x15, 0x7b, 0x96, 0x10, 0xe9, 0x22, 0xfd, 0x8d, 0xfe, 0x17, 0x40,
-	0x27, 0xc4, 0x31, 0x0e, 0x46, 0xc1, 0x17, 0x96, 0x13, 0x8e, 0x63, 0xcd, 0x03, 0xae, 0x5f, 0x12,
-	0xe7, 0x72, 0x2e, 0x90, 0x0d, 0x9d, 0xcd, 0x90, 0x46, 0x65, 0x17, 0x2a, 0x51, 0xd9, 0xb3, 0x72,
-	0xae, 0x82, 0x97, 0x1c, 0xf8, 0xa9, 0x45, 0x9a, 0x3f, 0xbe, 0xb0, 0x86, 0xf2, 0x9d, 0x14, 0x94,
-	0xc2, 0x87, 0xf9, 0x9f, 0x30, 0x7c, 0x2b, 0xdf, 0x97, 0x20, 0xef, 0xaf, 0x3f, 0xfa, 0x04, 0x13,
-	0x79, 0xbb, 0xe2, 0xe6, 0x4b, 0x85, 0xdf, 0x4d, 0xf8, 0x4b, 0x55, 0xda, 0x7f, 0xa9, 0xfa, 0x0f,
-	0x3f, 0x12, 0x25, 0x32, 0x24, 0x61, 0x6b, 0x8b, 0x83, 0xe5, 0x45, 0xc6, 0x37, 0xa0, 0xe0, 0xbb,
-	0x04, 0x5a, 0x78, 0x78, 0xcc, 0x93, 0x24, 0xee, 0xa5, 0x60, 0x9c, 0xe6, 0x20, 0x6b, 0x5b, 0x1f,
-	0x89, 0x57, 0x99, 0xb4, 0xca, 0x1b, 0x8a, 0x0b, 0xd3, 0x63, 0xfe, 0x24, 0x00, 0xa6, 0x42, 0x40,
-	0xa4, 0x40, 0xd9, 0x1e, 0x1d, 0x68, 0x0f, 0xf1, 0x89, 0x78, 0xa3, 0xe1, 0xd3, 0x2f, 0xda, 0xa3,
-	0x83, 0x3b, 0xf8, 0x84, 0x3f, 0xd2, 0x2c, 0x43, 0xc9, 0xc3, 0xb0, 0x23, 0xce, 0xf7, 0x14, 0x38,
-	0xa4, 0xcd, 0x1f, 0xd8, 0x24, 0x39, 0xa5, 0xfc, 0x50, 0x82, 0xbc, 0x77, 0x4b, 0xd0, 0xdb, 0x50,
-	0xf0, 0x5d, 0x97, 0x48, 0xda, 0x9f, 0x3a, 0xc5, 0xe9, 0x89, 0xc5, 0x07, 0x32, 0x68, 0xdd, 0x7b,
-	0x29, 0x36, 0xba, 0x5a, 0x6f, 0xa0, 0x1f, 0x8a, 0x07, 0xbf, 0xc5, 0x18, 0xef, 0xc6, 0xfc, 0xca,
-	0xd6, 0xed, 0x8d, 0x81, 0x7e, 0xa8, 0x16, 0x99, 0xd0, 0x56, 0x97, 0x36, 0x44, 0x3a, 0xf4, 0xc7,
-	0x14, 0xc8, 0xe3, 0xb7, 0xf8, 0x9b, 0xcf, 0x6f, 0x32, 0x6c, 0xa6, 0xe3, 0xc2, 0xe6, 0x2a, 0xcc,
-	0xfa, 0x08, 0xcd, 0x35, 0x0e, 0x4d, 0x9d, 0x8c, 0x1c, 0x2c, 0x38, 0x4e, 0xe4, 0x0f, 0xb5, 0xbc,
-	0x91, 0xc9, 0x75, 0x67, 0x1f, 0x7b, 0xdd, 0xc9, 0x14, 0x72, 0x2e, 0x89, 0x42, 0x46, 0x6f, 0xc0,
-	0xc2, 0x78, 0x78, 0x0f, 0x4d, 0x97, 0x57, 0x16, 0x17, 0xa2, 0x81, 0xde, 0x9f, 0xb3, 0xb0, 0xf3,
-	0xc7, 0x29, 0x28, 0x86, 0x28, 0x5e, 0xf4, 0xef, 0x21, 0x97, 0x58, 0x89, 0x0b, 0x79, 0x21, 0x70,
-	0xf0, 0x5a, 0x1b, 0xdd, 0x99, 0xd4, 0x13, 0xec, 0x4c, 0x12, 0xff, 0xee, 0x71, 0xc6, 0x99, 0xc7,
-	0xe6, 0x8c, 0x9f, 0x07, 0x44, 0x2c, 0xa2, 0x0f, 0xa8, 0x39, 0x0d, 0xf3, 0x50, 0xe3, 0x17, 0x89,
-	0x7b, 0x30, 0x99, 0x8d, 0xec, 0xb3, 0x81, 0x26, 0xbb, 0x7c, 0xdf, 0x95, 0x20, 0xef, 0xf3, 0x69,
-	0x8f, 0xfb, 0x8a, 0x7b, 0x1e, 0x72, 0x22, 0xe5, 0xe4, 0xcf, 0xb8, 0xa2, 0x15, 0x4b, 0x8e, 0x2f,
-	0x40, 0x7e, 0x88, 0x89, 0xce, 0xdc, 0x31, 0x0f, 0xd7, 0x7e, 0xfb, 0xc6, 0x01, 0x14, 0x43, 0x0f,
-	0xe1, 0xe8, 0x22, 0xcc, 0xd7, 0x37, 0x1b, 0xf5, 0x3b, 0x5a, 0xfb, 0x3d, 0xad, 0x7d, 0xbf, 0xd9,
-	0xd0, 0xee, 0xed, 0xde, 0xd9, 0xdd, 0xfb, 0xcf, 0x5d, 0xf9, 0xdc, 0xe4, 0x90, 0xda, 0x60, 0x6d,
-	0x59, 0x42, 0x17, 0x60, 0x36, 0x3a, 0xc4, 0x07, 0x52, 0x0b, 0x99, 0x1f, 0xfc, 0x6c, 0xf1, 0xdc,
-	0x8d, 0x3f, 0x4b, 0x30, 0x1b, 0x93, 0xdc, 0xa3, 0xcb, 0xf0, 0xf4, 0xde, 0xc6, 0x46, 0x43, 0xd5,
-	0x5a, 0xbb, 0xb5, 0x66, 0x6b, 0x73, 0xaf, 0xad, 0xa9, 0x8d, 0xd6, 0xbd, 0x9d, 0x76, 0xe8, 0xa3,
-	0xcb, 0x70, 0x29, 0x1e, 0x52, 0xab, 0xd7, 0x1b, 0xcd, 0xb6, 0x2c, 0xa1, 0x25, 0x78, 0x2a, 0x01,
-	0xb1, 0xbe, 0xa7, 0xb6, 0xe5, 0x54, 0xb2, 0x0a, 0xb5, 0xb1, 0xdd, 0xa8, 0xb7, 0xe5, 0x34, 0xba,
-	0x06, 0x57, 0x4e, 0x43, 0x68, 0x1b, 0x7b, 0xea, 0xdd, 0x5a, 0x5b, 0xce, 0x9c, 0x09, 0x6c, 0x35,
-	0x76, 0x6f, 0x37, 0x54, 0x39, 0x2b, 0xd6, 0xfd, 0xd3, 0x14, 0x54, 0x93, 0x6a, 0x08, 0xaa, 0xab,
-	0xd6, 0x6c, 0xee, 0xdc, 0x0f, 0x74, 0xd5, 0x37, 0xef, 0xed, 0xde, 0x99, 0x34, 0xc1, 0xb3, 0xa0,
-	0x9c, 0x06, 0xf4, 0x0d, 0x71, 0x15, 0x2e, 0x9f, 0x8a, 0x13, 0xe6, 0x38, 0x03, 0xa6, 0x36, 0xda,
-	0xea, 0x7d, 0x39, 0x8d, 0x56, 0xe0, 0xc6, 0x99, 0x30, 0x7f, 0x4c, 0xce, 0xa0, 0x55, 0xb8, 0x79,
-	0x3a, 0x9e, 0x1b, 0xc8, 0x13, 0xf0, 0x4c, 0xf4, 0x89, 0x04, 0xf3, 0xb1, 0xc5, 0x08, 0xba, 0x02,
-	0x4b, 0x4d, 0x75, 0xaf, 0xde, 0x68, 0xb5, 0xb4, 0xa6, 0xba, 0xd7, 0xdc, 0x6b, 0xd5, 0x76, 0xb4,
-	0x56, 0xbb, 0xd6, 0xbe, 0xd7, 0x0a, 0xd9, 0x46, 0x81, 0xc5, 0x24, 0x90, 0x6f, 0x97, 0x53, 0x30,
-	0xe2, 0x04, 0x78, 0xe7, 0xf4, 0x27, 0x12, 0x5c, 0x4c, 0x2c, 0x29, 0xd0, 0x75, 0x78, 0x66, 0xbf,
-	0xa1, 0x6e, 0x6d, 0xdc, 0xd7, 0xf6, 0xf7, 0xda, 0x0d, 0xad, 0xf1, 0x5e, 0xbb, 0xb1, 0xdb, 0xda,
-	0xda, 0xdb, 0x9d, 0x9c, 0xd5, 0x35, 0xb8, 0x72, 0x2a, 0xd2, 0x9f, 0xda, 0x59, 0xc0, 0xb1, 0xf9,
-	0x7d, 0x4f, 0x82, 0xe9, 0x31, 0x5f, 0x88, 0x2e, 0x41, 0xf5, 0xee, 0x56, 0x6b, 0xbd, 0xb1, 0x59,
-	0xdb, 0xdf, 0xda, 0x53, 0xc7, 0xef, 0xec, 0x15, 0x58, 0x9a, 0x18, 0xbd, 0x7d, 0xaf, 0xb9, 0xb3,
-	0x55, 0xaf, 0xb5, 0x1b, 0xec, 0xa3, 0xb2, 0x44, 0x17, 0x36, 0x01, 0xda, 0xd9, 0x7a, 0x67, 0xb3,
-	0xad, 0xd5, 0x77, 0xb6, 0x1a, 0xbb, 0x6d, 0xad, 0xd6, 0x6e, 0xd7, 0x82, 0xeb, 0xbc, 0x7e, 0xe7,
-	0xb3, 0xaf, 0x16, 0xa5, 0x2f, 0xbe, 0x5a, 0x94, 0xfe, 0xf0, 0xd5, 0xa2, 0xf4, 0xe9, 0xd7, 0x8b,
-	0xe7, 0xbe, 0xf8, 0x7a, 0xf1, 0xdc, 0xef, 0xbe, 0x5e, 0x3c, 0xf7, 0xe0, 0xd6, 0xa1, 0x41, 0xfa,
-	0xa3, 0x03, 0xea, 0x85, 0x57, 0x83, 0xff, 0xeb, 0xfa, 0x7f, 0xf4, 0xb5, 0x8d, 0xd5, 0xf1, 0x7f,
-	0xfd, 0x1e, 0xe4, 0x98, 0x5b, 0x7d, 0xf1, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x54, 0xeb,
-	0x69, 0x10, 0x2c, 0x00, 0x00,
+	// 3433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xd9, 0xf7, 0x92, 0x14, 0x45, 0x3e, 0xfc, 0xd0, 0x6a, 0x24, 0xd9, 0xb4, 0xe2, 0x48, 0xf2, 0x3a,
+	0x8e, 0x1d, 0x3b, 0x91, 0x5e, 0x2b, 0xef, 0x9b, 0xcf, 0x37, 0x09, 0x28, 0x99, 0x8a, 0x24, 0xcb,
+	0x12, 0xb3, 0xa4, 0xf5, 0xc6, 0x7e, 0x3f, 0x36, 0x2b, 0x72, 0x28, 0x6d, 0x4c, 0xee, 0x6e, 0x76,
+	0x87, 0x0c, 0xf9, 0xf6, 0xd4, 0x02, 0x29, 0xda, 0x9c, 0x72, 0x29, 0x50, 0x14, 0x28, 0x50, 0xa0,
+	0xe8, 0xb1, 0x3d, 0xf4, 0xd0, 0x3f, 0xa1, 0xc8, 0xa9, 0xc9, 0xb1, 0xa7, 0xb4, 0x48, 0xd0, 0x4b,
+	0xef, 0x05, 0x0a, 0xf4, 0x52, 0xcc, 0xc7, 0x7e, 0x91, 0xbb, 0x92, 0xed, 0x24, 0x87, 0xa2, 0xbd,
+	0x71, 0x66, 0x7e, 0xcf, 0x33, 0xb3, 0xcf, 0xcc, 0x3c, 0x1f, 0xbf, 0x21, 0x5c, 0x6a, 0x59, 0x3d,
+	0x4c, 0x8e, 0x3a, 0x64, 0x4d, 0x3f, 0x6a, 0x19, 0x6b, 0x83, 0xf5, 0x35, 0x32, 0xb2, 0xb1, 0xbb,
+	0x6a, 0x3b, 0x16, 0xb1, 0x90, 0xec, 0x8d, 0xae, 0xd2, 0xd1, 0xd5, 0xc1, 0xfa, 0xe2, 0x92, 0x8f,
+	0x6f, 0x39, 0x23, 0x9b, 0x58, 0x6b, 0x83, 0x5b, 0x6b, 0xb6, 0x63, 0x59, 0x1d, 0x2e, 0x11, 0x1a,
+	0x67, 0x7a, 0xa8, 0x42, 0x5b, 0x77, 0xf4, 0x9e, 0xd0, 0xb8, 0x78, 0x79, 0x72, 0x7c, 0xa0, 0x77,
+	0x8d, 0xb6, 0x4e, 0x2c, 0x47, 0x40, 0xe6, 0x8f, 0xad, 0x63, 0x8b, 0xfd, 0x5c, 0xa3, 0xbf, 0x44,
+	0xef, 0xf2, 0xb1, 0x65, 0x1d, 0x77, 0xf1, 0x1a, 0x6b, 0x1d, 0xf5, 0x3b, 0x6b, 0xc4, 0xe8, 0x61,
+	0x97, 0xe8, 0x3d, 0xdb, 0x9b, 0x79, 0x1c, 0xd0, 0xee, 0x3b, 0x3a, 0x31, 0x2c, 0x93, 0x8f, 0x2b,
+	0x9f, 0xe5, 0x61, 0x5a, 0xc5, 0x1f, 0xf4, 0xb1, 0x4b, 0xd0, 0x8b, 0x90, 0xc1, 0xad, 0x13, 0xab,
+	0x22, 0xad, 0x48, 0xd7, 0x0b, 0xeb, 0x4f, 0xaf, 0x8e, 0x7f, 0xe6, 0x6a, 0xad, 0x75, 0x62, 0x09,
+	0xf0, 0xf6, 0x39, 0x95, 0x81, 0xd1, 0x4b, 0x30, 0xd5, 0xe9, 0xf6, 0xdd, 0x93, 0x4a, 0x8a, 0x49,
+	0x2d, 0x4d, 0x4a, 0x6d, 0xd1, 0xe1, 0x40, 0x8c, 0xc3, 0xe9, 0x64, 0x86, 0xd9, 0xb1, 0x2a, 0xe9,
+	0xa4, 0xc9, 0x76, 0xcc, 0x4e, 0x78, 0x32, 0x0a, 0x46, 0x9b, 0x00, 0x86, 0x69, 0x10, 0xad, 0x75,
+	0xa2, 0x1b, 0x66, 0x65, 0x8a, 0x89, 0x2a, 0x71, 0xa2, 0x06, 0xd9, 0xa4, 0x90, 0x40, 0x3e, 0x6f,
+	0x78, 0x7d, 0x74, 0xc5, 0x1f, 0xf4, 0xb1, 0x33, 0xaa, 0x64, 0x93, 0x56, 0xfc, 0x0e, 0x1d, 0x0e,
+	0xad, 0x98, 0xc1, 0xd1, 0x1b, 0x90, 0x6b, 0x9d, 0xe0, 0xd6, 0x43, 0x8d, 0x0c, 0x2b, 0x39, 0x26,
+	0xba, 0x32, 0x29, 0xba, 0x49, 0x11, 0xcd, 0x61, 0x20, 0x3c, 0xdd, 0xe2, 0x3d, 0xe8, 0x55, 0xc8,
+	0xb6, 0xac, 0x5e, 0xcf, 0x20, 0x95, 0x02, 0x13, 0x5e, 0x8e, 0x11, 0x66, 0xe3, 0x81, 0xac, 0x10,
+	0x40, 0x07, 0x50, 0xee, 0x1a, 0x2e, 0xd1, 0x5c, 0x53, 0xb7, 0xdd, 0x13, 0x8b, 0xb8, 0x95, 0x22,
+	0x53, 0xf1, 0xec, 0xa4, 0x8a, 0x3d, 0xc3, 0x25, 0x0d, 0x0f, 0x16, 0x68, 0x2a, 0x75, 0xc3, 0xfd,
+	0x54, 0xa1, 0xd5, 0xe9, 0x60, 0xc7, 0xd7, 0x58, 0x29, 0x25, 0x29, 0x3c, 0xa0, 0x38, 0x4f, 0x32,
+	0xa4, 0xd0, 0x0a, 0xf7, 0xa3, 0xff, 0x81, 0xb9, 0xae, 0xa5, 0xb7, 0x7d, 0x7d, 0x5a, 0xeb, 0xa4,
+	0x6f, 0x3e, 0xac, 0x94, 0x99, 0xd6, 0x1b, 0x31, 0xcb, 0xb4, 0xf4, 0xb6, 0x27, 0xbc, 0x49, 0xa1,
+	0x81, 0xe6, 0xd9, 0xee, 0xf8, 0x18, 0xd2, 0x60, 0x5e, 0xb7, 0xed, 0xee, 0x68, 0x5c, 0xfd, 0x0c,
+	0x53, 0x7f, 0x73, 0x52, 0x7d, 0x95, 0xa2, 0x13, 0xf4, 0x23, 0x7d, 0x62, 0x10, 0xdd, 0x03, 0xd9,
+	0x76, 0xb0, 0xad, 0x3b, 0x58, 0xb3, 0x1d, 0xcb, 0xb6, 0x5c, 0xbd, 0x5b, 0x91, 0x99, 0xf2, 0xeb,
+	0x93, 0xca, 0xeb, 0x1c, 0x59, 0x17, 0xc0, 0x40, 0xf3, 0x8c, 0x1d, 0x1d, 0xe1, 0x6a, 0xad, 0x16,
+	0x76, 0xdd, 0x40, 0xed, 0x6c, 0xb2, 0x5a, 0x86, 0x8c, 0x55, 0x1b, 0x19, 0x41, 0x5b, 0x50, 0xc0,
+	0x43, 0x82, 0xcd, 0xb6, 0x36, 0xb0, 0x08, 0xae, 0x20, 0xa6, 0xf1, 0x4a, 0xcc, 0x75, 0x65, 0xa0,
+	0x43, 0x8b, 0xe0, 0x40, 0x19, 0x60, 0xbf, 0x13, 0x1d, 0xc1, 0xc2, 0x00, 0x3b, 0x46, 0x67, 0xc4,
+	0xf4, 0x68, 0x6c, 0xc4, 0x35, 0x2c, 0xb3, 0x32, 0xc7, 0x34, 0x3e, 0x3f, 0xa9, 0xf1, 0x90, 0xc1,
+	0xa9, 0x70, 0xcd, 0x03, 0x07, 0xaa, 0xe7, 0x06, 0x93, 0xa3, 0xf4, 0xa4, 0x75, 0x0c, 0x53, 0xef,
+	0x1a, 0xff, 0x8f, 0xb5, 0xa3, 0xae, 0xd5, 0x7a, 0x58, 0x99, 0x4f, 0x3a, 0x69, 0x5b, 0x02, 0xb7,
+	0x41, 0x61, 0xa1, 0x93, 0xd6, 0x09, 0xf7, 0x6f, 0x4c, 0xc3, 0xd4, 0x40, 0xef, 0xf6, 0xf1, 0x6e,
+	0x26, 0x97, 0x91, 0xa7, 0x76, 0x33, 0xb9, 0x69, 0x39, 0xb7, 0x9b, 0xc9, 0xe5, 0x65, 0xd8, 0xcd,
+	0xe4, 0x40, 0x2e, 0x28, 0xd7, 0xa0, 0x10, 0xf2, 0x53, 0xa8, 0x02, 0xd3, 0x3d, 0xec, 0xba, 0xfa,
+	0x31, 0x66, 0x7e, 0x2d, 0xaf, 0x7a, 0x4d, 0xa5, 0x0c, 0xc5, 0xb0, 0x6b, 0x52, 0x3e, 0x91, 0xa0,
+	0x10, 0x72, 0x3a, 0x54, 0x72, 0x80, 0x1d, 0x66, 0x10, 0x21, 0x29, 0x9a, 0xe8, 0x0a, 0x94, 0xd8,
+	0xb7, 0x68, 0xde, 0x38, 0xf5, 0x7d, 0x19, 0xb5, 0xc8, 0x3a, 0x0f, 0x05, 0x68, 0x19, 0x0a, 0xf6,
+	0xba, 0xed, 0x43, 0xd2, 0x0c, 0x02, 0xf6, 0xba, 0xed, 0x01, 0x2e, 0x43, 0x91, 0x7e, 0xba, 0x8f,
+	0xc8, 0xb0, 0x49, 0x0a, 0xb4, 0x4f, 0x40, 0x94, 0xdf, 0xa5, 0x40, 0x1e, 0x77, 0x66, 0xe8, 0x15,
+	0xc8, 0x50, 0x2f, 0x2f, 0xdc, 0xf4, 0xe2, 0x2a, 0xf7, 0xf0, 0xab, 0x9e, 0x87, 0x5f, 0x6d, 0x7a,
+	0x21, 0x60, 0x23, 0xf7, 0xe9, 0x17, 0xcb, 0xe7, 0x3e, 0xf9, 0xc3, 0xb2, 0xa4, 0x32, 0x09, 0x74,
+	0x91, 0x7a, 0x30, 0xdd, 0x30, 0x35, 0xa3, 0xcd, 0x96, 0x9c, 0xa7, 0xde, 0x49, 0x37, 0xcc, 0x9d,
+	0x36, 0xba, 0x0b, 0x72, 0xcb, 0x32, 0x5d, 0x6c, 0xba, 0x7d, 0x57, 0xe3, 0xb1, 0xa9, 0x92, 0x1e,
+	0xf7, 0xaf, 0x3c, 0x08, 0x32, 0x47, 0x25, 0xa0, 0x75, 0x86, 0x54, 0x67, 0x5a, 0xd1, 0x0e, 0xf4,
+	0x36, 0x80, 0x1f, 0xc0, 0xdc, 0x4a, 0x66, 0x25, 0x7d, 0xbd, 0xb0, 0x7e, 0x39, 0xe6, 0x3c, 0x79,
+	0x98, 0x7b, 0x76, 0x5b, 0x27, 0x78, 0x23, 0x43, 0x17, 0xac, 0x86, 0x44, 0xd1, 0xb3, 0x30, 0xa3,
+	0xdb, 0xb6, 0xe6, 0x12, 0x9d, 0x60, 0xed, 0x68, 0x44, 0xb0, 0xcb, 0xdc, 0x7e, 0x51, 0x2d, 0xe9,
+	0xb6, 0xdd, 0xa0, 0xbd, 0x1b, 0xb4, 0x13, 0x5d, 0x85, 0x32, 0xf5, 0xf0, 0x86, 0xde, 0xd5, 0x4e,
+	0xb0, 0x71, 0x7c, 0x42, 0x98, 0x77, 0x4f, 0xab, 0x25, 0xd1, 0xbb, 0xcd, 0x3a, 0x95, 0x36, 0x14,
+	0xc3, 0xce, 0x1d, 0x21, 0xc8, 0xb4, 0x75, 0xa2, 0x33, 0x5b, 0x16, 0x55, 0xf6, 0x9b, 0xf6, 0xd9,
+	0x3a, 0x39, 0x11, 0x16, 0x62, 0xbf, 0xd1, 0x79, 0xc8, 0x0a, 0xb5, 0x69, 0xa6, 0x56, 0xb4, 0xd0,
+	0x3c, 0x4c, 0xd9, 0x8e, 0x35, 0xc0, 0x6c, 0xf3, 0x72, 0x2a, 0x6f, 0x28, 0xf7, 0xa1, 0x1c, 0x8d,
+	0x03, 0xa8, 0x0c, 0x29, 0x32, 0x14, 0xb3, 0xa4, 0xc8, 0x10, 0xdd, 0x82, 0x0c, 0x35, 0x26, 0xd3,
+	0x56, 0x8e, 0x8b, 0x7e, 0x42, 0xbe, 0x39, 0xb2, 0xb1, 0xca, 0xa0, 0xbb, 0x99, 0x5c, 0x4a, 0x4e,
+	0x2b, 0x33, 0x50, 0x8a, 0x44, 0x09, 0xe5, 0x3c, 0xcc, 0xc7, 0xf9, 0x7c, 0xc5, 0x80, 0xf9, 0x38,
+	0xd7, 0x8d, 0x5e, 0x82, 0x9c, 0xef, 0xf4, 0xbd, 0x13, 0x34, 0x31, 0xbb, 0x2f, 0xe4, 0x63, 0xe9,
+	0xd9, 0xa1, 0x1b, 0x71, 0xa2, 0x8b, 0x50, 0x5f, 0x54, 0xa7, 0x75, 0xdb, 0xde, 0xd6, 0xdd, 0x13,
+	0xe5, 0x3d, 0xa8, 0x24, 0xf9, 0xf3, 0x90, 0xe1, 0x24, 0x76, 0x01, 0x3c, 0xc3, 0x9d, 0x87, 0x6c,
+	0xc7, 0x72, 0x7a, 0x3a, 0x61, 0xca, 0x4a, 0xaa, 0x68, 0x51, 0x83, 0x72, 0xdf, 0x9e, 0x66, 0xdd,
+	0xbc, 0xa1, 0x68, 0x70, 0x31, 0xd1, 0xa5, 0x53, 0x11, 0xc3, 0x6c, 0x63, 0x6e, 0xde, 0x92, 0xca,
+	0x1b, 0x81, 0x22, 0xbe, 0x58, 0xde, 0xa0, 0xd3, 0xba, 0xd8, 0x6c, 0x63, 0x87, 0xe9, 0xcf, 0xab,
+	0xa2, 0xa5, 0xfc, 0x24, 0x0d, 0xe7, 0xe3, 0xfd, 0x3a, 0x5a, 0x81, 0x62, 0x4f, 0x1f, 0x6a, 0x64,
+	0x28, 0x8e, 0x9f, 0xc4, 0x0e, 0x00, 0xf4, 0xf4, 0x61, 0x73, 0xc8, 0xcf, 0x9e, 0x0c, 0x69, 0x32,
+	0x74, 0x2b, 0xa9, 0x95, 0xf4, 0xf5, 0xa2, 0x4a, 0x7f, 0xa2, 0x43, 0x98, 0xed, 0x5a, 0x2d, 0xbd,
+	0xab, 0x75, 0x75, 0x97, 0x68, 0x22, 0xec, 0xf3, 0xeb, 0xf4, 0x4c, 0x92, 0x9f, 0xc6, 0x6d, 0xbe,
+	0xb1, 0xd4, 0x05, 0x89, 0x8b, 0x30, 0xc3, 0x94, 0xec, 0xe9, 0x2e, 0xe1, 0x43, 0xa8, 0x06, 0x85,
+	0x9e, 0xe1, 0x1e, 0xe1, 0x13, 0x7d, 0x60, 0x58, 0x8e, 0xb8, 0x57, 0x31, 0xa7, 0xe7, 0x6e, 0x00,
+	0x12, 0xaa, 0xc2, 0x72, 0xa1, 0x4d, 0x99, 0x8a, 0x9c, 0x66, 0xcf, 0xb3, 0x64, 0x1f, 0xdb, 0xb3,
+	0xfc, 0x1b, 0xcc, 0x9b, 0x78, 0x48, 0xb4, 0xe0, 0xe6, 0xf2, 0x93, 0x32, 0xcd, 0x8c, 0x8f, 0xe8,
+	0x98, 0x7f, 0xd7, 0x5d, 0x7a, 0x68, 0xd0, 0x73, 0x2c, 0x36, 0xda, 0x96, 0x8b, 0x1d, 0x4d, 0x6f,
+	0xb7, 0x1d, 0xec, 0xba, 0x2c, 0xab, 0x2a, 0xaa, 0x33, 0x5e, 0x7f, 0x95, 0x77, 0x2b, 0x1f, 0xb3,
+	0xcd, 0x89, 0x8b, 0x8e, 0x9e, 0xe9, 0xa5, 0xc0, 0xf4, 0x4d, 0x98, 0x17, 0xf2, 0xed, 0x88, 0xf5,
+	0x79, 0x7a, 0x7a, 0x29, 0x29, 0xe9, 0x0a, 0x59, 0x1d, 0x79, 0xf2, 0xc9, 0x86, 0x4f, 0x3f, 0xa1,
+	0xe1, 0x11, 0x64, 0x98, 0x59, 0x32, 0xdc, 0xdd, 0xd0, 0xdf, 0xff, 0x68, 0x9b, 0xf1, 0x51, 0x1a,
+	0x66, 0x27, 0x12, 0x0b, 0xff, 0xc3, 0xa4, 0xd8, 0x0f, 0x4b, 0xc5, 0x7e, 0x58, 0xfa, 0xb1, 0x3f,
+	0x4c, 0xec, 0x76, 0xe6, 0xec, 0xdd, 0x9e, 0xfa, 0x26, 0x77, 0x3b, 0xfb, 0x84, 0xbb, 0xfd, 0xad,
+	0xee, 0xc3, 0x67, 0x12, 0x2c, 0x26, 0xa7, 0x63, 0xb1, 0x1b, 0x72, 0x13, 0x66, 0xfd, 0xa5, 0xf8,
+	0xea, 0xb9, 0x7b, 0x94, 0xfd, 0x01, 0xa1, 0x3f, 0x31, 0xe2, 0x5d, 0x85, 0xf2, 0x58, 0xb6, 0xc8,
+	0x0f, 0x73, 0x69, 0x10, 0xc9, 0xfb, 0x6e, 0xc1, 0x82, 0x69, 0x99, 0x9a, 0x63, 0x8f, 0xe7, 0x96,
+	0x53, 0xe2, 0xe3, 0x2d, 0x53, 0xb5, 0x23, 0x2b, 0x57, 0x7e, 0x9d, 0x86, 0xf9, 0xb8, 0x1c, 0x30,
+	0xe6, 0x92, 0xab, 0x30, 0xd7, 0xc6, 0x2d, 0xa3, 0xfd, 0xc4, 0x77, 0x7c, 0x56, 0x88, 0xff, 0xeb,
+	0x8a, 0x4f, 0x1e, 0x2d, 0x74, 0x03, 0x66, 0xdd, 0x91, 0xd9, 0x32, 0xcc, 0x63, 0x8d, 0x58, 0x5e,
+	0x3a, 0x95, 0x67, 0x2b, 0x9f, 0x11, 0x03, 0x4d, 0x4b, 0x24, 0x54, 0xbf, 0x00, 0xc8, 0xa9, 0xd8,
+	0xb5, 0x69, 0xfe, 0x87, 0x36, 0x21, 0x8f, 0x87, 0x2d, 0x6c, 0x13, 0x2f, 0x67, 0x4e, 0x28, 0x4b,
+	0x04, 0xc4, 0x93, 0xa3, 0xe5, 0xb9, 0x2f, 0x87, 0xfe, 0x5d, 0xb0, 0x10, 0x89, 0x7c, 0x02, 0xcf,
+	0xee, 0x7d, 0x51, 0x86, 0x46, 0x2f, 0x7b, 0x34, 0x44, 0x3a, 0xa9, 0xb8, 0x16, 0xb9, 0xbe, 0x2f,
+	0xc7, 0xf1, 0x74, 0x3a, 0xc6, 0x43, 0x64, 0x92, 0xa6, 0xe3, 0x25, 0x41, 0x30, 0x1d, 0x45, 0xa3,
+	0xdb, 0x11, 0x22, 0x22, 0x9b, 0xf4, 0xa9, 0xa1, 0xdc, 0x3d, 0xf8, 0xd4, 0x80, 0x89, 0x78, 0xd9,
+	0x63, 0x22, 0xa6, 0x93, 0x16, 0x2d, 0x92, 0xd5, 0x60, 0xd1, 0x0c, 0x8f, 0xde, 0x0c, 0x51, 0x11,
+	0xf9, 0x15, 0x29, 0x3e, 0xb9, 0xf6, 0x53, 0x50, 0x5f, 0xda, 0xe7, 0x22, 0x5e, 0xf3, 0xb9, 0x88,
+	0x62, 0x22, 0x91, 0x21, 0xb2, 0x4c, 0x5f, 0x58, 0x48, 0xa0, 0xfa, 0x04, 0x19, 0xc1, 0xb9, 0x83,
+	0x6b, 0x67, 0x92, 0x11, 0xbe, 0xaa, 0x31, 0x36, 0xa2, 0x3e, 0xc1, 0x46, 0x94, 0x93, 0x34, 0x8e,
+	0xa5, 0xb4, 0x81, 0xc6, 0x28, 0x1d, 0xf1, 0xbf, 0xf1, 0x74, 0x44, 0x22, 0x5f, 0x10, 0x93, 0xbe,
+	0xfa, 0xaa, 0x63, 0xf8, 0x88, 0xf7, 0x12, 0xf8, 0x08, 0x39, 0xa9, 0x6e, 0x8e, 0x4b, 0x5e, 0xfd,
+	0x09, 0xe2, 0x08, 0x89, 0xc3, 0x18, 0x42, 0x82, 0x33, 0x07, 0xcf, 0x3d, 0x02, 0x21, 0xe1, 0xab,
+	0x9e, 0x60, 0x24, 0x0e, 0x63, 0x18, 0x09, 0x94, 0xac, 0x77, 0x2c, 0xe7, 0x0a, 0xeb, 0x8d, 0x0c,
+	0xa1, 0xb7, 0xa3, 0x94, 0xc4, 0xdc, 0xe9, 0xa9, 0x2e, 0xcf, 0x1c, 0x7c, 0x6d, 0x61, 0x4e, 0xa2,
+	0x95, 0xc4, 0x49, 0x70, 0xda, 0xe0, 0x85, 0x47, 0xe4, 0x24, 0x7c, 0xdd, 0xb1, 0xa4, 0x44, 0x7d,
+	0x82, 0x94, 0x58, 0x48, 0x3a, 0x70, 0x63, 0x01, 0x29, 0x38, 0x70, 0x89, 0xac, 0xc4, 0x94, 0x9c,
+	0xdd, 0xcd, 0xe4, 0x72, 0x72, 0x9e, 0xf3, 0x11, 0xbb, 0x99, 0x5c, 0x41, 0x2e, 0x2a, 0xcf, 0xd1,
+	0xac, 0x69, 0xcc, 0xef, 0xd1, 0x1a, 0x05, 0x3b, 0x8e, 0xe5, 0x08, 0x7e, 0x81, 0x37, 0x94, 0xeb,
+	0x50, 0x0c, 0xbb, 0xb8, 0x53, 0x18, 0x8c, 0x19, 0x28, 0x45, 0xbc, 0x9a, 0xf2, 0xb7, 0x14, 0x14,
+	0xc3, 0xfe, 0x2a, 0x52, 0xdf, 0xe6, 0x45, 0x7d, 0x1b, 0xe2, 0x35, 0x52, 0x51, 0x5e, 0x63, 0x19,
+	0x0a, 0xb4, 0xc6, 0x1b, 0xa3, 0x2c, 0x74, 0xdb, 0xa7, 0x2c, 0x6e, 0xc0, 0x2c, 0x8b, 0xb7, 0x9c,
+	0xfd, 0x10, 0x91, 0x21, 0xc3, 0x23, 0x03, 0x1d, 0x60, 0xc6, 0xe0, 0x91, 0x01, 0xbd, 0x00, 0x73,
+	0x21, 0xac, 0x5f, 0x3b, 0xf2, 0xf8, 0x2f, 0xfb, 0xe8, 0x2a, 0x2f, 0x22, 0xd1, 0x7f, 0xc3, 0x4c,
+	0x57, 0x37, 0xe9, 0x71, 0x37, 0x2c, 0xc7, 0x20, 0x06, 0x76, 0x45, 0xde, 0xb5, 0x7e, 0xba, 0x4b,
+	0x5e, 0xdd, 0xd3, 0x4d, 0x5c, 0xf7, 0x85, 0x6a, 0x26, 0x71, 0x46, 0x6a, 0xb9, 0x1b, 0xe9, 0xa4,
+	0x54, 0x4b, 0x1b, 0x77, 0xf4, 0x7e, 0x97, 0x68, 0x74, 0x84, 0xf9, 0xdb, 0xbc, 0x5a, 0x10, 0x7d,
+	0x54, 0xc3, 0x62, 0x15, 0xe6, 0x62, 0x34, 0xd1, 0xdc, 0xe3, 0x21, 0x1e, 0x09, 0xfb, 0xd1, 0x9f,
+	0x68, 0x5e, 0x6c, 0xb5, 0x28, 0x5c, 0x79, 0xe3, 0xb5, 0xd4, 0x2b, 0x92, 0xf2, 0x5b, 0x09, 0x66,
+	0x27, 0x3c, 0x7e, 0x2c, 0xb3, 0x22, 0x7d, 0x53, 0xcc, 0x4a, 0xea, 0xc9, 0x99, 0x95, 0x70, 0x41,
+	0x9f, 0x8e, 0x16, 0xf4, 0x7f, 0x95, 0xa0, 0x14, 0x89, 0x3c, 0xf4, 0x1c, 0xb5, 0xac, 0x36, 0x16,
+	0x25, 0x36, 0xfb, 0x4d, 0x4d, 0xd3, 0xb5, 0x8e, 0x45, 0x21, 0x4d, 0x7f, 0x52, 0x94, 0x1f, 0x4b,
+	0xf3, 0x22, 0x52, 0xfa, 0xd5, 0x39, 0x4f, 0x7d, 0x78, 0xc3, 0x33, 0x6b, 0x96, 0xcd, 0x1b, 0x35,
+	0x2b, 0x4f, 0x61, 0x78, 0x03, 0xbd, 0x0a, 0x79, 0xf6, 0x8e, 0xa2, 0x59, 0xb6, 0x5b, 0xc9, 0x8d,
+	0xa7, 0x77, 0xfc, 0xb1, 0x65, 0x75, 0x70, 0x8b, 0xba, 0x2a, 0xab, 0x73, 0x60, 0xbb, 0x6a, 0xce,
+	0x16, 0xbf, 0x42, 0x49, 0x57, 0x3e, 0x92, 0x74, 0x5d, 0x82, 0x3c, 0x5d, 0xbe, 0x6b, 0xeb, 0x2d,
+	0x5c, 0x01, 0xb6, 0xd2, 0xa0, 0x43, 0xf9, 0x4d, 0x1a, 0x66, 0xc6, 0x02, 0x67, 0xec, 0xc7, 0x7b,
+	0x17, 0x2b, 0x15, 0x22, 0x8e, 0x1e, 0xcd, 0x20, 0x4b, 0x00, 0xc7, 0xba, 0xab, 0x7d, 0xa8, 0x9b,
+	0x04, 0xb7, 0x85, 0x55, 0x42, 0x3d, 0x68, 0x11, 0x72, 0xb4, 0xd5, 0x77, 0x71, 0x5b, 0x70, 0x58,
+	0x7e, 0x1b, 0xed, 0x40, 0x16, 0x0f, 0xb0, 0x49, 0xdc, 0xca, 0x34, 0xdb, 0xf8, 0x0b, 0x31, 0x1e,
+	0x96, 0x8e, 0x6f, 0x54, 0xe8, 0x76, 0xff, 0xf9, 0x8b, 0x65, 0x99, 0xc3, 0x9f, 0xb7, 0x7a, 0x06,
+	0xc1, 0x3d, 0x9b, 0x8c, 0x54, 0xa1, 0x20, 0x6a, 0x86, 0xdc, 0x98, 0x19, 0xd0, 0x05, 0x98, 0x66,
+	0xb7, 0xd1, 0x68, 0xb3, 0x0c, 0x21, 0xaf, 0x66, 0x69, 0x73, 0xa7, 0x4d, 0x5d, 0x04, 0x19, 0x7a,
+	0x97, 0x74, 0xc4, 0x42, 0x7f, 0x5a, 0x05, 0x32, 0x14, 0xf7, 0x66, 0x44, 0x09, 0x3b, 0x07, 0xdb,
+	0x5d, 0xbd, 0x85, 0xdb, 0x94, 0x33, 0xa1, 0xbb, 0x5c, 0xe6, 0x05, 0x82, 0xd7, 0xdd, 0x1c, 0xde,
+	0xc1, 0x23, 0x74, 0xcd, 0xc7, 0xf5, 0xb0, 0x49, 0x18, 0x6e, 0x86, 0xcd, 0x54, 0x0e, 0x75, 0xdf,
+	0xc1, 0x23, 0xc6, 0xed, 0x16, 0x3d, 0xa2, 0x86, 0xee, 0x2f, 0x9f, 0x48, 0x2d, 0xf5, 0x70, 0xcf,
+	0xb6, 0xac, 0xae, 0xc6, 0xbd, 0x66, 0x15, 0xca, 0xd1, 0x94, 0x85, 0xb2, 0xb4, 0x0e, 0x26, 0x94,
+	0xee, 0x8c, 0x14, 0x32, 0x45, 0xde, 0xc9, 0xbd, 0xd4, 0x6e, 0x26, 0x27, 0xc9, 0x29, 0xc1, 0xad,
+	0xbd, 0x03, 0x0b, 0xb1, 0x19, 0x0b, 0x7a, 0x05, 0xf2, 0x41, 0xb6, 0x23, 0xad, 0xa4, 0xcf, 0x20,
+	0xcd, 0x02, 0xb0, 0x72, 0x08, 0x0b, 0xb1, 0x29, 0x0b, 0x7a, 0x03, 0xb2, 0x0e, 0x76, 0xfb, 0x5d,
+	0xce, 0x8b, 0x95, 0xd7, 0xaf, 0x9e, 0x9d, 0xeb, 0xf4, 0xbb, 0x44, 0x15, 0x42, 0xca, 0x2d, 0xb8,
+	0x98, 0x98, 0xb3, 0x04, 0xd4, 0x97, 0x14, 0xa2, 0xbe, 0x94, 0x5f, 0x49, 0xb0, 0x98, 0x9c, 0x87,
+	0xa0, 0x8d, 0xb1, 0x05, 0xdd, 0x78, 0xc4, 0x2c, 0x26, 0xb4, 0x2a, 0x5a, 0x1b, 0x3a, 0xb8, 0x83,
+	0x49, 0xeb, 0x84, 0x27, 0x44, 0xdc, 0x3f, 0x95, 0xd4, 0x92, 0xe8, 0x65, 0x32, 0x2e, 0x87, 0xbd,
+	0x8f, 0x5b, 0x44, 0xe3, 0x9b, 0xea, 0xb2, 0x62, 0x2b, 0xaf, 0x96, 0x78, 0x6f, 0x83, 0x77, 0x2a,
+	0x37, 0xe1, 0x42, 0x42, 0x66, 0x33, 0x59, 0x11, 0x2a, 0x0f, 0x28, 0x38, 0x36, 0x5d, 0x41, 0x6f,
+	0x41, 0xd6, 0x25, 0x3a, 0xe9, 0xbb, 0xe2, 0xcb, 0xae, 0x9d, 0x99, 0xe9, 0x34, 0x18, 0x5c, 0x15,
+	0x62, 0x0a, 0x06, 0x34, 0x99, 0xb7, 0xc4, 0x14, 0xc2, 0x52, 0x5c, 0x21, 0x7c, 0x1d, 0x64, 0x51,
+	0x08, 0x07, 0x40, 0xee, 0x34, 0xca, 0xac, 0x06, 0x0e, 0xea, 0xdf, 0x23, 0x78, 0xea, 0x94, 0x5c,
+	0x06, 0x6d, 0x8e, 0x7d, 0xc6, 0xcd, 0x47, 0x4a, 0x85, 0xc6, 0x3e, 0xe5, 0x87, 0x53, 0xb0, 0x10,
+	0x9b, 0xd2, 0x84, 0x5c, 0x8b, 0xf4, 0x75, 0x5d, 0xcb, 0x1b, 0x00, 0x64, 0xa8, 0xf1, 0x33, 0xe1,
+	0x85, 0xa8, 0xb8, 0x3a, 0x6e, 0x88, 0x5b, 0xcd, 0xa1, 0x38, 0x42, 0x79, 0x22, 0x7e, 0x51, 0x4e,
+	0x27, 0x44, 0x53, 0xf4, 0x59, 0xf8, 0x72, 0x2b, 0xe9, 0xc7, 0x0b, 0x74, 0xf2, 0x20, 0xda, 0xed,
+	0xa2, 0x07, 0x70, 0x61, 0x2c, 0x0c, 0xfb, 0xba, 0x33, 0x8f, 0x1c, 0x8d, 0x17, 0xa2, 0xd1, 0xd8,
+	0xd3, 0x1d, 0x0e, 0xa5, 0x53, 0x91, 0x50, 0x4a, 0xa3, 0x3f, 0x2b, 0xd4, 0x79, 0x16, 0xd4, 0xc6,
+	0x5d, 0xdd, 0x7b, 0x77, 0xbe, 0x38, 0x51, 0xee, 0xdf, 0x16, 0x4f, 0xf3, 0xbc, 0xda, 0xff, 0x31,
+	0xad, 0xf6, 0xcb, 0x54, 0x98, 0x6d, 0xd4, 0x6d, 0x2a, 0x8a, 0xda, 0x93, 0x59, 0x12, 0x8f, 0x04,
+	0xaf, 0x3f, 0x62, 0xea, 0xfa, 0x44, 0xe9, 0x52, 0xee, 0x5b, 0x49, 0x97, 0x1e, 0x00, 0x04, 0xbc,
+	0x0c, 0xc5, 0x39, 0x56, 0xdf, 0x6c, 0x33, 0xd9, 0x29, 0x95, 0x37, 0xe8, 0x5b, 0x3d, 0xbd, 0x4e,
+	0xde, 0x29, 0x8a, 0xf1, 0xba, 0xf4, 0xb4, 0x87, 0x88, 0x1d, 0x0e, 0x57, 0xde, 0x07, 0x34, 0xc9,
+	0xaa, 0x27, 0xcc, 0xf1, 0x66, 0x74, 0x0e, 0x25, 0x99, 0xa0, 0x8f, 0x9f, 0xeb, 0x3b, 0x30, 0xc5,
+	0x6e, 0x06, 0x8d, 0xf6, 0xec, 0x51, 0x47, 0x24, 0xdb, 0xf4, 0x37, 0xfa, 0x3f, 0x00, 0x9d, 0x10,
+	0xc7, 0x38, 0xea, 0x07, 0x33, 0xac, 0x24, 0x5c, 0xad, 0xaa, 0x07, 0xdc, 0xb8, 0x24, 0xee, 0xd8,
+	0x7c, 0x20, 0x1b, 0xba, 0x67, 0x21, 0x8d, 0xca, 0x3e, 0x94, 0xa3, 0xb2, 0x67, 0x6d, 0x41, 0xde,
+	0x4b, 0xad, 0xfc, 0xc4, 0x2c, 0xcd, 0x9f, 0xae, 0x58, 0x43, 0xf9, 0x6e, 0x0a, 0x8a, 0xe1, 0x8b,
+	0xf9, 0x4f, 0x98, 0xfc, 0x28, 0xdf, 0x97, 0x20, 0xe7, 0x7f, 0x7f, 0xf4, 0x01, 0x2b, 0xf2, 0xf2,
+	0xc7, 0xcd, 0x97, 0x0a, 0xbf, 0x3a, 0xf1, 0x77, 0xbe, 0xb4, 0xff, 0xce, 0xf7, 0x9f, 0x7e, 0x54,
+	0x4d, 0xe4, 0x97, 0xc2, 0xd6, 0x16, 0x07, 0xcb, 0x8b, 0xf2, 0xaf, 0x43, 0xde, 0x77, 0x6f, 0xb4,
+	0x6c, 0xf3, 0x78, 0x3b, 0x49, 0xf8, 0x18, 0xde, 0xa4, 0x4b, 0xb1, 0xad, 0x0f, 0xc5, 0x9b, 0x56,
+	0x5a, 0xe5, 0x0d, 0xc5, 0x85, 0x99, 0x31, 0xdf, 0x18, 0x00, 0x53, 0x21, 0x20, 0x52, 0xa0, 0x64,
+	0xf7, 0x8f, 0x68, 0x06, 0x26, 0x5e, 0xb8, 0xf8, 0xf2, 0x0b, 0x76, 0xff, 0xe8, 0x0e, 0x1e, 0xf1,
+	0x27, 0xae, 0x15, 0x28, 0x7a, 0x18, 0x76, 0xc4, 0xf9, 0x9e, 0x02, 0x87, 0x34, 0xf9, 0xf3, 0xa4,
+	0x24, 0xa7, 0x94, 0x1f, 0x49, 0x90, 0xf3, 0x6e, 0x09, 0x7a, 0x0b, 0xf2, 0xbe, 0x1b, 0x16, 0x25,
+	0xcf, 0x53, 0xa7, 0x38, 0x70, 0xf1, 0xf1, 0x81, 0x0c, 0xda, 0xf0, 0xde, 0xd9, 0x8d, 0xb6, 0xd6,
+	0xe9, 0xea, 0xc7, 0xe2, 0xb9, 0x74, 0x29, 0xc6, 0x53, 0x33, 0x27, 0xb7, 0x73, 0x7b, 0xab, 0xab,
+	0x1f, 0xab, 0x05, 0x26, 0xb4, 0xd3, 0xa6, 0x0d, 0x91, 0xda, 0xfd, 0x29, 0x05, 0xf2, 0xf8, 0x2d,
+	0xfe, 0xfa, 0xeb, 0x9b, 0x4c, 0x01, 0xd2, 0x71, 0x29, 0xc0, 0x1a, 0xcc, 0xf9, 0x08, 0xcd, 0x35,
+	0x8e, 0x4d, 0x9d, 0xf4, 0x1d, 0x2c, 0x18, 0x62, 0xe4, 0x0f, 0x35, 0xbc, 0x91, 0xc9, 0xef, 0x9e,
+	0x7a, 0xec, 0xef, 0x4e, 0x26, 0xe0, 0xb3, 0x49, 0x04, 0x3c, 0x7a, 0x1d, 0x16, 0xc7, 0x53, 0x95,
+	0xd0, 0x72, 0x79, 0x5d, 0x76, 0x21, 0x9a, 0xb4, 0xf8, 0x6b, 0x16, 0x76, 0xfe, 0x28, 0x05, 0x85,
+	0x10, 0x41, 0x8e, 0xfe, 0x23, 0xe4, 0x12, 0xcb, 0x71, 0xe1, 0x3b, 0x04, 0x0e, 0xde, 0xba, 0xa3,
+	0x3b, 0x93, 0x7a, 0x82, 0x9d, 0x49, 0x7a, 0xbd, 0xf0, 0x18, 0xf7, 0xcc, 0x63, 0x33, 0xee, 0xcf,
+	0x03, 0x22, 0x16, 0xd1, 0xbb, 0xd4, 0x9c, 0x94, 0x19, 0xe7, 0x17, 0x89, 0x7b, 0x30, 0x99, 0x8d,
+	0x1c, 0xb2, 0x81, 0x3a, 0xbb, 0x7c, 0xdf, 0x93, 0x20, 0xe7, 0xb3, 0x91, 0x8f, 0xfb, 0x06, 0x7e,
+	0x1e, 0xb2, 0x22, 0x7d, 0xe6, 0x8f, 0xe0, 0xa2, 0x15, 0xfb, 0xb4, 0xb0, 0x08, 0xb9, 0x1e, 0x26,
+	0x3a, 0x73, 0xc7, 0x3c, 0xf5, 0xf0, 0xdb, 0x37, 0x8e, 0xa0, 0x10, 0xfa, 0x1b, 0x01, 0xba, 0x08,
+	0x0b, 0x9b, 0xdb, 0xb5, 0xcd, 0x3b, 0x5a, 0xf3, 0x5d, 0xad, 0x79, 0xbf, 0x5e, 0xd3, 0xee, 0xed,
+	0xdf, 0xd9, 0x3f, 0xf8, 0xaf, 0x7d, 0xf9, 0xdc, 0xe4, 0x90, 0x5a, 0x63, 0x6d, 0x59, 0x42, 0x17,
+	0x60, 0x2e, 0x3a, 0xc4, 0x07, 0x52, 0x8b, 0x99, 0x1f, 0xfc, 0x7c, 0xe9, 0xdc, 0x8d, 0xbf, 0x48,
+	0x30, 0x17, 0x53, 0xa8, 0xa0, 0xcb, 0xf0, 0xf4, 0xc1, 0xd6, 0x56, 0x4d, 0xd5, 0x1a, 0xfb, 0xd5,
+	0x7a, 0x63, 0xfb, 0xa0, 0xa9, 0xa9, 0xb5, 0xc6, 0xbd, 0xbd, 0x66, 0x68, 0xd2, 0x15, 0xb8, 0x14,
+	0x0f, 0xa9, 0x6e, 0x6e, 0xd6, 0xea, 0x4d, 0x59, 0x42, 0xcb, 0xf0, 0x54, 0x02, 0x62, 0xe3, 0x40,
+	0x6d, 0xca, 0xa9, 0x64, 0x15, 0x6a, 0x6d, 0xb7, 0xb6, 0xd9, 0x94, 0xd3, 0xe8, 0x1a, 0x5c, 0x39,
+	0x0d, 0xa1, 0x6d, 0x1d, 0xa8, 0x77, 0xab, 0x4d, 0x39, 0x73, 0x26, 0xb0, 0x51, 0xdb, 0xbf, 0x5d,
+	0x53, 0xe5, 0x29, 0xf1, 0xdd, 0x3f, 0x4b, 0x41, 0x25, 0xa9, 0x1e, 0xa2, 0xba, 0xaa, 0xf5, 0xfa,
+	0xde, 0xfd, 0x40, 0xd7, 0xe6, 0xf6, 0xbd, 0xfd, 0x3b, 0x93, 0x26, 0x78, 0x16, 0x94, 0xd3, 0x80,
+	0xbe, 0x21, 0xae, 0xc2, 0xe5, 0x53, 0x71, 0xc2, 0x1c, 0x67, 0xc0, 0xd4, 0x5a, 0x53, 0xbd, 0x2f,
+	0xa7, 0xd1, 0x2a, 0xdc, 0x38, 0x13, 0xe6, 0x8f, 0xc9, 0x19, 0xb4, 0x06, 0x37, 0x4f, 0xc7, 0x73,
+	0x03, 0x79, 0x02, 0x9e, 0x89, 0x3e, 0x96, 0x60, 0x21, 0xb6, 0xb0, 0x42, 0x57, 0x60, 0xb9, 0xae,
+	0x1e, 0x6c, 0xd6, 0x1a, 0x0d, 0xad, 0xae, 0x1e, 0xd4, 0x0f, 0x1a, 0xd5, 0x3d, 0xad, 0xd1, 0xac,
+	0x36, 0xef, 0x35, 0x42, 0xb6, 0x51, 0x60, 0x29, 0x09, 0xe4, 0xdb, 0xe5, 0x14, 0x8c, 0x38, 0x01,
+	0xde, 0x39, 0xfd, 0xa9, 0x04, 0x17, 0x13, 0xcb, 0x23, 0x74, 0x1d, 0x9e, 0x39, 0xac, 0xa9, 0x3b,
+	0x5b, 0xf7, 0xb5, 0xc3, 0x83, 0x66, 0x4d, 0xab, 0xbd, 0xdb, 0xac, 0xed, 0x37, 0x76, 0x0e, 0xf6,
+	0x27, 0x57, 0x75, 0x0d, 0xae, 0x9c, 0x8a, 0xf4, 0x97, 0x76, 0x16, 0x70, 0x6c, 0x7d, 0xbf, 0x94,
+	0x60, 0x66, 0xcc, 0x17, 0xa2, 0x4b, 0x50, 0xb9, 0xbb, 0xd3, 0xd8, 0xa8, 0x6d, 0x57, 0x0f, 0x77,
+	0x0e, 0xd4, 0xf1, 0x3b, 0x7b, 0x05, 0x96, 0x27, 0x46, 0x6f, 0xdf, 0xab, 0xef, 0xed, 0x6c, 0x56,
+	0x9b, 0x35, 0x36, 0xa9, 0x2c, 0xd1, 0x0f, 0x9b, 0x00, 0xed, 0xed, 0xbc, 0xbd, 0xdd, 0xd4, 0x36,
+	0xf7, 0x76, 0x6a, 0xfb, 0x4d, 0xad, 0xda, 0x6c, 0x56, 0xe9, 0x75, 0xa6, 0xeb, 0x3d, 0x45, 0x9d,
+	0x67, 0x5d, 0x39, 0xcd, 0xd7, 0xbb, 0x71, 0xe7, 0xd3, 0x2f, 0x97, 0xa4, 0xcf, 0xbf, 0x5c, 0x92,
+	0xfe, 0xf8, 0xe5, 0x92, 0xf4, 0xc9, 0x57, 0x4b, 0xe7, 0x3e, 0xff, 0x6a, 0xe9, 0xdc, 0xef, 0xbf,
+	0x5a, 0x3a, 0xf7, 0xe0, 0xd6, 0xb1, 0x41, 0x4e, 0xfa, 0x47, 0xd4, 0x5d, 0xaf, 0x05, 0x7f, 0x8b,
+	0xf6, 0x7e, 0xe8, 0xb6, 0xb1, 0x36, 0xfe, 0xe7, 0xea, 0xa3, 0x2c, 0xf3, 0xbf, 0x2f, 0xfe, 0x7d,
+	0x00, 0xd8, 0x8b, 0x76, 0xf7, 0x77, 0x2d, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -6005,6 +6066,25 @@ func (m *CheckTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReplacementKey) > 0 {
+		i -= len(m.ReplacementKey)
+		copy(dAtA[i:], m.ReplacementKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReplacementKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ReplacedTxKey) > 0 {
+		i -= len(m.ReplacedTxKey)
+		copy(dAtA[i:], m.ReplacedTxKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReplacedTxKey)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.LaneId) > 0 {
 		i -= len(m.LaneId)
 		copy(dAtA[i:], m.LaneId)
@@ -6395,6 +6475,30 @@ func (m *FinalizeBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DefaultLane) > 0 {
+		i -= len(m.DefaultLane)
+		copy(dAtA[i:], m.DefaultLane)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DefaultLane)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LanePriorities) > 0 {
+		for k := range m.LanePriorities {
+			v := m.LanePriorities[k]
+			baseI := i
+			i = encodeVarintTypes(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTypes(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	n49, err49 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NextBlockDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay):])
 	if err49 != nil {
 		return 0, err49
@@ -8051,6 +8155,17 @@ func (m *CheckTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.TxPriority != 0 {
+		n += 1 + sovTypes(uint64(m.TxPriority))
+	}
+	l = len(m.ReplacedTxKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ReplacementKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -8221,6 +8336,18 @@ func (m *FinalizeBlockResponse) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay)
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.LanePriorities) > 0 {
+		for k, v := range m.LanePriorities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + sovTypes(uint64(v))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DefaultLane)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -13700,6 +13827,91 @@ func (m *CheckTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.LaneId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedTxKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedTxKey = append(m.ReplacedTxKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReplacedTxKey == nil {
+				m.ReplacedTxKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacementKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14776,6 +14988,151 @@ func (m *FinalizeBlockResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LanePriorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LanePriorities == nil {
+				m.LanePriorities = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTypes(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LanePriorities[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultLane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
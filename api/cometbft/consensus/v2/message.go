@@ -66,6 +66,24 @@ func (m *HasProposalBlockPart) Wrap() proto.Message {
 	return cm
 }
 
+func (m *CompactBlock) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlock{CompactBlock: m}
+	return cm
+}
+
+func (m *RequestMissingTxs) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_RequestMissingTxs{RequestMissingTxs: m}
+	return cm
+}
+
+func (m *MissingTxs) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_MissingTxs{MissingTxs: m}
+	return cm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped consensus
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -100,6 +118,15 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_VoteSetBits:
 		return m.GetVoteSetBits(), nil
 
+	case *Message_CompactBlock:
+		return m.GetCompactBlock(), nil
+
+	case *Message_RequestMissingTxs:
+		return m.GetRequestMissingTxs(), nil
+
+	case *Message_MissingTxs:
+		return m.GetMissingTxs(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
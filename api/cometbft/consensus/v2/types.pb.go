@@ -670,6 +670,217 @@ func (m *HasProposalBlockPart) GetIndex() int32 {
 	return 0
 }
 
+// CompactBlock is sent instead of the parts of a proposal block when compact
+// block propagation is enabled. It carries the block without its txs, which are
+// identified by their keys so that peers can look them up in their mempool.
+type CompactBlock struct {
+	Height             int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round              int32            `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockPartSetHeader v2.PartSetHeader `protobuf:"bytes,3,opt,name=block_part_set_header,json=blockPartSetHeader,proto3" json:"block_part_set_header"`
+	Block              *v2.Block        `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	TxKeys             [][]byte         `protobuf:"bytes,5,rep,name=tx_keys,json=txKeys,proto3" json:"tx_keys,omitempty"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbfa7f975842dd1, []int{10}
+}
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetBlockPartSetHeader() v2.PartSetHeader {
+	if m != nil {
+		return m.BlockPartSetHeader
+	}
+	return v2.PartSetHeader{}
+}
+
+func (m *CompactBlock) GetBlock() *v2.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetTxKeys() [][]byte {
+	if m != nil {
+		return m.TxKeys
+	}
+	return nil
+}
+
+// RequestMissingTxs is sent to request the txs of a compact block that are
+// missing from the mempool.
+type RequestMissingTxs struct {
+	Height             int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockPartSetHeader v2.PartSetHeader `protobuf:"bytes,2,opt,name=block_part_set_header,json=blockPartSetHeader,proto3" json:"block_part_set_header"`
+	Indexes            []uint32         `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *RequestMissingTxs) Reset()         { *m = RequestMissingTxs{} }
+func (m *RequestMissingTxs) String() string { return proto.CompactTextString(m) }
+func (*RequestMissingTxs) ProtoMessage()    {}
+func (*RequestMissingTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbfa7f975842dd1, []int{11}
+}
+func (m *RequestMissingTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestMissingTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestMissingTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestMissingTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestMissingTxs.Merge(m, src)
+}
+func (m *RequestMissingTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestMissingTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestMissingTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestMissingTxs proto.InternalMessageInfo
+
+func (m *RequestMissingTxs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RequestMissingTxs) GetBlockPartSetHeader() v2.PartSetHeader {
+	if m != nil {
+		return m.BlockPartSetHeader
+	}
+	return v2.PartSetHeader{}
+}
+
+func (m *RequestMissingTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// MissingTxs is sent in response to RequestMissingTxs, with the txs at the
+// given indexes of the block.
+type MissingTxs struct {
+	Height             int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockPartSetHeader v2.PartSetHeader `protobuf:"bytes,2,opt,name=block_part_set_header,json=blockPartSetHeader,proto3" json:"block_part_set_header"`
+	Indexes            []uint32         `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	Txs                [][]byte         `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *MissingTxs) Reset()         { *m = MissingTxs{} }
+func (m *MissingTxs) String() string { return proto.CompactTextString(m) }
+func (*MissingTxs) ProtoMessage()    {}
+func (*MissingTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbfa7f975842dd1, []int{12}
+}
+func (m *MissingTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissingTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissingTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissingTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingTxs.Merge(m, src)
+}
+func (m *MissingTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissingTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingTxs proto.InternalMessageInfo
+
+func (m *MissingTxs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MissingTxs) GetBlockPartSetHeader() v2.PartSetHeader {
+	if m != nil {
+		return m.BlockPartSetHeader
+	}
+	return v2.PartSetHeader{}
+}
+
+func (m *MissingTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *MissingTxs) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 // Message is an abstract consensus message.
 type Message struct {
 	// Sum of all possible messages.
@@ -685,6 +896,9 @@ type Message struct {
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
 	//	*Message_HasProposalBlockPart
+	//	*Message_CompactBlock
+	//	*Message_RequestMissingTxs
+	//	*Message_MissingTxs
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -692,7 +906,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbfa7f975842dd1, []int{10}
+	return fileDescriptor_1fbfa7f975842dd1, []int{13}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,6 +971,15 @@ type Message_VoteSetBits struct {
 type Message_HasProposalBlockPart struct {
 	HasProposalBlockPart *HasProposalBlockPart `protobuf:"bytes,10,opt,name=has_proposal_block_part,json=hasProposalBlockPart,proto3,oneof" json:"has_proposal_block_part,omitempty"`
 }
+type Message_CompactBlock struct {
+	CompactBlock *CompactBlock `protobuf:"bytes,11,opt,name=compact_block,json=compactBlock,proto3,oneof" json:"compact_block,omitempty"`
+}
+type Message_RequestMissingTxs struct {
+	RequestMissingTxs *RequestMissingTxs `protobuf:"bytes,12,opt,name=request_missing_txs,json=requestMissingTxs,proto3,oneof" json:"request_missing_txs,omitempty"`
+}
+type Message_MissingTxs struct {
+	MissingTxs *MissingTxs `protobuf:"bytes,13,opt,name=missing_txs,json=missingTxs,proto3,oneof" json:"missing_txs,omitempty"`
+}
 
 func (*Message_NewRoundStep) isMessage_Sum()         {}
 func (*Message_NewValidBlock) isMessage_Sum()        {}
@@ -768,6 +991,9 @@ func (*Message_HasVote) isMessage_Sum()              {}
 func (*Message_VoteSetMaj23) isMessage_Sum()         {}
 func (*Message_VoteSetBits) isMessage_Sum()          {}
 func (*Message_HasProposalBlockPart) isMessage_Sum() {}
+func (*Message_CompactBlock) isMessage_Sum()         {}
+func (*Message_RequestMissingTxs) isMessage_Sum()    {}
+func (*Message_MissingTxs) isMessage_Sum()           {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -846,6 +1072,27 @@ func (m *Message) GetHasProposalBlockPart() *HasProposalBlockPart {
 	return nil
 }
 
+func (m *Message) GetCompactBlock() *CompactBlock {
+	if x, ok := m.GetSum().(*Message_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
+func (m *Message) GetRequestMissingTxs() *RequestMissingTxs {
+	if x, ok := m.GetSum().(*Message_RequestMissingTxs); ok {
+		return x.RequestMissingTxs
+	}
+	return nil
+}
+
+func (m *Message) GetMissingTxs() *MissingTxs {
+	if x, ok := m.GetSum().(*Message_MissingTxs); ok {
+		return x.MissingTxs
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -859,6 +1106,9 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
 		(*Message_HasProposalBlockPart)(nil),
+		(*Message_CompactBlock)(nil),
+		(*Message_RequestMissingTxs)(nil),
+		(*Message_MissingTxs)(nil),
 	}
 }
 
@@ -873,70 +1123,84 @@ func init() {
 	proto.RegisterType((*VoteSetMaj23)(nil), "cometbft.consensus.v2.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "cometbft.consensus.v2.VoteSetBits")
 	proto.RegisterType((*HasProposalBlockPart)(nil), "cometbft.consensus.v2.HasProposalBlockPart")
+	proto.RegisterType((*CompactBlock)(nil), "cometbft.consensus.v2.CompactBlock")
+	proto.RegisterType((*RequestMissingTxs)(nil), "cometbft.consensus.v2.RequestMissingTxs")
+	proto.RegisterType((*MissingTxs)(nil), "cometbft.consensus.v2.MissingTxs")
 	proto.RegisterType((*Message)(nil), "cometbft.consensus.v2.Message")
 }
 
 func init() { proto.RegisterFile("cometbft/consensus/v2/types.proto", fileDescriptor_1fbfa7f975842dd1) }
 
 var fileDescriptor_1fbfa7f975842dd1 = []byte{
-	// 908 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x9e, 0x21, 0x76, 0xec, 0x94, 0xf3, 0x03, 0xad, 0x84, 0xb5, 0xb2, 0xc2, 0x31, 0x03, 0x87,
-	0x88, 0x45, 0x63, 0xc5, 0x41, 0x70, 0x58, 0x21, 0xb1, 0x06, 0xc1, 0x44, 0xbb, 0xc9, 0x5a, 0xed,
-	0xd5, 0x4a, 0xec, 0x65, 0x34, 0xf6, 0x34, 0x76, 0x83, 0x3d, 0x3d, 0x9a, 0xee, 0x4c, 0xc8, 0x99,
-	0x17, 0xe0, 0x05, 0x78, 0x0c, 0x2e, 0x3c, 0xc1, 0x1e, 0xf7, 0xc8, 0x69, 0x85, 0x1c, 0xf1, 0x0a,
-	0x70, 0x45, 0x5d, 0xd3, 0x1e, 0x8f, 0xb3, 0xf6, 0x86, 0x70, 0x40, 0xda, 0x5b, 0x4f, 0x57, 0xd5,
-	0xd7, 0xd5, 0x5f, 0x55, 0x7d, 0x3d, 0xf0, 0xfe, 0x40, 0x4c, 0x98, 0xea, 0x7f, 0xa7, 0x5a, 0x03,
-	0x11, 0x49, 0x16, 0xc9, 0x73, 0xd9, 0x4a, 0xdb, 0x2d, 0x75, 0x19, 0x33, 0xe9, 0xc6, 0x89, 0x50,
-	0x82, 0xec, 0xcd, 0x5c, 0xdc, 0xdc, 0xc5, 0x4d, 0xdb, 0xfb, 0xbb, 0x43, 0x31, 0x14, 0xe8, 0xd1,
-	0xd2, 0xab, 0xcc, 0x79, 0x7f, 0x8e, 0x37, 0xe6, 0x7d, 0xd9, 0xea, 0x73, 0x25, 0x5b, 0xe9, 0x51,
-	0x11, 0x6f, 0xff, 0xbd, 0xdc, 0x05, 0x77, 0xaf, 0x1d, 0xe7, 0xfc, 0x6a, 0xc3, 0xe6, 0x19, 0xbb,
-	0xa0, 0xe2, 0x3c, 0x0a, 0x7b, 0x8a, 0xc5, 0xe4, 0x5d, 0x58, 0x1f, 0x31, 0x3e, 0x1c, 0xa9, 0xba,
-	0xdd, 0xb4, 0x0f, 0xd7, 0xa8, 0xf9, 0x22, 0xbb, 0x50, 0x4e, 0xb4, 0x53, 0xfd, 0xad, 0xa6, 0x7d,
-	0x58, 0xa6, 0xd9, 0x07, 0x21, 0x50, 0x92, 0x8a, 0xc5, 0xf5, 0xb5, 0xa6, 0x7d, 0xb8, 0x45, 0x71,
-	0x4d, 0x3e, 0x83, 0xba, 0x64, 0x03, 0x11, 0x85, 0xd2, 0x97, 0x3c, 0x1a, 0x30, 0x5f, 0xaa, 0x20,
-	0x51, 0xbe, 0xe2, 0x13, 0x56, 0x2f, 0x21, 0xe6, 0x9e, 0xb1, 0xf7, 0xb4, 0xb9, 0xa7, 0xad, 0x4f,
-	0xf8, 0x84, 0x91, 0x8f, 0xe0, 0x9d, 0x71, 0x20, 0x95, 0x3f, 0x10, 0x93, 0x09, 0x57, 0x7e, 0x76,
-	0x5c, 0x19, 0x8f, 0xdb, 0xd1, 0x86, 0x2f, 0x71, 0x1f, 0x53, 0x75, 0xfe, 0xb6, 0x61, 0xeb, 0x8c,
-	0x5d, 0x3c, 0x0d, 0xc6, 0x3c, 0xec, 0x8c, 0xc5, 0xe0, 0x87, 0x5b, 0x26, 0xfe, 0x2d, 0xec, 0xf5,
-	0x75, 0x98, 0x1f, 0xeb, 0xdc, 0x24, 0x53, 0xfe, 0x88, 0x05, 0x21, 0x4b, 0xf0, 0x26, 0xb5, 0x76,
-	0xd3, 0xcd, 0xcb, 0x90, 0xb1, 0x95, 0xb6, 0xdd, 0x6e, 0x90, 0xa8, 0x1e, 0x53, 0x1e, 0xfa, 0x75,
-	0x4a, 0xcf, 0x5f, 0x1e, 0x58, 0x94, 0x20, 0xc8, 0x82, 0x85, 0x7c, 0x01, 0xb5, 0x39, 0xb4, 0xc4,
-	0x2b, 0xd7, 0xda, 0x07, 0x73, 0x40, 0x5d, 0x2a, 0x57, 0x97, 0xca, 0x4d, 0x8f, 0xdc, 0x0e, 0x57,
-	0x0f, 0x92, 0x24, 0xb8, 0xa4, 0x90, 0x23, 0x49, 0x72, 0x17, 0x36, 0xb8, 0x34, 0x34, 0x20, 0x01,
-	0x55, 0x5a, 0xe5, 0x32, 0xbb, 0xbe, 0x73, 0x02, 0xd5, 0x6e, 0x22, 0x62, 0x21, 0x83, 0x31, 0xf9,
-	0x1c, 0xaa, 0xb1, 0x59, 0xe3, 0xad, 0x6b, 0xed, 0xbb, 0xcb, 0x12, 0x37, 0x2e, 0x26, 0xe7, 0x3c,
-	0xc4, 0xf9, 0xc5, 0x86, 0xda, 0xcc, 0xd8, 0x7d, 0xfc, 0x68, 0x25, 0x85, 0x1f, 0x03, 0x99, 0xc5,
-	0xf8, 0xb1, 0x18, 0xfb, 0x45, 0x3e, 0xdf, 0x9e, 0x59, 0xba, 0x62, 0x8c, 0xa5, 0x21, 0x1e, 0x6c,
-	0x16, 0xbd, 0x0d, 0xa3, 0x37, 0x11, 0x60, 0x92, 0xab, 0x15, 0xe0, 0x9c, 0x31, 0x6c, 0x74, 0x66,
-	0xac, 0xdc, 0xb2, 0xbe, 0x47, 0x50, 0xd2, 0xf4, 0x9b, 0xc3, 0xef, 0xac, 0x28, 0xa7, 0x39, 0x14,
-	0x5d, 0x9d, 0x63, 0x28, 0x3d, 0x15, 0x8a, 0x91, 0x7b, 0x50, 0x4a, 0x85, 0x62, 0x86, 0xd0, 0x65,
-	0xa1, 0xda, 0x8d, 0xa2, 0x93, 0xf3, 0x93, 0x0d, 0x15, 0x2f, 0x90, 0x18, 0x78, 0xbb, 0x0c, 0x3f,
-	0x81, 0x92, 0x06, 0xc4, 0x0c, 0xb7, 0x97, 0x36, 0x5c, 0x8f, 0x0f, 0x23, 0x16, 0x9e, 0xca, 0xe1,
-	0x93, 0xcb, 0x98, 0x51, 0xf4, 0xd6, 0x58, 0x3c, 0x0a, 0xd9, 0x8f, 0xd8, 0x56, 0x65, 0x9a, 0x7d,
-	0x38, 0xbf, 0xd9, 0xb0, 0xa9, 0x53, 0xe8, 0x31, 0x75, 0x1a, 0x7c, 0xdf, 0x3e, 0xfe, 0x5f, 0x52,
-	0xf9, 0x1a, 0xaa, 0x59, 0x9f, 0xf3, 0xd0, 0x34, 0xf9, 0xfe, 0x92, 0x48, 0x2c, 0xe0, 0xc9, 0x57,
-	0x9d, 0x1d, 0xcd, 0xf4, 0xf4, 0xe5, 0x41, 0xc5, 0x6c, 0xd0, 0x0a, 0x06, 0x9f, 0x84, 0xce, 0x5f,
-	0x36, 0xd4, 0x4c, 0xf2, 0x1d, 0xae, 0xe4, 0x9b, 0x94, 0x3b, 0xb9, 0x0f, 0x65, 0xdd, 0x06, 0x12,
-	0xa7, 0xf4, 0x5f, 0x37, 0x79, 0x16, 0xe3, 0x3c, 0x83, 0x5d, 0x2f, 0x90, 0xf9, 0x74, 0xfe, 0xc7,
-	0x4e, 0xcf, 0x3b, 0x62, 0xad, 0xd8, 0x11, 0x7f, 0x96, 0xa1, 0x72, 0xca, 0xa4, 0x0c, 0x86, 0x8c,
-	0x3c, 0x84, 0xed, 0x88, 0x5d, 0x64, 0x53, 0xeb, 0xa3, 0x5c, 0x67, 0xad, 0xfd, 0x81, 0xbb, 0xf4,
-	0xad, 0x71, 0x8b, 0xef, 0x81, 0x67, 0xd1, 0xcd, 0xa8, 0xf8, 0x3e, 0x9c, 0xc1, 0x8e, 0x06, 0x4b,
-	0xb5, 0xf0, 0xfa, 0x48, 0x03, 0xa6, 0x53, 0x6b, 0x7f, 0xb8, 0x1a, 0x6d, 0xae, 0xd2, 0x9e, 0x45,
-	0xb7, 0xa2, 0x05, 0xd9, 0x2e, 0x4a, 0xd8, 0x2b, 0x4a, 0xb1, 0x00, 0x34, 0x23, 0xca, 0x2b, 0x48,
-	0x18, 0xf9, 0xe6, 0x9a, 0xd8, 0x64, 0xc5, 0x74, 0x6e, 0x80, 0xe8, 0x3e, 0x7e, 0xe4, 0x2d, 0x6a,
-	0x0d, 0x79, 0x00, 0x30, 0x57, 0x6d, 0x53, 0xce, 0xe6, 0x0a, 0x98, 0xbc, 0x54, 0x9e, 0x45, 0x37,
-	0x72, 0xdd, 0xd6, 0x9a, 0x83, 0xc2, 0xb1, 0x7e, 0x5d, 0x89, 0x17, 0x82, 0x75, 0xab, 0x7b, 0x56,
-	0x26, 0x1f, 0xe4, 0x3e, 0x54, 0x47, 0x81, 0xf4, 0x31, 0xac, 0x82, 0x61, 0x8d, 0x15, 0x61, 0x46,
-	0x64, 0x3c, 0x8b, 0x56, 0x46, 0x46, 0x6f, 0x1e, 0xc2, 0xb6, 0x0e, 0xc4, 0xd7, 0x6b, 0xa2, 0xc7,
-	0xbe, 0x5e, 0x7d, 0x6d, 0x5d, 0x8b, 0x0a, 0xa1, 0xeb, 0x9a, 0x16, 0x15, 0xc3, 0x83, 0xad, 0x1c,
-	0x4c, 0xb7, 0x6d, 0x7d, 0xe3, 0xb5, 0x4c, 0x16, 0x06, 0x56, 0x33, 0x99, 0x16, 0xe6, 0x37, 0x84,
-	0x3b, 0xfa, 0x4e, 0x79, 0x59, 0x0a, 0xb4, 0x02, 0x62, 0xde, 0x5b, 0x7d, 0xc5, 0x57, 0x86, 0xc1,
-	0xb3, 0xe8, 0xee, 0x68, 0xc9, 0x7e, 0xa7, 0x0c, 0x6b, 0xf2, 0x7c, 0xd2, 0xe9, 0x3e, 0x9f, 0x36,
-	0xec, 0x17, 0xd3, 0x86, 0xfd, 0xc7, 0xb4, 0x61, 0xff, 0x7c, 0xd5, 0xb0, 0x5e, 0x5c, 0x35, 0xac,
-	0xdf, 0xaf, 0x1a, 0xd6, 0xb3, 0x4f, 0x87, 0x5c, 0x8d, 0xce, 0xfb, 0xfa, 0xac, 0x56, 0xe1, 0xb7,
-	0xcb, 0x2c, 0x82, 0x98, 0xb7, 0x96, 0xfe, 0x8c, 0xf5, 0xd7, 0xf1, 0xc7, 0xe8, 0xf8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc2, 0x8d, 0x4e, 0xe0, 0xac, 0x09, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xdf, 0xad, 0xed, 0xd8, 0x79, 0xb6, 0x9b, 0x66, 0x48, 0xc8, 0x2a, 0x15, 0x8e, 0xbb, 0x70,
+	0xb0, 0x28, 0x5a, 0x2b, 0x0e, 0x82, 0x43, 0x85, 0x44, 0xdd, 0x0a, 0x36, 0xb4, 0x49, 0xad, 0x71,
+	0x54, 0x89, 0x5c, 0x56, 0x6b, 0x7b, 0xb0, 0x97, 0x7a, 0xff, 0xb0, 0x33, 0x76, 0xec, 0x33, 0x5f,
+	0x80, 0x2f, 0x80, 0xc4, 0x9d, 0x33, 0x17, 0x3e, 0x41, 0x8f, 0x95, 0xb8, 0x70, 0xaa, 0xaa, 0xe4,
+	0x3b, 0xc0, 0x15, 0xcd, 0xcc, 0x7a, 0xbd, 0x76, 0xbc, 0x81, 0x80, 0x84, 0xd2, 0xdb, 0xcc, 0xbc,
+	0xf7, 0x7e, 0xf3, 0xde, 0xef, 0xbd, 0x79, 0x6f, 0x17, 0xee, 0x75, 0x7d, 0x97, 0xb0, 0xce, 0x37,
+	0xac, 0xde, 0xf5, 0x3d, 0x4a, 0x3c, 0x3a, 0xa2, 0xf5, 0x71, 0xa3, 0xce, 0xa6, 0x01, 0xa1, 0x46,
+	0x10, 0xfa, 0xcc, 0x47, 0xdb, 0x33, 0x15, 0x23, 0x56, 0x31, 0xc6, 0x8d, 0xdd, 0xad, 0xbe, 0xdf,
+	0xf7, 0x85, 0x46, 0x9d, 0xaf, 0xa4, 0xf2, 0xee, 0x1c, 0x6f, 0xe8, 0x74, 0x68, 0xbd, 0xe3, 0x30,
+	0x5a, 0x1f, 0xef, 0x27, 0xf1, 0x76, 0xdf, 0x8b, 0x55, 0xc4, 0xe9, 0xd2, 0x75, 0xab, 0xc4, 0x9d,
+	0xa1, 0xdf, 0x7d, 0x21, 0xc5, 0xfa, 0x2f, 0x2a, 0x94, 0x8e, 0xc9, 0x19, 0xf6, 0x47, 0x5e, 0xaf,
+	0xcd, 0x48, 0x80, 0xde, 0x85, 0xb5, 0x01, 0x71, 0xfa, 0x03, 0xa6, 0xa9, 0x55, 0xb5, 0x96, 0xc1,
+	0xd1, 0x0e, 0x6d, 0x41, 0x2e, 0xe4, 0x4a, 0xda, 0xad, 0xaa, 0x5a, 0xcb, 0x61, 0xb9, 0x41, 0x08,
+	0xb2, 0x94, 0x91, 0x40, 0xcb, 0x54, 0xd5, 0x5a, 0x19, 0x8b, 0x35, 0xfa, 0x14, 0x34, 0x4a, 0xba,
+	0xbe, 0xd7, 0xa3, 0x16, 0x75, 0xbc, 0x2e, 0xb1, 0x28, 0xb3, 0x43, 0x66, 0x31, 0xc7, 0x25, 0x5a,
+	0x56, 0x60, 0x6e, 0x47, 0xf2, 0x36, 0x17, 0xb7, 0xb9, 0xf4, 0xc4, 0x71, 0x09, 0xfa, 0x10, 0x36,
+	0x87, 0x36, 0x65, 0x56, 0xd7, 0x77, 0x5d, 0x87, 0x59, 0xf2, 0xba, 0x9c, 0xb8, 0x6e, 0x83, 0x0b,
+	0x1e, 0x89, 0x73, 0xe1, 0xaa, 0xfe, 0xa7, 0x0a, 0xe5, 0x63, 0x72, 0xf6, 0xdc, 0x1e, 0x3a, 0xbd,
+	0x26, 0x8f, 0xe7, 0x9a, 0x8e, 0x7f, 0x0d, 0xdb, 0x82, 0x06, 0x2b, 0xe0, 0xbe, 0x51, 0xc2, 0xac,
+	0x01, 0xb1, 0x7b, 0x24, 0x14, 0x91, 0x14, 0x1b, 0x55, 0x23, 0xce, 0x92, 0x24, 0x73, 0xdc, 0x30,
+	0x5a, 0x76, 0xc8, 0xda, 0x84, 0x99, 0x42, 0xaf, 0x99, 0x7d, 0xf9, 0x7a, 0x4f, 0xc1, 0x48, 0x80,
+	0x2c, 0x48, 0xd0, 0xe7, 0x50, 0x9c, 0x43, 0x53, 0x11, 0x72, 0xb1, 0xb1, 0x37, 0x07, 0xe4, 0x99,
+	0x34, 0x78, 0x26, 0x8d, 0xf1, 0xbe, 0xd1, 0x74, 0xd8, 0xc3, 0x30, 0xb4, 0xa7, 0x18, 0x62, 0x24,
+	0x8a, 0xee, 0xc2, 0xba, 0x43, 0x23, 0x1a, 0x04, 0x01, 0x05, 0x5c, 0x70, 0xa8, 0x0c, 0x5f, 0x3f,
+	0x84, 0x42, 0x2b, 0xf4, 0x03, 0x9f, 0xda, 0x43, 0xf4, 0x19, 0x14, 0x82, 0x68, 0x2d, 0xa2, 0x2e,
+	0x36, 0xee, 0xae, 0x72, 0x3c, 0x52, 0x89, 0x7c, 0x8e, 0x4d, 0xf4, 0x1f, 0x55, 0x28, 0xce, 0x84,
+	0xad, 0x67, 0x4f, 0x53, 0x29, 0xfc, 0x08, 0xd0, 0xcc, 0xc6, 0x0a, 0xfc, 0xa1, 0x95, 0xe4, 0xf3,
+	0xce, 0x4c, 0xd2, 0xf2, 0x87, 0x22, 0x35, 0xc8, 0x84, 0x52, 0x52, 0x5b, 0xcb, 0xfc, 0x23, 0x02,
+	0x22, 0xe7, 0x8a, 0x09, 0x38, 0x7d, 0x08, 0xeb, 0xcd, 0x19, 0x2b, 0xd7, 0xcc, 0xef, 0x3e, 0x64,
+	0x39, 0xfd, 0xd1, 0xe5, 0x3b, 0x29, 0xe9, 0x8c, 0x2e, 0x15, 0xaa, 0xfa, 0x01, 0x64, 0x9f, 0xfb,
+	0x8c, 0xa0, 0xfb, 0x90, 0x1d, 0xfb, 0x8c, 0x68, 0x6a, 0xaa, 0x29, 0x57, 0xc3, 0x42, 0x49, 0xff,
+	0x5e, 0x85, 0xbc, 0x69, 0x53, 0x61, 0x78, 0x3d, 0x0f, 0x3f, 0x86, 0x2c, 0x07, 0x14, 0x1e, 0xde,
+	0x5e, 0x59, 0x70, 0x6d, 0xa7, 0xef, 0x91, 0xde, 0x11, 0xed, 0x9f, 0x4c, 0x03, 0x82, 0x85, 0x36,
+	0xc7, 0x72, 0xbc, 0x1e, 0x99, 0x88, 0xb2, 0xca, 0x61, 0xb9, 0xd1, 0x7f, 0x55, 0xa1, 0xc4, 0x5d,
+	0x68, 0x13, 0x76, 0x64, 0x7f, 0xdb, 0x38, 0xf8, 0x5f, 0x5c, 0xf9, 0x02, 0x0a, 0xb2, 0xce, 0x9d,
+	0x5e, 0x54, 0xe4, 0xbb, 0x2b, 0x2c, 0x45, 0x02, 0x0f, 0x1f, 0x37, 0x37, 0x38, 0xd3, 0xe7, 0xaf,
+	0xf7, 0xf2, 0xd1, 0x01, 0xce, 0x0b, 0xe3, 0xc3, 0x9e, 0xfe, 0x87, 0x0a, 0xc5, 0xc8, 0xf9, 0xa6,
+	0xc3, 0xe8, 0xdb, 0xe4, 0x3b, 0x7a, 0x00, 0x39, 0x5e, 0x06, 0x54, 0xcb, 0x5d, 0xa7, 0xc8, 0xa5,
+	0x8d, 0x7e, 0x0a, 0x5b, 0xa6, 0x4d, 0xe3, 0xd7, 0xf9, 0x2f, 0x2b, 0x3d, 0xae, 0x88, 0x4c, 0xb2,
+	0x22, 0xde, 0xa8, 0x50, 0x7a, 0xe4, 0xbb, 0x81, 0xdd, 0x65, 0x37, 0xac, 0x3d, 0x1a, 0x90, 0x13,
+	0xa7, 0x11, 0xef, 0x5a, 0x1a, 0xef, 0x58, 0xaa, 0xa1, 0x1d, 0xc8, 0xb3, 0x89, 0xf5, 0x82, 0x4c,
+	0x39, 0xc9, 0x99, 0x5a, 0x09, 0xaf, 0xb1, 0xc9, 0x13, 0x32, 0xa5, 0xfa, 0x4f, 0x2a, 0x6c, 0x62,
+	0xf2, 0xdd, 0x88, 0x50, 0x76, 0xe4, 0x50, 0xea, 0x78, 0xfd, 0x93, 0x49, 0x7a, 0xf5, 0xa4, 0x46,
+	0x74, 0xeb, 0x3f, 0x47, 0xa4, 0x41, 0x5e, 0x90, 0x4e, 0xa8, 0x96, 0xa9, 0x66, 0x6a, 0x65, 0x3c,
+	0xdb, 0xea, 0x3f, 0xab, 0x00, 0x37, 0xd4, 0x37, 0x74, 0x07, 0x32, 0x6c, 0xc2, 0xc7, 0x13, 0xe7,
+	0x94, 0x2f, 0xf5, 0xdf, 0xf2, 0x90, 0x3f, 0x22, 0x94, 0xda, 0x7d, 0x82, 0x9e, 0xc0, 0x6d, 0x8f,
+	0x9c, 0xc9, 0x4e, 0x6f, 0x89, 0x11, 0x2f, 0xdb, 0xe1, 0xfb, 0xc6, 0xca, 0xcf, 0x17, 0x23, 0xf9,
+	0x0d, 0x61, 0x2a, 0xb8, 0xe4, 0x25, 0xf6, 0xe8, 0x18, 0x36, 0x38, 0xd8, 0x98, 0x0f, 0x6b, 0x4b,
+	0x26, 0x5f, 0x46, 0xf6, 0x41, 0x3a, 0xda, 0x7c, 0xb2, 0x9b, 0x0a, 0x2e, 0x7b, 0xc9, 0x83, 0x85,
+	0xb1, 0x77, 0x69, 0xba, 0x2c, 0x00, 0xcd, 0x1e, 0x97, 0x99, 0x18, 0x7b, 0xe8, 0xcb, 0xa5, 0x01,
+	0x25, 0x0b, 0x51, 0xff, 0x1b, 0x88, 0xd6, 0xb3, 0xa7, 0xe6, 0xe2, 0x7c, 0x42, 0x0f, 0x01, 0xe6,
+	0x79, 0xd3, 0x72, 0xcb, 0xc9, 0x5a, 0x80, 0x89, 0x9f, 0xb7, 0xa9, 0xe0, 0xf5, 0x38, 0x51, 0x7c,
+	0x4e, 0x89, 0x61, 0xb3, 0xb6, 0x3c, 0xbd, 0x17, 0x8c, 0x79, 0x7b, 0x34, 0x15, 0x39, 0x72, 0xd0,
+	0x03, 0x28, 0x0c, 0x6c, 0x6a, 0x09, 0xb3, 0xbc, 0x30, 0xab, 0xa4, 0x98, 0x45, 0x83, 0xc9, 0x54,
+	0x70, 0x7e, 0x20, 0x97, 0x3c, 0xaf, 0xdc, 0x50, 0x14, 0x99, 0xcb, 0x47, 0x85, 0x56, 0xb8, 0x32,
+	0xaf, 0xc9, 0xa9, 0xc2, 0xf3, 0x3a, 0x4e, 0xec, 0x91, 0x09, 0xe5, 0x18, 0x8c, 0xb7, 0x3a, 0x6d,
+	0xfd, 0x4a, 0x26, 0x13, 0x4d, 0x9e, 0x33, 0x39, 0x9e, 0x6f, 0x51, 0x0f, 0x76, 0x78, 0x4c, 0x71,
+	0x5a, 0x12, 0xb4, 0x82, 0xc0, 0xbc, 0x9f, 0x1e, 0xe2, 0xa5, 0x06, 0x6a, 0x2a, 0x78, 0x6b, 0xb0,
+	0xe2, 0x1c, 0x7d, 0x05, 0xe5, 0xae, 0xec, 0x89, 0x51, 0x15, 0x16, 0xaf, 0x8c, 0x3d, 0xd9, 0x3f,
+	0x79, 0xec, 0xdd, 0xc4, 0x1e, 0x9d, 0xc2, 0x3b, 0xa1, 0x6c, 0x3e, 0x96, 0x2b, 0x5f, 0xb8, 0xc5,
+	0x9f, 0x53, 0x49, 0x20, 0xd6, 0x52, 0x10, 0x2f, 0xb5, 0x2b, 0x53, 0xc1, 0x9b, 0xe1, 0xf2, 0x21,
+	0x7a, 0x0c, 0xc5, 0x24, 0x66, 0x59, 0x60, 0xde, 0x4b, 0xc1, 0x5c, 0x00, 0x03, 0x37, 0xde, 0x35,
+	0x73, 0x90, 0xa1, 0x23, 0xb7, 0xd9, 0x7a, 0x79, 0x5e, 0x51, 0x5f, 0x9d, 0x57, 0xd4, 0x37, 0xe7,
+	0x15, 0xf5, 0x87, 0x8b, 0x8a, 0xf2, 0xea, 0xa2, 0xa2, 0xfc, 0x7e, 0x51, 0x51, 0x4e, 0x3f, 0xe9,
+	0x3b, 0x6c, 0x30, 0xea, 0x70, 0xdc, 0x7a, 0xe2, 0xbf, 0x25, 0x5a, 0xd8, 0x81, 0x53, 0x5f, 0xf9,
+	0x37, 0xd3, 0x59, 0x13, 0xbf, 0x0e, 0x07, 0x7f, 0x0d, 0x00, 0x6f, 0xae, 0xb5, 0xa9, 0xed, 0x0c,
+	0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1393,7 +1657,7 @@ func (m *HasProposalBlockPart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *CompactBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1403,38 +1667,28 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.TxKeys) > 0 {
+		for iNdEx := len(m.TxKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxKeys[iNdEx])
+			copy(dAtA[i:], m.TxKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TxKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewRoundStep != nil {
+	if m.Block != nil {
 		{
-			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1442,61 +1696,256 @@ func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewValidBlock != nil {
-		{
-			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	{
+		size, err := m.BlockPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *RequestMissingTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestMissingTxs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestMissingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA13 := make([]byte, len(m.Indexes)*10)
+		var j12 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTypes(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x1a
 	}
+	{
+		size, err := m.BlockPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_ProposalPol) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Message_ProposalPol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ProposalPol != nil {
-		{
+func (m *MissingTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissingTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Indexes) > 0 {
+		dAtA16 := make([]byte, len(m.Indexes)*10)
+		var j15 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTypes(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.BlockPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewRoundStep != nil {
+		{
+			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewValidBlock != nil {
+		{
+			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_ProposalPol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ProposalPol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProposalPol != nil {
+		{
 			size, err := m.ProposalPol.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -1635,6 +2084,69 @@ func (m *Message_HasProposalBlockPart) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_CompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlock != nil {
+		{
+			size, err := m.CompactBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_RequestMissingTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_RequestMissingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestMissingTxs != nil {
+		{
+			size, err := m.RequestMissingTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MissingTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MissingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MissingTxs != nil {
+		{
+			size, err := m.MissingTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1833,6 +2345,81 @@ func (m *HasProposalBlockPart) Size() (n int) {
 	return n
 }
 
+func (m *CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.BlockPartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TxKeys) > 0 {
+		for _, b := range m.TxKeys {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RequestMissingTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.BlockPartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MissingTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.BlockPartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1965,11 +2552,47 @@ func (m *Message_HasProposalBlockPart) Size() (n int) {
 	}
 	return n
 }
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Message_CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlock != nil {
+		l = m.CompactBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
 }
-func sozTypes(x uint64) (n int) {
+func (m *Message_RequestMissingTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestMissingTxs != nil {
+		l = m.RequestMissingTxs.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_MissingTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissingTxs != nil {
+		l = m.MissingTxs.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
@@ -2216,10 +2839,358 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockParts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockParts == nil {
+				m.BlockParts = &v1.BitArray{}
+			}
+			if err := m.BlockParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCommit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCommit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalPOL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalPOL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPolRound", wireType)
+			}
+			m.ProposalPolRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalPolRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalPol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockPart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockPart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockPart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockParts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Part", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2246,33 +3217,10 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockParts == nil {
-				m.BlockParts = &v1.BitArray{}
-			}
-			if err := m.BlockParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Part.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCommit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsCommit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2294,7 +3242,7 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2317,15 +3265,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2352,7 +3300,10 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vote == nil {
+				m.Vote = &v2.Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2377,7 +3328,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
+func (m *HasVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2400,10 +3351,10 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalPOL: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalPOL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2427,9 +3378,9 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPolRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.ProposalPolRound = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2439,16 +3390,16 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalPolRound |= int32(b&0x7F) << shift
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPol", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2458,25 +3409,30 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= v2.SignedMsgType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if err := m.ProposalPol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2498,7 +3454,7 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockPart) Unmarshal(dAtA []byte) error {
+func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2521,10 +3477,10 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockPart: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteSetMaj23: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockPart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteSetMaj23: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2566,8 +3522,27 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v2.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Part", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2594,7 +3569,7 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Part.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2619,7 +3594,7 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,15 +3617,72 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteSetBits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteSetBits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v2.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2677,10 +3709,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &v2.Vote{}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2705,7 +3767,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasVote) Unmarshal(dAtA []byte) error {
+func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2728,10 +3790,10 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasProposalBlockPart: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasProposalBlockPart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2773,25 +3835,6 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v2.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
@@ -2831,7 +3874,7 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
+func (m *CompactBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,10 +3897,10 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteSetMaj23: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteSetMaj23: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2899,10 +3942,10 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2912,14 +3955,28 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v2.SignedMsgType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2946,10 +4003,45 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Block == nil {
+				m.Block = &v2.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKeys = append(m.TxKeys, make([]byte, postIndex-iNdEx))
+			copy(m.TxKeys[len(m.TxKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2971,7 +4063,7 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
+func (m *RequestMissingTxs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2994,10 +4086,10 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteSetBits: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestMissingTxs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteSetBits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestMissingTxs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3020,46 +4112,8 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v2.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3086,43 +4140,86 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
-			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3144,7 +4241,7 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
+func (m *MissingTxs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3167,10 +4264,10 @@ func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasProposalBlockPart: wiretype end group for non-group")
+			return fmt.Errorf("proto: MissingTxs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasProposalBlockPart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MissingTxs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3193,10 +4290,10 @@ func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
 			}
-			m.Round = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3206,16 +4303,106 @@ func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
-			m.Index = 0
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3225,11 +4412,24 @@ func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3630,6 +4830,111 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_HasProposalBlockPart{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlock{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestMissingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestMissingTxs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_RequestMissingTxs{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MissingTxs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MissingTxs{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/mempool/v2/journal.proto
+
+package v2
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// JournalRecord is a record of the mempool journal, which persists the
+// transactions of the mempool across restarts. A record either adds a
+// transaction or a bundle of transactions to the mempool, or removes a
+// transaction from it.
+type JournalRecord struct {
+	// Sum of all possible records.
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*JournalRecord_AddTx
+	//	*JournalRecord_RemoveTx
+	//	*JournalRecord_AddBundle
+	Sum isJournalRecord_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *JournalRecord) Reset()         { *m = JournalRecord{} }
+func (m *JournalRecord) String() string { return proto.CompactTextString(m) }
+func (*JournalRecord) ProtoMessage()    {}
+func (*JournalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_536d1dce0e772725, []int{0}
+}
+func (m *JournalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JournalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalRecord.Merge(m, src)
+}
+func (m *JournalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalRecord proto.InternalMessageInfo
+
+type isJournalRecord_Sum interface {
+	isJournalRecord_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type JournalRecord_AddTx struct {
+	AddTx *JournalAddTx `protobuf:"bytes,1,opt,name=add_tx,json=addTx,proto3,oneof" json:"add_tx,omitempty"`
+}
+type JournalRecord_RemoveTx struct {
+	RemoveTx *JournalRemoveTx `protobuf:"bytes,2,opt,name=remove_tx,json=removeTx,proto3,oneof" json:"remove_tx,omitempty"`
+}
+type JournalRecord_AddBundle struct {
+	AddBundle *JournalAddBundle `protobuf:"bytes,3,opt,name=add_bundle,json=addBundle,proto3,oneof" json:"add_bundle,omitempty"`
+}
+
+func (*JournalRecord_AddTx) isJournalRecord_Sum()     {}
+func (*JournalRecord_RemoveTx) isJournalRecord_Sum()  {}
+func (*JournalRecord_AddBundle) isJournalRecord_Sum() {}
+
+func (m *JournalRecord) GetSum() isJournalRecord_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *JournalRecord) GetAddTx() *JournalAddTx {
+	if x, ok := m.GetSum().(*JournalRecord_AddTx); ok {
+		return x.AddTx
+	}
+	return nil
+}
+
+func (m *JournalRecord) GetRemoveTx() *JournalRemoveTx {
+	if x, ok := m.GetSum().(*JournalRecord_RemoveTx); ok {
+		return x.RemoveTx
+	}
+	return nil
+}
+
+func (m *JournalRecord) GetAddBundle() *JournalAddBundle {
+	if x, ok := m.GetSum().(*JournalRecord_AddBundle); ok {
+		return x.AddBundle
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*JournalRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*JournalRecord_AddTx)(nil),
+		(*JournalRecord_RemoveTx)(nil),
+		(*JournalRecord_AddBundle)(nil),
+	}
+}
+
+// JournalAddTx records a transaction accepted in the mempool, with the time
+// and height at which it was accepted, from which its TTL is counted.
+type JournalAddTx struct {
+	Tx        []byte    `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Lane      string    `protobuf:"bytes,2,opt,name=lane,proto3" json:"lane,omitempty"`
+	Senders   []string  `protobuf:"bytes,3,rep,name=senders,proto3" json:"senders,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Height    int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *JournalAddTx) Reset()         { *m = JournalAddTx{} }
+func (m *JournalAddTx) String() string { return proto.CompactTextString(m) }
+func (*JournalAddTx) ProtoMessage()    {}
+func (*JournalAddTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_536d1dce0e772725, []int{1}
+}
+func (m *JournalAddTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalAddTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalAddTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JournalAddTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalAddTx.Merge(m, src)
+}
+func (m *JournalAddTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalAddTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalAddTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalAddTx proto.InternalMessageInfo
+
+func (m *JournalAddTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *JournalAddTx) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *JournalAddTx) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *JournalAddTx) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *JournalAddTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// JournalAddBundle records a bundle of transactions accepted in the mempool, in
+// the order in which they must be included in a block, with the time and height
+// at which it was accepted. The removal of any transaction of the bundle
+// removes the whole bundle.
+type JournalAddBundle struct {
+	Txs       [][]byte  `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Lane      string    `protobuf:"bytes,2,opt,name=lane,proto3" json:"lane,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Height    int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *JournalAddBundle) Reset()         { *m = JournalAddBundle{} }
+func (m *JournalAddBundle) String() string { return proto.CompactTextString(m) }
+func (*JournalAddBundle) ProtoMessage()    {}
+func (*JournalAddBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_536d1dce0e772725, []int{2}
+}
+func (m *JournalAddBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalAddBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalAddBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JournalAddBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalAddBundle.Merge(m, src)
+}
+func (m *JournalAddBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalAddBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalAddBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalAddBundle proto.InternalMessageInfo
+
+func (m *JournalAddBundle) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *JournalAddBundle) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *JournalAddBundle) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *JournalAddBundle) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// JournalRemoveTx records the removal of a transaction from the mempool.
+type JournalRemoveTx struct {
+	TxKey []byte `protobuf:"bytes,1,opt,name=tx_key,json=txKey,proto3" json:"tx_key,omitempty"`
+}
+
+func (m *JournalRemoveTx) Reset()         { *m = JournalRemoveTx{} }
+func (m *JournalRemoveTx) String() string { return proto.CompactTextString(m) }
+func (*JournalRemoveTx) ProtoMessage()    {}
+func (*JournalRemoveTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_536d1dce0e772725, []int{3}
+}
+func (m *JournalRemoveTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalRemoveTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalRemoveTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JournalRemoveTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalRemoveTx.Merge(m, src)
+}
+func (m *JournalRemoveTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalRemoveTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalRemoveTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalRemoveTx proto.InternalMessageInfo
+
+func (m *JournalRemoveTx) GetTxKey() []byte {
+	if m != nil {
+		return m.TxKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*JournalRecord)(nil), "cometbft.mempool.v2.JournalRecord")
+	proto.RegisterType((*JournalAddTx)(nil), "cometbft.mempool.v2.JournalAddTx")
+	proto.RegisterType((*JournalAddBundle)(nil), "cometbft.mempool.v2.JournalAddBundle")
+	proto.RegisterType((*JournalRemoveTx)(nil), "cometbft.mempool.v2.JournalRemoveTx")
+}
+
+func init() { proto.RegisterFile("cometbft/mempool/v2/journal.proto", fileDescriptor_536d1dce0e772725) }
+
+var fileDescriptor_536d1dce0e772725 = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x6f, 0x94, 0x40,
+	0x18, 0xc6, 0x99, 0x9d, 0x5d, 0x2c, 0xaf, 0xab, 0x36, 0xe3, 0x9f, 0x90, 0x3d, 0xb0, 0x74, 0xa3,
+	0x09, 0xa7, 0x99, 0x64, 0xf5, 0xe4, 0x4d, 0x4c, 0x4c, 0xa3, 0x89, 0x87, 0x49, 0x4f, 0x5e, 0x1a,
+	0x28, 0x53, 0x16, 0x05, 0x86, 0xc0, 0xb0, 0xa1, 0xdf, 0xa2, 0x07, 0x3f, 0x84, 0x1f, 0xa5, 0xc7,
+	0x1e, 0x3d, 0x59, 0xb3, 0xfb, 0x45, 0x0c, 0x03, 0xac, 0xb6, 0xd9, 0xe8, 0xc1, 0xdb, 0xf3, 0x86,
+	0xe7, 0x79, 0xf9, 0xcd, 0x33, 0x03, 0x47, 0x67, 0x32, 0x13, 0x2a, 0x3c, 0x57, 0x2c, 0x13, 0x59,
+	0x21, 0x65, 0xca, 0xd6, 0x4b, 0xf6, 0x59, 0xd6, 0x65, 0x1e, 0xa4, 0xb4, 0x28, 0xa5, 0x92, 0xe4,
+	0xf1, 0x60, 0xa1, 0xbd, 0x85, 0xae, 0x97, 0xb3, 0x27, 0xb1, 0x8c, 0xa5, 0xfe, 0xce, 0x5a, 0xd5,
+	0x59, 0x67, 0xf3, 0x58, 0xca, 0x38, 0x15, 0x4c, 0x4f, 0x61, 0x7d, 0xce, 0x54, 0x92, 0x89, 0x4a,
+	0x05, 0x59, 0xd1, 0x19, 0x16, 0x37, 0x08, 0x1e, 0xbc, 0xef, 0xb6, 0x73, 0x71, 0x26, 0xcb, 0x88,
+	0xbc, 0x06, 0x33, 0x88, 0xa2, 0x53, 0xd5, 0xd8, 0xc8, 0x45, 0xde, 0xfd, 0xe5, 0x11, 0xdd, 0xf3,
+	0x3b, 0xda, 0x67, 0xde, 0x44, 0xd1, 0x49, 0x73, 0x6c, 0xf0, 0x49, 0xd0, 0x0a, 0xf2, 0x16, 0xac,
+	0x52, 0x64, 0x72, 0x2d, 0xda, 0xf8, 0x48, 0xc7, 0x9f, 0xff, 0x2d, 0xce, 0xb5, 0x59, 0x6f, 0x38,
+	0x28, 0x7b, 0x4d, 0xde, 0x01, 0xb4, 0x00, 0x61, 0x9d, 0x47, 0xa9, 0xb0, 0xb1, 0xde, 0xf2, 0xe2,
+	0x1f, 0x10, 0xbe, 0x36, 0x1f, 0x1b, 0xdc, 0x0a, 0x86, 0xc1, 0x9f, 0x00, 0xae, 0xea, 0x6c, 0xf1,
+	0x0d, 0xc1, 0xf4, 0x4f, 0x5a, 0xf2, 0x10, 0x46, 0xfd, 0xe1, 0xa6, 0x7c, 0xa4, 0x1a, 0x42, 0x60,
+	0x9c, 0x06, 0xb9, 0xd0, 0xbc, 0x16, 0xd7, 0x9a, 0xd8, 0x70, 0xaf, 0x12, 0x79, 0x24, 0xca, 0xca,
+	0xc6, 0x2e, 0xf6, 0x2c, 0x3e, 0x8c, 0xc4, 0x07, 0x6b, 0xd7, 0xa1, 0x3d, 0xd6, 0x70, 0x33, 0xda,
+	0xb5, 0x4c, 0x87, 0x96, 0xe9, 0xc9, 0xe0, 0xf0, 0x0f, 0xae, 0x7e, 0xcc, 0x8d, 0xcb, 0x9b, 0x39,
+	0xe2, 0xbf, 0x63, 0xe4, 0x19, 0x98, 0x2b, 0x91, 0xc4, 0x2b, 0x65, 0x4f, 0x5c, 0xe4, 0x61, 0xde,
+	0x4f, 0x8b, 0xaf, 0x08, 0x0e, 0xef, 0x9e, 0x89, 0x1c, 0x02, 0x56, 0x4d, 0x65, 0x23, 0x17, 0x7b,
+	0x53, 0xde, 0xca, 0xbd, 0xc0, 0xb7, 0xb0, 0xf0, 0xff, 0x62, 0x8d, 0x6f, 0x61, 0x79, 0xf0, 0xe8,
+	0xce, 0x7d, 0x91, 0xa7, 0x60, 0xaa, 0xe6, 0xf4, 0x8b, 0xb8, 0xe8, 0x7b, 0x9c, 0xa8, 0xe6, 0x83,
+	0xb8, 0xf0, 0x3f, 0x5e, 0x6d, 0x1c, 0x74, 0xbd, 0x71, 0xd0, 0xcf, 0x8d, 0x83, 0x2e, 0xb7, 0x8e,
+	0x71, 0xbd, 0x75, 0x8c, 0xef, 0x5b, 0xc7, 0xf8, 0xf4, 0x2a, 0x4e, 0xd4, 0xaa, 0x0e, 0xdb, 0x6b,
+	0x64, 0xbb, 0x17, 0xbe, 0x13, 0x41, 0x91, 0xb0, 0x3d, 0xef, 0x3e, 0x34, 0x35, 0xfa, 0xcb, 0x5f,
+	0x03, 0x00, 0xe3, 0xa6, 0xba, 0x53, 0x15, 0x03, 0x00, 0x00,
+}
+
+func (m *JournalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JournalRecord_AddTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalRecord_AddTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddTx != nil {
+		{
+			size, err := m.AddTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJournal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JournalRecord_RemoveTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalRecord_RemoveTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveTx != nil {
+		{
+			size, err := m.RemoveTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJournal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JournalRecord_AddBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalRecord_AddBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddBundle != nil {
+		{
+			size, err := m.AddBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJournal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JournalAddTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalAddTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalAddTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintJournal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintJournal(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintJournal(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintJournal(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintJournal(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JournalAddBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalAddBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalAddBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintJournal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintJournal(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintJournal(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintJournal(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JournalRemoveTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalRemoveTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalRemoveTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxKey) > 0 {
+		i -= len(m.TxKey)
+		copy(dAtA[i:], m.TxKey)
+		i = encodeVarintJournal(dAtA, i, uint64(len(m.TxKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJournal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJournal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JournalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *JournalRecord_AddTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddTx != nil {
+		l = m.AddTx.Size()
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	return n
+}
+func (m *JournalRecord_RemoveTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveTx != nil {
+		l = m.RemoveTx.Size()
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	return n
+}
+func (m *JournalRecord_AddBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddBundle != nil {
+		l = m.AddBundle.Size()
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	return n
+}
+func (m *JournalAddTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovJournal(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovJournal(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovJournal(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *JournalAddBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovJournal(uint64(l))
+		}
+	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovJournal(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovJournal(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *JournalRemoveTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxKey)
+	if l > 0 {
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	return n
+}
+
+func sovJournal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJournal(x uint64) (n int) {
+	return sovJournal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *JournalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JournalAddTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &JournalRecord_AddTx{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JournalRemoveTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &JournalRecord_RemoveTx{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JournalAddBundle{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &JournalRecord_AddBundle{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalAddTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalAddTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalAddTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalAddBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalAddBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalAddBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalRemoveTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalRemoveTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalRemoveTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKey = append(m.TxKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxKey == nil {
+				m.TxKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipJournal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthJournal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupJournal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthJournal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthJournal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowJournal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupJournal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1338 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/evidence/v1/evidence.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	v2 "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EvidenceStatus tells whether evidence is pending or committed.
+type EvidenceStatus int32
+
+const (
+	// Unknown
+	EvidenceStatus_EVIDENCE_STATUS_UNKNOWN EvidenceStatus = 0
+	// The evidence is pending in the evidence pool.
+	EvidenceStatus_EVIDENCE_STATUS_PENDING EvidenceStatus = 1
+	// The evidence was committed in a block.
+	EvidenceStatus_EVIDENCE_STATUS_COMMITTED EvidenceStatus = 2
+)
+
+var EvidenceStatus_name = map[int32]string{
+	0: "EVIDENCE_STATUS_UNKNOWN",
+	1: "EVIDENCE_STATUS_PENDING",
+	2: "EVIDENCE_STATUS_COMMITTED",
+}
+
+var EvidenceStatus_value = map[string]int32{
+	"EVIDENCE_STATUS_UNKNOWN":   0,
+	"EVIDENCE_STATUS_PENDING":   1,
+	"EVIDENCE_STATUS_COMMITTED": 2,
+}
+
+func (x EvidenceStatus) String() string {
+	return proto.EnumName(EvidenceStatus_name, int32(x))
+}
+
+func (EvidenceStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5c4eba8253b605, []int{0}
+}
+
+// GetPendingEvidenceRequest is a request for the evidence pending in the
+// evidence pool.
+type GetPendingEvidenceRequest struct {
+}
+
+func (m *GetPendingEvidenceRequest) Reset()         { *m = GetPendingEvidenceRequest{} }
+func (m *GetPendingEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingEvidenceRequest) ProtoMessage()    {}
+func (*GetPendingEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5c4eba8253b605, []int{0}
+}
+func (m *GetPendingEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPendingEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPendingEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPendingEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingEvidenceRequest.Merge(m, src)
+}
+func (m *GetPendingEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPendingEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingEvidenceRequest proto.InternalMessageInfo
+
+// GetPendingEvidenceResponse holds the evidence pending in the evidence pool,
+// from oldest to newest.
+type GetPendingEvidenceResponse struct {
+	Evidence []*EvidenceInfo `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *GetPendingEvidenceResponse) Reset()         { *m = GetPendingEvidenceResponse{} }
+func (m *GetPendingEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingEvidenceResponse) ProtoMessage()    {}
+func (*GetPendingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5c4eba8253b605, []int{1}
+}
+func (m *GetPendingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPendingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPendingEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPendingEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingEvidenceResponse.Merge(m, src)
+}
+func (m *GetPendingEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPendingEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingEvidenceResponse proto.InternalMessageInfo
+
+func (m *GetPendingEvidenceResponse) GetEvidence() []*EvidenceInfo {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+// GetCommittedEvidenceRequest is a request for the evidence committed in the
+// block at the specified height.
+type GetCommittedEvidenceRequest struct {
+	// The height of the block. If 0, the latest block is used.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetCommittedEvidenceRequest) Reset()         { *m = GetCommittedEvidenceRequest{} }
+func (m *GetCommittedEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCommittedEvidenceRequest) ProtoMessage()    {}
+func (*GetCommittedEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5c4eba8253b605, []int{2}
+}
+func (m *GetCommittedEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCommittedEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCommittedEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCommittedEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommittedEvidenceRequest.Merge(m, src)
+}
+func (m *GetCommittedEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCommittedEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommittedEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCommittedEvidenceRequest proto.InternalMessageInfo
+
+func (m *GetCommittedEvidenceRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetCommittedEvidenceResponse holds the evidence committed in the block at
+// the given height.
+type GetCommittedEvidenceResponse struct {
+	Height   int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Evidence []*EvidenceInfo `protobuf:"bytes,2,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *GetCommittedEvidenceResponse) Reset()         { *m = GetCommittedEvidenceResponse{} }
+func (m *GetCommittedEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCommittedEvidenceResponse) ProtoMessage()    {}
+func (*GetCommittedEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5c4eba8253b605, []int{3}
+}
+func (m *GetCommittedEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCommittedEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCommittedEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCommittedEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommittedEvidenceResponse.Merge(m, src)
+}
+func (m *GetCommittedEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCommittedEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommittedEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCommittedEvidenceResponse proto.InternalMessageInfo
+
+func (m *GetCommittedEvidenceResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetCommittedEvidenceResponse) GetEvidence() []*EvidenceInfo {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+// EvidenceInfo describes evidence held by the evidence pool.
+type EvidenceInfo struct {
+	Evidence *v2.Evidence   `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Status   EvidenceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cometbft.services.evidence.v1.EvidenceStatus" json:"status,omitempty"`
+	// Whether pending evidence is still valid against the current state.
+	// Committed evidence is not verified again.
+	Verified bool `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	// The reason why pending evidence is not valid anymore, if any.
+	VerifyError string `protobuf:"bytes,4,opt,name=verify_error,json=verifyError,proto3" json:"verify_error,omitempty"`
+	// The evidence expires once the last block height is greater than
+	// expiry_height and the last block time is after expiry_time.
+	ExpiryHeight int64     `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+	// ID of the peer the evidence was received from. Empty if the evidence was
+	// not received from a peer, or before the node restarted.
+	Peer string `protobuf:"bytes,7,opt,name=peer,proto3" json:"peer,omitempty"`
+}
+
+func (m *EvidenceInfo) Reset()         { *m = EvidenceInfo{} }
+func (m *EvidenceInfo) String() string { return proto.CompactTextString(m) }
+func (*EvidenceInfo) ProtoMessage()    {}
+func (*EvidenceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5c4eba8253b605, []int{4}
+}
+func (m *EvidenceInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvidenceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceInfo.Merge(m, src)
+}
+func (m *EvidenceInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceInfo proto.InternalMessageInfo
+
+func (m *EvidenceInfo) GetEvidence() *v2.Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *EvidenceInfo) GetStatus() EvidenceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EvidenceStatus_EVIDENCE_STATUS_UNKNOWN
+}
+
+func (m *EvidenceInfo) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *EvidenceInfo) GetVerifyError() string {
+	if m != nil {
+		return m.VerifyError
+	}
+	return ""
+}
+
+func (m *EvidenceInfo) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *EvidenceInfo) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return time.Time{}
+}
+
+func (m *EvidenceInfo) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cometbft.services.evidence.v1.EvidenceStatus", EvidenceStatus_name, EvidenceStatus_value)
+	proto.RegisterType((*GetPendingEvidenceRequest)(nil), "cometbft.services.evidence.v1.GetPendingEvidenceRequest")
+	proto.RegisterType((*GetPendingEvidenceResponse)(nil), "cometbft.services.evidence.v1.GetPendingEvidenceResponse")
+	proto.RegisterType((*GetCommittedEvidenceRequest)(nil), "cometbft.services.evidence.v1.GetCommittedEvidenceRequest")
+	proto.RegisterType((*GetCommittedEvidenceResponse)(nil), "cometbft.services.evidence.v1.GetCommittedEvidenceResponse")
+	proto.RegisterType((*EvidenceInfo)(nil), "cometbft.services.evidence.v1.EvidenceInfo")
+}
+
+func init() {
+	proto.RegisterFile("cometbft/services/evidence/v1/evidence.proto", fileDescriptor_1f5c4eba8253b605)
+}
+
+var fileDescriptor_1f5c4eba8253b605 = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4b, 0x6f, 0xd3, 0x4c,
+	0x14, 0xcd, 0x24, 0xfd, 0xf2, 0x85, 0x49, 0xa8, 0xa2, 0x11, 0x02, 0x37, 0xa1, 0x8e, 0x09, 0x1b,
+	0x8b, 0x87, 0xad, 0x06, 0x21, 0x76, 0x48, 0x34, 0xb1, 0x42, 0x84, 0xea, 0x56, 0x4e, 0x0a, 0x88,
+	0x4d, 0x94, 0xc7, 0x8d, 0x33, 0x12, 0xf6, 0x18, 0x7b, 0x62, 0x91, 0x15, 0x7f, 0xa1, 0x3f, 0xab,
+	0x1b, 0xa4, 0x2e, 0x59, 0x01, 0x4a, 0xfe, 0x08, 0xf2, 0xf8, 0x51, 0x12, 0x85, 0x0a, 0x89, 0xdd,
+	0xb9, 0x8f, 0x73, 0x74, 0xee, 0x9d, 0x3b, 0xf8, 0xc9, 0x84, 0x39, 0xc0, 0xc7, 0x33, 0xae, 0x07,
+	0xe0, 0x87, 0x74, 0x02, 0x81, 0x0e, 0x21, 0x9d, 0x82, 0x3b, 0x01, 0x3d, 0x3c, 0xca, 0xb0, 0xe6,
+	0xf9, 0x8c, 0x33, 0x72, 0x98, 0x76, 0x6b, 0x69, 0xb7, 0x96, 0x75, 0x84, 0x47, 0x35, 0x25, 0x13,
+	0xe3, 0x4b, 0x0f, 0x02, 0x3d, 0x6c, 0x6d, 0x09, 0xd4, 0xee, 0xd8, 0xcc, 0x66, 0x02, 0xea, 0x11,
+	0x4a, 0xb2, 0x0d, 0x9b, 0x31, 0xfb, 0x23, 0xe8, 0x22, 0x1a, 0x2f, 0x66, 0x3a, 0xa7, 0x0e, 0x04,
+	0x7c, 0xe4, 0x78, 0x71, 0x43, 0xb3, 0x8e, 0x0f, 0xba, 0xc0, 0xcf, 0xc0, 0x9d, 0x52, 0xd7, 0x36,
+	0x12, 0x49, 0x0b, 0x3e, 0x2d, 0x20, 0xe0, 0x4d, 0xc0, 0xb5, 0x5d, 0xc5, 0xc0, 0x63, 0x6e, 0x00,
+	0xa4, 0x8b, 0x4b, 0xa9, 0x07, 0x09, 0x29, 0x05, 0xb5, 0xdc, 0x7a, 0xac, 0xdd, 0x38, 0x85, 0x96,
+	0x4a, 0xf4, 0xdc, 0x19, 0xb3, 0x32, 0x72, 0xf3, 0x39, 0xae, 0x77, 0x81, 0xb7, 0x99, 0xe3, 0x50,
+	0xce, 0x61, 0xba, 0xe5, 0x82, 0xdc, 0xc5, 0xc5, 0x39, 0x50, 0x7b, 0xce, 0x25, 0xa4, 0x20, 0xb5,
+	0x60, 0x25, 0x51, 0xf3, 0x0b, 0xbe, 0xbf, 0x9b, 0x96, 0xf8, 0xfb, 0x03, 0x6f, 0xc3, 0x77, 0xfe,
+	0x5f, 0x7c, 0x7f, 0xcd, 0xe3, 0xca, 0xef, 0x25, 0xf2, 0x62, 0x63, 0x23, 0x48, 0x2d, 0xb7, 0xea,
+	0xd7, 0xca, 0xe2, 0xe1, 0xb4, 0xb0, 0x95, 0xa9, 0x5d, 0x2b, 0x11, 0x03, 0x17, 0x03, 0x3e, 0xe2,
+	0x8b, 0x40, 0xca, 0x2b, 0x48, 0xdd, 0x6f, 0x3d, 0xfd, 0x4b, 0x43, 0x7d, 0x41, 0xb2, 0x12, 0x32,
+	0xa9, 0xe1, 0x52, 0x08, 0x3e, 0x9d, 0x51, 0x98, 0x4a, 0x05, 0x05, 0xa9, 0x25, 0x2b, 0x8b, 0xc9,
+	0x03, 0x5c, 0x11, 0x78, 0x39, 0x04, 0xdf, 0x67, 0xbe, 0xb4, 0xa7, 0x20, 0xf5, 0x96, 0x55, 0x8e,
+	0x73, 0x46, 0x94, 0x22, 0x0f, 0xf1, 0x6d, 0xf8, 0xec, 0x51, 0x7f, 0x39, 0x4c, 0xf6, 0xf6, 0x9f,
+	0xd8, 0x5b, 0x25, 0x4e, 0xbe, 0x8e, 0xb7, 0x67, 0xe0, 0x72, 0xd2, 0x14, 0x9d, 0x92, 0x54, 0x14,
+	0x63, 0xd6, 0xb4, 0xf8, 0xce, 0xb4, 0xf4, 0xce, 0xb4, 0x41, 0x7a, 0x67, 0xc7, 0xa5, 0xcb, 0xef,
+	0x8d, 0xdc, 0xc5, 0x8f, 0x06, 0xb2, 0x70, 0x4c, 0x8c, 0x4a, 0x84, 0xe0, 0x3d, 0x0f, 0xc0, 0x97,
+	0xfe, 0x17, 0x36, 0x04, 0x7e, 0x44, 0xf1, 0xfe, 0xe6, 0x60, 0xa4, 0x8e, 0xef, 0x19, 0x6f, 0x7b,
+	0x1d, 0xc3, 0x6c, 0x1b, 0xc3, 0xfe, 0xe0, 0xd5, 0xe0, 0xbc, 0x3f, 0x3c, 0x37, 0xdf, 0x98, 0xa7,
+	0xef, 0xcc, 0x6a, 0x6e, 0x57, 0xf1, 0xcc, 0x30, 0x3b, 0x3d, 0xb3, 0x5b, 0x45, 0xe4, 0x10, 0x1f,
+	0x6c, 0x17, 0xdb, 0xa7, 0x27, 0x27, 0xbd, 0xc1, 0xc0, 0xe8, 0x54, 0xf3, 0xc7, 0xef, 0x2f, 0x57,
+	0x32, 0xba, 0x5a, 0xc9, 0xe8, 0xe7, 0x4a, 0x46, 0x17, 0x6b, 0x39, 0x77, 0xb5, 0x96, 0x73, 0xdf,
+	0xd6, 0x72, 0xee, 0xc3, 0x4b, 0x9b, 0xf2, 0xf9, 0x62, 0x1c, 0x3d, 0x80, 0x9e, 0x7d, 0xba, 0x0c,
+	0x8c, 0x3c, 0xaa, 0xdf, 0xf8, 0xaf, 0xc7, 0x45, 0xb1, 0x82, 0x67, 0xbf, 0x06, 0x00, 0xda, 0x71,
+	0x8f, 0x8c, 0xff, 0x03, 0x00, 0x00,
+}
+
+func (m *GetPendingEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPendingEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPendingEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPendingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPendingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPendingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCommittedEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCommittedEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCommittedEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCommittedEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCommittedEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCommittedEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvidence(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VerifyError) > 0 {
+		i -= len(m.VerifyError)
+		copy(dAtA[i:], m.VerifyError)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VerifyError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetPendingEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetPendingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCommittedEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetCommittedEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvidenceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvidence(uint64(m.Status))
+	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.VerifyError)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.ExpiryHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovEvidence(uint64(l))
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetPendingEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPendingEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPendingEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPendingEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPendingEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPendingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &EvidenceInfo{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCommittedEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCommittedEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCommittedEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCommittedEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCommittedEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCommittedEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &EvidenceInfo{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &v2.Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EvidenceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
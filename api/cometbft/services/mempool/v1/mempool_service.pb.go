@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/mempool/v1/mempool_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/mempool/v1/mempool_service.proto", fileDescriptor_f8560b1ab7181466)
+}
+
+var fileDescriptor_f8560b1ab7181466 = []byte{
+	// 223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0xcf, 0x4d,
+	0xcd, 0x2d, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x84, 0x31, 0xe3, 0xa1, 0x72, 0x7a, 0x05, 0x45,
+	0xf9, 0x25, 0xf9, 0x42, 0x32, 0x30, 0x3d, 0x7a, 0x30, 0x3d, 0x7a, 0x50, 0x85, 0x7a, 0x65, 0x86,
+	0x52, 0x5a, 0xc4, 0x98, 0x08, 0x31, 0xc9, 0xa8, 0x8d, 0x89, 0x8b, 0xcf, 0x17, 0x22, 0x12, 0x0c,
+	0x51, 0x2c, 0x54, 0xc2, 0xc5, 0xed, 0x9e, 0x5a, 0x12, 0x52, 0xe1, 0x5a, 0x96, 0x9a, 0x57, 0x52,
+	0x2c, 0x64, 0xa0, 0x87, 0xcf, 0x32, 0x3d, 0x24, 0xa5, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25,
+	0x52, 0x86, 0x24, 0xe8, 0x28, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x60, 0x14, 0xaa, 0xe3, 0xe2,
+	0x77, 0x2a, 0xca, 0x4f, 0x4c, 0x49, 0x4e, 0x2c, 0x2e, 0x71, 0x2a, 0xcd, 0x4b, 0xc9, 0x49, 0x15,
+	0x32, 0xc1, 0x6f, 0x0e, 0x9a, 0x72, 0x98, 0xed, 0xa6, 0x24, 0xea, 0x82, 0xb8, 0xc0, 0x29, 0xfc,
+	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
+	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x6c, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
+	0x93, 0x40, 0xc6, 0xea, 0xc3, 0x43, 0x16, 0xce, 0x48, 0x2c, 0xc8, 0xd4, 0xc7, 0x17, 0xde, 0x49,
+	0x6c, 0xe0, 0x80, 0x36, 0x06, 0x0c, 0x00, 0xcc, 0x12, 0x6f, 0x76, 0xe8, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolServiceClient is the client API for MempoolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolServiceClient interface {
+	// GetTxEvents returns a stream of the transactions added to and removed from
+	// the mempool, optionally filtered by lane. This is a long-lived stream that
+	// is only terminated by the server if an error occurs.
+	//
+	// The server never blocks the mempool on a slow client: events that cannot
+	// be sent are dropped, and their number is reported in the next event sent.
+	GetTxEvents(ctx context.Context, in *GetTxEventsRequest, opts ...grpc.CallOption) (MempoolService_GetTxEventsClient, error)
+	// BroadcastBundle checks a bundle of transactions with the application and
+	// adds them to the mempool if all of them are valid. The transactions of a
+	// bundle are proposed together, in the given order, or not at all. Bundles
+	// are not gossiped to other nodes: they are only proposed by the node they
+	// are submitted to, which should thus be a validator.
+	BroadcastBundle(ctx context.Context, in *BroadcastBundleRequest, opts ...grpc.CallOption) (*BroadcastBundleResponse, error)
+}
+
+type mempoolServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolServiceClient(cc grpc1.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{cc}
+}
+
+func (c *mempoolServiceClient) GetTxEvents(ctx context.Context, in *GetTxEventsRequest, opts ...grpc.CallOption) (MempoolService_GetTxEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MempoolService_serviceDesc.Streams[0], "/cometbft.services.mempool.v1.MempoolService/GetTxEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolServiceGetTxEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MempoolService_GetTxEventsClient interface {
+	Recv() (*GetTxEventsResponse, error)
+	grpc.ClientStream
+}
+
+type mempoolServiceGetTxEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolServiceGetTxEventsClient) Recv() (*GetTxEventsResponse, error) {
+	m := new(GetTxEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mempoolServiceClient) BroadcastBundle(ctx context.Context, in *BroadcastBundleRequest, opts ...grpc.CallOption) (*BroadcastBundleResponse, error) {
+	out := new(BroadcastBundleResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/BroadcastBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolServiceServer is the server API for MempoolService service.
+type MempoolServiceServer interface {
+	// GetTxEvents returns a stream of the transactions added to and removed from
+	// the mempool, optionally filtered by lane. This is a long-lived stream that
+	// is only terminated by the server if an error occurs.
+	//
+	// The server never blocks the mempool on a slow client: events that cannot
+	// be sent are dropped, and their number is reported in the next event sent.
+	GetTxEvents(*GetTxEventsRequest, MempoolService_GetTxEventsServer) error
+	// BroadcastBundle checks a bundle of transactions with the application and
+	// adds them to the mempool if all of them are valid. The transactions of a
+	// bundle are proposed together, in the given order, or not at all. Bundles
+	// are not gossiped to other nodes: they are only proposed by the node they
+	// are submitted to, which should thus be a validator.
+	BroadcastBundle(context.Context, *BroadcastBundleRequest) (*BroadcastBundleResponse, error)
+}
+
+// UnimplementedMempoolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServiceServer struct {
+}
+
+func (*UnimplementedMempoolServiceServer) GetTxEvents(req *GetTxEventsRequest, srv MempoolService_GetTxEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTxEvents not implemented")
+}
+func (*UnimplementedMempoolServiceServer) BroadcastBundle(ctx context.Context, req *BroadcastBundleRequest) (*BroadcastBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastBundle not implemented")
+}
+
+func RegisterMempoolServiceServer(s grpc1.Server, srv MempoolServiceServer) {
+	s.RegisterService(&_MempoolService_serviceDesc, srv)
+}
+
+func _MempoolService_GetTxEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTxEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServiceServer).GetTxEvents(m, &mempoolServiceGetTxEventsServer{stream})
+}
+
+type MempoolService_GetTxEventsServer interface {
+	Send(*GetTxEventsResponse) error
+	grpc.ServerStream
+}
+
+type mempoolServiceGetTxEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolServiceGetTxEventsServer) Send(m *GetTxEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MempoolService_BroadcastBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).BroadcastBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/BroadcastBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).BroadcastBundle(ctx, req.(*BroadcastBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var MempoolService_serviceDesc = _MempoolService_serviceDesc
+var _MempoolService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.mempool.v1.MempoolService",
+	HandlerType: (*MempoolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BroadcastBundle",
+			Handler:    _MempoolService_BroadcastBundle_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetTxEvents",
+			Handler:       _MempoolService_GetTxEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cometbft/services/mempool/v1/mempool_service.proto",
+}
@@ -373,6 +373,69 @@ func (m *ABCIResponsesInfo) GetFinalizeBlock() *v2.FinalizeBlockResponse {
 	return nil
 }
 
+// MempoolLanes represents the last lanes of the mempool set by the application
+// in a FinalizeBlock response.
+type MempoolLanes struct {
+	LanePriorities map[string]uint32 `protobuf:"bytes,1,rep,name=lane_priorities,json=lanePriorities,proto3" json:"lane_priorities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DefaultLane    string            `protobuf:"bytes,2,opt,name=default_lane,json=defaultLane,proto3" json:"default_lane,omitempty"`
+	// the height of the block whose FinalizeBlock response set the lanes.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MempoolLanes) Reset()         { *m = MempoolLanes{} }
+func (m *MempoolLanes) String() string { return proto.CompactTextString(m) }
+func (*MempoolLanes) ProtoMessage()    {}
+func (*MempoolLanes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6c696255fbe933e, []int{6}
+}
+func (m *MempoolLanes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolLanes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolLanes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolLanes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolLanes.Merge(m, src)
+}
+func (m *MempoolLanes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolLanes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolLanes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolLanes proto.InternalMessageInfo
+
+func (m *MempoolLanes) GetLanePriorities() map[string]uint32 {
+	if m != nil {
+		return m.LanePriorities
+	}
+	return nil
+}
+
+func (m *MempoolLanes) GetDefaultLane() string {
+	if m != nil {
+		return m.DefaultLane
+	}
+	return ""
+}
+
+func (m *MempoolLanes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Version is a message for storing versioning information.
 type Version struct {
 	Consensus v1.Consensus `protobuf:"bytes,1,opt,name=consensus,proto3" json:"consensus"`
@@ -383,7 +446,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6c696255fbe933e, []int{6}
+	return fileDescriptor_c6c696255fbe933e, []int{7}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +526,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6c696255fbe933e, []int{7}
+	return fileDescriptor_c6c696255fbe933e, []int{8}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,6 +667,8 @@ func init() {
 	proto.RegisterType((*ValidatorsInfo)(nil), "cometbft.state.v2.ValidatorsInfo")
 	proto.RegisterType((*ConsensusParamsInfo)(nil), "cometbft.state.v2.ConsensusParamsInfo")
 	proto.RegisterType((*ABCIResponsesInfo)(nil), "cometbft.state.v2.ABCIResponsesInfo")
+	proto.RegisterType((*MempoolLanes)(nil), "cometbft.state.v2.MempoolLanes")
+	proto.RegisterMapType((map[string]uint32)(nil), "cometbft.state.v2.MempoolLanes.LanePrioritiesEntry")
 	proto.RegisterType((*Version)(nil), "cometbft.state.v2.Version")
 	proto.RegisterType((*State)(nil), "cometbft.state.v2.State")
 }
@@ -611,71 +676,77 @@ func init() {
 func init() { proto.RegisterFile("cometbft/state/v2/types.proto", fileDescriptor_c6c696255fbe933e) }
 
 var fileDescriptor_c6c696255fbe933e = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
+	// 1113 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xa3, 0xc4, 0x92, 0x46, 0xd1, 0x8f, 0x57, 0x4d, 0xc3, 0xb8, 0x8d, 0xe4, 0xa8, 0x4d,
-	0x6a, 0x14, 0x05, 0x85, 0xa8, 0xb7, 0x5e, 0xd2, 0x50, 0x4a, 0x60, 0x01, 0x6e, 0x50, 0xd0, 0x6e,
-	0x0e, 0xbe, 0x10, 0x2b, 0x72, 0x25, 0x2d, 0x4a, 0x91, 0x84, 0x76, 0xc5, 0xca, 0x7d, 0x80, 0x5e,
-	0x9b, 0x63, 0xd1, 0x07, 0xe9, 0x33, 0xe4, 0x98, 0x5b, 0x7b, 0x72, 0x03, 0xf9, 0xd6, 0xa7, 0x28,
-	0x76, 0xb9, 0xfc, 0xd1, 0x0f, 0x0a, 0x17, 0xb9, 0x91, 0x3b, 0x33, 0xdf, 0x7c, 0xf3, 0xed, 0xcc,
-	0xee, 0xc2, 0x43, 0x27, 0x98, 0x11, 0x3e, 0x1a, 0xf3, 0x2e, 0xe3, 0x98, 0x93, 0x6e, 0xd4, 0xeb,
-	0xf2, 0xcb, 0x90, 0x30, 0x23, 0x9c, 0x07, 0x3c, 0x40, 0x07, 0x89, 0xd9, 0x90, 0x66, 0x23, 0xea,
-	0x1d, 0x7e, 0x9a, 0x46, 0xe0, 0x91, 0x43, 0x37, 0x02, 0x0e, 0x5b, 0xa9, 0x55, 0xae, 0x0a, 0x73,
-	0x88, 0xe7, 0x78, 0x96, 0xd8, 0x1f, 0x6e, 0xdb, 0xf3, 0xe1, 0x8f, 0xb6, 0xcd, 0x11, 0xf6, 0xa8,
-	0x8b, 0x79, 0x30, 0x57, 0x2e, 0xed, 0xd4, 0x25, 0x22, 0x73, 0x46, 0x03, 0xbf, 0x1b, 0x3d, 0x5d,
-	0xc3, 0xf8, 0x68, 0x12, 0x4c, 0x02, 0xf9, 0xd9, 0x15, 0x5f, 0x49, 0xd8, 0x24, 0x08, 0x26, 0x1e,
-	0xe9, 0xca, 0xbf, 0xd1, 0x62, 0xdc, 0xe5, 0x74, 0x46, 0x18, 0xc7, 0xb3, 0x30, 0x61, 0xbe, 0xe9,
-	0xe0, 0x2e, 0xe6, 0x98, 0xd3, 0xc0, 0x8f, 0xed, 0x9d, 0xf7, 0x1a, 0x34, 0x4f, 0xc9, 0x04, 0x3b,
-	0x97, 0xcf, 0xcd, 0xfe, 0xd0, 0x22, 0x2c, 0x0c, 0x7c, 0x46, 0x18, 0x7a, 0x06, 0x15, 0x97, 0x78,
-	0x34, 0x22, 0x73, 0x9b, 0x2f, 0x99, 0xae, 0x1d, 0x15, 0x8e, 0x2b, 0xbd, 0x96, 0x91, 0x0a, 0x27,
-	0x54, 0x32, 0xa2, 0x9e, 0xf1, 0x62, 0x49, 0x9c, 0xf3, 0xa5, 0x45, 0xd8, 0xc2, 0xe3, 0x16, 0xa8,
-	0x90, 0xf3, 0x25, 0x43, 0xdf, 0x42, 0x99, 0xf8, 0xae, 0x3d, 0xf2, 0x02, 0xe7, 0x47, 0xfd, 0xd6,
-	0x91, 0x76, 0x5c, 0xe9, 0x7d, 0x66, 0x6c, 0xe9, 0x6e, 0x24, 0x19, 0x5f, 0xf8, 0xae, 0x29, 0x5c,
-	0xad, 0x12, 0x51, 0x5f, 0xe8, 0x25, 0x54, 0x46, 0x64, 0x42, 0x7d, 0x85, 0x51, 0x90, 0x18, 0x8f,
-	0xff, 0x03, 0xc3, 0x14, 0xde, 0x31, 0x0a, 0x8c, 0xd2, 0xef, 0x8e, 0x0d, 0x68, 0xdb, 0x03, 0x0d,
-	0x61, 0x9f, 0x44, 0xc4, 0xe7, 0x49, 0x6d, 0xf7, 0x77, 0xd4, 0x26, 0xec, 0xa6, 0xfe, 0xf6, 0xaa,
-	0xbd, 0xf7, 0xcf, 0x55, 0xbb, 0x11, 0xbb, 0x7f, 0x15, 0xcc, 0x28, 0x27, 0xb3, 0x90, 0x5f, 0x5a,
-	0x0a, 0xa0, 0xf3, 0xeb, 0x2d, 0x68, 0x6c, 0xd6, 0x81, 0xce, 0xe1, 0x20, 0xdd, 0x63, 0x7b, 0x11,
-	0xba, 0x98, 0x93, 0x24, 0xd5, 0xa3, 0xed, 0x54, 0xaf, 0x13, 0xd7, 0x1f, 0xa4, 0xa7, 0x79, 0x5b,
-	0x24, 0xb5, 0x1a, 0xd1, 0xfa, 0x32, 0x43, 0x17, 0x70, 0xdf, 0x11, 0x69, 0x7c, 0xb6, 0x60, 0xb6,
-	0x6c, 0xc1, 0x14, 0x3b, 0xd6, 0xb8, 0x93, 0x61, 0xc7, 0xdd, 0x13, 0xf5, 0x8c, 0x7e, 0x12, 0xf1,
-	0xbd, 0xec, 0x59, 0xeb, 0x9e, 0xb3, 0xb6, 0x90, 0x60, 0x67, 0x8a, 0x14, 0x3e, 0x54, 0x91, 0x5f,
-	0x34, 0xa8, 0xa5, 0x25, 0xb1, 0xa1, 0x3f, 0x0e, 0xd0, 0x00, 0xaa, 0x99, 0x1e, 0x8c, 0x70, 0x5d,
-	0x93, 0x7c, 0xdb, 0x3b, 0xf8, 0xa6, 0x91, 0x67, 0x84, 0x5b, 0x77, 0xa3, 0xdc, 0x1f, 0x32, 0xa0,
-	0xe9, 0x61, 0xc6, 0xed, 0x29, 0xa1, 0x93, 0x29, 0xb7, 0x9d, 0x29, 0xf6, 0x27, 0xc4, 0x95, 0xb5,
-	0x17, 0xac, 0x03, 0x61, 0x3a, 0x91, 0x96, 0x7e, 0x6c, 0xe8, 0xfc, 0xae, 0x41, 0x73, 0xa3, 0x7c,
-	0xc9, 0xe6, 0x0c, 0x1a, 0x1b, 0x3a, 0x32, 0x45, 0xe8, 0x06, 0x02, 0xaa, 0xdd, 0xa9, 0xaf, 0xcb,
-	0xc8, 0xfe, 0x37, 0xb9, 0x3f, 0x35, 0x38, 0x58, 0x9b, 0x3a, 0x49, 0xed, 0x02, 0xee, 0x79, 0x72,
-	0x20, 0x6d, 0xa1, 0xba, 0x3d, 0x4f, 0x8c, 0x8a, 0xdf, 0x93, 0x1d, 0x03, 0xb0, 0x63, 0x80, 0xad,
-	0x66, 0x0c, 0xf2, 0x7c, 0xe4, 0xd0, 0x6c, 0xaa, 0x3f, 0x86, 0xfd, 0x98, 0x9c, 0x22, 0xa5, 0xfe,
-	0xd0, 0x2b, 0xa8, 0x8d, 0xa9, 0x8f, 0x3d, 0xfa, 0x33, 0x59, 0x9b, 0xb6, 0x2f, 0xb6, 0x5b, 0xe0,
-	0xa5, 0xf2, 0x8b, 0xe7, 0x4c, 0x21, 0x5b, 0xd5, 0x71, 0x7e, 0xb9, 0x43, 0xa1, 0xf8, 0x3a, 0x3e,
-	0xc6, 0x90, 0x09, 0xe5, 0x54, 0x27, 0x55, 0x42, 0xee, 0x18, 0x51, 0x87, 0x9d, 0x11, 0x3d, 0xcd,
-	0x44, 0x56, 0xf2, 0x66, 0x61, 0xe8, 0x10, 0x4a, 0x2c, 0x18, 0xf3, 0x9f, 0xf0, 0x9c, 0x48, 0xe2,
-	0x65, 0x2b, 0xfd, 0xef, 0xfc, 0x51, 0x84, 0x3b, 0x67, 0x42, 0x08, 0xf4, 0x0d, 0x14, 0x15, 0x9c,
-	0xca, 0x73, 0xb8, 0x43, 0x2a, 0x45, 0x4b, 0xe5, 0x48, 0x02, 0xd0, 0x13, 0x28, 0x39, 0x53, 0x4c,
-	0x7d, 0x9b, 0xc6, 0xfb, 0x55, 0x36, 0x2b, 0xab, 0xab, 0x76, 0xb1, 0x2f, 0xd6, 0x86, 0x03, 0xab,
-	0x28, 0x8d, 0x43, 0x17, 0x3d, 0x86, 0x1a, 0xf5, 0x29, 0xa7, 0xd8, 0x53, 0xbb, 0xac, 0xd7, 0xa4,
-	0x90, 0x55, 0xb5, 0x1a, 0x6f, 0x30, 0xfa, 0x12, 0xe4, 0x76, 0xc7, 0x5a, 0x26, 0x9e, 0x05, 0xe9,
-	0x59, 0x17, 0x06, 0xa9, 0x92, 0xf2, 0x3d, 0x83, 0x6a, 0xce, 0x97, 0xba, 0xfa, 0xed, 0x4d, 0xf2,
-	0x69, 0x1f, 0xca, 0xb0, 0xe1, 0xc0, 0x6c, 0x0a, 0xf2, 0xab, 0xab, 0x76, 0xe5, 0x34, 0xc1, 0x1a,
-	0x0e, 0xac, 0x4a, 0x0a, 0x3c, 0x74, 0xd1, 0x29, 0xd4, 0x73, 0xa0, 0xe2, 0x52, 0xd0, 0xef, 0x28,
-	0xd8, 0xf8, 0x42, 0x30, 0x92, 0x0b, 0xc1, 0x38, 0x4f, 0x6e, 0x0c, 0xb3, 0x24, 0x60, 0xdf, 0xfc,
-	0xdd, 0xd6, 0xac, 0x6a, 0x8a, 0x25, 0xac, 0xe8, 0x04, 0xea, 0x3e, 0x59, 0x72, 0x3b, 0x1d, 0x45,
-	0xa6, 0xef, 0xdf, 0x6c, 0x7a, 0x6b, 0x22, 0x2e, 0x3b, 0x09, 0xd0, 0x33, 0x80, 0x1c, 0x48, 0xf1,
-	0x66, 0x20, 0xb9, 0x10, 0x41, 0x45, 0x16, 0x96, 0x43, 0x29, 0xdd, 0x90, 0x8a, 0x88, 0xcb, 0x51,
-	0xe9, 0x43, 0x2b, 0x3f, 0xad, 0x19, 0x60, 0x3a, 0xb8, 0x65, 0xb9, 0x61, 0x9f, 0x64, 0x83, 0x9b,
-	0x45, 0xab, 0x11, 0xde, 0x79, 0x8e, 0xc0, 0x87, 0x9e, 0x23, 0xaf, 0xe0, 0xf3, 0xb5, 0x73, 0x64,
-	0x23, 0x41, 0xca, 0xaf, 0x22, 0xf9, 0x1d, 0xe5, 0x0e, 0x96, 0x75, 0xa0, 0x84, 0x64, 0xd2, 0x8d,
-	0x73, 0x79, 0x4b, 0x33, 0x7b, 0x8a, 0xd9, 0x54, 0xbf, 0x7b, 0xa4, 0x1d, 0xdf, 0x8d, 0xbb, 0x31,
-	0xbe, 0xbd, 0xd9, 0x09, 0x66, 0x53, 0xf4, 0x00, 0x4a, 0x38, 0x0c, 0x63, 0x97, 0xaa, 0x74, 0x29,
-	0xe2, 0x30, 0x94, 0xa6, 0xef, 0xa0, 0x21, 0xbb, 0x20, 0xee, 0x29, 0x97, 0x78, 0xf8, 0x52, 0xaf,
-	0xcb, 0x5a, 0x1f, 0x6c, 0x35, 0xd5, 0x40, 0xbd, 0x32, 0xe2, 0x9e, 0xfa, 0x4d, 0xf4, 0x94, 0x6c,
-	0x05, 0xd9, 0x53, 0x03, 0x11, 0x6a, 0x9e, 0xbe, 0x5d, 0xb5, 0xb4, 0x77, 0xab, 0x96, 0xf6, 0x7e,
-	0xd5, 0xd2, 0xde, 0x5c, 0xb7, 0xf6, 0xde, 0x5d, 0xb7, 0xf6, 0xfe, 0xba, 0x6e, 0xed, 0x5d, 0xf4,
-	0x26, 0x94, 0x4f, 0x17, 0x23, 0x21, 0x60, 0x37, 0x7d, 0x16, 0x65, 0xef, 0xb3, 0x90, 0x76, 0xb7,
-	0x9e, 0x77, 0xa3, 0x7d, 0x99, 0xfa, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x7a, 0x66,
-	0xcf, 0xfa, 0x09, 0x00, 0x00,
+	0x10, 0x36, 0xa3, 0xc4, 0x92, 0x46, 0xd6, 0x8f, 0x57, 0x49, 0xa3, 0xb8, 0x8d, 0x64, 0xab, 0x4d,
+	0x6a, 0x14, 0x05, 0x85, 0x28, 0x97, 0x22, 0x97, 0xd6, 0xb2, 0x1c, 0x58, 0x80, 0x13, 0x04, 0xb4,
+	0x9b, 0x83, 0x51, 0x80, 0x58, 0x89, 0x2b, 0x69, 0x11, 0x8a, 0x24, 0xb8, 0x2b, 0xd6, 0xea, 0x03,
+	0xf4, 0xda, 0x1c, 0x8b, 0x3e, 0x48, 0x9f, 0x21, 0xc7, 0xdc, 0xda, 0x93, 0x1b, 0xd8, 0xb7, 0x3c,
+	0x45, 0xb1, 0xcb, 0xe5, 0x8a, 0xfa, 0x41, 0xe1, 0x22, 0x17, 0x62, 0x77, 0x67, 0xe6, 0x9b, 0x1f,
+	0x7e, 0x33, 0xbb, 0xf0, 0x70, 0xe0, 0x4f, 0x08, 0xef, 0x0f, 0x79, 0x8b, 0x71, 0xcc, 0x49, 0x2b,
+	0x6a, 0xb7, 0xf8, 0x2c, 0x20, 0xcc, 0x0c, 0x42, 0x9f, 0xfb, 0x68, 0x3b, 0x11, 0x9b, 0x52, 0x6c,
+	0x46, 0xed, 0x9d, 0x2f, 0xb4, 0x05, 0xee, 0x0f, 0xe8, 0x92, 0xc1, 0x4e, 0x5d, 0x4b, 0xe5, 0xa9,
+	0x10, 0x07, 0x38, 0xc4, 0x93, 0x44, 0xfe, 0x70, 0x55, 0x9e, 0x36, 0xdf, 0x5b, 0x15, 0x47, 0xd8,
+	0xa5, 0x0e, 0xe6, 0x7e, 0xa8, 0x54, 0x1a, 0x5a, 0x25, 0x22, 0x21, 0xa3, 0xbe, 0xd7, 0x8a, 0x9e,
+	0x2c, 0x60, 0xdc, 0x1d, 0xf9, 0x23, 0x5f, 0x2e, 0x5b, 0x62, 0x95, 0x98, 0x8d, 0x7c, 0x7f, 0xe4,
+	0x92, 0x96, 0xdc, 0xf5, 0xa7, 0xc3, 0x16, 0xa7, 0x13, 0xc2, 0x38, 0x9e, 0x04, 0x49, 0xe4, 0xcb,
+	0x0a, 0xce, 0x34, 0xc4, 0x9c, 0xfa, 0x5e, 0x2c, 0x6f, 0x7e, 0x30, 0xa0, 0x7a, 0x42, 0x46, 0x78,
+	0x30, 0x3b, 0xe8, 0x1c, 0xf6, 0x2c, 0xc2, 0x02, 0xdf, 0x63, 0x84, 0xa1, 0xef, 0xa1, 0xe0, 0x10,
+	0x97, 0x46, 0x24, 0xb4, 0xf9, 0x05, 0xab, 0x19, 0xbb, 0x99, 0xfd, 0x42, 0xbb, 0x6e, 0xea, 0xc2,
+	0x89, 0x2a, 0x99, 0x51, 0xdb, 0x3c, 0xba, 0x20, 0x83, 0xb3, 0x0b, 0x8b, 0xb0, 0xa9, 0xcb, 0x2d,
+	0x50, 0x26, 0x67, 0x17, 0x0c, 0xfd, 0x00, 0x79, 0xe2, 0x39, 0x76, 0xdf, 0xf5, 0x07, 0x6f, 0x6a,
+	0xb7, 0x76, 0x8d, 0xfd, 0x42, 0xfb, 0x4b, 0x73, 0xa5, 0xee, 0x66, 0xe2, 0xf1, 0xc8, 0x73, 0x3a,
+	0x42, 0xd5, 0xca, 0x11, 0xb5, 0x42, 0xcf, 0xa1, 0xd0, 0x27, 0x23, 0xea, 0x29, 0x8c, 0x8c, 0xc4,
+	0x78, 0xf4, 0x1f, 0x18, 0x1d, 0xa1, 0x1d, 0xa3, 0x40, 0x5f, 0xaf, 0x9b, 0x36, 0xa0, 0x55, 0x0d,
+	0xd4, 0x83, 0x4d, 0x12, 0x11, 0x8f, 0x27, 0xb9, 0xdd, 0x5f, 0x93, 0x9b, 0x90, 0x77, 0x6a, 0xef,
+	0x2e, 0x1b, 0x1b, 0x1f, 0x2f, 0x1b, 0x95, 0x58, 0xfd, 0x5b, 0x7f, 0x42, 0x39, 0x99, 0x04, 0x7c,
+	0x66, 0x29, 0x80, 0xe6, 0x6f, 0xb7, 0xa0, 0xb2, 0x9c, 0x07, 0x3a, 0x83, 0x6d, 0xfd, 0x8f, 0xed,
+	0x69, 0xe0, 0x60, 0x4e, 0x12, 0x57, 0x7b, 0xab, 0xae, 0x5e, 0x27, 0xaa, 0x3f, 0x4a, 0xcd, 0xce,
+	0x6d, 0xe1, 0xd4, 0xaa, 0x44, 0x8b, 0xc7, 0x0c, 0x9d, 0xc3, 0xfd, 0x81, 0x70, 0xe3, 0xb1, 0x29,
+	0xb3, 0x25, 0x05, 0x35, 0x76, 0x5c, 0xe3, 0xe6, 0x1c, 0x3b, 0x66, 0x4f, 0xd4, 0x36, 0x0f, 0x13,
+	0x8b, 0x57, 0xc2, 0x80, 0x59, 0xf7, 0x06, 0x0b, 0x07, 0x09, 0xf6, 0xbc, 0x22, 0x99, 0x4f, 0xad,
+	0xc8, 0xaf, 0x06, 0x94, 0x74, 0x4a, 0xac, 0xe7, 0x0d, 0x7d, 0xd4, 0x85, 0xe2, 0xbc, 0x1e, 0x8c,
+	0xf0, 0x9a, 0x21, 0xe3, 0x6d, 0xac, 0x89, 0x57, 0x5b, 0x9e, 0x12, 0x6e, 0x6d, 0x45, 0xa9, 0x1d,
+	0x32, 0xa1, 0xea, 0x62, 0xc6, 0xed, 0x31, 0xa1, 0xa3, 0x31, 0xb7, 0x07, 0x63, 0xec, 0x8d, 0x88,
+	0x23, 0x73, 0xcf, 0x58, 0xdb, 0x42, 0x74, 0x2c, 0x25, 0x87, 0xb1, 0xa0, 0xf9, 0x87, 0x01, 0xd5,
+	0xa5, 0xf4, 0x65, 0x34, 0xa7, 0x50, 0x59, 0xaa, 0x23, 0xab, 0x19, 0x37, 0x2d, 0xa0, 0xfa, 0x3b,
+	0xe5, 0xc5, 0x32, 0xb2, 0xff, 0x1d, 0xdc, 0x5f, 0x06, 0x6c, 0x2f, 0x74, 0x9d, 0x0c, 0xed, 0x1c,
+	0xee, 0xb9, 0xb2, 0x21, 0x6d, 0x51, 0x75, 0x3b, 0x4c, 0x84, 0x2a, 0xbe, 0xc7, 0x6b, 0x1a, 0x60,
+	0x4d, 0x03, 0x5b, 0xd5, 0x18, 0xe4, 0xa0, 0x3f, 0xa0, 0xf3, 0xae, 0xfe, 0x0c, 0x36, 0xe3, 0xe0,
+	0x54, 0x50, 0x6a, 0x87, 0x5e, 0x42, 0x69, 0x48, 0x3d, 0xec, 0xd2, 0x5f, 0xc8, 0x42, 0xb7, 0x7d,
+	0xbd, 0x4a, 0x81, 0xe7, 0x4a, 0x2f, 0xee, 0x33, 0x85, 0x6c, 0x15, 0x87, 0xe9, 0xe3, 0xe6, 0x47,
+	0x03, 0xb6, 0x5e, 0x90, 0x49, 0xe0, 0xfb, 0xee, 0x09, 0xf6, 0x08, 0x43, 0x3f, 0x41, 0xd9, 0xc5,
+	0x1e, 0xb1, 0x83, 0x90, 0xfa, 0x21, 0xe5, 0x54, 0xf7, 0xc2, 0xd3, 0x35, 0xe9, 0xa4, 0x2d, 0x4d,
+	0xf1, 0x7d, 0xa5, 0xad, 0x8e, 0x3c, 0x1e, 0xce, 0xac, 0x92, 0xbb, 0x70, 0x88, 0xf6, 0x60, 0xcb,
+	0x21, 0x43, 0x3c, 0x75, 0xb9, 0x2d, 0x24, 0x32, 0xb9, 0xbc, 0x55, 0x50, 0x67, 0x02, 0x21, 0x95,
+	0x79, 0x26, 0x9d, 0xf9, 0xce, 0x01, 0x54, 0xd7, 0x78, 0x40, 0x15, 0xc8, 0xbc, 0x21, 0x33, 0x59,
+	0xf2, 0xbc, 0x25, 0x96, 0xe8, 0x2e, 0xdc, 0x89, 0xb0, 0x3b, 0x8d, 0xc1, 0x8b, 0x56, 0xbc, 0x79,
+	0x76, 0xeb, 0x3b, 0xa3, 0x49, 0x21, 0xfb, 0x3a, 0x9e, 0xd9, 0xa8, 0x03, 0x79, 0x4d, 0x0a, 0xf5,
+	0xbf, 0x52, 0x33, 0x53, 0x4d, 0x76, 0x33, 0x7a, 0x32, 0x67, 0x94, 0xe2, 0xd2, 0xdc, 0x0c, 0xed,
+	0x40, 0x8e, 0xf9, 0x43, 0xfe, 0x33, 0x0e, 0x93, 0x44, 0xf4, 0xbe, 0xf9, 0x67, 0x16, 0xee, 0x9c,
+	0x8a, 0x32, 0xa1, 0x67, 0x90, 0x55, 0x70, 0xca, 0xcf, 0xce, 0x9a, 0x42, 0xaa, 0xb0, 0x94, 0x8f,
+	0xc4, 0x00, 0x3d, 0x86, 0xdc, 0x60, 0x8c, 0xa9, 0x67, 0xd3, 0x98, 0x9c, 0xf9, 0x4e, 0xe1, 0xea,
+	0xb2, 0x91, 0x3d, 0x14, 0x67, 0xbd, 0xae, 0x95, 0x95, 0xc2, 0x9e, 0x83, 0x1e, 0x41, 0x89, 0x7a,
+	0x94, 0x53, 0xec, 0x2a, 0x4a, 0xd7, 0x4a, 0xb2, 0x76, 0x45, 0x75, 0x1a, 0xb3, 0x19, 0x7d, 0x03,
+	0x92, 0xdb, 0x31, 0x71, 0xec, 0x85, 0x2a, 0x97, 0x85, 0x40, 0x52, 0x42, 0xe9, 0x9e, 0x42, 0x31,
+	0xa5, 0x4b, 0x9d, 0xda, 0xed, 0xe5, 0xe0, 0x75, 0xd3, 0x49, 0xb3, 0x5e, 0xb7, 0x53, 0x15, 0xc1,
+	0x5f, 0x5d, 0x36, 0x0a, 0x27, 0x09, 0x56, 0xaf, 0x6b, 0x15, 0x34, 0x70, 0xcf, 0x41, 0x27, 0x50,
+	0x4e, 0x81, 0x8a, 0x1b, 0xb0, 0x76, 0x47, 0xc1, 0xc6, 0xb7, 0x9f, 0x99, 0xdc, 0x7e, 0xe6, 0x59,
+	0x72, 0x3d, 0x76, 0x72, 0x02, 0xf6, 0xed, 0x3f, 0x0d, 0xc3, 0x2a, 0x6a, 0x2c, 0x21, 0x45, 0xc7,
+	0x50, 0xf6, 0xc8, 0x05, 0xb7, 0xf5, 0xdc, 0x61, 0xb5, 0xcd, 0x9b, 0x8d, 0xaa, 0x92, 0xb0, 0xd3,
+	0x27, 0xe2, 0x0e, 0x85, 0x14, 0x48, 0xf6, 0x66, 0x20, 0x29, 0x13, 0x11, 0x8a, 0x4c, 0x2c, 0x85,
+	0x92, 0xbb, 0x61, 0x28, 0xc2, 0x2e, 0x15, 0xca, 0x21, 0xd4, 0xd3, 0xa3, 0x69, 0x0e, 0xa8, 0xa7,
+	0x54, 0x5e, 0xfe, 0xb0, 0xcf, 0xe7, 0x53, 0x6a, 0x6e, 0xad, 0xe6, 0xd5, 0xda, 0xa1, 0x09, 0x9f,
+	0x3a, 0x34, 0x5f, 0xc2, 0x57, 0x0b, 0x43, 0x73, 0xc9, 0x81, 0x8e, 0xaf, 0x20, 0xe3, 0xdb, 0x4d,
+	0x4d, 0xd1, 0x45, 0xa0, 0x24, 0xc8, 0x84, 0x8d, 0xa1, 0x7c, 0x92, 0x30, 0x7b, 0x8c, 0xd9, 0xb8,
+	0xb6, 0xb5, 0x6b, 0xec, 0x6f, 0xc5, 0x6c, 0x8c, 0x9f, 0x2a, 0xec, 0x18, 0xb3, 0x31, 0x7a, 0x00,
+	0x39, 0x1c, 0x04, 0xb1, 0x4a, 0x51, 0xaa, 0x64, 0x71, 0x10, 0x48, 0xd1, 0x0b, 0xa8, 0x48, 0x16,
+	0xc4, 0x9c, 0x72, 0x88, 0x8b, 0x67, 0xb5, 0xb2, 0xcc, 0xf5, 0xc1, 0x0a, 0xa9, 0xba, 0xea, 0x49,
+	0x15, 0x73, 0xea, 0x77, 0xc1, 0x29, 0x49, 0x05, 0xc9, 0xa9, 0xae, 0x30, 0xed, 0x9c, 0xbc, 0xbb,
+	0xaa, 0x1b, 0xef, 0xaf, 0xea, 0xc6, 0x87, 0xab, 0xba, 0xf1, 0xf6, 0xba, 0xbe, 0xf1, 0xfe, 0xba,
+	0xbe, 0xf1, 0xf7, 0x75, 0x7d, 0xe3, 0xbc, 0x3d, 0xa2, 0x7c, 0x3c, 0xed, 0x8b, 0x02, 0xb6, 0xf4,
+	0x1b, 0x50, 0x2f, 0x70, 0x40, 0x5b, 0x2b, 0x6f, 0xd9, 0xfe, 0xa6, 0x74, 0xfd, 0xf4, 0xdf, 0x01,
+	0x00, 0xb3, 0x34, 0xba, 0x81, 0xe7, 0x0a, 0x00, 0x00,
 }
 
 func (m *LegacyABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -969,6 +1040,58 @@ func (m *ABCIResponsesInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MempoolLanes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolLanes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolLanes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DefaultLane) > 0 {
+		i -= len(m.DefaultLane)
+		copy(dAtA[i:], m.DefaultLane)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DefaultLane)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LanePriorities) > 0 {
+		for k := range m.LanePriorities {
+			v := m.LanePriorities[k]
+			baseI := i
+			i = encodeVarintTypes(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTypes(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Version) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1279,6 +1402,30 @@ func (m *ABCIResponsesInfo) Size() (n int) {
 	return n
 }
 
+func (m *MempoolLanes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LanePriorities) > 0 {
+		for k, v := range m.LanePriorities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + sovTypes(uint64(v))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DefaultLane)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *Version) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2097,6 +2244,220 @@ func (m *ABCIResponsesInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MempoolLanes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolLanes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolLanes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LanePriorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LanePriorities == nil {
+				m.LanePriorities = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTypes(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LanePriorities[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultLane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Version) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,6 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type BlockStoreState struct {
 	Base   int64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Last height whose block has been moved to the cold tier, or 0 if none.
+	ColdHeight int64 `protobuf:"varint,3,opt,name=cold_height,json=coldHeight,proto3" json:"cold_height,omitempty"`
 }
 
 func (m *BlockStoreState) Reset()         { *m = BlockStoreState{} }
@@ -75,25 +77,110 @@ func (m *BlockStoreState) GetHeight() int64 {
 	return 0
 }
 
+func (m *BlockStoreState) GetColdHeight() int64 {
+	if m != nil {
+		return m.ColdHeight
+	}
+	return 0
+}
+
+// BlockSegmentEntry holds the records of a block moved to a cold tier segment
+// of the block store. Each record is kept encoded as it was in the database.
+type BlockSegmentEntry struct {
+	Parts          [][]byte `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	BlockCommit    []byte   `protobuf:"bytes,2,opt,name=block_commit,json=blockCommit,proto3" json:"block_commit,omitempty"`
+	SeenCommit     []byte   `protobuf:"bytes,3,opt,name=seen_commit,json=seenCommit,proto3" json:"seen_commit,omitempty"`
+	ExtendedCommit []byte   `protobuf:"bytes,4,opt,name=extended_commit,json=extendedCommit,proto3" json:"extended_commit,omitempty"`
+}
+
+func (m *BlockSegmentEntry) Reset()         { *m = BlockSegmentEntry{} }
+func (m *BlockSegmentEntry) String() string { return proto.CompactTextString(m) }
+func (*BlockSegmentEntry) ProtoMessage()    {}
+func (*BlockSegmentEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39bdcbdd79a94f5f, []int{1}
+}
+func (m *BlockSegmentEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSegmentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockSegmentEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockSegmentEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSegmentEntry.Merge(m, src)
+}
+func (m *BlockSegmentEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSegmentEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSegmentEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSegmentEntry proto.InternalMessageInfo
+
+func (m *BlockSegmentEntry) GetParts() [][]byte {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *BlockSegmentEntry) GetBlockCommit() []byte {
+	if m != nil {
+		return m.BlockCommit
+	}
+	return nil
+}
+
+func (m *BlockSegmentEntry) GetSeenCommit() []byte {
+	if m != nil {
+		return m.SeenCommit
+	}
+	return nil
+}
+
+func (m *BlockSegmentEntry) GetExtendedCommit() []byte {
+	if m != nil {
+		return m.ExtendedCommit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockStoreState)(nil), "cometbft.store.v1.BlockStoreState")
+	proto.RegisterType((*BlockSegmentEntry)(nil), "cometbft.store.v1.BlockSegmentEntry")
 }
 
 func init() { proto.RegisterFile("cometbft/store/v1/types.proto", fileDescriptor_39bdcbdd79a94f5f) }
 
 var fileDescriptor_39bdcbdd79a94f5f = []byte{
-	// 170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xce, 0xcf, 0x4d,
-	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9,
-	0x2c, 0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x49, 0xeb, 0x81, 0xa5,
-	0xf5, 0xca, 0x0c, 0x95, 0x6c, 0xb9, 0xf8, 0x9d, 0x72, 0xf2, 0x93, 0xb3, 0x83, 0x41, 0x02, 0xc1,
-	0x25, 0x89, 0x25, 0xa9, 0x42, 0x42, 0x5c, 0x2c, 0x49, 0x89, 0xc5, 0xa9, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xcc, 0x41, 0x60, 0xb6, 0x90, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04,
-	0x13, 0x58, 0x14, 0xca, 0x73, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xa3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb8, 0xab, 0xe0,
-	0x8c, 0xc4, 0x82, 0x4c, 0x7d, 0x0c, 0xb7, 0x26, 0xb1, 0x81, 0x9d, 0x69, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x25, 0xd4, 0x78, 0x17, 0xc7, 0x00, 0x00, 0x00,
+	// 276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xbf, 0x4e, 0x83, 0x50,
+	0x14, 0xc6, 0x7b, 0xa5, 0x76, 0x38, 0x25, 0x36, 0xbd, 0x31, 0x86, 0xc5, 0x6b, 0xed, 0x62, 0x27,
+	0x48, 0xf5, 0x0d, 0x6a, 0x4c, 0x1c, 0x9c, 0xe8, 0xe6, 0x60, 0xc3, 0x9f, 0x23, 0x10, 0x0b, 0x97,
+	0xc0, 0xb1, 0xb1, 0x6f, 0xe1, 0xe4, 0x33, 0x39, 0x76, 0x74, 0x34, 0xf0, 0x22, 0x86, 0x03, 0x74,
+	0x71, 0x3b, 0xe7, 0xf7, 0xfd, 0x92, 0x2f, 0xf9, 0xe0, 0x32, 0xd0, 0x29, 0x92, 0xff, 0x4a, 0x4e,
+	0x49, 0xba, 0x40, 0x67, 0xb7, 0x74, 0x68, 0x9f, 0x63, 0x69, 0xe7, 0x85, 0x26, 0x2d, 0xa7, 0x7d,
+	0x6c, 0x73, 0x6c, 0xef, 0x96, 0xf3, 0x17, 0x98, 0xac, 0xb6, 0x3a, 0x78, 0x5b, 0x37, 0x60, 0x4d,
+	0x1e, 0xa1, 0x94, 0x30, 0xf4, 0xbd, 0x12, 0x2d, 0x31, 0x13, 0x0b, 0xc3, 0xe5, 0x5b, 0x5e, 0xc0,
+	0x28, 0xc6, 0x24, 0x8a, 0xc9, 0x3a, 0x61, 0xda, 0x7d, 0xf2, 0x0a, 0xc6, 0x81, 0xde, 0x86, 0x9b,
+	0x2e, 0x34, 0x38, 0x84, 0x06, 0x3d, 0x32, 0x99, 0x7f, 0x09, 0x98, 0xb6, 0x05, 0x18, 0xa5, 0x98,
+	0xd1, 0x43, 0x46, 0xc5, 0x5e, 0x9e, 0xc3, 0x69, 0xee, 0x15, 0x54, 0x5a, 0x62, 0x66, 0x2c, 0x4c,
+	0xb7, 0x7d, 0xe4, 0x35, 0x98, 0x7e, 0xa3, 0x6e, 0x02, 0x9d, 0xa6, 0x49, 0x5b, 0x65, 0xba, 0x63,
+	0x66, 0xf7, 0x8c, 0x9a, 0xbe, 0x12, 0x31, 0xeb, 0x0d, 0x83, 0x0d, 0x68, 0x50, 0x27, 0xdc, 0xc0,
+	0x04, 0x3f, 0x08, 0xb3, 0x10, 0xc3, 0x5e, 0x1a, 0xb2, 0x74, 0xd6, 0xe3, 0x56, 0x5c, 0x3d, 0x7d,
+	0x57, 0x4a, 0x1c, 0x2a, 0x25, 0x7e, 0x2b, 0x25, 0x3e, 0x6b, 0x35, 0x38, 0xd4, 0x6a, 0xf0, 0x53,
+	0xab, 0xc1, 0xf3, 0x6d, 0x94, 0x50, 0xfc, 0xee, 0xdb, 0x81, 0x4e, 0x9d, 0xe3, 0x9e, 0xc7, 0xc3,
+	0xcb, 0x13, 0xe7, 0xdf, 0xca, 0xfe, 0x88, 0x07, 0xbe, 0xfb, 0x1b, 0x00, 0x55, 0x61, 0xca, 0x93,
+	0x81, 0x01, 0x00, 0x00,
 }
 
 func (m *BlockStoreState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +203,11 @@ func (m *BlockStoreState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ColdHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ColdHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Height != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 		i--
@@ -129,6 +221,59 @@ func (m *BlockStoreState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockSegmentEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSegmentEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSegmentEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtendedCommit) > 0 {
+		i -= len(m.ExtendedCommit)
+		copy(dAtA[i:], m.ExtendedCommit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtendedCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SeenCommit) > 0 {
+		i -= len(m.SeenCommit)
+		copy(dAtA[i:], m.SeenCommit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SeenCommit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockCommit) > 0 {
+		i -= len(m.BlockCommit)
+		copy(dAtA[i:], m.BlockCommit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlockCommit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parts[iNdEx])
+			copy(dAtA[i:], m.Parts[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Parts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -152,6 +297,36 @@ func (m *BlockStoreState) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovTypes(uint64(m.Height))
 	}
+	if m.ColdHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ColdHeight))
+	}
+	return n
+}
+
+func (m *BlockSegmentEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Parts) > 0 {
+		for _, b := range m.Parts {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.BlockCommit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SeenCommit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtendedCommit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -228,6 +403,209 @@ func (m *BlockStoreState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdHeight", wireType)
+			}
+			m.ColdHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColdHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockSegmentEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSegmentEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSegmentEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parts = append(m.Parts, make([]byte, postIndex-iNdEx))
+			copy(m.Parts[len(m.Parts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockCommit = append(m.BlockCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockCommit == nil {
+				m.BlockCommit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeenCommit = append(m.SeenCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeenCommit == nil {
+				m.SeenCommit = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedCommit = append(m.ExtendedCommit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedCommit == nil {
+				m.ExtendedCommit = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -85,8 +85,9 @@ func (m *CanonicalBlockID) GetPartSetHeader() CanonicalPartSetHeader {
 // CanonicalPartSetHeader is a canonical representation of a PartSetHeader,
 // which gets serialized and signed.
 type CanonicalPartSetHeader struct {
-	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Total  uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Parity uint32 `protobuf:"varint,3,opt,name=parity,proto3" json:"parity,omitempty"`
 }
 
 func (m *CanonicalPartSetHeader) Reset()         { *m = CanonicalPartSetHeader{} }
@@ -136,6 +137,13 @@ func (m *CanonicalPartSetHeader) GetHash() []byte {
 	return nil
 }
 
+func (m *CanonicalPartSetHeader) GetParity() uint32 {
+	if m != nil {
+		return m.Parity
+	}
+	return 0
+}
+
 // CanonicalProposal is a canonical representation of a Proposal, which gets
 // serialized and signed.
 type CanonicalProposal struct {
@@ -397,40 +405,41 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v2/canonical.proto", fileDescriptor_ebaceb41a6daa7e5) }
 
 var fileDescriptor_ebaceb41a6daa7e5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xdf, 0x6a, 0xd3, 0x50,
-	0x1c, 0xc7, 0x9b, 0x2e, 0x6d, 0x93, 0xb3, 0x55, 0xb7, 0xc3, 0x18, 0xb1, 0x68, 0x12, 0x2b, 0x48,
-	0x77, 0x93, 0x40, 0xf4, 0x09, 0x32, 0x05, 0x8b, 0x13, 0x47, 0x36, 0x14, 0xbc, 0x29, 0x27, 0xc9,
-	0x59, 0x12, 0x4c, 0x73, 0x0e, 0xc9, 0xe9, 0x70, 0x57, 0x7b, 0x85, 0x3d, 0x88, 0x0f, 0xb2, 0xcb,
-	0x5d, 0x0a, 0x42, 0x95, 0xf4, 0x45, 0xe4, 0x9c, 0x34, 0x69, 0xa1, 0x63, 0x20, 0xca, 0xee, 0x7e,
-	0xff, 0x7f, 0x5f, 0x3e, 0xbf, 0xe4, 0x80, 0xe7, 0x01, 0x99, 0x62, 0xe6, 0x9f, 0x33, 0x9b, 0x5d,
-	0x52, 0x5c, 0xd8, 0x17, 0x8e, 0x1d, 0xa0, 0x8c, 0x64, 0x49, 0x80, 0x52, 0x8b, 0xe6, 0x84, 0x11,
-	0xb8, 0x57, 0x97, 0x58, 0xa2, 0xc4, 0xba, 0x70, 0x06, 0xfb, 0x11, 0x89, 0x88, 0xc8, 0xda, 0xdc,
-	0xaa, 0x0a, 0x07, 0xcf, 0x36, 0x67, 0x55, 0x1d, 0x55, 0xda, 0x88, 0x08, 0x89, 0x52, 0x6c, 0x0b,
-	0xcf, 0x9f, 0x9d, 0xdb, 0x2c, 0x99, 0xe2, 0x82, 0xa1, 0x29, 0xad, 0x0a, 0x86, 0x57, 0x60, 0xf7,
-	0xa8, 0xde, 0xed, 0xa6, 0x24, 0xf8, 0x3a, 0x7e, 0x03, 0x21, 0x90, 0x63, 0x54, 0xc4, 0x9a, 0x64,
-	0x4a, 0xa3, 0x1d, 0x4f, 0xd8, 0xf0, 0x33, 0x78, 0x4c, 0x51, 0xce, 0x26, 0x05, 0x66, 0x93, 0x18,
-	0xa3, 0x10, 0xe7, 0x5a, 0xdb, 0x94, 0x46, 0xdb, 0xce, 0xa1, 0xb5, 0x21, 0xd5, 0x6a, 0x26, 0x9e,
-	0xa0, 0x9c, 0x9d, 0x62, 0xf6, 0x4e, 0x34, 0xb8, 0xf2, 0xcd, 0xdc, 0x68, 0x79, 0x7d, 0xba, 0x1e,
-	0x1c, 0xba, 0xe0, 0xe0, 0xee, 0x72, 0xb8, 0x0f, 0x3a, 0x8c, 0x30, 0x94, 0x0a, 0x1d, 0x7d, 0xaf,
-	0x72, 0x1a, 0x71, 0xed, 0x95, 0xb8, 0xe1, 0xcf, 0x36, 0xd8, 0x5b, 0x0d, 0xc9, 0x09, 0x25, 0x05,
-	0x4a, 0xe1, 0x6b, 0x20, 0x73, 0x45, 0xa2, 0xfd, 0x91, 0x63, 0xde, 0xa1, 0xf3, 0x34, 0x89, 0x32,
-	0x1c, 0x7e, 0x28, 0xa2, 0xb3, 0x4b, 0x8a, 0x3d, 0x51, 0x0d, 0x0f, 0x40, 0x37, 0xc6, 0x49, 0x14,
-	0x33, 0xb1, 0x61, 0xd7, 0x5b, 0x7a, 0x5c, 0x4d, 0x4e, 0x66, 0x59, 0xa8, 0x6d, 0x89, 0x70, 0xe5,
-	0xc0, 0x43, 0xa0, 0x52, 0x92, 0x4e, 0xaa, 0x8c, 0x6c, 0x4a, 0xa3, 0x2d, 0x77, 0xa7, 0x9c, 0x1b,
-	0xca, 0xc9, 0xc7, 0x63, 0x8f, 0xc7, 0x3c, 0x85, 0x92, 0x54, 0x58, 0xf0, 0x3d, 0x50, 0x7c, 0x0e,
-	0x78, 0x92, 0x84, 0x5a, 0x47, 0xa0, 0x7b, 0x71, 0x1f, 0xba, 0xe5, 0x31, 0xdc, 0xed, 0x72, 0x6e,
-	0xf4, 0x96, 0x8e, 0xd7, 0x13, 0x13, 0xc6, 0x21, 0x74, 0x81, 0xda, 0x5c, 0x52, 0xeb, 0x8a, 0x69,
-	0x03, 0xab, 0xba, 0xb5, 0x55, 0xdf, 0xda, 0x3a, 0xab, 0x2b, 0x5c, 0x85, 0x93, 0xbf, 0xfe, 0x65,
-	0x48, 0xde, 0xaa, 0x0d, 0xbe, 0x04, 0x4a, 0x10, 0xa3, 0x24, 0xe3, 0x82, 0x7a, 0xa6, 0x34, 0x52,
-	0xab, 0x5d, 0x47, 0x3c, 0xc6, 0x77, 0x89, 0xe4, 0x38, 0x1c, 0x7e, 0x6f, 0x83, 0x7e, 0x23, 0xeb,
-	0x13, 0x61, 0xf8, 0x41, 0xc8, 0xae, 0xe3, 0x92, 0xff, 0x2b, 0xae, 0xce, 0xbf, 0xe3, 0xea, 0xde,
-	0x83, 0xeb, 0x6a, 0xed, 0x83, 0xe6, 0xb4, 0xde, 0x7e, 0x63, 0x38, 0x2b, 0x12, 0x92, 0xc1, 0xa7,
-	0x40, 0xc5, 0xb5, 0xb3, 0xfc, 0xb9, 0x56, 0x81, 0xbf, 0xc4, 0xf3, 0x64, 0x4d, 0x0d, 0xc7, 0xa3,
-	0x36, 0x02, 0xdc, 0xe3, 0x9b, 0x52, 0x97, 0x6e, 0x4b, 0x5d, 0xfa, 0x5d, 0xea, 0xd2, 0xf5, 0x42,
-	0x6f, 0xdd, 0x2e, 0xf4, 0xd6, 0x8f, 0x85, 0xde, 0xfa, 0xe2, 0x44, 0x09, 0x8b, 0x67, 0x3e, 0xe7,
-	0x68, 0x37, 0xef, 0x46, 0x63, 0x20, 0x9a, 0xd8, 0x1b, 0xaf, 0x89, 0xdf, 0x15, 0x7c, 0x5e, 0xfd,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x49, 0xce, 0x51, 0xb5, 0x04, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xdb, 0x6a, 0xdb, 0x30,
+	0x18, 0xc7, 0xe3, 0x34, 0x47, 0xb5, 0xd9, 0x52, 0x51, 0x42, 0x16, 0x36, 0x27, 0xcb, 0x60, 0xa4,
+	0x37, 0x36, 0x64, 0x7b, 0x02, 0x77, 0x83, 0x85, 0x75, 0xac, 0xa8, 0x65, 0x83, 0xde, 0x04, 0xd9,
+	0x56, 0x6d, 0x31, 0xc7, 0x12, 0xb6, 0x52, 0x96, 0xab, 0xbe, 0x42, 0x1f, 0x64, 0x0f, 0xd2, 0xcb,
+	0x5e, 0x0e, 0x06, 0xd9, 0x70, 0x5e, 0x64, 0x48, 0x3e, 0x24, 0x23, 0xa5, 0x30, 0x36, 0x7a, 0xf7,
+	0x1d, 0xfe, 0xfa, 0xbe, 0x3f, 0x3f, 0xd9, 0x02, 0xcf, 0x1d, 0x36, 0x23, 0xc2, 0xbe, 0x10, 0xa6,
+	0x58, 0x70, 0x12, 0x9b, 0x97, 0x63, 0xd3, 0xc1, 0x21, 0x0b, 0xa9, 0x83, 0x03, 0x83, 0x47, 0x4c,
+	0x30, 0xb8, 0x9f, 0x4b, 0x0c, 0x25, 0x31, 0x2e, 0xc7, 0xbd, 0x03, 0x8f, 0x79, 0x4c, 0x75, 0x4d,
+	0x19, 0xa5, 0xc2, 0xde, 0xb3, 0xed, 0x59, 0xe9, 0x89, 0xb4, 0xdd, 0xf7, 0x18, 0xf3, 0x02, 0x62,
+	0xaa, 0xcc, 0x9e, 0x5f, 0x98, 0x82, 0xce, 0x48, 0x2c, 0xf0, 0x8c, 0xa7, 0x82, 0xe1, 0x15, 0x68,
+	0x1f, 0xe5, 0xbb, 0xad, 0x80, 0x39, 0x5f, 0x26, 0x6f, 0x20, 0x04, 0x15, 0x1f, 0xc7, 0x7e, 0x57,
+	0x1b, 0x68, 0xa3, 0x3d, 0xa4, 0x62, 0xf8, 0x19, 0x3c, 0xe6, 0x38, 0x12, 0xd3, 0x98, 0x88, 0xa9,
+	0x4f, 0xb0, 0x4b, 0xa2, 0x6e, 0x79, 0xa0, 0x8d, 0x76, 0xc7, 0x87, 0xc6, 0x96, 0x55, 0xa3, 0x98,
+	0x78, 0x82, 0x23, 0x71, 0x4a, 0xc4, 0x3b, 0x75, 0xc0, 0xaa, 0xdc, 0x2c, 0xfb, 0x25, 0xd4, 0xe2,
+	0x9b, 0xc5, 0xe1, 0x39, 0xe8, 0xdc, 0x2d, 0x87, 0x07, 0xa0, 0x2a, 0x98, 0xc0, 0x81, 0xf2, 0xd1,
+	0x42, 0x69, 0x52, 0x98, 0x2b, 0x6f, 0x98, 0xeb, 0x80, 0x1a, 0xc7, 0x11, 0x15, 0x8b, 0xee, 0x8e,
+	0x92, 0x66, 0xd9, 0xf0, 0x47, 0x19, 0xec, 0xaf, 0x87, 0x47, 0x8c, 0xb3, 0x18, 0x07, 0xf0, 0x35,
+	0xa8, 0x48, 0xa7, 0x6a, 0xec, 0xa3, 0xf1, 0xe0, 0x0e, 0xff, 0xa7, 0xd4, 0x0b, 0x89, 0xfb, 0x21,
+	0xf6, 0xce, 0x16, 0x9c, 0x20, 0xa5, 0x96, 0x3b, 0x7c, 0x42, 0x3d, 0x5f, 0xa8, 0xcd, 0x6d, 0x94,
+	0x65, 0xd2, 0x65, 0xc4, 0xe6, 0xa1, 0xab, 0x56, 0xb7, 0x51, 0x9a, 0xc0, 0x43, 0xd0, 0xe4, 0x2c,
+	0x98, 0xa6, 0x9d, 0xca, 0x40, 0x1b, 0xed, 0x58, 0x7b, 0xc9, 0xb2, 0xdf, 0x38, 0xf9, 0x78, 0x8c,
+	0x64, 0x0d, 0x35, 0x38, 0x0b, 0x54, 0x04, 0xdf, 0x83, 0x86, 0x2d, 0xc1, 0x4f, 0xa9, 0xdb, 0xad,
+	0x2a, 0xa4, 0x2f, 0xee, 0x43, 0x9a, 0x5d, 0x92, 0xb5, 0x9b, 0x2c, 0xfb, 0xf5, 0x2c, 0x41, 0x75,
+	0x35, 0x61, 0xe2, 0x42, 0x0b, 0x34, 0x8b, 0x1b, 0xee, 0xd6, 0xd4, 0xb4, 0x9e, 0x91, 0x7e, 0x03,
+	0x46, 0xfe, 0x0d, 0x18, 0x67, 0xb9, 0xc2, 0x6a, 0xc8, 0x1b, 0xb9, 0xfe, 0xd9, 0xd7, 0xd0, 0xfa,
+	0x18, 0x7c, 0x09, 0x1a, 0x8e, 0x8f, 0x69, 0x28, 0x0d, 0xd5, 0x07, 0xda, 0xa8, 0x99, 0xee, 0x3a,
+	0x92, 0x35, 0xb9, 0x4b, 0x35, 0x27, 0xee, 0xf0, 0x5b, 0x19, 0xb4, 0x0a, 0x5b, 0x9f, 0x98, 0x20,
+	0x0f, 0x42, 0x76, 0x13, 0x57, 0xe5, 0xbf, 0xe2, 0xaa, 0xfe, 0x3b, 0xae, 0xda, 0x3d, 0xb8, 0xae,
+	0x40, 0xe7, 0x0f, 0x5a, 0x6f, 0xbf, 0x0a, 0x12, 0xc6, 0x94, 0x85, 0xf0, 0x29, 0x68, 0x92, 0x3c,
+	0xc9, 0x7e, 0xba, 0x75, 0xe1, 0x2f, 0xf1, 0x3c, 0xd9, 0x70, 0x23, 0xf1, 0x34, 0x0b, 0x03, 0xd6,
+	0xf1, 0x4d, 0xa2, 0x6b, 0xb7, 0x89, 0xae, 0xfd, 0x4a, 0x74, 0xed, 0x7a, 0xa5, 0x97, 0x6e, 0x57,
+	0x7a, 0xe9, 0xfb, 0x4a, 0x2f, 0x9d, 0x8f, 0x3d, 0x2a, 0xfc, 0xb9, 0x2d, 0x39, 0x9a, 0xc5, 0x7b,
+	0x52, 0x04, 0x98, 0x53, 0x73, 0xeb, 0x95, 0xb1, 0x6b, 0x8a, 0xcf, 0xab, 0xdf, 0x03, 0x00, 0x39,
+	0x3e, 0x43, 0x9e, 0xcd, 0x04, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -493,6 +502,11 @@ func (m *CanonicalPartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Parity != 0 {
+		i = encodeVarintCanonical(dAtA, i, uint64(m.Parity))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -735,6 +749,9 @@ func (m *CanonicalPartSetHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCanonical(uint64(l))
 	}
+	if m.Parity != 0 {
+		n += 1 + sovCanonical(uint64(m.Parity))
+	}
 	return n
 }
 
@@ -1025,6 +1042,25 @@ func (m *CanonicalPartSetHeader) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			m.Parity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCanonical(dAtA[iNdEx:])
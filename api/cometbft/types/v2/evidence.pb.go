@@ -34,6 +34,7 @@ type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
 	//	*Evidence_LightClientAttackEvidence
+	//	*Evidence_DuplicateProposalEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -82,9 +83,13 @@ type Evidence_DuplicateVoteEvidence struct {
 type Evidence_LightClientAttackEvidence struct {
 	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,2,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
 }
+type Evidence_DuplicateProposalEvidence struct {
+	DuplicateProposalEvidence *DuplicateProposalEvidence `protobuf:"bytes,3,opt,name=duplicate_proposal_evidence,json=duplicateProposalEvidence,proto3,oneof" json:"duplicate_proposal_evidence,omitempty"`
+}
 
 func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()     {}
 func (*Evidence_LightClientAttackEvidence) isEvidence_Sum() {}
+func (*Evidence_DuplicateProposalEvidence) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -107,11 +112,19 @@ func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
 	return nil
 }
 
+func (m *Evidence) GetDuplicateProposalEvidence() *DuplicateProposalEvidence {
+	if x, ok := m.GetSum().(*Evidence_DuplicateProposalEvidence); ok {
+		return x.DuplicateProposalEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
 		(*Evidence_LightClientAttackEvidence)(nil),
+		(*Evidence_DuplicateProposalEvidence)(nil),
 	}
 }
 
@@ -269,6 +282,91 @@ func (m *LightClientAttackEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// DuplicateProposalEvidence contains evidence of a validator signed two conflicting proposals.
+type DuplicateProposalEvidence struct {
+	ProposalA        *Proposal `protobuf:"bytes,1,opt,name=proposal_a,json=proposalA,proto3" json:"proposal_a,omitempty"`
+	ProposalB        *Proposal `protobuf:"bytes,2,opt,name=proposal_b,json=proposalB,proto3" json:"proposal_b,omitempty"`
+	ProposerAddress  []byte    `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	TotalVotingPower int64     `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower   int64     `protobuf:"varint,5,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp        time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *DuplicateProposalEvidence) Reset()         { *m = DuplicateProposalEvidence{} }
+func (m *DuplicateProposalEvidence) String() string { return proto.CompactTextString(m) }
+func (*DuplicateProposalEvidence) ProtoMessage()    {}
+func (*DuplicateProposalEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_329a3b5063a1e206, []int{3}
+}
+func (m *DuplicateProposalEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateProposalEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateProposalEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateProposalEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateProposalEvidence.Merge(m, src)
+}
+func (m *DuplicateProposalEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateProposalEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateProposalEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateProposalEvidence proto.InternalMessageInfo
+
+func (m *DuplicateProposalEvidence) GetProposalA() *Proposal {
+	if m != nil {
+		return m.ProposalA
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetProposalB() *Proposal {
+	if m != nil {
+		return m.ProposalB
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetProposerAddress() []byte {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
 // EvidenceList is a list of evidence.
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
@@ -278,7 +376,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_329a3b5063a1e206, []int{3}
+	return fileDescriptor_329a3b5063a1e206, []int{4}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,47 +416,53 @@ func init() {
 	proto.RegisterType((*Evidence)(nil), "cometbft.types.v2.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "cometbft.types.v2.DuplicateVoteEvidence")
 	proto.RegisterType((*LightClientAttackEvidence)(nil), "cometbft.types.v2.LightClientAttackEvidence")
+	proto.RegisterType((*DuplicateProposalEvidence)(nil), "cometbft.types.v2.DuplicateProposalEvidence")
 	proto.RegisterType((*EvidenceList)(nil), "cometbft.types.v2.EvidenceList")
 }
 
 func init() { proto.RegisterFile("cometbft/types/v2/evidence.proto", fileDescriptor_329a3b5063a1e206) }
 
 var fileDescriptor_329a3b5063a1e206 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0x3a, 0xa9, 0xc2, 0xb6, 0x40, 0xbb, 0xb4, 0x6a, 0x1a, 0x5a, 0x27, 0x84, 0x03,
-	0x39, 0x54, 0xb6, 0x14, 0xce, 0x1c, 0x6a, 0x40, 0xaa, 0x50, 0x10, 0xc8, 0x42, 0x3d, 0x70, 0xb1,
-	0xd6, 0xce, 0xc6, 0x59, 0xd5, 0xf6, 0x5a, 0xf1, 0xc4, 0xa8, 0x3c, 0x45, 0xc5, 0x53, 0xf5, 0x46,
-	0x8f, 0x9c, 0x00, 0x25, 0x12, 0xcf, 0x81, 0x76, 0x6d, 0x6f, 0x23, 0xc5, 0xe6, 0xc4, 0x6d, 0x33,
-	0xf3, 0xfd, 0x3b, 0x33, 0xff, 0xc4, 0x8b, 0xfa, 0x3e, 0x8f, 0x28, 0x78, 0x53, 0xb0, 0xe0, 0x3a,
-	0xa1, 0xa9, 0x95, 0x8d, 0x2c, 0x9a, 0xb1, 0x09, 0x8d, 0x7d, 0x6a, 0x26, 0x73, 0x0e, 0x1c, 0xef,
-	0x97, 0x84, 0x29, 0x09, 0x33, 0x1b, 0x75, 0x4f, 0x37, 0x45, 0x79, 0x4e, 0x2a, 0xba, 0xcf, 0x36,
-	0xd3, 0x19, 0x09, 0xd9, 0x84, 0x00, 0x9f, 0x17, 0xc8, 0x41, 0xc0, 0x03, 0x2e, 0x8f, 0x96, 0x38,
-	0x15, 0xd1, 0x5e, 0xc0, 0x79, 0x10, 0x52, 0x4b, 0xfe, 0xf2, 0x16, 0x53, 0x0b, 0x58, 0x44, 0x53,
-	0x20, 0x51, 0x92, 0x03, 0x83, 0x3f, 0x1a, 0x6a, 0xbf, 0x2d, 0xda, 0xc3, 0x1e, 0x3a, 0x9a, 0x2c,
-	0x92, 0x90, 0xf9, 0x04, 0xa8, 0x9b, 0x71, 0xa0, 0x6e, 0xd9, 0x79, 0x47, 0xeb, 0x6b, 0xc3, 0x9d,
-	0xd1, 0xd0, 0xdc, 0x68, 0xdd, 0x7c, 0x53, 0x2a, 0x2e, 0x39, 0xd0, 0xf2, 0xaa, 0x8b, 0x86, 0x73,
-	0x38, 0xa9, 0x4a, 0x60, 0x8e, 0x4e, 0x42, 0x16, 0xcc, 0xc0, 0xf5, 0x43, 0x46, 0x63, 0x70, 0x09,
-	0x00, 0xf1, 0xaf, 0xee, 0x0b, 0x6d, 0xc9, 0x42, 0x67, 0x15, 0x85, 0xc6, 0x42, 0xf6, 0x5a, 0xaa,
-	0xce, 0xa5, 0x68, 0xad, 0xd8, 0x71, 0x58, 0x97, 0xb4, 0x5b, 0x48, 0x4f, 0x17, 0xd1, 0xe0, 0xdb,
-	0x16, 0x3a, 0xac, 0x6c, 0x15, 0x9b, 0x68, 0x5b, 0xce, 0x4a, 0x8a, 0x21, 0x8f, 0x2a, 0x6a, 0x0b,
-	0x81, 0xd3, 0x12, 0xd8, 0xb9, 0xe2, 0xbd, 0xa2, 0xd7, 0x7f, 0xf3, 0x36, 0x3e, 0x43, 0x18, 0x38,
-	0x90, 0x50, 0x38, 0xca, 0xe2, 0xc0, 0x4d, 0xf8, 0x17, 0x3a, 0xef, 0xe8, 0x7d, 0x6d, 0xa8, 0x3b,
-	0x7b, 0x32, 0x73, 0x29, 0x13, 0x1f, 0x45, 0x1c, 0xbf, 0x40, 0x8f, 0xd5, 0x6a, 0x0b, 0xb4, 0x29,
-	0xd1, 0x47, 0x2a, 0x9c, 0x83, 0x36, 0x7a, 0xa0, 0x96, 0xd9, 0x69, 0xc9, 0x4e, 0xba, 0x66, 0xbe,
-	0x6e, 0xb3, 0x5c, 0xb7, 0xf9, 0xa9, 0x24, 0xec, 0xf6, 0xed, 0xcf, 0x5e, 0xe3, 0xe6, 0x57, 0x4f,
-	0x73, 0xee, 0x65, 0x83, 0xef, 0x5b, 0xe8, 0xb8, 0xd6, 0x56, 0xfc, 0x0e, 0xed, 0xfb, 0x3c, 0x9e,
-	0x86, 0xcc, 0x97, 0x7d, 0x7b, 0x21, 0xf7, 0xaf, 0x0a, 0x8f, 0x4e, 0xeb, 0xf6, 0x63, 0x0b, 0xc8,
-	0xd9, 0x5b, 0xd3, 0xc9, 0x08, 0x7e, 0x8e, 0x1e, 0xfa, 0x3c, 0x8a, 0x78, 0xec, 0xce, 0xa8, 0xe0,
-	0xa4, 0x77, 0xba, 0xb3, 0x9b, 0x07, 0x2f, 0x64, 0x0c, 0x7f, 0x40, 0x07, 0xde, 0xf5, 0x57, 0x12,
-	0x03, 0x8b, 0xa9, 0xab, 0xc6, 0x4d, 0x3b, 0x7a, 0x5f, 0x1f, 0xee, 0x8c, 0x4e, 0xaa, 0x7c, 0x2e,
-	0x21, 0xe7, 0x89, 0x52, 0xaa, 0x58, 0x5a, 0x63, 0x7d, 0xb3, 0xc6, 0xfa, 0xff, 0xe1, 0xe8, 0x7b,
-	0xb4, 0x5b, 0xfa, 0x37, 0x66, 0x29, 0xe0, 0x57, 0xa8, 0xbd, 0xf6, 0x0d, 0x89, 0x31, 0x9e, 0x56,
-	0x8c, 0xa1, 0xfe, 0xac, 0x4d, 0x71, 0xa7, 0xa3, 0x24, 0xf6, 0xf8, 0x76, 0x69, 0x68, 0x77, 0x4b,
-	0x43, 0xfb, 0xbd, 0x34, 0xb4, 0x9b, 0x95, 0xd1, 0xb8, 0x5b, 0x19, 0x8d, 0x1f, 0x2b, 0xa3, 0xf1,
-	0x79, 0x14, 0x30, 0x98, 0x2d, 0x3c, 0x71, 0x99, 0xa5, 0x5e, 0x07, 0x75, 0x20, 0x09, 0xb3, 0x36,
-	0xde, 0x0c, 0x6f, 0x5b, 0x4e, 0xf1, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xa6, 0x4d,
-	0xab, 0xa3, 0x04, 0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0x92, 0x75, 0xda, 0xbc, 0xc1, 0x36, 0xb3, 0x69, 0xdd, 0xaf, 0x6c, 0x94, 0x03, 0x45,
+	0x9a, 0x12, 0xa9, 0xdc, 0x90, 0x38, 0x34, 0x80, 0x34, 0xa1, 0x22, 0xa6, 0x08, 0xed, 0xc0, 0x25,
+	0x72, 0x12, 0x37, 0xb5, 0x96, 0xc4, 0x51, 0xe2, 0x16, 0x8d, 0xbf, 0x62, 0xe2, 0xaf, 0xda, 0x8d,
+	0x9d, 0x10, 0x5c, 0x00, 0xb5, 0xff, 0x08, 0x8a, 0x13, 0xbb, 0x45, 0x4d, 0xc4, 0x40, 0xdc, 0x9c,
+	0xf7, 0xbd, 0xe7, 0xef, 0xfb, 0x5e, 0x9e, 0x0c, 0x4e, 0x3c, 0x1a, 0x61, 0xe6, 0x0e, 0x98, 0xc9,
+	0xae, 0x12, 0x9c, 0x99, 0xe3, 0xae, 0x89, 0xc7, 0xc4, 0xc7, 0xb1, 0x87, 0x8d, 0x24, 0xa5, 0x8c,
+	0xc2, 0x2d, 0xc1, 0x30, 0x38, 0xc3, 0x18, 0x77, 0xf7, 0x8f, 0x16, 0x45, 0x45, 0x8d, 0x2b, 0xf6,
+	0x1f, 0x2e, 0x96, 0xc7, 0x28, 0x24, 0x3e, 0x62, 0x34, 0x2d, 0x29, 0xdb, 0x01, 0x0d, 0x28, 0x3f,
+	0x9a, 0xf9, 0xa9, 0x44, 0x8f, 0x03, 0x4a, 0x83, 0x10, 0x9b, 0xfc, 0xcb, 0x1d, 0x0d, 0x4c, 0x46,
+	0x22, 0x9c, 0x31, 0x14, 0x25, 0x05, 0xa1, 0xfd, 0x45, 0x05, 0x2b, 0xaf, 0xca, 0xf1, 0xa0, 0x0b,
+	0x76, 0xfd, 0x51, 0x12, 0x12, 0x0f, 0x31, 0xec, 0x8c, 0x29, 0xc3, 0x8e, 0x98, 0xbc, 0xa5, 0x9c,
+	0x28, 0x9d, 0xb5, 0x6e, 0xc7, 0x58, 0x18, 0xdd, 0x78, 0x29, 0x14, 0x17, 0x94, 0x61, 0x71, 0xd5,
+	0x59, 0xc3, 0xde, 0xf1, 0xab, 0x0a, 0x90, 0x82, 0xc3, 0x90, 0x04, 0x43, 0xe6, 0x78, 0x21, 0xc1,
+	0x31, 0x73, 0x10, 0x63, 0xc8, 0xbb, 0x9c, 0x35, 0x52, 0x79, 0xa3, 0xd3, 0x8a, 0x46, 0xfd, 0x5c,
+	0xf6, 0x82, 0xab, 0x7a, 0x5c, 0x34, 0xd7, 0x6c, 0x2f, 0xac, 0x2b, 0xc2, 0x18, 0x1c, 0xcc, 0x96,
+	0x4a, 0x52, 0x9a, 0xd0, 0x0c, 0x85, 0xb3, 0x7e, 0x5a, 0x6d, 0x3f, 0xb9, 0xd8, 0x79, 0x29, 0x9a,
+	0xef, 0xe7, 0xd7, 0x15, 0xad, 0x26, 0xd0, 0xb2, 0x51, 0xd4, 0xfe, 0xa4, 0x82, 0x9d, 0x4a, 0x6b,
+	0xa0, 0x01, 0x96, 0xb9, 0xb7, 0xa8, 0x34, 0x75, 0xb7, 0xa2, 0x77, 0x2e, 0xb0, 0x9b, 0x39, 0xad,
+	0x27, 0xf9, 0x6e, 0x4b, 0xbd, 0x03, 0xdf, 0x82, 0xa7, 0x00, 0x32, 0xca, 0x50, 0x98, 0xff, 0x41,
+	0x12, 0x07, 0x4e, 0x42, 0x3f, 0xe0, 0x94, 0xef, 0xa9, 0xd9, 0x9b, 0xbc, 0x72, 0xc1, 0x0b, 0xe7,
+	0x39, 0x0e, 0x1f, 0x83, 0x0d, 0x19, 0xa5, 0x92, 0xba, 0xc4, 0xa9, 0xf7, 0x25, 0x5c, 0x10, 0x2d,
+	0xb0, 0x2a, 0xc3, 0xd3, 0x6a, 0xf2, 0x49, 0xf6, 0x8d, 0x22, 0x5e, 0x86, 0x88, 0x97, 0xf1, 0x4e,
+	0x30, 0xac, 0x95, 0x9b, 0xef, 0xc7, 0x8d, 0xeb, 0x1f, 0xc7, 0x8a, 0x3d, 0x93, 0xb5, 0x3f, 0xab,
+	0x60, 0xaf, 0xf6, 0x37, 0xc2, 0xd7, 0x60, 0xcb, 0xa3, 0xf1, 0x20, 0x24, 0x1e, 0x9f, 0xdb, 0x0d,
+	0xa9, 0x77, 0x59, 0x7a, 0x74, 0x54, 0x97, 0x07, 0x2b, 0x27, 0xd9, 0x9b, 0x73, 0x3a, 0x8e, 0xc0,
+	0x47, 0xe0, 0x9e, 0x47, 0xa3, 0x88, 0xc6, 0xce, 0x10, 0xe7, 0x3c, 0xee, 0x9d, 0x66, 0xaf, 0x17,
+	0xe0, 0x19, 0xc7, 0xe0, 0x5b, 0xb0, 0xed, 0x5e, 0x7d, 0x44, 0x31, 0x23, 0x31, 0x76, 0xe4, 0xba,
+	0x59, 0x4b, 0x3b, 0xd1, 0x3a, 0x6b, 0xdd, 0xc3, 0x2a, 0x9f, 0x05, 0xc9, 0x7e, 0x20, 0x95, 0x12,
+	0xcb, 0x6a, 0xac, 0x5f, 0xaa, 0xb1, 0xfe, 0x7f, 0x38, 0xfa, 0x4d, 0x05, 0x7b, 0xb5, 0x41, 0x85,
+	0xcf, 0x00, 0x90, 0x89, 0x17, 0x71, 0x3b, 0xa8, 0x58, 0x4b, 0x08, 0xed, 0x55, 0x41, 0xef, 0xfd,
+	0xa6, 0x15, 0xd1, 0xbb, 0x9b, 0xd6, 0x82, 0x4f, 0xc0, 0x66, 0xf1, 0x81, 0x53, 0x07, 0xf9, 0x7e,
+	0x8a, 0xb3, 0x8c, 0x07, 0x70, 0xdd, 0xde, 0x10, 0x78, 0xaf, 0x80, 0xff, 0xd2, 0xb2, 0x8a, 0xb4,
+	0x36, 0xff, 0x9c, 0xd6, 0xe5, 0x7f, 0xf3, 0xf6, 0x0d, 0x58, 0x17, 0x4e, 0xf6, 0x49, 0xc6, 0xe0,
+	0x73, 0xb0, 0x32, 0xf7, 0x1e, 0x6a, 0x35, 0x7e, 0xc8, 0x87, 0x60, 0x29, 0xbf, 0xd3, 0x96, 0x12,
+	0xab, 0x7f, 0x33, 0xd1, 0x95, 0xdb, 0x89, 0xae, 0xfc, 0x9c, 0xe8, 0xca, 0xf5, 0x54, 0x6f, 0xdc,
+	0x4e, 0xf5, 0xc6, 0xd7, 0xa9, 0xde, 0x78, 0xdf, 0x0d, 0x08, 0x1b, 0x8e, 0xdc, 0xfc, 0x32, 0x53,
+	0xbe, 0xf4, 0xf2, 0x80, 0x12, 0x62, 0x2e, 0xbc, 0xff, 0xee, 0x32, 0xdf, 0xe2, 0xe9, 0xaf, 0x01,
+	0x00, 0x96, 0xad, 0x82, 0x12, 0x6f, 0x06, 0x00, 0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
@@ -435,6 +539,27 @@ func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateProposalEvidence != nil {
+		{
+			size, err := m.DuplicateProposalEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -455,12 +580,12 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -520,12 +645,12 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintEvidence(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintEvidence(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	if m.TotalVotingPower != 0 {
@@ -567,6 +692,78 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DuplicateProposalEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintEvidence(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalB != nil {
+		{
+			size, err := m.ProposalB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalA != nil {
+		{
+			size, err := m.ProposalA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -651,6 +848,18 @@ func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
 	}
 	return n
 }
+func (m *Evidence_DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateProposalEvidence != nil {
+		l = m.DuplicateProposalEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
 func (m *DuplicateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -703,6 +912,35 @@ func (m *LightClientAttackEvidence) Size() (n int) {
 	return n
 }
 
+func (m *DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalA != nil {
+		l = m.ProposalA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalB != nil {
+		l = m.ProposalB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func (m *EvidenceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -823,6 +1061,41 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_LightClientAttackEvidence{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateProposalEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DuplicateProposalEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_DuplicateProposalEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -1228,6 +1501,233 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DuplicateProposalEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalA == nil {
+				m.ProposalA = &Proposal{}
+			}
+			if err := m.ProposalA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalB == nil {
+				m.ProposalB = &Proposal{}
+			}
+			if err := m.ProposalB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EvidenceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
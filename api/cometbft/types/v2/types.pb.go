@@ -69,6 +69,10 @@ func (SignedMsgType) EnumDescriptor() ([]byte, []int) {
 type PartSetHeader struct {
 	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of the parts which are Reed-Solomon parity parts. A value > 0
+	// denotes an erasure-coded part set, of which any total - parity parts
+	// suffice to reconstruct the block.
+	Parity uint32 `protobuf:"varint,3,opt,name=parity,proto3" json:"parity,omitempty"`
 }
 
 func (m *PartSetHeader) Reset()         { *m = PartSetHeader{} }
@@ -118,6 +122,13 @@ func (m *PartSetHeader) GetHash() []byte {
 	return nil
 }
 
+func (m *PartSetHeader) GetParity() uint32 {
+	if m != nil {
+		return m.Parity
+	}
+	return 0
+}
+
 // Part of the block.
 type Part struct {
 	Index uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -588,6 +599,10 @@ type Commit struct {
 	Round      int32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	BlockID    BlockID     `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
 	Signatures []CommitSig `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+	// BLS12-381 aggregate of the signatures of the commit sigs, which then have
+	// no signature of their own. Only set if the validator set has only
+	// BLS12-381 keys.
+	AggregatedSignature []byte `protobuf:"bytes,5,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
 }
 
 func (m *Commit) Reset()         { *m = Commit{} }
@@ -651,6 +666,13 @@ func (m *Commit) GetSignatures() []CommitSig {
 	return nil
 }
 
+func (m *Commit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
 // CommitSig is a part of the Vote included in a Commit.
 type CommitSig struct {
 	BlockIdFlag      BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=cometbft.types.v2.BlockIDFlag" json:"block_id_flag,omitempty"`
@@ -726,6 +748,10 @@ type ExtendedCommit struct {
 	Round              int32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	BlockID            BlockID             `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
 	ExtendedSignatures []ExtendedCommitSig `protobuf:"bytes,4,rep,name=extended_signatures,json=extendedSignatures,proto3" json:"extended_signatures"`
+	// BLS12-381 aggregate of the signatures of the extended commit sigs that
+	// have no signature of their own. Only set if the extended commit was
+	// reconstructed from a Commit with an aggregated signature.
+	AggregatedSignature []byte `protobuf:"bytes,5,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
 }
 
 func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
@@ -789,6 +815,13 @@ func (m *ExtendedCommit) GetExtendedSignatures() []ExtendedCommitSig {
 	return nil
 }
 
+func (m *ExtendedCommit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
 // ExtendedCommitSig retains all the same fields as CommitSig but adds vote
 // extension-related fields, where:
 // 'extension' and 'extension_signature' are used for replay-protected vote extensions.
@@ -1250,93 +1283,94 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v2/types.proto", fileDescriptor_b33958ab5ece188f) }
 
 var fileDescriptor_b33958ab5ece188f = []byte{
-	// 1363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x1b, 0xff, 0x79, 0xb6, 0x13, 0x67, 0x89, 0xa8, 0xe3, 0xb6, 0x8e, 0x31, 0xff,
-	0x4c, 0x41, 0x76, 0x6b, 0x40, 0x80, 0x90, 0x90, 0xea, 0x24, 0x6d, 0x23, 0x9a, 0xc4, 0x5a, 0xbb,
-	0x45, 0xc0, 0x61, 0xb5, 0xf6, 0x4e, 0xec, 0x55, 0xed, 0x9d, 0xd5, 0xee, 0xd8, 0x24, 0xfd, 0x04,
-	0xa8, 0xa7, 0x1e, 0x39, 0x50, 0x09, 0x09, 0x0e, 0x7c, 0x01, 0xbe, 0x00, 0xe2, 0xd0, 0x63, 0x6f,
-	0x70, 0x2a, 0x28, 0xb9, 0xf0, 0x31, 0xd0, 0xbc, 0x99, 0xdd, 0xb5, 0x63, 0x87, 0x96, 0xb6, 0x02,
-	0x89, 0xdb, 0xcc, 0x7b, 0xbf, 0xf7, 0xe6, 0xed, 0x7b, 0xbf, 0x79, 0xfb, 0x06, 0x2e, 0x76, 0xe9,
-	0x90, 0xb0, 0xce, 0x01, 0xab, 0xb1, 0x23, 0x97, 0xf8, 0xb5, 0x71, 0x5d, 0x2c, 0xaa, 0xae, 0x47,
-	0x19, 0xd5, 0x56, 0x03, 0x75, 0x55, 0x48, 0xc7, 0xf5, 0x42, 0x31, 0xb4, 0xe8, 0x7a, 0x47, 0x2e,
-	0xa3, 0xb5, 0xf1, 0x95, 0x9a, 0xeb, 0x51, 0x7a, 0x20, 0x4c, 0x0a, 0xaf, 0xcc, 0x7a, 0x1c, 0x9b,
-	0x03, 0xdb, 0x32, 0x19, 0xf5, 0x24, 0x64, 0x23, 0x84, 0x8c, 0x89, 0xe7, 0xdb, 0xd4, 0xe1, 0x3e,
-	0x26, 0x8e, 0x2d, 0xac, 0xf5, 0x68, 0x8f, 0xe2, 0xb2, 0xc6, 0x57, 0x81, 0x59, 0x8f, 0xd2, 0xde,
-	0x80, 0xd4, 0x70, 0xd7, 0x19, 0x1d, 0xd4, 0x98, 0x3d, 0x24, 0x3e, 0x33, 0x87, 0xae, 0x00, 0x94,
-	0x3f, 0x82, 0x6c, 0xd3, 0xf4, 0x58, 0x8b, 0xb0, 0x1b, 0xc4, 0xb4, 0x88, 0xa7, 0xad, 0xc1, 0x12,
-	0xa3, 0xcc, 0x1c, 0xe4, 0x95, 0x92, 0x52, 0xc9, 0xea, 0x62, 0xa3, 0x69, 0xa0, 0xf6, 0x4d, 0xbf,
-	0x9f, 0x5f, 0x2c, 0x29, 0x95, 0x8c, 0x8e, 0xeb, 0xb2, 0x0d, 0x2a, 0x37, 0xe5, 0x16, 0xb6, 0x63,
-	0x91, 0xc3, 0xc0, 0x02, 0x37, 0x5c, 0xda, 0x39, 0x62, 0xc4, 0x97, 0x26, 0x62, 0xa3, 0xbd, 0x0f,
-	0x4b, 0xf8, 0xe1, 0xf9, 0x58, 0x49, 0xa9, 0xa4, 0xeb, 0xeb, 0xd5, 0x30, 0x59, 0x22, 0x33, 0xd5,
-	0xf1, 0x95, 0x6a, 0x93, 0x03, 0x1a, 0xea, 0xc3, 0xc7, 0x1b, 0x0b, 0xba, 0x40, 0x97, 0x87, 0x90,
-	0x68, 0x0c, 0x68, 0xf7, 0xce, 0xce, 0x56, 0x18, 0x89, 0x12, 0x45, 0xa2, 0xed, 0xc1, 0x8a, 0x6b,
-	0x7a, 0xcc, 0xf0, 0x09, 0x33, 0xfa, 0xf8, 0x19, 0x78, 0x6a, 0xba, 0x5e, 0xaa, 0xce, 0x14, 0xa3,
-	0x3a, 0xf5, 0xb9, 0xf2, 0x98, 0xac, 0x3b, 0x29, 0x2c, 0xff, 0xa9, 0x42, 0x5c, 0xa6, 0xe3, 0x13,
-	0x48, 0xc8, 0x84, 0xe3, 0x89, 0xe9, 0x7a, 0x31, 0x72, 0x29, 0x15, 0x3c, 0xe6, 0x4d, 0xea, 0xf8,
-	0xc4, 0xf1, 0x47, 0xbe, 0x74, 0x18, 0x18, 0x69, 0x6f, 0x40, 0xb2, 0xdb, 0x37, 0x6d, 0xc7, 0xb0,
-	0x2d, 0x8c, 0x29, 0xd5, 0x48, 0x1f, 0x3f, 0xde, 0x48, 0x6c, 0x72, 0xd9, 0xce, 0x96, 0x9e, 0x40,
-	0xe5, 0x8e, 0xa5, 0xbd, 0x0c, 0xf1, 0x3e, 0xb1, 0x7b, 0x7d, 0x86, 0x99, 0x89, 0xe9, 0x72, 0xa7,
-	0x7d, 0x08, 0x2a, 0x2f, 0x59, 0x5e, 0xc5, 0xc3, 0x0b, 0x55, 0x51, 0xcf, 0x6a, 0x50, 0xcf, 0x6a,
-	0x3b, 0xa8, 0x67, 0x23, 0xc9, 0x0f, 0xbe, 0xff, 0xfb, 0x86, 0xa2, 0xa3, 0x85, 0xb6, 0x05, 0xd9,
-	0x81, 0xe9, 0x33, 0xa3, 0xc3, 0x13, 0xc7, 0x8f, 0x5f, 0x92, 0x2e, 0x66, 0x53, 0x22, 0x73, 0x2b,
-	0x63, 0x4f, 0x73, 0x33, 0x21, 0xb2, 0xb4, 0x0a, 0xe4, 0xd0, 0x4b, 0x97, 0x0e, 0x87, 0x36, 0x33,
-	0x30, 0xf5, 0x71, 0x4c, 0xfd, 0x32, 0x97, 0x6f, 0xa2, 0xf8, 0x06, 0x2f, 0xc2, 0x79, 0x48, 0x59,
-	0x26, 0x33, 0x05, 0x24, 0x81, 0x90, 0x24, 0x17, 0xa0, 0xf2, 0x4d, 0x58, 0x09, 0x19, 0xed, 0x0b,
-	0x48, 0x52, 0x78, 0x89, 0xc4, 0x08, 0xbc, 0x0c, 0x6b, 0x0e, 0x39, 0x64, 0xc6, 0x69, 0x74, 0x0a,
-	0xd1, 0x1a, 0xd7, 0xdd, 0x9e, 0xb6, 0x78, 0x1d, 0x96, 0xbb, 0x41, 0xf6, 0x05, 0x16, 0x10, 0x9b,
-	0x0d, 0xa5, 0x08, 0x5b, 0x87, 0xa4, 0xe9, 0xba, 0x02, 0x90, 0x46, 0x40, 0xc2, 0x74, 0x5d, 0x54,
-	0x5d, 0x82, 0x55, 0xfc, 0x46, 0x8f, 0xf8, 0xa3, 0x01, 0x93, 0x4e, 0x32, 0x88, 0x59, 0xe1, 0x0a,
-	0x5d, 0xc8, 0x11, 0xfb, 0x2a, 0x64, 0xc9, 0xd8, 0xb6, 0x88, 0xd3, 0x25, 0x02, 0x97, 0x45, 0x5c,
-	0x26, 0x10, 0x22, 0xe8, 0x2d, 0xc8, 0xb9, 0x1e, 0x75, 0xa9, 0x4f, 0x3c, 0xc3, 0xb4, 0x2c, 0x8f,
-	0xf8, 0x7e, 0x7e, 0x59, 0xf8, 0x0b, 0xe4, 0x57, 0x85, 0xb8, 0x9c, 0x07, 0x75, 0xcb, 0x64, 0xa6,
-	0x96, 0x83, 0x18, 0x3b, 0xf4, 0xf3, 0x4a, 0x29, 0x56, 0xc9, 0xe8, 0x7c, 0x59, 0xfe, 0x4e, 0x05,
-	0xf5, 0x36, 0x65, 0x44, 0x7b, 0x0f, 0x54, 0x5e, 0x29, 0xe4, 0xdf, 0xf2, 0x5c, 0x4a, 0xb7, 0xec,
-	0x9e, 0x43, 0xac, 0x5d, 0xbf, 0xd7, 0x3e, 0x72, 0x89, 0x8e, 0xe8, 0x09, 0x42, 0x2d, 0x4e, 0x11,
-	0x6a, 0x0d, 0x96, 0x3c, 0x3a, 0x72, 0x2c, 0xe4, 0xd9, 0x92, 0x2e, 0x36, 0xda, 0x35, 0x48, 0x86,
-	0x3c, 0x51, 0x9f, 0xc8, 0x93, 0x15, 0xce, 0x13, 0x4e, 0x63, 0x29, 0xd0, 0x13, 0x1d, 0x49, 0x97,
-	0x06, 0xa4, 0xc2, 0x0e, 0x13, 0x12, 0xee, 0x69, 0x38, 0x1b, 0x99, 0x69, 0x6f, 0xc3, 0x6a, 0x58,
-	0xfd, 0x30, 0x7d, 0x82, 0x73, 0xb9, 0x50, 0x21, 0xf3, 0x37, 0x45, 0x2c, 0x43, 0xb4, 0xa1, 0x04,
-	0x7e, 0x58, 0x44, 0xac, 0x1d, 0xec, 0x47, 0x17, 0x20, 0xe5, 0xdb, 0x3d, 0xc7, 0x64, 0x23, 0x8f,
-	0x48, 0xee, 0x45, 0x02, 0xae, 0x25, 0x87, 0x8c, 0x38, 0x78, 0xd1, 0x05, 0xd7, 0x22, 0x81, 0x56,
-	0x83, 0x97, 0xc2, 0x8d, 0x11, 0x79, 0x11, 0x3c, 0xd3, 0x42, 0x55, 0x2b, 0x74, 0x57, 0x81, 0x9c,
-	0x43, 0x1d, 0xc3, 0x73, 0x8d, 0xc8, 0xab, 0x20, 0xdd, 0xb2, 0x43, 0x1d, 0xdd, 0xdd, 0x0e, 0x5d,
-	0x7f, 0x0c, 0x85, 0xd3, 0xc8, 0x89, 0x13, 0x04, 0x09, 0xcf, 0x4d, 0xdb, 0x84, 0xc7, 0x94, 0x7f,
-	0x56, 0x20, 0x2e, 0x6e, 0xe0, 0x44, 0xb9, 0x95, 0xf9, 0xe5, 0x5e, 0x3c, 0xab, 0xdc, 0xb1, 0xe7,
-	0x2a, 0x37, 0x84, 0xc1, 0xfa, 0x79, 0xb5, 0x14, 0xab, 0xa4, 0xeb, 0x17, 0xe6, 0x78, 0x12, 0x41,
-	0xb6, 0xec, 0x9e, 0x6c, 0x31, 0x13, 0x56, 0xe5, 0xc7, 0x0a, 0xa4, 0x42, 0xbd, 0xd6, 0x80, 0x6c,
-	0x10, 0x99, 0x71, 0x30, 0x30, 0x7b, 0x92, 0xf5, 0xc5, 0xb3, 0xc3, 0xbb, 0x36, 0x30, 0x7b, 0x7a,
-	0x5a, 0x46, 0xc4, 0x37, 0xf3, 0x09, 0xb4, 0x78, 0x06, 0x81, 0xa6, 0x18, 0x1b, 0x7b, 0x36, 0xc6,
-	0x4e, 0x71, 0x4b, 0x3d, 0xc5, 0xad, 0xf2, 0x89, 0x02, 0xcb, 0x58, 0x3c, 0x8b, 0x58, 0xff, 0x69,
-	0xb5, 0xbe, 0x94, 0x34, 0xb6, 0x88, 0x65, 0xcc, 0x94, 0xed, 0xb5, 0x39, 0x2e, 0xa7, 0xa3, 0x8e,
-	0xca, 0xa7, 0x05, 0x6e, 0x5a, 0x51, 0x19, 0xbf, 0x8d, 0xc1, 0xea, 0x0c, 0xfe, 0x7f, 0x58, 0xce,
-	0xe9, 0x56, 0xb1, 0xf4, 0x94, 0xad, 0x22, 0xfe, 0x8f, 0x5a, 0x45, 0xe2, 0x19, 0x5a, 0x45, 0xf2,
-	0xef, 0x5b, 0xc5, 0x4f, 0x8b, 0x90, 0x6c, 0xe2, 0xbf, 0xc7, 0x1c, 0xfc, 0x2b, 0x7f, 0x94, 0xf3,
-	0x90, 0x72, 0xe9, 0xc0, 0x10, 0x1a, 0x15, 0x35, 0x49, 0x97, 0x0e, 0xf4, 0x19, 0x46, 0x2f, 0xbd,
-	0xa8, 0xdf, 0x4d, 0xfc, 0x05, 0x54, 0x3b, 0x71, 0xfa, 0xf2, 0x32, 0xc8, 0x88, 0x5c, 0xc8, 0x79,
-	0xf0, 0x0a, 0x4f, 0x02, 0x4e, 0x98, 0xca, 0xe9, 0x09, 0x36, 0x8c, 0x5b, 0x40, 0x75, 0x09, 0xe4,
-	0x26, 0x62, 0x7a, 0x92, 0x43, 0xe9, 0xfa, 0x99, 0x0d, 0x52, 0x97, 0xc0, 0xf2, 0x37, 0x0a, 0xc0,
-	0x4d, 0x9e, 0x5c, 0xfc, 0x62, 0x3e, 0xca, 0xf9, 0x18, 0x84, 0x31, 0x75, 0xf6, 0xc6, 0x99, 0x85,
-	0x93, 0x11, 0x64, 0xfc, 0xc9, 0xd0, 0xb7, 0x20, 0x1b, 0xdd, 0x23, 0x9f, 0x04, 0xe1, 0xcc, 0xf3,
-	0x12, 0x8e, 0x58, 0x2d, 0xc2, 0xf4, 0xcc, 0x78, 0x62, 0x57, 0xfe, 0x45, 0x81, 0x14, 0x46, 0xb5,
-	0x4b, 0x98, 0x39, 0x55, 0x48, 0xe5, 0x39, 0x0a, 0x79, 0x11, 0x40, 0xf8, 0xf1, 0xed, 0xbb, 0x44,
-	0xf2, 0x2b, 0x85, 0x92, 0x96, 0x7d, 0x97, 0x68, 0x1f, 0x84, 0x59, 0x8f, 0x3d, 0x21, 0xeb, 0xb2,
-	0x43, 0x05, 0xb9, 0x3f, 0x07, 0x09, 0x67, 0x34, 0x34, 0xf8, 0x68, 0xa5, 0x0a, 0xd2, 0x3a, 0xa3,
-	0x61, 0xfb, 0xd0, 0x2f, 0xdf, 0x81, 0x44, 0xfb, 0x10, 0x5f, 0x1a, 0x9c, 0xa9, 0x1e, 0xa5, 0x72,
-	0xb6, 0x15, 0xcf, 0x8a, 0x24, 0x17, 0xe0, 0x28, 0xa7, 0x81, 0xca, 0x87, 0xd8, 0xe0, 0xe1, 0xc3,
-	0xd7, 0x5a, 0xed, 0x69, 0x1f, 0x31, 0xf2, 0xf9, 0x72, 0xe9, 0x57, 0x05, 0xb2, 0x53, 0x37, 0x4a,
-	0x7b, 0x07, 0xce, 0xb5, 0x76, 0xae, 0xef, 0x6d, 0x6f, 0x19, 0xbb, 0xad, 0xeb, 0x46, 0xfb, 0xf3,
-	0xe6, 0xb6, 0x71, 0x6b, 0xef, 0xd3, 0xbd, 0xfd, 0xcf, 0xf6, 0x72, 0x0b, 0x85, 0x95, 0x7b, 0x0f,
-	0x4a, 0xe9, 0x5b, 0xce, 0x1d, 0x87, 0x7e, 0xe5, 0x9c, 0x85, 0x6e, 0xea, 0xdb, 0xb7, 0xf7, 0xdb,
-	0xdb, 0x39, 0x45, 0xa0, 0x9b, 0x1e, 0x19, 0x53, 0x46, 0x10, 0x7d, 0x19, 0xd6, 0xe7, 0xa0, 0x37,
-	0xf7, 0x77, 0x77, 0x77, 0xda, 0xb9, 0xc5, 0xc2, 0xea, 0xbd, 0x07, 0xa5, 0x6c, 0xd3, 0x23, 0x82,
-	0x6a, 0x68, 0x51, 0x85, 0xfc, 0xac, 0xc5, 0x7e, 0x73, 0xbf, 0x75, 0xf5, 0x66, 0xae, 0x54, 0xc8,
-	0xdd, 0x7b, 0x50, 0xca, 0x04, 0xbd, 0x83, 0xe3, 0x0b, 0xc9, 0xaf, 0xbf, 0x2f, 0x2e, 0xfc, 0xf8,
-	0x43, 0x51, 0x69, 0xdc, 0x7c, 0x78, 0x5c, 0x54, 0x1e, 0x1d, 0x17, 0x95, 0x3f, 0x8e, 0x8b, 0xca,
-	0xfd, 0x93, 0xe2, 0xc2, 0xa3, 0x93, 0xe2, 0xc2, 0x6f, 0x27, 0xc5, 0x85, 0x2f, 0xea, 0x3d, 0x9b,
-	0xf5, 0x47, 0x1d, 0x9e, 0x9b, 0x5a, 0xf4, 0xfc, 0x0d, 0x16, 0xa6, 0x6b, 0xd7, 0x66, 0x1e, 0xbd,
-	0x9d, 0x38, 0xde, 0xd9, 0x77, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x67, 0x89, 0x32, 0x06, 0x62,
-	0x0f, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xda, 0xeb, 0xb7, 0xc7, 0x76, 0xe2, 0x6c, 0xa3, 0x7f, 0x5d, 0xb7, 0x75, 0xfc, 0x37,
+	0x6f, 0xa1, 0x20, 0xbb, 0x31, 0x20, 0x90, 0x90, 0x90, 0xea, 0x24, 0x6d, 0x23, 0x9a, 0xc4, 0xac,
+	0xdd, 0x22, 0xe0, 0xb0, 0x1a, 0x7b, 0x27, 0xeb, 0x55, 0xed, 0x9d, 0xd5, 0xee, 0xd8, 0x24, 0xfd,
+	0x04, 0xa8, 0xa7, 0x9e, 0x10, 0x07, 0x2a, 0x21, 0xc1, 0x81, 0x2f, 0xc0, 0x37, 0xe0, 0xd0, 0x63,
+	0x6f, 0x70, 0x2a, 0x28, 0xbd, 0xf0, 0x05, 0xb8, 0xa3, 0x79, 0xd9, 0x5d, 0x3b, 0xb6, 0xe9, 0xab,
+	0x40, 0xe2, 0x36, 0xf3, 0x3c, 0xbf, 0xe7, 0x37, 0xcf, 0x3c, 0xcf, 0x6f, 0x66, 0x67, 0xe1, 0x62,
+	0x8f, 0x0c, 0x31, 0xed, 0x1e, 0xd2, 0x3a, 0x3d, 0x76, 0xb1, 0x5f, 0x1f, 0x37, 0xc4, 0xa0, 0xe6,
+	0x7a, 0x84, 0x12, 0x6d, 0x35, 0x70, 0xd7, 0x84, 0x75, 0xdc, 0x28, 0x95, 0xc3, 0x88, 0x9e, 0x77,
+	0xec, 0x52, 0x52, 0x1f, 0x6f, 0xd6, 0x5d, 0x8f, 0x90, 0x43, 0x11, 0x52, 0xfa, 0xff, 0x2c, 0xe3,
+	0x18, 0x0d, 0x6c, 0x13, 0x51, 0xe2, 0x49, 0xc8, 0x7a, 0x08, 0x19, 0x63, 0xcf, 0xb7, 0x89, 0xc3,
+	0x38, 0x26, 0x96, 0x2d, 0xad, 0x59, 0xc4, 0x22, 0x7c, 0x58, 0x67, 0xa3, 0x20, 0xcc, 0x22, 0xc4,
+	0x1a, 0xe0, 0x3a, 0x9f, 0x75, 0x47, 0x87, 0x75, 0x6a, 0x0f, 0xb1, 0x4f, 0xd1, 0xd0, 0x15, 0x80,
+	0xea, 0x27, 0x90, 0x6f, 0x21, 0x8f, 0xb6, 0x31, 0xbd, 0x8e, 0x91, 0x89, 0x3d, 0x6d, 0x0d, 0x12,
+	0x94, 0x50, 0x34, 0x28, 0x2a, 0x15, 0x65, 0x23, 0xaf, 0x8b, 0x89, 0xa6, 0x81, 0xda, 0x47, 0x7e,
+	0xbf, 0x18, 0xab, 0x28, 0x1b, 0x39, 0x9d, 0x8f, 0xb5, 0xff, 0x41, 0xd2, 0x45, 0x9e, 0x4d, 0x8f,
+	0x8b, 0x71, 0x0e, 0x95, 0xb3, 0xaa, 0x0d, 0x2a, 0xa3, 0x64, 0x4c, 0xb6, 0x63, 0xe2, 0xa3, 0x80,
+	0x89, 0x4f, 0x98, 0xb5, 0x7b, 0x4c, 0xb1, 0x2f, 0xa9, 0xc4, 0x44, 0x7b, 0x0f, 0x12, 0xbc, 0x20,
+	0x9c, 0x2a, 0xdb, 0x38, 0x57, 0x0b, 0x8b, 0x28, 0x2a, 0x56, 0x1b, 0x6f, 0xd6, 0x5a, 0x0c, 0xd0,
+	0x54, 0x1f, 0x3c, 0x5a, 0x5f, 0xd2, 0x05, 0xba, 0x3a, 0x84, 0x54, 0x73, 0x40, 0x7a, 0xb7, 0x77,
+	0xb7, 0xc3, 0x0c, 0x95, 0x89, 0x0c, 0xf7, 0x61, 0xc5, 0x45, 0x1e, 0x35, 0x7c, 0x4c, 0x8d, 0x3e,
+	0xdf, 0x1e, 0x5f, 0x35, 0xdb, 0xa8, 0xd4, 0x66, 0x9a, 0x54, 0x9b, 0x2a, 0x83, 0x5c, 0x26, 0xef,
+	0x4e, 0x1a, 0xab, 0x7f, 0xa8, 0x90, 0x94, 0x65, 0xfa, 0x08, 0x52, 0xb2, 0x11, 0x7c, 0xc5, 0x6c,
+	0xa3, 0x1c, 0x51, 0x4a, 0x07, 0xcb, 0x79, 0x8b, 0x38, 0x3e, 0x76, 0xfc, 0x91, 0x2f, 0x09, 0x83,
+	0x20, 0xed, 0x75, 0x48, 0xf7, 0xfa, 0xc8, 0x76, 0x0c, 0xdb, 0xe4, 0x39, 0x65, 0x9a, 0xd9, 0x93,
+	0x47, 0xeb, 0xa9, 0x2d, 0x66, 0xdb, 0xdd, 0xd6, 0x53, 0xdc, 0xb9, 0x6b, 0xb2, 0x22, 0xf7, 0xb1,
+	0x6d, 0xf5, 0x29, 0xaf, 0x4c, 0x5c, 0x97, 0x33, 0xed, 0x03, 0x50, 0x59, 0x2b, 0x8b, 0x2a, 0x5f,
+	0xbc, 0x54, 0x13, 0x7d, 0xae, 0x05, 0x7d, 0xae, 0x75, 0x82, 0x3e, 0x37, 0xd3, 0x6c, 0xe1, 0x7b,
+	0xbf, 0xad, 0x2b, 0x3a, 0x8f, 0xd0, 0xb6, 0x21, 0x3f, 0x40, 0x3e, 0x35, 0xba, 0xac, 0x70, 0x6c,
+	0xf9, 0x84, 0xa4, 0x98, 0x2d, 0x89, 0xac, 0xad, 0xcc, 0x3d, 0xcb, 0xc2, 0x84, 0xc9, 0xd4, 0x36,
+	0xa0, 0xc0, 0x59, 0x7a, 0x64, 0x38, 0xb4, 0xa9, 0xc1, 0x4b, 0x9f, 0xe4, 0xa5, 0x5f, 0x66, 0xf6,
+	0x2d, 0x6e, 0xbe, 0xce, 0x9a, 0x70, 0x1e, 0x32, 0x26, 0xa2, 0x48, 0x40, 0x52, 0x1c, 0x92, 0x66,
+	0x06, 0xee, 0x7c, 0x03, 0x56, 0x42, 0xa5, 0xfb, 0x02, 0x92, 0x16, 0x2c, 0x91, 0x99, 0x03, 0x2f,
+	0xc3, 0x9a, 0x83, 0x8f, 0xa8, 0x71, 0x1a, 0x9d, 0xe1, 0x68, 0x8d, 0xf9, 0x6e, 0x4d, 0x47, 0xbc,
+	0x06, 0xcb, 0xbd, 0xa0, 0xfa, 0x02, 0x0b, 0x1c, 0x9b, 0x0f, 0xad, 0x1c, 0x76, 0x0e, 0xd2, 0xc8,
+	0x75, 0x05, 0x20, 0xcb, 0x01, 0x29, 0xe4, 0xba, 0xdc, 0x75, 0x09, 0x56, 0xf9, 0x1e, 0x3d, 0xec,
+	0x8f, 0x06, 0x54, 0x92, 0xe4, 0x38, 0x66, 0x85, 0x39, 0x74, 0x61, 0xe7, 0xd8, 0x57, 0x20, 0x8f,
+	0xc7, 0xb6, 0x89, 0x9d, 0x1e, 0x16, 0xb8, 0x3c, 0xc7, 0xe5, 0x02, 0x23, 0x07, 0xbd, 0x09, 0x05,
+	0xd7, 0x23, 0x2e, 0xf1, 0xb1, 0x67, 0x20, 0xd3, 0xf4, 0xb0, 0xef, 0x17, 0x97, 0x05, 0x5f, 0x60,
+	0xbf, 0x22, 0xcc, 0xd5, 0x22, 0xa8, 0xdb, 0x88, 0x22, 0xad, 0x00, 0x71, 0x7a, 0xe4, 0x17, 0x95,
+	0x4a, 0x7c, 0x23, 0xa7, 0xb3, 0x61, 0xf5, 0x3b, 0x15, 0xd4, 0x5b, 0x84, 0x62, 0xed, 0x5d, 0x50,
+	0x59, 0xa7, 0xb8, 0xfe, 0x96, 0xe7, 0x4a, 0xba, 0x6d, 0x5b, 0x0e, 0x36, 0xf7, 0x7c, 0xab, 0x73,
+	0xec, 0x62, 0x9d, 0xa3, 0x27, 0x04, 0x15, 0x9b, 0x12, 0xd4, 0x1a, 0x24, 0x3c, 0x32, 0x72, 0x4c,
+	0xae, 0xb3, 0x84, 0x2e, 0x26, 0xda, 0x55, 0x48, 0x87, 0x3a, 0x51, 0x9f, 0xa8, 0x93, 0x15, 0xa6,
+	0x13, 0x26, 0x63, 0x69, 0xd0, 0x53, 0x5d, 0x29, 0x97, 0x26, 0x64, 0xc2, 0x9b, 0xa7, 0x98, 0x78,
+	0x06, 0xcd, 0x46, 0x61, 0xda, 0x5b, 0xb0, 0x1a, 0x76, 0x3f, 0x2c, 0x9f, 0xd0, 0x5c, 0x21, 0x74,
+	0xc8, 0xfa, 0x4d, 0x09, 0xcb, 0x10, 0xd7, 0x50, 0x8a, 0x6f, 0x2c, 0x12, 0xd6, 0x2e, 0xb3, 0x6a,
+	0x17, 0x20, 0xe3, 0xdb, 0x96, 0x83, 0xe8, 0xc8, 0xc3, 0x52, 0x7b, 0x91, 0x81, 0x79, 0xf1, 0x11,
+	0xc5, 0x0e, 0x3f, 0xe8, 0x42, 0x6b, 0x91, 0x41, 0xab, 0xc3, 0x99, 0x70, 0x62, 0x44, 0x2c, 0x42,
+	0x67, 0x5a, 0xe8, 0x6a, 0x87, 0x74, 0x1b, 0x50, 0x70, 0x88, 0x63, 0x78, 0xae, 0x11, 0xb1, 0x0a,
+	0xd1, 0x2d, 0x3b, 0xc4, 0xd1, 0xdd, 0x9d, 0x90, 0xfa, 0x43, 0x28, 0x9d, 0x46, 0x4e, 0xac, 0x20,
+	0x44, 0x78, 0x76, 0x3a, 0x26, 0x5c, 0xa6, 0xfa, 0xa7, 0x02, 0x49, 0x71, 0x02, 0x27, 0xda, 0xad,
+	0xcc, 0x6f, 0x77, 0x6c, 0x51, 0xbb, 0xe3, 0x2f, 0xd4, 0x6e, 0x08, 0x93, 0xf5, 0x8b, 0x6a, 0x25,
+	0xbe, 0x91, 0x6d, 0x5c, 0x98, 0xc3, 0x24, 0x92, 0x6c, 0xdb, 0x96, 0xbc, 0x62, 0x26, 0xa2, 0xb4,
+	0x4d, 0x58, 0x43, 0x96, 0xe5, 0x61, 0x0b, 0x51, 0x6c, 0x4e, 0xec, 0x3d, 0xc1, 0xf7, 0x7e, 0x26,
+	0xf2, 0x45, 0xfb, 0x7e, 0xa4, 0x40, 0x26, 0xa4, 0xd4, 0x9a, 0x90, 0x0f, 0x36, 0x63, 0x1c, 0x0e,
+	0x90, 0x25, 0x0f, 0x4a, 0x79, 0xf1, 0x8e, 0xae, 0x0e, 0x90, 0xa5, 0x67, 0xe5, 0x26, 0xd8, 0x64,
+	0xbe, 0xe6, 0x62, 0x0b, 0x34, 0x37, 0x25, 0xf2, 0xf8, 0xf3, 0x89, 0x7c, 0x4a, 0x8e, 0xea, 0x29,
+	0x39, 0x56, 0xbf, 0x8e, 0xc1, 0x32, 0xef, 0xb7, 0x89, 0xcd, 0x7f, 0xb5, 0xc1, 0x5f, 0x48, 0xe5,
+	0x9b, 0x93, 0xad, 0x09, 0x3a, 0xfd, 0xea, 0x1c, 0xca, 0xe9, 0xac, 0xa3, 0x8e, 0x6b, 0x01, 0x4d,
+	0xfb, 0x85, 0x3a, 0xff, 0x6d, 0x1c, 0x56, 0x67, 0x96, 0xf8, 0x0f, 0x2a, 0x60, 0xfa, 0x42, 0x4a,
+	0x3c, 0xe5, 0x85, 0x94, 0x7c, 0xa6, 0x0b, 0x29, 0xf5, 0x1c, 0x17, 0x52, 0xfa, 0xef, 0x2f, 0xa4,
+	0x9f, 0x62, 0x90, 0x6e, 0xf1, 0x2f, 0x1c, 0x1a, 0xfc, 0x23, 0xdf, 0xad, 0xf3, 0x90, 0x71, 0xc9,
+	0xc0, 0x10, 0x1e, 0x95, 0x7b, 0xd2, 0x2e, 0x19, 0xe8, 0x33, 0x87, 0x20, 0xf1, 0xb2, 0x3e, 0x6a,
+	0xc9, 0x97, 0xd0, 0xed, 0xd4, 0xe9, 0xf3, 0x4e, 0x21, 0x27, 0x6a, 0x21, 0x5f, 0x9d, 0x9b, 0xac,
+	0x08, 0x6c, 0x54, 0x54, 0x4e, 0xbf, 0x93, 0xc3, 0xbc, 0x05, 0x54, 0x4f, 0xf6, 0xc3, 0x10, 0xf1,
+	0x46, 0x2b, 0xc6, 0x16, 0x86, 0x88, 0x13, 0xa3, 0x4b, 0x60, 0xf5, 0x1b, 0x05, 0xe0, 0x06, 0x2b,
+	0x2e, 0xdf, 0x31, 0x7b, 0x30, 0xfa, 0x3c, 0x09, 0x63, 0x6a, 0xed, 0xf5, 0x85, 0x8d, 0x93, 0x19,
+	0xe4, 0xfc, 0xc9, 0xd4, 0xb7, 0x21, 0x1f, 0x9d, 0x23, 0x1f, 0x07, 0xe9, 0xcc, 0x63, 0x09, 0x1f,
+	0x72, 0x6d, 0x4c, 0xf5, 0xdc, 0x78, 0x62, 0x56, 0xfd, 0x59, 0x81, 0x0c, 0xcf, 0x6a, 0x0f, 0x53,
+	0x34, 0xd5, 0x48, 0xe5, 0x05, 0x1a, 0x79, 0x11, 0x40, 0xf0, 0xf8, 0xf6, 0x1d, 0x2c, 0xf5, 0x95,
+	0xe1, 0x96, 0xb6, 0x7d, 0x07, 0x6b, 0xef, 0x87, 0x55, 0x8f, 0x3f, 0xa1, 0xea, 0xf2, 0x52, 0x0b,
+	0x6a, 0x7f, 0x16, 0x52, 0xce, 0x68, 0x68, 0xb0, 0x07, 0x9c, 0x2a, 0x44, 0xeb, 0x8c, 0x86, 0x9d,
+	0x23, 0xbf, 0x7a, 0x1b, 0x52, 0x9d, 0x23, 0xfe, 0x3f, 0xc3, 0x94, 0xea, 0x11, 0x22, 0x5f, 0xd0,
+	0xe2, 0xe7, 0x25, 0xcd, 0x0c, 0xfc, 0xc1, 0xa8, 0x81, 0xca, 0x9e, 0xca, 0xc1, 0x6f, 0x17, 0x1b,
+	0x6b, 0xf5, 0xa7, 0xfd, 0x55, 0x92, 0x3f, 0x49, 0x97, 0x7e, 0x51, 0x20, 0x3f, 0x75, 0xa2, 0xb4,
+	0xb7, 0xe1, 0x6c, 0x7b, 0xf7, 0xda, 0xfe, 0xce, 0xb6, 0xb1, 0xd7, 0xbe, 0x66, 0x74, 0x3e, 0x6b,
+	0xed, 0x18, 0x37, 0xf7, 0x3f, 0xde, 0x3f, 0xf8, 0x74, 0xbf, 0xb0, 0x54, 0x5a, 0xb9, 0x7b, 0xbf,
+	0x92, 0xbd, 0xe9, 0xdc, 0x76, 0xc8, 0x97, 0xce, 0x22, 0x74, 0x4b, 0xdf, 0xb9, 0x75, 0xd0, 0xd9,
+	0x29, 0x28, 0x02, 0xdd, 0xf2, 0xf0, 0x98, 0x50, 0xcc, 0xd1, 0x97, 0xe1, 0xdc, 0x1c, 0xf4, 0xd6,
+	0xc1, 0xde, 0xde, 0x6e, 0xa7, 0x10, 0x2b, 0xad, 0xde, 0xbd, 0x5f, 0xc9, 0xb7, 0x3c, 0x2c, 0xa4,
+	0xc6, 0x23, 0x6a, 0x50, 0x9c, 0x8d, 0x38, 0x68, 0x1d, 0xb4, 0xaf, 0xdc, 0x28, 0x54, 0x4a, 0x85,
+	0xbb, 0xf7, 0x2b, 0xb9, 0xe0, 0xee, 0x60, 0xf8, 0x52, 0xfa, 0xab, 0xef, 0xcb, 0x4b, 0x3f, 0xfe,
+	0x50, 0x56, 0x9a, 0x37, 0x1e, 0x9c, 0x94, 0x95, 0x87, 0x27, 0x65, 0xe5, 0xf7, 0x93, 0xb2, 0x72,
+	0xef, 0x71, 0x79, 0xe9, 0xe1, 0xe3, 0xf2, 0xd2, 0xaf, 0x8f, 0xcb, 0x4b, 0x9f, 0x37, 0x2c, 0x9b,
+	0xf6, 0x47, 0x5d, 0x56, 0x9b, 0x7a, 0xf4, 0xf3, 0x1d, 0x0c, 0x90, 0x6b, 0xd7, 0x67, 0x7e, 0xb9,
+	0xbb, 0x49, 0x7e, 0x66, 0xdf, 0xf9, 0x6b, 0x00, 0x7e, 0x21, 0x51, 0x2f, 0xe0, 0x0f, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1359,6 +1393,11 @@ func (m *PartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Parity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Parity))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -1740,6 +1779,13 @@ func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1847,6 +1893,13 @@ func (m *ExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ExtendedSignatures) > 0 {
 		for iNdEx := len(m.ExtendedSignatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2250,6 +2303,9 @@ func (m *PartSetHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Parity != 0 {
+		n += 1 + sovTypes(uint64(m.Parity))
+	}
 	return n
 }
 
@@ -2428,6 +2484,10 @@ func (m *Commit) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2473,6 +2533,10 @@ func (m *ExtendedCommit) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2705,6 +2769,25 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			m.Parity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4097,6 +4180,40 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4422,6 +4539,40 @@ func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
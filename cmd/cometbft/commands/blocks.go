@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	"github.com/cometbft/cometbft/internal/progressbar"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// BlocksCmd groups the offline tools moving blocks in and out of the node's
+// databases.
+var BlocksCmd = &cobra.Command{
+	Use:   "blocks",
+	Short: "Export and import blocks as portable archives",
+}
+
+var (
+	exportFromHeight int64
+	exportToHeight   int64
+	exportOutFile    string
+	importInFile     string
+)
+
+func init() {
+	ExportBlocksCmd.Flags().Int64Var(&exportFromHeight, "from", 0, "first height to export (default: the block store base)")
+	ExportBlocksCmd.Flags().Int64Var(&exportToHeight, "to", 0, "last height to export (default: the block store height)")
+	ExportBlocksCmd.Flags().StringVar(&exportOutFile, "out", "", "file to write the archive to")
+	_ = ExportBlocksCmd.MarkFlagRequired("out")
+
+	ImportBlocksCmd.Flags().StringVar(&importInFile, "in", "", "archive file to import")
+	_ = ImportBlocksCmd.MarkFlagRequired("in")
+
+	BlocksCmd.AddCommand(ExportBlocksCmd, ImportBlocksCmd)
+}
+
+// ExportBlocksCmd writes a range of blocks to a block archive.
+var ExportBlocksCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a range of blocks to an archive file",
+	Long: `
+export writes the blocks of the given height range, along with their seen and
+extended commits, their results and the validator sets which signed them, to a
+portable archive file. The archive can be imported into another node with
+"cometbft blocks import", whatever database backend or key layout it uses.
+Importing requires the results of every block, so the node must not discard
+them (see discard_abci_responses).
+
+The node must be stopped while exporting.
+`,
+	Example: `
+	cometbft blocks export --out blocks.archive
+	cometbft blocks export --from 1000 --to 2000 --out blocks.archive
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if err := exportBlocks(exportOutFile, exportFromHeight, exportToHeight); err != nil {
+			return fmt.Errorf("failed to export blocks: %w", err)
+		}
+		return nil
+	},
+}
+
+// ImportBlocksCmd saves the blocks of a block archive into the node's stores.
+var ImportBlocksCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import the blocks of an archive file",
+	Long: `
+import verifies the blocks of an archive written by "cometbft blocks export" and
+saves them, along with their commits and results, in the node's block store,
+updating the node's state past every imported block.
+
+The archive must continue the chain of the node: it must start right above the
+last block of the node's state, or at the initial height of the chain if the
+node has no state yet. Every block is validated against the state as if it was
+received from a peer: it must extend the previous block, match the results of
+the previous block and be signed by +2/3 of the validators of its height.
+
+The application does not execute the imported blocks while importing. The node
+must be stopped while importing; when it starts again, it replays the imported
+blocks on its application during the ABCI handshake.
+`,
+	Example: `
+	cometbft blocks import --in blocks.archive
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if err := importBlocks(importInFile); err != nil {
+			return fmt.Errorf("failed to import blocks: %w", err)
+		}
+		return nil
+	},
+}
+
+func exportBlocks(outFile string, from, to int64) error {
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	if from == 0 {
+		from = blockStore.Base()
+	}
+	if to == 0 {
+		to = blockStore.Height()
+	}
+
+	f, err := os.Create(outFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	var bar progressbar.Bar
+	bar.NewOption(from-1, to)
+
+	fmt.Printf("exporting blocks %d to %d:\n", from, to)
+	err = state.ExportBlocks(w, genDoc.ChainID, blockStore, stateStore, from, to, bar.Play)
+	bar.Finish()
+	if err != nil {
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func importBlocks(inFile string) error {
+	f, err := os.Open(inFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Peek at the header to know the range of heights, then read the archive
+	// from the start.
+	header := new(cmtstore.ArchiveHeader)
+	if _, err := protoio.NewDelimitedReader(f, 1024).ReadMsg(header); err != nil {
+		return fmt.Errorf("reading archive header: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	blockStore, stateStore, err := openStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	var bar progressbar.Bar
+	bar.NewOption(header.FromHeight-1, header.ToHeight)
+
+	fmt.Printf("importing blocks %d to %d:\n", header.FromHeight, header.ToHeight)
+	from, to, err := state.ImportBlocks(bufio.NewReader(f), genDoc, blockStore, stateStore, bar.Play)
+	bar.Finish()
+	if err != nil {
+		if to >= from {
+			fmt.Printf("imported blocks %d to %d before failing\n", from, to)
+		}
+		return err
+	}
+
+	fmt.Printf("imported blocks %d to %d\n", from, to)
+	return nil
+}
@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/internal/progressbar"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+// DBCmd groups the offline tools operating on the node's databases.
+var DBCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Utilities for managing the node's databases",
+}
+
+var targetKeyLayout string
+
+func init() {
+	MigrateKeyLayoutCmd.Flags().StringVar(&targetKeyLayout, "to", "v2", "key layout to migrate the databases to (v1 or v2)")
+	DBCmd.AddCommand(MigrateKeyLayoutCmd)
+}
+
+// MigrateKeyLayoutCmd rewrites the block store and the state store using a
+// different key layout.
+var MigrateKeyLayoutCmd = &cobra.Command{
+	Use:     "migrate-key-layout",
+	Aliases: []string{"migrate_key_layout"},
+	Short:   "Rewrite the block store and state store in a different key layout",
+	Long: `
+migrate-key-layout rewrites the block store and the state store in place, so that
+they use the given key layout. The node must be stopped while the migration runs.
+
+All records are first copied under the new layout, and a verification pass checks
+that every block, block meta and commit reads the same through both layouts.
+Only then do the databases switch to the new layout, and the records stored under
+the old one are deleted. The migration can be interrupted at any time: running
+the command again resumes it. Until the switch, the databases can still be used
+with the old layout.
+
+The blocks moved to the cold tier (see storage.cold_tier_depth) stay in their
+segment files, which do not depend on the key layout.
+
+Note: the databases temporarily take up to twice their size on disk.
+`,
+	Example: `
+	cometbft db migrate-key-layout --to v2
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if err := MigrateKeyLayout(config, targetKeyLayout); err != nil {
+			return fmt.Errorf("failed to migrate key layout: %w", err)
+		}
+		return nil
+	},
+}
+
+// keyLayoutMigration is implemented by the key layout migrations of both the
+// block store and the state store.
+type keyLayoutMigration interface {
+	Done() bool
+	Heights() (first, last int64)
+	Copy(onHeight func(height int64)) error
+	Verify(onHeight func(height int64)) error
+	Switch() error
+	Cleanup(onHeight func(height int64)) error
+}
+
+// MigrateKeyLayout migrates the block store and the state store of the node
+// to the key layout with the given version.
+func MigrateKeyLayout(config *cfg.Config, toVersion string) error {
+	for _, name := range []string{"blockstore", "state"} {
+		if !os.FileExists(filepath.Join(config.DBDir(), name+".db")) {
+			return fmt.Errorf("no %s found in %v", name, config.DBDir())
+		}
+	}
+
+	blockStoreDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	blockStoreMigration, err := store.NewKeyLayoutMigration(blockStoreDB, toVersion)
+	if err != nil {
+		return fmt.Errorf("block store: %w", err)
+	}
+	stateMigration, err := state.NewKeyLayoutMigration(stateDB, toVersion)
+	if err != nil {
+		return fmt.Errorf("state store: %w", err)
+	}
+
+	migrations := []struct {
+		name string
+		keyLayoutMigration
+	}{
+		{"block store", blockStoreMigration},
+		{"state store", stateMigration},
+	}
+
+	// Neither store is switched before both have been copied and verified, so
+	// that a failure leaves the node usable with the old layout.
+	for _, m := range migrations {
+		if m.Done() {
+			fmt.Printf("%s already uses key layout %s\n", m.name, toVersion)
+			continue
+		}
+		if err := runMigrationStep("copying "+m.name, m, m.Copy); err != nil {
+			return err
+		}
+		if err := runMigrationStep("verifying "+m.name, m, m.Verify); err != nil {
+			return err
+		}
+	}
+	for _, m := range migrations {
+		if err := m.Switch(); err != nil {
+			return fmt.Errorf("%s: %w", m.name, err)
+		}
+	}
+	for _, m := range migrations {
+		if m.Done() {
+			continue
+		}
+		if err := runMigrationStep("cleaning up "+m.name, m, m.Cleanup); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("block store and state store now use key layout %s\n", toVersion)
+	return nil
+}
+
+// runMigrationStep runs one step of a key layout migration, showing its
+// progress. Progress is saved after every height, so the command can be killed
+// at any time and the step resumed by running it again.
+func runMigrationStep(
+	name string,
+	m keyLayoutMigration,
+	step func(onHeight func(height int64)) error,
+) error {
+	first, last := m.Heights()
+
+	var bar progressbar.Bar
+	bar.NewOption(first-1, last)
+
+	fmt.Println(name + ":")
+	defer bar.Finish()
+
+	if err := step(bar.Play); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	return nil
+}
@@ -38,4 +38,6 @@ func init() {
 
 	DebugCmd.AddCommand(killCmd)
 	DebugCmd.AddCommand(dumpCmd)
+	DebugCmd.AddCommand(verifyStoreCmd)
+	DebugCmd.AddCommand(walCmd)
 }
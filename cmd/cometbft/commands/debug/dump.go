@@ -107,6 +107,12 @@ func dumpDebugData(outDir string, conf *cfg.Config, rpc *rpchttp.HTTP) {
 		return
 	}
 
+	logger.Info("getting node consensus trace...")
+	if err := dumpConsensusTrace(rpc, tmpDir, "consensus_trace.json"); err != nil {
+		logger.Error("failed to dump node consensus trace", "error", err)
+		return
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(conf, tmpDir); err != nil {
 		logger.Error("failed to copy node WAL", "error", err)
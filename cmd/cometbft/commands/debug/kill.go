@@ -76,6 +76,11 @@ func killCmdHandler(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	logger.Info("getting node consensus trace...")
+	if err := dumpConsensusTrace(rpc, tmpDir, "consensus_trace.json"); err != nil {
+		return err
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(conf, tmpDir); err != nil {
 		return err
@@ -0,0 +1,196 @@
+package debug
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cs "github.com/cometbft/cometbft/internal/consensus"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+var (
+	walDumpHeight int64
+	walDumpRound  int32
+	walDumpTypes  []string
+
+	walTruncateDryRun bool
+)
+
+var walCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus write-ahead log",
+	Long: `Inspect and repair the consensus write-ahead log (WAL) of a stopped node.
+The subcommands take the path of the WAL as argument, defaulting to the WAL of
+the node. The rotated files of the WAL are processed along with its head.`,
+}
+
+var walDumpCmd = &cobra.Command{
+	Use:   "dump [wal-file]",
+	Short: "Dump the messages of the WAL as JSON lines",
+	Long: `Dump the messages of the WAL as JSON lines, oldest first. Every line holds the
+file and offset of the message, the height and round it belongs to, its type
+and the message itself.
+
+The messages which do not carry a height are attributed to the height following
+the last EndHeight message. The round is -1 for the messages which do not
+carry one. Corrupted entries are reported on the standard error.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: walDumpCmdHandler,
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify [wal-file]",
+	Short: "Verify the checksums of the WAL entries",
+	Long: `Verify the checksum and length of every entry of the WAL, and report the
+offset of the first corrupted entry of every file of the WAL as JSON. The
+command fails if any corruption is found.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: walVerifyCmdHandler,
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate [wal-file]",
+	Short: "Truncate a corrupted WAL at the last valid EndHeight message",
+	Long: `Truncate a corrupted WAL right after the last valid EndHeight message preceding
+its first corrupted entry. The files of the WAL following the truncated one
+are removed, and the truncated file becomes the head of the WAL.
+
+The messages of the height following the truncation point are lost: on restart,
+the node will not be able to replay them.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: walTruncateCmdHandler,
+}
+
+func init() {
+	walDumpCmd.Flags().Int64Var(&walDumpHeight, "height", 0, "only dump the messages of this height (default: all heights)")
+	walDumpCmd.Flags().Int32Var(&walDumpRound, "round", -1, "only dump the messages of this round, or of all rounds if negative")
+	walDumpCmd.Flags().StringSliceVar(&walDumpTypes, "type", nil,
+		"only dump the messages of these types, e.g. EndHeight, Timeout, RoundState, Proposal, BlockPart, Vote")
+
+	walTruncateCmd.Flags().BoolVar(&walTruncateDryRun, "dry-run", false, "report the truncation without modifying the WAL")
+
+	walCmd.AddCommand(walDumpCmd)
+	walCmd.AddCommand(walVerifyCmd)
+	walCmd.AddCommand(walTruncateCmd)
+}
+
+// walFilePath returns the WAL path given as argument, or the WAL of the node.
+func walFilePath(cmd *cobra.Command, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	conf, err := commands.ParseConfig(cmd)
+	if err != nil {
+		return "", err
+	}
+	return conf.Consensus.WalFile(), nil
+}
+
+type walDumpLine struct {
+	File   string          `json:"file"`
+	Offset int64           `json:"offset"`
+	Height int64           `json:"height"`
+	Round  int32           `json:"round"`
+	Type   string          `json:"type"`
+	Time   time.Time       `json:"time"`
+	Msg    json.RawMessage `json:"msg"`
+}
+
+func walDumpCmdHandler(cmd *cobra.Command, args []string) error {
+	walFile, err := walFilePath(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	enc := json.NewEncoder(out)
+
+	return cs.WalkWAL(walFile,
+		func(entry *cs.WALEntry) error {
+			if walDumpHeight > 0 && entry.Height != walDumpHeight {
+				return nil
+			}
+			if walDumpRound >= 0 && entry.Round != walDumpRound {
+				return nil
+			}
+			if len(walDumpTypes) > 0 && !containsFold(walDumpTypes, entry.Type) {
+				return nil
+			}
+			msg, err := cmtjson.Marshal(entry.Msg.Msg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal message at %v:%d: %w", entry.File, entry.Offset, err)
+			}
+			return enc.Encode(walDumpLine{
+				File:   entry.File,
+				Offset: entry.Offset,
+				Height: entry.Height,
+				Round:  entry.Round,
+				Type:   entry.Type,
+				Time:   entry.Msg.Time,
+				Msg:    msg,
+			})
+		},
+		func(c cs.WALCorruption) error {
+			fmt.Fprintf(os.Stderr, "corrupted entry at %v:%d: %v\n", c.File, c.Offset, c.Err)
+			return nil
+		},
+	)
+}
+
+func walVerifyCmdHandler(cmd *cobra.Command, args []string) error {
+	walFile, err := walFilePath(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	report, err := cs.VerifyWAL(walFile)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	if !report.OK() {
+		return fmt.Errorf("found %d corrupted entries", len(report.Corruptions))
+	}
+	return nil
+}
+
+func walTruncateCmdHandler(cmd *cobra.Command, args []string) error {
+	walFile, err := walFilePath(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	truncation, err := cs.TruncateWAL(walFile, walTruncateDryRun)
+	if err != nil {
+		return err
+	}
+	if truncation == nil {
+		return errors.New("the WAL is not corrupted, nothing to truncate")
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(truncation)
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
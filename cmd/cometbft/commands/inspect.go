@@ -47,7 +47,10 @@ func runInspect(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore := store.NewBlockStore(blockStoreDB,
+		store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout),
+		store.WithColdTier(config.ColdTierDir(), 0, config.Storage.ColdTierSegmentSize),
+	)
 	defer blockStore.Close()
 
 	stateDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "state", Config: config})
@@ -76,32 +76,93 @@ var (
 func init() {
 	LightCmd.Flags().StringVar(&listenAddr, "laddr", "tcp://localhost:8888",
 		"serve the proxy on the given address")
-	LightCmd.Flags().StringVarP(&primaryAddr, "primary", "p", "",
-		"connect to a CometBFT node at this address")
-	LightCmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
-		"CometBFT nodes to cross-check the primary node, comma-separated")
-	LightCmd.Flags().StringVar(&home, "home-dir", os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
-		"specify the home directory")
 	LightCmd.Flags().IntVar(
 		&maxOpenConnections,
 		"max-open-connections",
 		900,
 		"maximum number of simultaneous connections (including WebSocket).")
-	LightCmd.Flags().DurationVar(&trustingPeriod, "trusting-period", 168*time.Hour,
+	addLightClientFlags(LightCmd)
+}
+
+// addLightClientFlags adds the flags configuring the light client to the
+// command.
+func addLightClientFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&primaryAddr, "primary", "p", "",
+		"connect to a CometBFT node at this address")
+	cmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
+		"CometBFT nodes to cross-check the primary node, comma-separated")
+	cmd.Flags().StringVar(&home, "home-dir", os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
+		"specify the home directory")
+	cmd.Flags().DurationVar(&trustingPeriod, "trusting-period", 168*time.Hour,
 		"trusting period that headers can be verified within. Should be significantly less than the unbonding period")
-	LightCmd.Flags().Int64Var(&trustedHeight, "height", 1, "Trusted header's height")
-	LightCmd.Flags().BytesHexVar(&trustedHash, "hash", []byte{}, "Trusted header's hash")
-	LightCmd.Flags().BoolVar(&verbose, "verbose", false, "Verbose output")
-	LightCmd.Flags().StringVar(&trustLevelStr, "trust-level", "1/3",
+	cmd.Flags().Int64Var(&trustedHeight, "height", 1, "Trusted header's height")
+	cmd.Flags().BytesHexVar(&trustedHash, "hash", []byte{}, "Trusted header's hash")
+	cmd.Flags().BoolVar(&verbose, "verbose", false, "Verbose output")
+	cmd.Flags().StringVar(&trustLevelStr, "trust-level", "1/3",
 		"trust level. Must be between 1/3 and 3/3",
 	)
-	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
+	cmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
 }
 
 func runProxy(_ *cobra.Command, args []string) error {
-	// Initialize logger.
+	logger := newLightLogger()
+
+	chainID = args[0]
+	c, err := newLightClient(logger, "light-client-db",
+		light.ConfirmationFunction(func(action string) bool {
+			fmt.Println(action)
+			scanner := bufio.NewScanner(os.Stdin)
+			for {
+				scanner.Scan()
+				response := scanner.Text()
+				switch response {
+				case "y", "Y":
+					return true
+				case "n", "N":
+					return false
+				default:
+					fmt.Println("please input 'Y' or 'n' and press ENTER")
+				}
+			}
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	cfg := rpcserver.DefaultConfig()
+	cfg.MaxBodyBytes = config.RPC.MaxBodyBytes
+	cfg.MaxHeaderBytes = config.RPC.MaxHeaderBytes
+	cfg.MaxOpenConnections = maxOpenConnections
+	// If necessary adjust global WriteTimeout to ensure it's greater than
+	// TimeoutBroadcastTxCommit.
+	// See https://github.com/tendermint/tendermint/issues/3435
+	if cfg.WriteTimeout <= config.RPC.TimeoutBroadcastTxCommit {
+		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
+	}
+
+	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger, lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
+	if err != nil {
+		return err
+	}
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	cmtos.TrapSignal(logger, func() {
+		p.Listener.Close()
+	})
+
+	logger.Info("Starting proxy...", "laddr", listenAddr)
+	if err := p.ListenAndServe(); err != http.ErrServerClosed {
+		// Error starting or closing listener:
+		logger.Error("proxy ListenAndServe", "err", err)
+	}
+
+	return nil
+}
+
+func newLightLogger() log.Logger {
 	logger := log.NewLogger(os.Stdout)
 	var option log.Option
 	if verbose {
@@ -109,9 +170,14 @@ func runProxy(_ *cobra.Command, args []string) error {
 	} else {
 		option, _ = log.AllowLevel("info")
 	}
-	logger = log.NewFilter(logger, option)
+	return log.NewFilter(logger, option)
+}
 
-	chainID = args[0]
+// newLightClient creates a light client of the chain chainID, storing its
+// trusted light blocks and providers in the database dbID under the home
+// directory. If no primary address is given, the providers stored in the
+// database are used.
+func newLightClient(logger log.Logger, dbID string, options ...light.Option) (*light.Client, error) {
 	logger.Info("Creating client...", "chainID", chainID)
 
 	witnessesAddrs := []string{}
@@ -123,22 +189,22 @@ func runProxy(_ *cobra.Command, args []string) error {
 	configCopy.RootDir = home
 	configCopy.DBPath = ""
 	dbCtx := &cfg.DBContext{
-		ID:     "light-client-db",
+		ID:     dbID,
 		Config: configCopy,
 	}
 	db, err := cfg.DefaultDBProvider(dbCtx)
 	if err != nil {
-		return fmt.Errorf("can't create a db: %w", err)
+		return nil, fmt.Errorf("can't create a db: %w", err)
 	}
 
 	if primaryAddr == "" { // check to see if we can start from an existing state
 		var err error
 		primaryAddr, witnessesAddrs, err = checkForExistingProviders(db)
 		if err != nil {
-			return fmt.Errorf("failed to retrieve primary or witness from db: %w", err)
+			return nil, fmt.Errorf("failed to retrieve primary or witness from db: %w", err)
 		}
 		if primaryAddr == "" {
-			return errors.New("no primary address was provided nor found. Please provide a primary (using -p)." +
+			return nil, errors.New("no primary address was provided nor found. Please provide a primary (using -p)." +
 				" Run the command: cometbft light --help for more information")
 		}
 	} else {
@@ -150,29 +216,10 @@ func runProxy(_ *cobra.Command, args []string) error {
 
 	trustLevel, err := cmtmath.ParseFraction(trustLevelStr)
 	if err != nil {
-		return fmt.Errorf("can't parse trust level: %w", err)
-	}
-
-	options := []light.Option{
-		light.Logger(logger),
-		light.ConfirmationFunction(func(action string) bool {
-			fmt.Println(action)
-			scanner := bufio.NewScanner(os.Stdin)
-			for {
-				scanner.Scan()
-				response := scanner.Text()
-				switch response {
-				case "y", "Y":
-					return true
-				case "n", "N":
-					return false
-				default:
-					fmt.Println("please input 'Y' or 'n' and press ENTER")
-				}
-			}
-		}),
+		return nil, fmt.Errorf("can't parse trust level: %w", err)
 	}
 
+	options = append(options, light.Logger(logger))
 	if sequential {
 		options = append(options, light.SequentialVerification())
 	} else {
@@ -207,38 +254,7 @@ func runProxy(_ *cobra.Command, args []string) error {
 			logger.Error("Cannot start the light client from an empty trusted store. Please provide either an initialized trusted store, using the `--home-dir` flag, or trusted information to bootstrap the trusted store, via `--hash` and `--height` flags.")
 		}
 	}
-	if err != nil {
-		return err
-	}
-
-	cfg := rpcserver.DefaultConfig()
-	cfg.MaxBodyBytes = config.RPC.MaxBodyBytes
-	cfg.MaxHeaderBytes = config.RPC.MaxHeaderBytes
-	cfg.MaxOpenConnections = maxOpenConnections
-	// If necessary adjust global WriteTimeout to ensure it's greater than
-	// TimeoutBroadcastTxCommit.
-	// See https://github.com/tendermint/tendermint/issues/3435
-	if cfg.WriteTimeout <= config.RPC.TimeoutBroadcastTxCommit {
-		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
-	}
-
-	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger, lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
-	if err != nil {
-		return err
-	}
-
-	// Stop upon receiving SIGTERM or CTRL-C.
-	cmtos.TrapSignal(logger, func() {
-		p.Listener.Close()
-	})
-
-	logger.Info("Starting proxy...", "laddr", listenAddr)
-	if err := p.ListenAndServe(); err != http.ErrServerClosed {
-		// Error starting or closing listener:
-		logger.Error("proxy ListenAndServe", "err", err)
-	}
-
-	return nil
+	return c, err
 }
 
 func checkForExistingProviders(db cmtdb.DB) (string, []string, error) {
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/light"
+)
+
+// LightMonitorCmd runs a light client attack monitor.
+var LightMonitorCmd = &cobra.Command{
+	Use:   "monitor [chainID]",
+	Short: "Run a light client attack monitor, following the chain head",
+	Long: `Run a light client attack monitor, following the chain head.
+
+The monitor follows the head of the chain from the primary, verifying every
+new header, one height after the other, with a light client and cross-checking
+it against the headers of the witnesses. When the headers diverge, it builds the
+evidence of the light client attack and reports it to all the providers but
+the faulty one, then writes an alert, as a line of JSON, to the alert log.
+Unlike the light client proxy, the monitor keeps following the chain once an
+attack is detected, replacing a faulty primary with a witness.
+
+Like the proxy, a fresh instance of the monitor needs a primary RPC address, a
+trusted hash and height and witness RPC addresses. To restart the monitor,
+thereafter only the chainID is required.
+`,
+	RunE: runMonitor,
+	Args: cobra.ExactArgs(1),
+	Example: `light monitor cosmoshub-3 -p http://52.57.29.196:26657 -w http://public-seed-node.cosmoshub.certus.one:26657
+	--height 962118 --hash 28B97BE9F6DE51AC69F70E0B7BFD7E5C9CD1A595B7DC31AFF27C50D4948020CD --metrics-laddr :26660`,
+}
+
+var (
+	monitorInterval     time.Duration
+	monitorAlertLog     string
+	monitorMetricsLaddr string
+)
+
+func init() {
+	LightMonitorCmd.Flags().DurationVar(&monitorInterval, "interval", light.DefaultMonitorInterval,
+		"interval between two checks of the chain head")
+	LightMonitorCmd.Flags().StringVar(&monitorAlertLog, "alert-log", "",
+		"file the alerts are appended to (default: monitor-alerts.log in the home directory)")
+	LightMonitorCmd.Flags().StringVar(&monitorMetricsLaddr, "metrics-laddr", "",
+		"serve the Prometheus metrics on the given address (disabled if empty)")
+	addLightClientFlags(LightMonitorCmd)
+	LightCmd.AddCommand(LightMonitorCmd)
+}
+
+func runMonitor(_ *cobra.Command, args []string) error {
+	logger := newLightLogger()
+
+	chainID = args[0]
+	c, err := newLightClient(logger, "light-monitor-db")
+	if err != nil {
+		return err
+	}
+
+	if monitorAlertLog == "" {
+		monitorAlertLog = filepath.Join(home, "monitor-alerts.log")
+	}
+	alerts, err := os.OpenFile(monitorAlertLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("can't open the alert log: %w", err)
+	}
+	defer alerts.Close()
+
+	metrics := light.NopMetrics()
+	if monitorMetricsLaddr != "" {
+		metrics = light.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)
+		srv := &http.Server{
+			Addr:              monitorMetricsLaddr,
+			Handler:           promhttp.Handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+				// Error starting or closing listener:
+				logger.Error("Prometheus HTTP server ListenAndServe", "err", err)
+			}
+		}()
+		defer srv.Close()
+	}
+
+	m := light.NewMonitor(c,
+		light.MonitorInterval(monitorInterval),
+		light.MonitorMetrics(metrics),
+		light.MonitorAlerts(alerts),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	cmtos.TrapSignal(logger, func() {
+		cancel()
+		alerts.Close()
+	})
+
+	logger.Info("Starting monitor...", "interval", monitorInterval, "alert_log", monitorAlertLog)
+	return m.Run(ctx)
+}
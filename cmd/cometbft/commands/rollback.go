@@ -68,7 +68,16 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 	if !os.FileExists(filepath.Join(config.DBDir(), "blockstore.db")) {
 		return nil, nil, fmt.Errorf("no blockstore found in %v", config.DBDir())
 	}
+	if !os.FileExists(filepath.Join(config.DBDir(), "state.db")) {
+		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
+	}
+
+	return openStateAndBlockStore(config)
+}
 
+// openStateAndBlockStore opens the block and state stores of the node,
+// creating them if they do not exist.
+func openStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
 	// Get BlockStore
 	blockStoreDBCtx := &cfg.DBContext{
 		ID:     "blockstore",
@@ -78,11 +87,10 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 	if err != nil {
 		return nil, nil, err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
-
-	if !os.FileExists(filepath.Join(config.DBDir(), "state.db")) {
-		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
-	}
+	blockStore := store.NewBlockStore(blockStoreDB,
+		store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout),
+		store.WithColdTier(config.ColdTierDir(), 0, config.Storage.ColdTierSegmentSize),
+	)
 
 	// Get StateStore
 	stateStoreDBCtx := &cfg.DBContext{
@@ -28,6 +28,8 @@ func main() {
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
 		cmd.InspectCmd,
+		cmd.DBCmd,
+		cmd.BlocksCmd,
 		debug.DebugCmd,
 		config.Command(),
 		cli.NewCompletionCmd(rootCmd, true),
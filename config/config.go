@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +35,10 @@ const (
 	DefaultConfigDir = "config"
 	DefaultDataDir   = "data"
 
+	// DefaultDBBackend is the name of the database backend used unless
+	// db_backend says otherwise. It matches db.DefaultBackend.
+	DefaultDBBackend = "pebbledb"
+
 	DefaultConfigFileName  = "config.toml"
 	DefaultGenesisJSONName = "genesis.json"
 
@@ -232,6 +237,10 @@ type BaseConfig struct {
 	// Database directory
 	DBPath string `mapstructure:"db_dir"`
 
+	// Database backend: pebbledb | memdb, or the name of any backend
+	// registered via db.RegisterBackend. Empty means DefaultDBBackend.
+	DBBackend string `mapstructure:"db_backend"`
+
 	// Output level for logging
 	LogLevel string `mapstructure:"log_level"`
 
@@ -281,6 +290,7 @@ func DefaultBaseConfig() BaseConfig {
 		LogColors:          true,
 		FilterPeers:        false,
 		DBPath:             DefaultDataDir,
+		DBBackend:          DefaultDBBackend,
 	}
 }
 
@@ -316,6 +326,12 @@ func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
 }
 
+// ColdTierDir returns the full path to the segment files of the block store's
+// cold tier.
+func (cfg BaseConfig) ColdTierDir() string {
+	return filepath.Join(cfg.DBDir(), "blockstore.segments")
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg BaseConfig) ValidateBasic() error {
@@ -609,6 +625,14 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC mempool service streams the transactions added to and removed
+	// from the mempool
+	MempoolService *GRPCMempoolServiceConfig `mapstructure:"mempool_service"`
+
+	// The gRPC evidence service returns the evidence pending in the evidence
+	// pool and the evidence committed at a given height
+	EvidenceService *GRPCEvidenceServiceConfig `mapstructure:"evidence_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -620,6 +644,8 @@ func DefaultGRPCConfig() *GRPCConfig {
 		VersionService:      DefaultGRPCVersionServiceConfig(),
 		BlockService:        DefaultGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      DefaultGRPCMempoolServiceConfig(),
+		EvidenceService:     DefaultGRPCEvidenceServiceConfig(),
 		Privileged:          DefaultGRPCPrivilegedConfig(),
 	}
 }
@@ -630,6 +656,8 @@ func TestGRPCConfig() *GRPCConfig {
 		VersionService:      TestGRPCVersionServiceConfig(),
 		BlockService:        TestGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      TestGRPCMempoolServiceConfig(),
+		EvidenceService:     TestGRPCEvidenceServiceConfig(),
 		Privileged:          TestGRPCPrivilegedConfig(),
 	}
 }
@@ -644,6 +672,9 @@ func (cfg *GRPCConfig) ValidateBasic() error {
 			)
 		}
 	}
+	if err := cfg.MempoolService.ValidateBasic(); err != nil {
+		return fmt.Errorf("mempool_service: %w", err)
+	}
 	return nil
 }
 
@@ -689,6 +720,51 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCMempoolServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Maximum number of events buffered for each client of the stream of
+	// transactions. Events are dropped when a client does not keep up.
+	BufferSize int `mapstructure:"buffer_size"`
+}
+
+func DefaultGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled:    false,
+		BufferSize: 1000,
+	}
+}
+
+func TestGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled:    true,
+		BufferSize: 1000,
+	}
+}
+
+func (cfg *GRPCMempoolServiceConfig) ValidateBasic() error {
+	if cfg.BufferSize < 0 {
+		return cmterrors.ErrNegativeField{Field: "buffer_size"}
+	}
+	return nil
+}
+
+type GRPCEvidenceServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCEvidenceServiceConfig() *GRPCEvidenceServiceConfig {
+	return &GRPCEvidenceServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCEvidenceServiceConfig() *GRPCEvidenceServiceConfig {
+	return &GRPCEvidenceServiceConfig{
+		Enabled: true,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -947,12 +1023,56 @@ type MempoolConfig struct {
 	// transactions and a 5MB maximum mempool byte size, the mempool will
 	// only accept five transactions.
 	MaxTxsBytes int64 `mapstructure:"max_txs_bytes"`
+	// Maximum number of transactions in some lanes, as a list of
+	// "<lane>=<max>" entries. Lanes not listed get an even share of Size.
+	LaneMaxTxs []string `mapstructure:"lane_max_txs"`
+	// Maximum size in bytes of all transactions in some lanes, as a list of
+	// "<lane>=<max>" entries. Lanes not listed get an even share of
+	// MaxTxsBytes.
+	LaneMaxTxsBytes []string `mapstructure:"lane_max_txs_bytes"`
+	// TTLDuration, if non-zero, defines the maximum amount of time a transaction
+	// can exist for in the mempool. Expired transactions are removed from the
+	// mempool (and the cache) when a block is committed.
+	TTLDuration time.Duration `mapstructure:"ttl_duration"`
+	// TTLNumBlocks, if non-zero, defines the maximum number of blocks a
+	// transaction can exist for in the mempool. Expired transactions are
+	// removed from the mempool (and the cache) when a block is committed.
+	TTLNumBlocks int64 `mapstructure:"ttl_num_blocks"`
+	// JournalEnabled (default: false) defines whether the transactions in the
+	// mempool are recorded in a journal file, from which they are re-submitted
+	// to the mempool when the node restarts.
+	JournalEnabled bool `mapstructure:"journal_enabled"`
+	// Path to the mempool journal file, relative to the home directory.
+	JournalPath string `mapstructure:"journal_file"`
+	// Maximum size in bytes of the mempool journal file. When the journal
+	// reaches it, it is rewritten with the transactions in the mempool, up to
+	// half of this size.
+	JournalMaxBytes int64 `mapstructure:"journal_max_bytes"`
 	// Size of the cache (used to filter transactions we saw earlier) in transactions.
 	CacheSize int `mapstructure:"cache_size"`
 	// Do not remove invalid transactions from the cache (default: false)
 	// Set to true if it's not possible for any invalid transaction to become
 	// valid again in the future.
 	KeepInvalidTxsInCache bool `mapstructure:"keep-invalid-txs-in-cache"`
+	// Maximum number of transactions per second accepted from each peer.
+	// Transactions received above this rate are dropped without being checked.
+	// Bursts of up to one second worth of transactions are allowed. 0 means
+	// unlimited.
+	PeerMaxTxsPerSecond int `mapstructure:"peer_max_txs_per_second"`
+	// Maximum number of transaction bytes per second accepted from each peer.
+	// Transactions received above this rate are dropped without being checked.
+	// 0 means unlimited.
+	PeerMaxBytesPerSecond int64 `mapstructure:"peer_max_bytes_per_second"`
+	// Maximum ratio of the transactions received from a peer that may fail
+	// CheckTx. When a peer goes over it, the node disconnects from the peer.
+	// Must be between 0 and 1; 0 disables the check.
+	PeerMaxInvalidTxsRatio float64 `mapstructure:"peer_max_invalid_txs_ratio"`
+	// Minimum number of transactions received from a peer and checked before
+	// its ratio of invalid transactions is evaluated.
+	PeerInvalidTxsMinCount int `mapstructure:"peer_invalid_txs_min_count"`
+	// How long a peer disconnected for sending too many invalid transactions
+	// is banned from the address book. 0 means the peer is not banned.
+	PeerBanDuration time.Duration `mapstructure:"peer_ban_duration"`
 	// Experimental parameters to limit gossiping txs to up to the specified number of peers.
 	// We use two independent upper values for persistent and non-persistent peers.
 	// Unconditional peers are not affected by this feature.
@@ -999,10 +1119,15 @@ func DefaultMempoolConfig() *MempoolConfig {
 		Broadcast:      true,
 		// Each signature verification takes .5ms, Size reduced until we implement
 		// ABCI Recheck
-		Size:        5000,
-		MaxTxBytes:  1024 * 1024,      // 1MiB
-		MaxTxsBytes: 64 * 1024 * 1024, // 64MiB, enough to fill 16 blocks of 4 MiB
-		CacheSize:   10000,
+		Size:                   5000,
+		MaxTxBytes:             1024 * 1024,      // 1MiB
+		MaxTxsBytes:            64 * 1024 * 1024, // 64MiB, enough to fill 16 blocks of 4 MiB
+		LaneMaxTxs:             []string{},
+		LaneMaxTxsBytes:        []string{},
+		CacheSize:              10000,
+		JournalPath:            filepath.Join(DefaultDataDir, "mempool.journal"),
+		JournalMaxBytes:        128 * 1024 * 1024, // 128MiB, twice max_txs_bytes
+		PeerInvalidTxsMinCount: 100,
 		ExperimentalMaxGossipConnectionsToNonPersistentPeers: 0,
 		ExperimentalMaxGossipConnectionsToPersistentPeers:    0,
 		DOGProtocolEnabled:  true,
@@ -1018,6 +1143,50 @@ func TestMempoolConfig() *MempoolConfig {
 	return cfg
 }
 
+// JournalFile returns the full path to the mempool journal file.
+func (cfg *MempoolConfig) JournalFile() string {
+	return rootify(cfg.JournalPath, cfg.RootDir)
+}
+
+// LaneLimits returns the maximum number of transactions and bytes of the
+// lanes listed in LaneMaxTxs and LaneMaxTxsBytes, by lane ID.
+func (cfg *MempoolConfig) LaneLimits() (maxTxs, maxTxsBytes map[string]int64, err error) {
+	maxTxs, err = parseLaneLimits("lane_max_txs", cfg.LaneMaxTxs, int64(cfg.Size))
+	if err != nil {
+		return nil, nil, err
+	}
+	maxTxsBytes, err = parseLaneLimits("lane_max_txs_bytes", cfg.LaneMaxTxsBytes, cfg.MaxTxsBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return maxTxs, maxTxsBytes, nil
+}
+
+// parseLaneLimits parses a list of "<lane>=<max>" entries, where max must be
+// positive and not greater than globalMax.
+func parseLaneLimits(field string, entries []string, globalMax int64) (map[string]int64, error) {
+	limits := make(map[string]int64, len(entries))
+	for _, entry := range entries {
+		lane, value, found := strings.Cut(entry, "=")
+		lane = strings.TrimSpace(lane)
+		if !found || lane == "" {
+			return nil, cmterrors.ErrWrongField{Field: field, Err: fmt.Errorf("invalid entry %q, expected <lane>=<max>", entry)}
+		}
+		if _, ok := limits[lane]; ok {
+			return nil, cmterrors.ErrWrongField{Field: field, Err: fmt.Errorf("duplicate lane %q", lane)}
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, cmterrors.ErrWrongField{Field: field, Err: fmt.Errorf("invalid limit of lane %q: %w", lane, err)}
+		}
+		if limit <= 0 || limit > globalMax {
+			return nil, cmterrors.ErrWrongField{Field: field, Err: fmt.Errorf("limit of lane %q must be between 1 and %d", lane, globalMax)}
+		}
+		limits[lane] = limit
+	}
+	return limits, nil
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
@@ -1033,12 +1202,47 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxsBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_txs_bytes"}
 	}
+	if _, _, err := cfg.LaneLimits(); err != nil {
+		return err
+	}
 	if cfg.CacheSize < 0 {
 		return cmterrors.ErrNegativeField{Field: "cache_size"}
 	}
+	if cfg.TTLDuration < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_duration"}
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_num_blocks"}
+	}
+	if cfg.JournalMaxBytes < 0 {
+		return cmterrors.ErrNegativeField{Field: "journal_max_bytes"}
+	}
+	if cfg.JournalEnabled {
+		if cfg.JournalPath == "" {
+			return cmterrors.ErrWrongField{Field: "journal_file", Err: errors.New("must be set when the journal is enabled")}
+		}
+		if cfg.JournalMaxBytes == 0 {
+			return cmterrors.ErrNegativeOrZeroField{Field: "journal_max_bytes"}
+		}
+	}
 	if cfg.MaxTxBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_tx_bytes"}
 	}
+	if cfg.PeerMaxTxsPerSecond < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_max_txs_per_second"}
+	}
+	if cfg.PeerMaxBytesPerSecond < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_max_bytes_per_second"}
+	}
+	if cfg.PeerMaxInvalidTxsRatio < 0 || cfg.PeerMaxInvalidTxsRatio > 1 {
+		return cmterrors.ErrWrongField{Field: "peer_max_invalid_txs_ratio", Err: errors.New("must be between 0 and 1")}
+	}
+	if cfg.PeerInvalidTxsMinCount < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_invalid_txs_min_count"}
+	}
+	if cfg.PeerBanDuration < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_ban_duration"}
+	}
 	if cfg.ExperimentalMaxGossipConnectionsToPersistentPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "experimental_max_gossip_connections_to_persistent_peers"}
 	}
@@ -1246,6 +1450,38 @@ type ConsensusConfig struct {
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// Gossip proposal blocks to peers as compact blocks, made of the block
+	// without its txs and the keys of the txs, which peers look up in their
+	// mempool
+	CompactBlockPropagation bool `mapstructure:"compact_block_propagation"`
+	// How long we wait for a peer to reconstruct a compact block before
+	// falling back to sending it the block parts
+	CompactBlockTimeout time.Duration `mapstructure:"compact_block_timeout"`
+
+	// Number of most recent heights for which the timeline of the consensus
+	// (steps, proposal, block parts, votes and timeouts) is kept in memory
+	// and served by the /consensus_trace RPC endpoint. 0 disables the trace.
+	TraceNumHeights int `mapstructure:"trace_num_heights"`
+
+	// Derive timeout_propose and timeout_vote from the latencies observed in
+	// the most recent rounds, instead of using their static values
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Number of most recent latency samples the adaptive timeouts are derived from
+	AdaptiveTimeoutWindow int `mapstructure:"adaptive_timeout_window"`
+	// Percentile, in (0, 100], of the latency samples used as timeout
+	AdaptiveTimeoutPercentile float64 `mapstructure:"adaptive_timeout_percentile"`
+	// Bounds of the adaptive timeout_propose
+	TimeoutProposeMin time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax time.Duration `mapstructure:"timeout_propose_max"`
+	// Bounds of the adaptive timeout_vote
+	TimeoutVoteMin time.Duration `mapstructure:"timeout_vote_min"`
+	TimeoutVoteMax time.Duration `mapstructure:"timeout_vote_max"`
+
+	// Start executing a proposal block, on a separate speculative connection
+	// to the app, as soon as the node prevotes it, instead of once it is
+	// committed. The app must support the speculative connection.
+	OptimisticExecution bool `mapstructure:"optimistic_execution"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1263,6 +1499,17 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
 		DoubleSignCheckHeight:            int64(0),
+		CompactBlockPropagation:          false,
+		CompactBlockTimeout:              500 * time.Millisecond,
+		TraceNumHeights:                  10,
+		AdaptiveTimeouts:                 false,
+		AdaptiveTimeoutWindow:            100,
+		AdaptiveTimeoutPercentile:        99,
+		TimeoutProposeMin:                500 * time.Millisecond,
+		TimeoutProposeMax:                3000 * time.Millisecond,
+		TimeoutVoteMin:                   100 * time.Millisecond,
+		TimeoutVoteMax:                   1000 * time.Millisecond,
+		OptimisticExecution:              false,
 	}
 }
 
@@ -1277,6 +1524,7 @@ func TestConsensusConfig() *ConsensusConfig {
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
 	cfg.PeerQueryMaj23SleepDuration = 250 * time.Millisecond
 	cfg.DoubleSignCheckHeight = int64(0)
+	cfg.CompactBlockTimeout = 100 * time.Millisecond
 	return cfg
 }
 
@@ -1355,6 +1603,33 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
+	if cfg.CompactBlockTimeout < 0 {
+		return cmterrors.ErrNegativeField{Field: "compact_block_timeout"}
+	}
+	if cfg.TraceNumHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "trace_num_heights"}
+	}
+	if cfg.AdaptiveTimeoutWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "adaptive_timeout_window"}
+	}
+	if cfg.AdaptiveTimeouts && cfg.AdaptiveTimeoutWindow == 0 {
+		return cmterrors.ErrWrongField{Field: "adaptive_timeout_window", Err: errors.New("must be positive when adaptive timeouts are enabled")}
+	}
+	if cfg.AdaptiveTimeoutPercentile <= 0 || cfg.AdaptiveTimeoutPercentile > 100 {
+		return cmterrors.ErrWrongField{Field: "adaptive_timeout_percentile", Err: errors.New("must be in (0, 100]")}
+	}
+	if cfg.TimeoutProposeMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_propose_min"}
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return cmterrors.ErrWrongField{Field: "timeout_propose_max", Err: errors.New("must not be less than timeout_propose_min")}
+	}
+	if cfg.TimeoutVoteMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_vote_min"}
+	}
+	if cfg.TimeoutVoteMax < cfg.TimeoutVoteMin {
+		return cmterrors.ErrWrongField{Field: "timeout_vote_max", Err: errors.New("must not be less than timeout_vote_min")}
+	}
 	return nil
 }
 
@@ -1386,6 +1661,18 @@ type StorageConfig struct {
 	// 1000 by default.
 	CompactionInterval int64 `mapstructure:"compaction_interval"`
 
+	// Number of most recent blocks kept in the block store database. The parts
+	// and commits of older blocks are moved, ColdTierSegmentSize blocks at a
+	// time, to compressed and immutable segment files in the
+	// blockstore.segments directory next to the database. Pruning deletes
+	// whole segments.
+	// 0 by default (blocks are not moved; the ones already moved remain
+	// readable).
+	ColdTierDepth int64 `mapstructure:"cold_tier_depth"`
+	// Number of blocks in each segment file of the cold tier.
+	// 10000 by default.
+	ColdTierSegmentSize int64 `mapstructure:"cold_tier_segment_size"`
+
 	// The representation of keys in the database.
 	// The current representation of keys in Comet's stores is considered to be v1
 	// Users can experiment with a different layout by setting this field to v2.
@@ -1403,6 +1690,8 @@ func DefaultStorageConfig() *StorageConfig {
 		Compact:               false,
 		CompactionInterval:    1000,
 		ExperimentalKeyLayout: "v1",
+		ColdTierDepth:         0,
+		ColdTierSegmentSize:   10000,
 	}
 }
 
@@ -1422,6 +1711,12 @@ func (cfg *StorageConfig) ValidateBasic() error {
 	if cfg.ExperimentalKeyLayout != "v1" && cfg.ExperimentalKeyLayout != "v2" {
 		return fmt.Errorf("unsupported version of DB Key layout, expected v1 or v2, got %s", cfg.ExperimentalKeyLayout)
 	}
+	if cfg.ColdTierDepth < 0 {
+		return cmterrors.ErrNegativeField{Field: "cold_tier_depth"}
+	}
+	if cfg.ColdTierDepth > 0 && cfg.ColdTierSegmentSize <= 0 {
+		return errors.New("cold_tier_segment_size must be positive when cold_tier_depth is set")
+	}
 	return nil
 }
 
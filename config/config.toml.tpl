@@ -24,6 +24,14 @@ moniker = "{{ .BaseConfig.Moniker }}"
 # Database directory
 db_dir = "{{ js .BaseConfig.DBPath }}"
 
+# Database backend: pebbledb | memdb
+# * pebbledb (default): on-disk key-value store built on cockroachdb/pebble
+# * memdb: pebble over an in-memory file system; data is lost on shutdown,
+#   useful only for testing
+# Any other backend registered through db.RegisterBackend before the node
+# starts can be selected by name.
+db_backend = "{{ .BaseConfig.DBBackend }}"
+
 # Output level for logging, including package level options
 log_level = "{{ .BaseConfig.LogLevel }}"
 
@@ -204,6 +212,22 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC mempool service streams the transactions added to and removed from
+# the mempool, optionally filtered by lane.
+[grpc.mempool_service]
+enabled = {{ .GRPC.MempoolService.Enabled }}
+
+# Maximum number of events buffered for each client of the stream. When a client
+# does not keep up, events are dropped, and their number is reported in the next
+# event sent to the client.
+buffer_size = {{ .GRPC.MempoolService.BufferSize }}
+
+# The gRPC evidence service returns the evidence pending in the evidence pool
+# and the evidence committed at a given height, with its expiry and the peer it
+# was received from.
+[grpc.evidence_service]
+enabled = {{ .GRPC.EvidenceService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -341,6 +365,38 @@ max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 # only accept five transactions.
 max_txs_bytes = {{ .Mempool.MaxTxsBytes }}
 
+# Maximum number of transactions in some lanes, as a list of "<lane>=<max>"
+# entries. Lanes not listed get an even share of size.
+# Example: ["foo=1000", "bar=500"]
+lane_max_txs = [{{ range .Mempool.LaneMaxTxs }}{{ printf "%q, " . }}{{end}}]
+
+# Maximum size in bytes of all transactions in some lanes, as a list of
+# "<lane>=<max>" entries. Lanes not listed get an even share of max_txs_bytes.
+# Example: ["foo=16777216"]
+lane_max_txs_bytes = [{{ range .Mempool.LaneMaxTxsBytes }}{{ printf "%q, " . }}{{end}}]
+
+# ttl_duration, if non-zero, defines the maximum amount of time a transaction
+# can exist for in the mempool. Expired transactions are removed from the
+# mempool (and the cache) when a block is committed.
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
+# ttl_num_blocks, if non-zero, defines the maximum number of blocks a
+# transaction can exist for in the mempool. Expired transactions are removed
+# from the mempool (and the cache) when a block is committed.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+
+# Record the transactions in the mempool in a journal file, from which they
+# are re-submitted to the mempool (through CheckTx) when the node restarts.
+journal_enabled = {{ .Mempool.JournalEnabled }}
+
+# Path to the mempool journal file, relative to the home directory.
+journal_file = "{{ js .Mempool.JournalPath }}"
+
+# Maximum size in bytes of the mempool journal file. When the journal reaches
+# it, it is rewritten with the transactions in the mempool, up to half of this
+# size.
+journal_max_bytes = {{ .Mempool.JournalMaxBytes }}
+
 # Size of the cache (used to filter transactions we saw earlier) in transactions
 cache_size = {{ .Mempool.CacheSize }}
 
@@ -349,6 +405,30 @@ cache_size = {{ .Mempool.CacheSize }}
 # again in the future.
 keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 
+# Maximum number of transactions per second accepted from each peer.
+# Transactions received above this rate are dropped without being checked.
+# Bursts of up to one second worth of transactions are allowed. 0 means
+# unlimited.
+peer_max_txs_per_second = {{ .Mempool.PeerMaxTxsPerSecond }}
+
+# Maximum number of transaction bytes per second accepted from each peer.
+# Transactions received above this rate are dropped without being checked.
+# 0 means unlimited.
+peer_max_bytes_per_second = {{ .Mempool.PeerMaxBytesPerSecond }}
+
+# Maximum ratio of the transactions received from a peer that may fail CheckTx.
+# When a peer goes over it, the node disconnects from the peer. Must be between
+# 0 and 1; 0 disables the check.
+peer_max_invalid_txs_ratio = {{ .Mempool.PeerMaxInvalidTxsRatio }}
+
+# Minimum number of transactions received from a peer and checked before its
+# ratio of invalid transactions is evaluated.
+peer_invalid_txs_min_count = {{ .Mempool.PeerInvalidTxsMinCount }}
+
+# How long a peer disconnected for sending too many invalid transactions is
+# banned from the address book. 0 means the peer is not banned.
+peer_ban_duration = "{{ .Mempool.PeerBanDuration }}"
+
 # Experimental parameters to limit gossiping txs to up to the specified number of peers.
 # We use two independent upper values for persistent and non-persistent peers.
 # Unconditional peers are not affected by this feature.
@@ -452,6 +532,35 @@ timeout_vote_delta = "{{ .Consensus.TimeoutVoteDelta }}"
 # Deprecated: use `next_block_delay` in the ABCI application's `FinalizeBlockResponse`.
 timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 
+# Derive timeout_propose and timeout_vote from the latencies observed in the
+# most recent rounds: the time to receive the complete proposal after entering
+# the propose step, and the time to receive +2/3 prevotes/precommits for a
+# single block or nil after receiving +2/3 prevotes/precommits for anything.
+# A round that times out is sampled at the timeout value. The timeouts are the
+# given percentile of the latency samples, bounded by the min and max below,
+# and still increase by the deltas above with each round. The static values are
+# used until latencies are observed.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+# Number of most recent latency samples the adaptive timeouts are derived from
+adaptive_timeout_window = {{ .Consensus.AdaptiveTimeoutWindow }}
+# Percentile, in (0, 100], of the latency samples used as timeout
+adaptive_timeout_percentile = {{ .Consensus.AdaptiveTimeoutPercentile }}
+# Bounds of the adaptive timeout_propose
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+# Bounds of the adaptive timeout_vote
+timeout_vote_min = "{{ .Consensus.TimeoutVoteMin }}"
+timeout_vote_max = "{{ .Consensus.TimeoutVoteMax }}"
+
+# Start executing a proposal block as soon as the node prevotes it, with a
+# FinalizeBlock call on a separate speculative connection to the application,
+# so that its execution overlaps with the prevote and precommit steps. The
+# committed block is still finalized on the consensus connection, and the
+# application may then reuse the result of its speculative execution. The
+# application must support the speculative connection, as described in the
+# ABCI specification.
+optimistic_execution = {{ .Consensus.OptimisticExecution }}
+
 # How many blocks to look back to check existence of the node's consensus votes before joining consensus
 # When non-zero, the node will panic upon restart
 # if the same consensus key was used to sign {double_sign_check_height} last blocks.
@@ -467,6 +576,22 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Gossip proposal blocks as compact blocks, made of the block without its txs
+# and the keys of the txs. Peers reconstruct the block from the txs in their
+# mempool and request the missing ones. Only peers that enabled it too receive
+# compact blocks.
+compact_block_propagation = {{ .Consensus.CompactBlockPropagation }}
+
+# How long to wait for a peer to reconstruct a compact block before falling
+# back to sending it the block parts.
+compact_block_timeout = "{{ .Consensus.CompactBlockTimeout }}"
+
+# Number of most recent heights for which the timeline of the consensus (step
+# transitions, arrival of the proposal, block parts and votes, timeouts) is
+# kept in memory and served by the /consensus_trace RPC endpoint.
+# 0 disables the trace.
+trace_num_heights = {{ .Consensus.TraceNumHeights }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -484,7 +609,8 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 # Note that this is an experimental feature and switching back from v2 to v1
 # is not supported by CometBFT.
 # If the database was initially created with v1, it is necessary to migrate the DB
-# before switching to v2. The migration is not done automatically.
+# before switching to v2. The migration is not done automatically: stop the node
+# and run `cometbft db migrate-key-layout --to v2`.
 # v1 - the legacy layout existing in Comet prior to v1.
 # v2 - Order preserving representation ordering entries by height.
 experimental_db_key_layout = "{{ .Storage.ExperimentalKeyLayout }}"
@@ -502,6 +628,17 @@ compact = {{ .Storage.Compact }}
 # large multiple of your retain height as it might occur bigger overheads.
 compaction_interval = "{{ .Storage.CompactionInterval }}"
 
+# Number of most recent blocks kept in the block store database. The parts and
+# commits of older blocks are moved, cold_tier_segment_size blocks at a time, to
+# compressed and immutable segment files in the blockstore.segments directory
+# next to the database, which keeps the database small on archive nodes.
+# Pruning deletes whole segments.
+# 0 by default (blocks are not moved; the ones already moved remain readable).
+cold_tier_depth = {{ .Storage.ColdTierDepth }}
+
+# Number of blocks in each segment file of the cold tier.
+cold_tier_segment_size = {{ .Storage.ColdTierSegmentSize }}
+
 [storage.pruning]
 
 # The time period between automated background pruning operations.
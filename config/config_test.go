@@ -138,6 +138,19 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestGRPCConfigValidateBasic(t *testing.T) {
+	cfg := config.TestGRPCConfig()
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.MempoolService.BufferSize = -1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.MempoolService.BufferSize = 0
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ListenAddress = "127.0.0.1:36670"
+	require.Error(t, cfg.ValidateBasic())
+}
+
 func TestMempoolConfigValidateBasic(t *testing.T) {
 	cfg := config.TestMempoolConfig()
 	require.NoError(t, cfg.ValidateBasic())
@@ -162,6 +175,10 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		{"MaxTxsBytes", []int64{1}, []int64{-1, 0}},
 		{"CacheSize", []int64{0, 1}, []int64{-1}},
 		{"MaxTxBytes", []int64{1}, []int64{-1, 0}},
+		{"PeerMaxTxsPerSecond", []int64{0, 1}, []int64{-1}},
+		{"PeerMaxBytesPerSecond", []int64{0, 1}, []int64{-1}},
+		{"PeerInvalidTxsMinCount", []int64{0, 1}, []int64{-1}},
+		{"PeerBanDuration", []int64{0, 1}, []int64{-1}},
 		{"ExperimentalMaxGossipConnectionsToPersistentPeers", []int64{0, 1}, []int64{-1}},
 		{"ExperimentalMaxGossipConnectionsToNonPersistentPeers", []int64{0, 1}, []int64{-1}},
 	}
@@ -179,6 +196,32 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		}
 	}
 
+	// tamper with the invalid txs ratio
+	for _, value := range []float64{-0.1, 1.1} {
+		cfg.PeerMaxInvalidTxsRatio = value
+		require.Error(t, cfg.ValidateBasic())
+	}
+	cfg.PeerMaxInvalidTxsRatio = 0.5
+	require.NoError(t, cfg.ValidateBasic())
+
+	// tamper with the lane limits
+	cfg.Size, cfg.MaxTxsBytes = 100, 1000
+	cfg.LaneMaxTxs = []string{"foo=10", "bar = 100"}
+	cfg.LaneMaxTxsBytes = []string{"foo=1000"}
+	require.NoError(t, cfg.ValidateBasic())
+	maxTxs, maxTxsBytes, err := cfg.LaneLimits()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"foo": 10, "bar": 100}, maxTxs)
+	assert.Equal(t, map[string]int64{"foo": 1000}, maxTxsBytes)
+	for _, entries := range [][]string{{"foo"}, {"=10"}, {"foo=x"}, {"foo=0"}, {"foo=101"}, {"foo=1", "foo=2"}} {
+		cfg.LaneMaxTxs = entries
+		require.Error(t, cfg.ValidateBasic(), entries)
+	}
+	cfg.LaneMaxTxs = nil
+	cfg.LaneMaxTxsBytes = []string{"foo=1001"}
+	require.Error(t, cfg.ValidateBasic())
+	cfg.LaneMaxTxsBytes = nil
+
 	// with noop mempool, zero values are allowed for the fields below
 	reflect.ValueOf(cfg).Elem().FieldByName("Type").SetString(config.MempoolTypeNop)
 	fieldNames := []string{
@@ -239,6 +282,19 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"CompactBlockTimeout negative":         {func(c *config.ConsensusConfig) { c.CompactBlockTimeout = -1 }, true},
+		"TraceNumHeights negative":             {func(c *config.ConsensusConfig) { c.TraceNumHeights = -1 }, true},
+		"AdaptiveTimeoutWindow negative":       {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutWindow = -1 }, true},
+		"AdaptiveTimeoutWindow zero": {func(c *config.ConsensusConfig) {
+			c.AdaptiveTimeouts = true
+			c.AdaptiveTimeoutWindow = 0
+		}, true},
+		"AdaptiveTimeoutPercentile zero":      {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutPercentile = 0 }, true},
+		"AdaptiveTimeoutPercentile above 100": {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutPercentile = 101 }, true},
+		"TimeoutProposeMin negative":          {func(c *config.ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":         {func(c *config.ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutVoteMin negative":             {func(c *config.ConsensusConfig) { c.TimeoutVoteMin = -1 }, true},
+		"TimeoutVoteMax below min":            {func(c *config.ConsensusConfig) { c.TimeoutVoteMax = c.TimeoutVoteMin - 1 }, true},
 	}
 	for desc, tc := range testcases {
 		t.Run(desc, func(t *testing.T) {
@@ -27,13 +27,18 @@ type DBContext struct {
 // DBProvider takes a DBContext and returns an instantiated DB.
 type DBProvider func(*DBContext) (cmtdb.DB, error)
 
-// DefaultDBProvider creates a DB using the given ctx.
+// DefaultDBProvider creates a DB using the given ctx. The database backend is
+// looked up by name among the backends registered in the db package.
 func DefaultDBProvider(ctx *DBContext) (cmtdb.DB, error) {
 	var (
-		dbName = ctx.ID
-		dbDir  = ctx.Config.DBDir()
+		dbName    = ctx.ID
+		dbDir     = ctx.Config.DBDir()
+		dbBackend = cmtdb.BackendType(ctx.Config.DBBackend)
 	)
-	db, err := cmtdb.New(dbName, dbDir)
+	if dbBackend == "" {
+		dbBackend = cmtdb.DefaultBackend
+	}
+	db, err := cmtdb.NewWithBackend(dbName, dbBackend, dbDir)
 	if err != nil {
 		return nil, fmt.Errorf("database provider: %w", err)
 	}
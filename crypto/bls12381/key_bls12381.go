@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	blst "github.com/supranational/blst/bindings/go"
 
@@ -26,8 +27,12 @@ var (
 	// ErrInfinitePubKey is returned when the public key is infinite. It is part
 	// of a more comprehensive subgroup check on the key.
 	ErrInfinitePubKey = errors.New("bls12381: pubkey is infinite")
+	// ErrNoSignatures is returned when aggregating no signatures.
+	ErrNoSignatures = errors.New("bls12381: no signatures to aggregate")
 
 	dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+	// Domain separation tag of the coefficients of the aggregated signatures.
+	dstAggregate = []byte("BLS_AGG_BLS12381G2_XMD:SHA-256_COEFFICIENT_")
 )
 
 // For minimal-pubkey-size operations.
@@ -37,8 +42,8 @@ var (
 type (
 	blstPublicKey          = blst.P1Affine
 	blstSignature          = blst.P2Affine
-	blstAggregateSignature = blst.P1Aggregate
-	blstAggregatePublicKey = blst.P2Aggregate
+	blstAggregateSignature = blst.P2Aggregate
+	blstAggregatePublicKey = blst.P1Aggregate
 )
 
 // -------------------------------------.
@@ -222,3 +227,90 @@ func (pubkey *PubKey) UnmarshalJSON(bz []byte) error {
 	pubkey.pk = pk.pk
 	return nil
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// Signatures are aggregated with coefficients derived from the public key of
+// their signer and from a context, such as the hash of the signed block,
+// which the signers can't choose once their keys are known. Weighting the
+// signatures of a same message by different keys this way protects their
+// aggregation against rogue key attacks without proofs of possession, while
+// the signatures themselves follow the plain scheme.
+
+// AggregateSignatures aggregates the signatures sigs[i] by pubKeys[i] in the
+// given context into a single signature, added to the aggregated signature
+// agg of the same context, if any.
+func AggregateSignatures(agg []byte, sigs [][]byte, pubKeys []crypto.PubKey, context []byte) ([]byte, error) {
+	if len(sigs) != len(pubKeys) {
+		return nil, fmt.Errorf("bls12381: got %d signatures for %d keys", len(sigs), len(pubKeys))
+	}
+	if len(agg) == 0 && len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+
+	sum := new(blstAggregateSignature)
+	if len(agg) > 0 && !sum.AggregateCompressed([][]byte{agg}, true) {
+		return nil, ErrDeserialization
+	}
+	for i, sig := range sigs {
+		pk, err := blstPubKey(pubKeys[i])
+		if err != nil {
+			return nil, err
+		}
+		signature := new(blstSignature).Uncompress(sig)
+		if signature == nil || !signature.SigValidate(false) {
+			return nil, ErrDeserialization
+		}
+		var weighted blst.P2
+		weighted.FromAffine(signature)
+		weighted.MultAssign(coefficient(pk, context))
+		sum.Add(weighted.ToAffine(), false)
+	}
+	return sum.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregate, in the given
+// context, of the signatures of msgs[i] by pubKeys[i]. The messages need not
+// be distinct.
+func VerifyAggregateSignature(sig []byte, pubKeys []crypto.PubKey, msgs [][]byte, context []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	pks := make([]*blstPublicKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk, err := blstPubKey(pubKey)
+		if err != nil {
+			return false
+		}
+		var weighted blst.P1
+		weighted.FromAffine(pk)
+		pks[i] = weighted.MultAssign(coefficient(pk, context)).ToAffine()
+	}
+
+	signature := new(blstSignature).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+	return signature.AggregateVerify(true, pks, false, msgs, dstMinPk)
+}
+
+// coefficient returns the coefficient of the signatures by pk in the given
+// context.
+func coefficient(pk *blstPublicKey, context []byte) *blst.Scalar {
+	return blst.HashToScalar(append(pk.Compress(), context...), dstAggregate)
+}
+
+// blstPubKey returns the key wrapped by pubKey, which must be a BLS12-381 key.
+func blstPubKey(pubKey crypto.PubKey) (*blstPublicKey, error) {
+	switch pk := pubKey.(type) {
+	case PubKey:
+		return pk.pk, nil
+	case *PubKey:
+		return pk.pk, nil
+	default:
+		return nil, fmt.Errorf("bls12381: unsupported key type %s", pubKey.Type())
+	}
+}
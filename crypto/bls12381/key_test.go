@@ -112,6 +112,82 @@ func TestPrivKeySignAndPubKeyVerifySignature(t *testing.T) {
 	assert.True(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestAggregateSignatures(t *testing.T) {
+	const n = 4
+	privKeys := make([]*bls12381.PrivKey, n)
+	pubKeys := make([]crypto.PubKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range pubKeys {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey()
+		msgs[i] = crypto.CRandBytes(32)
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	context := crypto.CRandBytes(32)
+	_, err := bls12381.AggregateSignatures(nil, nil, nil, context)
+	require.ErrorIs(t, err, bls12381.ErrNoSignatures)
+
+	sig, err := bls12381.AggregateSignatures(nil, sigs, pubKeys, context)
+	require.NoError(t, err)
+	assert.Len(t, sig, bls12381.SignatureLength)
+	assert.True(t, bls12381.VerifyAggregateSignature(sig, pubKeys, msgs, context))
+
+	// Signatures can be added to an aggregate.
+	partial, err := bls12381.AggregateSignatures(nil, sigs[:2], pubKeys[:2], context)
+	require.NoError(t, err)
+	sig2, err := bls12381.AggregateSignatures(partial, sigs[2:], pubKeys[2:], context)
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+
+	// The aggregate of a subset, of other messages or in another context is
+	// not valid.
+	assert.False(t, bls12381.VerifyAggregateSignature(partial, pubKeys, msgs, context))
+	otherMsgs := append([][]byte{crypto.CRandBytes(32)}, msgs[1:]...)
+	assert.False(t, bls12381.VerifyAggregateSignature(sig, pubKeys, otherMsgs, context))
+	assert.False(t, bls12381.VerifyAggregateSignature(sig, pubKeys[1:], msgs[1:], context))
+	assert.False(t, bls12381.VerifyAggregateSignature(sig, pubKeys, msgs, crypto.CRandBytes(32)))
+
+	// The plain sum of the signatures is not a valid aggregate.
+	var sum blst.P2Aggregate
+	require.True(t, sum.AggregateCompressed(sigs, true))
+	assert.False(t, bls12381.VerifyAggregateSignature(sum.ToAffine().Compress(), pubKeys, msgs, context))
+
+	// The signatures of a same message by different keys can be aggregated.
+	sameSigs := make([][]byte, n)
+	sameMsgs := make([][]byte, n)
+	for i, privKey := range privKeys {
+		sameMsgs[i] = msgs[0]
+		sameSigs[i], err = privKey.Sign(msgs[0])
+		require.NoError(t, err)
+	}
+	sameSig, err := bls12381.AggregateSignatures(nil, sameSigs, pubKeys, context)
+	require.NoError(t, err)
+	assert.True(t, bls12381.VerifyAggregateSignature(sameSig, pubKeys, sameMsgs, context))
+	assert.False(t, bls12381.VerifyAggregateSignature(sameSig, pubKeys, msgs, context))
+}
+
+func TestSignatureIsMinPkNul(t *testing.T) {
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	defer privKey.Zeroize()
+
+	// Signatures follow the standard min-pk scheme without augmentation, so
+	// they are compatible with other implementations.
+	msg := crypto.CRandBytes(32)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	pk := new(blst.P1Affine).Deserialize(privKey.PubKey().Bytes())
+	require.NotNil(t, pk)
+	signature := new(blst.P2Affine).Uncompress(sig)
+	require.NotNil(t, signature)
+	assert.True(t, signature.Verify(true, pk, true, msg, []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")))
+}
+
 func TestPubKey(t *testing.T) {
 	privKey, err := bls12381.GenPrivKey()
 	require.NoError(t, err)
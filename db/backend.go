@@ -0,0 +1,98 @@
+package db
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// BackendType is the name under which a database backend is registered.
+type BackendType string
+
+const (
+	// PebbleDBBackend is the default on-disk backend, built on top of
+	// cockroachdb/pebble.
+	PebbleDBBackend BackendType = "pebbledb"
+
+	// MemDBBackend is a pebble instance backed by an in-memory file system. The
+	// directory passed to it is ignored, and all data is lost when the database
+	// is closed. Especially useful for testing.
+	MemDBBackend BackendType = "memdb"
+
+	// DefaultBackend is the backend used by [New].
+	DefaultBackend = PebbleDBBackend
+)
+
+// BackendCreator creates a new database with the given name, located at the
+// given directory.
+type BackendCreator func(name, dir string) (DB, error)
+
+var (
+	backendsMtx sync.RWMutex
+	backends    = map[BackendType]BackendCreator{}
+)
+
+func init() {
+	RegisterBackend(PebbleDBBackend, func(name, dir string) (DB, error) {
+		return newPebbleDB(name, dir)
+	})
+	RegisterBackend(MemDBBackend, func(string, string) (DB, error) {
+		return NewInMem()
+	})
+}
+
+// RegisterBackend makes a database backend available under the given name.
+// It is meant to be called from the init function of the package implementing
+// the backend. RegisterBackend panics if backend is empty, if creator is nil or
+// if a backend with the same name is already registered.
+func RegisterBackend(backend BackendType, creator BackendCreator) {
+	if backend == "" {
+		panic("db: cannot register a backend with an empty name")
+	}
+	if creator == nil {
+		panic(fmt.Sprintf("db: nil creator for backend %q", backend))
+	}
+
+	backendsMtx.Lock()
+	defer backendsMtx.Unlock()
+
+	if _, ok := backends[backend]; ok {
+		panic(fmt.Sprintf("db: backend %q is already registered", backend))
+	}
+	backends[backend] = creator
+}
+
+// Backends returns the sorted names of all registered database backends.
+func Backends() []BackendType {
+	backendsMtx.RLock()
+	defer backendsMtx.RUnlock()
+
+	names := make([]BackendType, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	return names
+}
+
+// NewWithBackend returns a new database with the given name, located at the
+// given directory, using the registered backend.
+// It returns an error if no backend is registered under that name.
+func NewWithBackend(name string, backend BackendType, dir string) (DB, error) {
+	backendsMtx.RLock()
+	creator, ok := backends[backend]
+	backendsMtx.RUnlock()
+
+	if !ok {
+		formatStr := "unknown database backend %q, expected one of %v"
+		return nil, fmt.Errorf(formatStr, backend, Backends())
+	}
+
+	db, err := creator(name, dir)
+	if err != nil {
+		return nil, fmt.Errorf("creating new %s database: %w", backend, err)
+	}
+
+	return db, nil
+}
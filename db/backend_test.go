@@ -0,0 +1,45 @@
+package db
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRegisterBackend(t *testing.T) {
+	const testBackend BackendType = "test_backend"
+
+	wantErr := errors.New("test backend")
+	RegisterBackend(testBackend, func(string, string) (DB, error) {
+		return nil, wantErr
+	})
+	t.Cleanup(func() {
+		backendsMtx.Lock()
+		delete(backends, testBackend)
+		backendsMtx.Unlock()
+	})
+
+	if !slices.Contains(Backends(), testBackend) {
+		t.Fatalf("expected %q among registered backends, got: %v", testBackend, Backends())
+	}
+
+	_, err := NewWithBackend("test", testBackend, t.TempDir())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected error %q, got: %v", wantErr, err)
+	}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected registering a duplicate backend to panic")
+			}
+		}()
+		RegisterBackend(testBackend, func(string, string) (DB, error) { return nil, nil })
+	})
+}
+
+func TestNewWithBackendUnknown(t *testing.T) {
+	if _, err := NewWithBackend("test", "no_such_backend", t.TempDir()); err == nil {
+		t.Fatal("expected an error when creating a DB with an unknown backend")
+	}
+}
@@ -0,0 +1,32 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/db"
+	"github.com/cometbft/cometbft/db/dbtest"
+)
+
+func TestBackendsConformance(t *testing.T) {
+	for _, backend := range db.Backends() {
+		t.Run(string(backend), func(t *testing.T) {
+			dbtest.TestBackend(t, backend)
+		})
+	}
+}
+
+func TestPrefixDBConformance(t *testing.T) {
+	dbtest.TestDB(t, func(t *testing.T) db.DB {
+		t.Helper()
+
+		memDB, err := db.NewInMem()
+		if err != nil {
+			t.Fatalf("creating in-memory DB: %s", err)
+		}
+		prefixDB, err := db.NewWithPrefix(memDB, []byte("test_prefix"))
+		if err != nil {
+			t.Fatalf("creating prefix DB: %s", err)
+		}
+		return prefixDB
+	})
+}
@@ -96,6 +96,12 @@ type DB interface {
 	// NewBatch creates a batch for atomic updates. The caller must call Batch.Close.
 	NewBatch() Batch
 
+	// NewSnapshot returns a read-only, point-in-time view of the database.
+	// Writes made after NewSnapshot returns, including batches committed
+	// concurrently, are not visible through the snapshot. The caller must call
+	// Snapshot.Close when done.
+	NewSnapshot() (Snapshot, error)
+
 	// Print prints all the key/value pairs in the database for debugging purposes.
 	Print() error
 
@@ -106,6 +112,44 @@ type DB interface {
 	Compact(start, end []byte) error
 }
 
+// Reader is the read-only subset of the [DB] interface. Both [DB] and [Snapshot]
+// implement it, so that code that only reads can run against either.
+type Reader interface {
+	// Get fetches the value of the given key, or nil if it does not exist.
+	// It is safe to modify the contents of key and of the returned slice after Get
+	// returns.
+	Get(key []byte) ([]byte, error)
+
+	// Has checks if a key exists.
+	// It is safe to modify the contents of key after Has returns.
+	Has(key []byte) (bool, error)
+
+	// Iterator returns an iterator over a domain of keys, in ascending order.
+	// See [DB.Iterator] for the semantics of start and end.
+	Iterator(start, end []byte) (Iterator, error)
+
+	// ReverseIterator returns an iterator over a domain of keys, in descending
+	// order. See [DB.ReverseIterator] for the semantics of start and end.
+	ReverseIterator(start, end []byte) (Iterator, error)
+}
+
+// Snapshot is a read-only view of a [DB] frozen at the time it was created.
+// Reads through a snapshot never observe writes made to the database after its
+// creation, so several keys read from the same snapshot are mutually
+// consistent. Snapshots are safe for concurrent use.
+//
+// Callers must call Close when done, and must close all iterators created from
+// a snapshot before closing it. Open snapshots prevent the underlying database
+// from reclaiming the space of overwritten or deleted keys, so they should be
+// short-lived.
+type Snapshot interface {
+	Reader
+
+	// Close releases the snapshot. It is not valid to call any of a Snapshot's
+	// methods after it has been closed.
+	Close() error
+}
+
 // Batch represents a group of writes. Callers must call Close on the batch when
 // done. A batch is not safe for concurrent use.
 type Batch interface {
@@ -193,13 +237,15 @@ type Iterator interface {
 }
 
 // New returns a new database with the given name, located at the given directory.
+// It uses the [DefaultBackend]; use [NewWithBackend] to pick another registered
+// backend.
 func New(name, dir string) (DB, error) {
-	pDB, err := newPebbleDB(name, dir)
+	db, err := NewWithBackend(name, DefaultBackend, dir)
 	if err != nil {
 		return nil, fmt.Errorf("creating new database: %w", err)
 	}
 
-	return pDB, nil
+	return db, nil
 }
 
 // NewInMem return a new database whose (k,v) pairs will be stored in memory.
@@ -0,0 +1,328 @@
+// Package dbtest provides a conformance suite for implementations of the
+// [db.DB] interface.
+//
+// Backends registered via [db.RegisterBackend] outside of this repository can
+// run the same checks as the built-in ones from their own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		dbtest.TestBackend(t, "mybackend")
+//	}
+package dbtest
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/cometbft/cometbft/db"
+)
+
+// TestDB runs the conformance suite against databases returned by newDB. Each
+// subtest calls newDB once and expects an empty database; TestDB closes it when
+// the subtest is done.
+func TestDB(t *testing.T, newDB func(t *testing.T) db.DB) {
+	t.Helper()
+
+	run := func(name string, test func(t *testing.T, tDB db.DB)) {
+		t.Run(name, func(t *testing.T) {
+			tDB := newDB(t)
+			test(t, tDB)
+			if err := tDB.Close(); err != nil {
+				t.Errorf("closing test database: %s", err)
+			}
+		})
+	}
+
+	run("GetSetDelete", testGetSetDelete)
+	run("Batch", testBatch)
+	run("Iterator", testIterator)
+	run("Snapshot", testSnapshot)
+}
+
+// TestBackend runs the conformance suite against databases of the given
+// registered backend, each stored in a fresh temporary directory.
+func TestBackend(t *testing.T, backend db.BackendType) {
+	t.Helper()
+
+	TestDB(t, func(t *testing.T) db.DB {
+		t.Helper()
+
+		tDB, err := db.NewWithBackend("test", backend, t.TempDir())
+		if err != nil {
+			t.Fatalf("creating test %s DB: %s", backend, err)
+		}
+		return tDB
+	})
+}
+
+func testGetSetDelete(t *testing.T, tDB db.DB) {
+	var (
+		key        = []byte("key")
+		v1, v2     = []byte{0x01}, []byte{0x02}
+		missingKey = []byte("missing")
+	)
+
+	if got, err := tDB.Get(missingKey); err != nil || got != nil {
+		t.Errorf("get missing key: want nil, got %X (err: %v)", got, err)
+	}
+	if ok, err := tDB.Has(missingKey); err != nil || ok {
+		t.Errorf("expected key %s to be missing (err: %v)", missingKey, err)
+	}
+
+	if err := tDB.Set(key, v1); err != nil {
+		t.Fatalf("setting key %s: %s", key, err)
+	}
+	if got, err := tDB.Get(key); err != nil || !bytes.Equal(got, v1) {
+		t.Errorf("get %s: want %X, got %X (err: %v)", key, v1, got, err)
+	}
+
+	if err := tDB.SetSync(key, v2); err != nil {
+		t.Fatalf("overwriting key %s: %s", key, err)
+	}
+	if got, err := tDB.Get(key); err != nil || !bytes.Equal(got, v2) {
+		t.Errorf("get %s: want %X, got %X (err: %v)", key, v2, got, err)
+	}
+
+	if err := tDB.Delete(key); err != nil {
+		t.Fatalf("deleting key %s: %s", key, err)
+	}
+	if ok, err := tDB.Has(key); err != nil || ok {
+		t.Errorf("expected deleted key %s to be missing (err: %v)", key, err)
+	}
+
+	// Deleting a missing key is not an error.
+	if err := tDB.DeleteSync(missingKey); err != nil {
+		t.Errorf("deleting missing key %s: %s", missingKey, err)
+	}
+
+	if err := tDB.Set(nil, v1); err == nil {
+		t.Errorf("expected an error setting an empty key")
+	}
+	if err := tDB.Set(key, nil); err == nil {
+		t.Errorf("expected an error setting a nil value")
+	}
+}
+
+func testBatch(t *testing.T, tDB db.DB) {
+	var (
+		a, b, c = []byte{'a'}, []byte{'b'}, []byte{'c'}
+		v1, v2  = []byte{0x01}, []byte{0x02}
+	)
+	if err := tDB.SetSync(a, v1); err != nil {
+		t.Fatalf("setting key %s: %s", a, err)
+	}
+
+	batch := tDB.NewBatch()
+	if err := batch.Set(b, v1); err != nil {
+		t.Fatalf("adding set to batch: %s", err)
+	}
+	if err := batch.Set(c, v2); err != nil {
+		t.Fatalf("adding set to batch: %s", err)
+	}
+	if err := batch.Delete(a); err != nil {
+		t.Fatalf("adding delete to batch: %s", err)
+	}
+
+	// Nothing is visible before the batch is written.
+	if ok, err := tDB.Has(b); err != nil || ok {
+		t.Errorf("expected key %s of an unwritten batch to be missing (err: %v)", b, err)
+	}
+
+	if err := batch.Write(); err != nil {
+		t.Fatalf("writing batch: %s", err)
+	}
+	if err := batch.Close(); err != nil {
+		t.Errorf("closing batch: %s", err)
+	}
+
+	if ok, err := tDB.Has(a); err != nil || ok {
+		t.Errorf("expected key %s deleted in batch to be missing (err: %v)", a, err)
+	}
+	if got, err := tDB.Get(b); err != nil || !bytes.Equal(got, v1) {
+		t.Errorf("get %s: want %X, got %X (err: %v)", b, v1, got, err)
+	}
+	if got, err := tDB.Get(c); err != nil || !bytes.Equal(got, v2) {
+		t.Errorf("get %s: want %X, got %X (err: %v)", c, v2, got, err)
+	}
+}
+
+func testIterator(t *testing.T, tDB db.DB) {
+	var (
+		a, b, c, d = []byte{'a'}, []byte{'b'}, []byte{'c'}, []byte{'d'}
+		keys       = [][]byte{a, b, c, d}
+
+		testCases = []struct {
+			start, end []byte
+			reverse    bool
+
+			// expected keys visited by the iterator in order.
+			wantVisit [][]byte
+		}{
+			{start: nil, end: nil, reverse: false, wantVisit: [][]byte{a, b, c, d}},
+			{start: nil, end: nil, reverse: true, wantVisit: [][]byte{d, c, b, a}},
+
+			// Because 'end is exclusive, and because 'a' is the first key in the DB,
+			// setting it as the iterator's upper bound will create an iterator over
+			// an empty key range.
+			{start: nil, end: a, reverse: false, wantVisit: [][]byte{}},
+			{start: nil, end: a, reverse: true, wantVisit: [][]byte{}},
+			{start: nil, end: b, reverse: false, wantVisit: [][]byte{a}},
+			{start: nil, end: b, reverse: true, wantVisit: [][]byte{a}},
+			{start: nil, end: c, reverse: false, wantVisit: [][]byte{a, b}},
+
+			// Because 'end' is exclusive, setting 'c' as the iterator's upper bound
+			// of a reverse iterator will create an iterator whose starting key
+			// ('c') will be skipped.
+			{start: nil, end: c, reverse: true, wantVisit: [][]byte{b, a}},
+			{start: nil, end: d, reverse: false, wantVisit: [][]byte{a, b, c}},
+			{start: nil, end: d, reverse: true, wantVisit: [][]byte{c, b, a}},
+
+			{start: a, end: nil, reverse: false, wantVisit: [][]byte{a, b, c, d}},
+
+			// 'start' is inclusive, so setting 'a' as the iterator's lower bound of
+			// a reverse iterator will include 'a', even if 'a' is the last
+			// effectively becomes the last key in the key range.
+			{start: a, end: nil, reverse: true, wantVisit: [][]byte{d, c, b, a}},
+			{start: a, end: b, reverse: false, wantVisit: [][]byte{a}},
+			{start: a, end: b, reverse: true, wantVisit: [][]byte{a}},
+			{start: a, end: c, reverse: false, wantVisit: [][]byte{a, b}},
+			{start: a, end: c, reverse: true, wantVisit: [][]byte{b, a}},
+			{start: a, end: d, reverse: false, wantVisit: [][]byte{a, b, c}},
+			{start: a, end: d, reverse: true, wantVisit: [][]byte{c, b, a}},
+
+			{start: b, end: nil, reverse: false, wantVisit: [][]byte{b, c, d}},
+			{start: b, end: nil, reverse: true, wantVisit: [][]byte{d, c, b}},
+			{start: b, end: c, reverse: false, wantVisit: [][]byte{b}},
+			{start: b, end: c, reverse: true, wantVisit: [][]byte{b}},
+			{start: b, end: d, reverse: false, wantVisit: [][]byte{b, c}},
+			{start: b, end: d, reverse: true, wantVisit: [][]byte{c, b}},
+
+			{start: c, end: nil, reverse: false, wantVisit: [][]byte{c, d}},
+			{start: c, end: nil, reverse: true, wantVisit: [][]byte{d, c}},
+			{start: c, end: d, reverse: false, wantVisit: [][]byte{c}},
+			{start: c, end: d, reverse: true, wantVisit: [][]byte{c}},
+
+			{start: d, end: nil, reverse: false, wantVisit: [][]byte{d}},
+			{start: d, end: nil, reverse: true, wantVisit: [][]byte{d}},
+		}
+	)
+
+	for i, key := range keys {
+		if err := tDB.SetSync(key, []byte{byte(i)}); err != nil {
+			t.Fatalf("test %d: setting key: %s", i, err)
+		}
+	}
+
+	for i, tc := range testCases {
+		var (
+			it  db.Iterator
+			err error
+		)
+		if tc.reverse {
+			it, err = tDB.ReverseIterator(tc.start, tc.end)
+			if err != nil {
+				t.Fatalf("test %d: creating reverse test iterator: %s", i, err)
+			}
+		} else {
+			it, err = tDB.Iterator(tc.start, tc.end)
+			if err != nil {
+				t.Fatalf("test %d: creating forward test iterator: %s", i, err)
+			}
+		}
+
+		visited := make([][]byte, 0, len(tc.wantVisit))
+		for ; it.Valid(); it.Next() {
+			visited = append(visited, slices.Clone(it.Key()))
+		}
+
+		if err := it.Error(); err != nil {
+			t.Errorf("test %d: unexpected error: %s", i, err)
+		}
+
+		if !slices.EqualFunc(visited, tc.wantVisit, bytes.Equal) {
+			formatStr := "test %d:\nwant visit order: %s\ngot: %s"
+			t.Errorf(formatStr, i, tc.wantVisit, visited)
+		}
+
+		if err := it.Close(); err != nil {
+			t.Errorf("test %d: closing iterator: %s", i, err)
+		}
+	}
+}
+
+func testSnapshot(t *testing.T, tDB db.DB) {
+	var (
+		a, b, c = []byte{'a'}, []byte{'b'}, []byte{'c'}
+		v1, v2  = []byte{0x01}, []byte{0x02}
+	)
+	for _, key := range [][]byte{a, b} {
+		if err := tDB.SetSync(key, v1); err != nil {
+			t.Fatalf("setting key %s: %s", key, err)
+		}
+	}
+
+	snap, err := tDB.NewSnapshot()
+	if err != nil {
+		t.Fatalf("creating snapshot: %s", err)
+	}
+
+	// None of these writes must be visible through the snapshot.
+	batch := tDB.NewBatch()
+	if err := batch.Set(a, v2); err != nil {
+		t.Fatalf("adding set to batch: %s", err)
+	}
+	if err := batch.Delete(b); err != nil {
+		t.Fatalf("adding delete to batch: %s", err)
+	}
+	if err := batch.Set(c, v2); err != nil {
+		t.Fatalf("adding set to batch: %s", err)
+	}
+	if err := batch.WriteSync(); err != nil {
+		t.Fatalf("writing batch: %s", err)
+	}
+	batch.Close()
+
+	if got, err := snap.Get(a); err != nil || !bytes.Equal(got, v1) {
+		t.Errorf("snapshot get %s: want %X, got %X (err: %v)", a, v1, got, err)
+	}
+	if ok, err := snap.Has(b); err != nil || !ok {
+		t.Errorf("expected deleted key %s to exist in snapshot (err: %v)", b, err)
+	}
+	if ok, err := snap.Has(c); err != nil || ok {
+		t.Errorf("expected key %s written after snapshot to be missing (err: %v)", c, err)
+	}
+	if got, err := tDB.Get(a); err != nil || !bytes.Equal(got, v2) {
+		t.Errorf("live get %s: want %X, got %X (err: %v)", a, v2, got, err)
+	}
+
+	for _, reverse := range []bool{false, true} {
+		var it db.Iterator
+		if reverse {
+			it, err = snap.ReverseIterator(nil, nil)
+		} else {
+			it, err = snap.Iterator(nil, nil)
+		}
+		if err != nil {
+			t.Fatalf("creating snapshot iterator: %s", err)
+		}
+
+		visited := make([][]byte, 0, 2)
+		for ; it.Valid(); it.Next() {
+			visited = append(visited, slices.Clone(it.Key()))
+		}
+		want := [][]byte{a, b}
+		if reverse {
+			want = [][]byte{b, a}
+		}
+		if !slices.EqualFunc(visited, want, bytes.Equal) {
+			t.Errorf("snapshot iterator (reverse: %t): want %s, got %s", reverse, want, visited)
+		}
+		if err := it.Close(); err != nil {
+			t.Errorf("closing snapshot iterator: %s", err)
+		}
+	}
+
+	if err := snap.Close(); err != nil {
+		t.Errorf("closing snapshot: %s", err)
+	}
+}
@@ -50,11 +50,18 @@ func (pDB *pebbleDB) DB() *pebble.DB {
 //
 // It implements the [DB] interface for type PebbleDB.
 func (pDB *pebbleDB) Get(key []byte) ([]byte, error) {
+	return pebbleGet(pDB.db, key)
+}
+
+// pebbleGet fetches the value of the given key from a pebble instance or
+// snapshot, or nil if it does not exist. The returned slice is a copy that the
+// caller is free to modify.
+func pebbleGet(src pebble.Reader, key []byte) ([]byte, error) {
 	if len(key) == 0 {
 		return nil, ErrKeyEmpty
 	}
 
-	value, closer, err := pDB.db.Get(key)
+	value, closer, err := src.Get(key)
 	if err != nil {
 		if err == pebble.ErrNotFound {
 			return nil, nil
@@ -298,6 +305,15 @@ func (pDB *pebbleDB) NewBatch() Batch {
 	return newPebbleDBBatch(pDB)
 }
 
+// NewSnapshot returns a read-only, point-in-time view of the database backed by
+// a pebble snapshot. The caller is responsible for calling Snapshot.Close() once
+// done.
+//
+// It implements the [DB] interface for type PebbleDB.
+func (pDB *pebbleDB) NewSnapshot() (Snapshot, error) {
+	return &pebbleDBSnapshot{snap: pDB.db.NewSnapshot()}, nil
+}
+
 // Iterator returns an iterator over a domain of keys, in ascending order.
 // The caller must call [Close] when done. End is exclusive, and start must be
 // less than end. A nil start iterates from the first key, and a nil end
@@ -309,7 +325,7 @@ func (pDB *pebbleDB) NewBatch() Batch {
 //
 // It implements the [DB] interface for type PebbleDB.
 func (pDB *pebbleDB) Iterator(start, end []byte) (Iterator, error) {
-	it, err := newPebbleDBIterator(pDB, start, end, false /* reverse */)
+	it, err := newPebbleDBIterator(pDB.db, start, end, false /* reverse */)
 	if err != nil {
 		return nil, fmt.Errorf("creating new forward iterator: %w", err)
 	}
@@ -328,7 +344,7 @@ func (pDB *pebbleDB) Iterator(start, end []byte) (Iterator, error) {
 //
 // It implements the [DB] interface for type PebbleDB.
 func (pDB *pebbleDB) ReverseIterator(start, end []byte) (Iterator, error) {
-	it, err := newPebbleDBIterator(pDB, start, end, true /* reverse */)
+	it, err := newPebbleDBIterator(pDB.db, start, end, true /* reverse */)
 	if err != nil {
 		return nil, fmt.Errorf("creating new reverse iterator: %w", err)
 	}
@@ -472,6 +488,87 @@ func (b *pebbleDBBatch) Close() error {
 	return nil
 }
 
+var _ Snapshot = (*pebbleDBSnapshot)(nil)
+
+// pebbleDBSnapshot is a read-only, point-in-time view of a pebbleDB. It is safe
+// for concurrent use.
+//
+// It implements the [Snapshot] interface.
+type pebbleDBSnapshot struct {
+	snap *pebble.Snapshot
+}
+
+// Get fetches the value of the given key as of the time the snapshot was taken,
+// or nil if it did not exist.
+// It is safe to modify the contents of key and of the returned slice after Get
+// returns.
+//
+// It implements the [Snapshot] interface for type pebbleDBSnapshot.
+func (s *pebbleDBSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := pebbleGet(s.snap, key)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot get: %w", err)
+	}
+
+	return value, nil
+}
+
+// Has returns true if the key existed when the snapshot was taken.
+// It is safe to modify the contents of key after Has returns.
+//
+// It implements the [Snapshot] interface for type pebbleDBSnapshot.
+func (s *pebbleDBSnapshot) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, ErrKeyEmpty
+	}
+
+	bytesPeb, err := s.Get(key)
+	if err != nil {
+		return false, fmt.Errorf("checking if key %X exists in the snapshot: %w", key, err)
+	}
+
+	return bytesPeb != nil, nil
+}
+
+// Iterator returns an iterator over a domain of keys of the snapshot, in
+// ascending order. The caller must call [Close] on the iterator before closing
+// the snapshot.
+//
+// It implements the [Snapshot] interface for type pebbleDBSnapshot.
+func (s *pebbleDBSnapshot) Iterator(start, end []byte) (Iterator, error) {
+	it, err := newPebbleDBIterator(s.snap, start, end, false /* reverse */)
+	if err != nil {
+		return nil, fmt.Errorf("creating new forward snapshot iterator: %w", err)
+	}
+
+	return it, nil
+}
+
+// ReverseIterator returns an iterator over a domain of keys of the snapshot, in
+// descending order. The caller must call [Close] on the iterator before closing
+// the snapshot.
+//
+// It implements the [Snapshot] interface for type pebbleDBSnapshot.
+func (s *pebbleDBSnapshot) ReverseIterator(start, end []byte) (Iterator, error) {
+	it, err := newPebbleDBIterator(s.snap, start, end, true /* reverse */)
+	if err != nil {
+		return nil, fmt.Errorf("creating new reverse snapshot iterator: %w", err)
+	}
+
+	return it, nil
+}
+
+// Close releases the snapshot.
+//
+// It implements the [Snapshot] interface for type pebbleDBSnapshot.
+func (s *pebbleDBSnapshot) Close() error {
+	if err := s.snap.Close(); err != nil {
+		return fmt.Errorf("closing snapshot: %w", err)
+	}
+
+	return nil
+}
+
 // pebbleDBIterator is an Iterator iterating over a database's key/value pairs in
 // key order. It is the caller's responsibility to call [Close] on it when done.
 // pebbleDBIterator is not safe for concurrent use, but it is safe to use multiple
@@ -492,9 +589,9 @@ type pebbleDBIterator struct {
 var _ Iterator = (*pebbleDBIterator)(nil)
 
 // newPebbleDBIterator returns a new pebbleDBIterator to iterate over a range of
-// database key/value pairs of the given instance of PebbleDB.
+// database key/value pairs of the given pebble instance or snapshot.
 func newPebbleDBIterator(
-	pDB *pebbleDB,
+	src pebble.Reader,
 	start, end []byte,
 	isReverse bool,
 ) (*pebbleDBIterator, error) {
@@ -510,7 +607,7 @@ func newPebbleDBIterator(
 		LowerBound: start,
 		UpperBound: end,
 	}
-	it, err := pDB.db.NewIter(&o)
+	it, err := src.NewIter(&o)
 	if err != nil {
 		formatStr := "iterator with bounds [%X, %X]: %w"
 		return nil, fmt.Errorf(formatStr, start, end, err)
@@ -507,7 +507,7 @@ func TestNewPebbleDBIterator(t *testing.T) {
 			start, end []byte
 			isReverse  bool
 		)
-		it, err := newPebbleDBIterator(pDB, start, end, isReverse)
+		it, err := newPebbleDBIterator(pDB.db, start, end, isReverse)
 		if err != nil {
 			t.Fatalf("creating test iterator: %s", err)
 		}
@@ -536,7 +536,7 @@ func TestNewPebbleDBIterator(t *testing.T) {
 			start, end []byte
 			isReverse  = true
 		)
-		it, err := newPebbleDBIterator(pDB, start, end, isReverse)
+		it, err := newPebbleDBIterator(pDB.db, start, end, isReverse)
 		if err != nil {
 			t.Fatalf("creating test iterator: %s", err)
 		}
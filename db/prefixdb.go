@@ -233,6 +233,21 @@ func (pDB *prefixDB) NewBatch() Batch {
 	return newPrefixDBBatch(pDB.prefix, pDB.db.NewBatch())
 }
 
+// NewSnapshot returns a read-only, point-in-time view of the prefixed namespace.
+// It takes a snapshot of the underlying database and scopes it to the prefix.
+// The caller is responsible for calling Snapshot.Close() once done, which
+// releases the snapshot of the underlying database.
+//
+// It implements the [DB] interface for type PrefixDB.
+func (pDB *prefixDB) NewSnapshot() (Snapshot, error) {
+	snap, err := pDB.db.NewSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("prefixed DB namespace snapshot: %w", err)
+	}
+
+	return &prefixDBSnapshot{prefix: pDB.prefix, source: snap}, nil
+}
+
 // Compact compacts the specified range of keys in the database.
 // Note that, as with all operations of a [prefixDB], the start and end keys are
 // prefixed with the prefix given to [newPrefixDB].
@@ -305,6 +320,104 @@ func (pDB *prefixDB) Stats() map[string]string {
 	return stats
 }
 
+// prefixDBSnapshot is a read-only, point-in-time view of a prefixed namespace.
+// It wraps a [Snapshot] of the underlying database and prepends the prefix to
+// every key read through it, exactly as [prefixDB] does for live reads.
+//
+// It implements the [Snapshot] interface.
+type prefixDBSnapshot struct {
+	prefix []byte
+	source Snapshot
+}
+
+var _ Snapshot = (*prefixDBSnapshot)(nil)
+
+// Get fetches the value of the given key as of the time the snapshot was taken,
+// or nil if it did not exist.
+// It is safe to modify the contents of key and of the returned slice after Get
+// returns.
+//
+// It implements the [Snapshot] interface for type prefixDBSnapshot.
+func (s *prefixDBSnapshot) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, ErrKeyEmpty
+	}
+
+	prefixedKey := prependPrefix(s.prefix, key)
+	value, err := s.source.Get(prefixedKey)
+	if err != nil {
+		return nil, fmt.Errorf("prefixed DB namespace snapshot get: %w", err)
+	}
+	return value, nil
+}
+
+// Has returns true if the key existed when the snapshot was taken.
+// It is safe to modify the contents of key after Has returns.
+//
+// It implements the [Snapshot] interface for type prefixDBSnapshot.
+func (s *prefixDBSnapshot) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, ErrKeyEmpty
+	}
+
+	prefixedKey := prependPrefix(s.prefix, key)
+	ok, err := s.source.Has(prefixedKey)
+	if err != nil {
+		return ok, fmt.Errorf("prefixed DB namespace snapshot key lookup: %w", err)
+	}
+
+	return ok, nil
+}
+
+// Iterator returns an iterator over a domain of keys of the snapshot, in
+// ascending order. The caller must call [Close] on the iterator before closing
+// the snapshot.
+//
+// It implements the [Snapshot] interface for type prefixDBSnapshot.
+func (s *prefixDBSnapshot) Iterator(start, end []byte) (Iterator, error) {
+	itStart, itEnd, err := prefixedIteratorBounds(s.prefix, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("prefixed DB namespace snapshot iterator: %w", err)
+	}
+
+	it, err := s.source.Iterator(itStart, itEnd)
+	if err != nil {
+		return nil, fmt.Errorf("prefixed DB namespace snapshot iterator: %w", err)
+	}
+
+	return newPrefixDBIterator(s.prefix, start, end, it)
+}
+
+// ReverseIterator returns an iterator over a domain of keys of the snapshot, in
+// descending order. The caller must call [Close] on the iterator before closing
+// the snapshot.
+//
+// It implements the [Snapshot] interface for type prefixDBSnapshot.
+func (s *prefixDBSnapshot) ReverseIterator(start, end []byte) (Iterator, error) {
+	itStart, itEnd, err := prefixedIteratorBounds(s.prefix, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("prefixed DB namespace snapshot reverse iterator: %w", err)
+	}
+
+	it, err := s.source.ReverseIterator(itStart, itEnd)
+	if err != nil {
+		return nil, fmt.Errorf("prefixed DB namespace snapshot reverse iterator: %w", err)
+	}
+
+	return newPrefixDBIterator(s.prefix, start, end, it)
+}
+
+// Close releases the snapshot of the underlying database.
+//
+// It implements the [Snapshot] interface for type prefixDBSnapshot.
+func (s *prefixDBSnapshot) Close() error {
+	if err := s.source.Close(); err != nil {
+		return fmt.Errorf("closing prefixed DB namespace snapshot: %w", err)
+	}
+
+	return nil
+}
+
 // prefixDBBatch is a sequence of database operations that are applied atomically.
 // A batch is not safe for concurrent use; callers should use a batch per goroutine
 // or provide their own synchronization methods.
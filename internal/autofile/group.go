@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -399,6 +400,51 @@ func (g *Group) readGroupInfo() GroupInfo {
 	return GroupInfo{minIndex, maxIndex, totalSize, headSize}
 }
 
+// GroupFilePaths returns the paths of the files of the group with the given
+// head path, without opening it: the rotated files in index order, followed
+// by the head if it exists.
+func GroupFilePaths(headPath string) ([]string, error) {
+	headBase := filepath.Base(headPath)
+	entries, err := os.ReadDir(filepath.Dir(headPath))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		indexes []int
+		hasHead bool
+	)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if fileName == headBase {
+			hasHead = true
+			continue
+		}
+		if !strings.HasPrefix(fileName, headBase+".") {
+			continue
+		}
+		submatch := indexedFilePattern.FindStringSubmatch(fileName)
+		if len(submatch) != 2 || fileName != headBase+"."+submatch[1] {
+			continue
+		}
+		index, err := strconv.Atoi(submatch[1])
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	paths := make([]string, 0, len(indexes)+1)
+	for _, index := range indexes {
+		paths = append(paths, filePathForIndex(headPath, index, -1))
+	}
+	if hasHead {
+		paths = append(paths, headPath)
+	}
+	return paths, nil
+}
+
 func filePathForIndex(headPath string, index int, maxIndex int) string {
 	if index == maxIndex {
 		return headPath
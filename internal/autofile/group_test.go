@@ -291,3 +291,29 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestGroupFilePaths(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+
+	for i := 0; i < 2; i++ {
+		err := g.WriteLine("Line")
+		require.NoError(t, err)
+		err = g.FlushAndSync()
+		require.NoError(t, err)
+		g.RotateFile()
+	}
+	err := g.WriteLine("Line")
+	require.NoError(t, err)
+	err = g.FlushAndSync()
+	require.NoError(t, err)
+	// A file sharing the prefix of the head must not be part of the group.
+	err = os.WriteFile(g.Head.Path+"-other.000", []byte("other"), 0o600)
+	require.NoError(t, err)
+
+	paths, err := GroupFilePaths(g.Head.Path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{g.Head.Path + ".000", g.Head.Path + ".001", g.Head.Path}, paths)
+
+	// Cleanup
+	destroyTestGroup(t, g)
+}
@@ -0,0 +1,209 @@
+package consensus
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// latencySampler measures the latency between two events of a round, and
+// keeps the most recent measurements.
+type latencySampler struct {
+	height int64     // height of the last round measured
+	round  int32     // last round measured
+	start  time.Time // zero if not measuring
+
+	samples []time.Duration // ring buffer
+	next    int
+	full    bool
+}
+
+func newLatencySampler(size int) *latencySampler {
+	return &latencySampler{samples: make([]time.Duration, size)}
+}
+
+// begin starts measuring the latency of an event of the given round.
+func (s *latencySampler) begin(height int64, round int32, now time.Time) {
+	s.height, s.round, s.start = height, round, now
+}
+
+// began returns true if the latency of an event of the given round was
+// measured, or is being measured.
+func (s *latencySampler) began(height int64, round int32) bool {
+	return s.height == height && s.round == round
+}
+
+// end records the latency of the event of the given round, if it was being
+// measured.
+func (s *latencySampler) end(height int64, round int32, now time.Time) {
+	if s.start.IsZero() || !s.began(height, round) {
+		return
+	}
+	s.record(now.Sub(s.start))
+}
+
+// timedOut records the timeout of the given round as its latency, if it was
+// being measured: the event did not occur before the timeout, so the latency
+// was at least that long. Otherwise the rounds that time out would not be
+// sampled, and the timeouts would never grow back after the latencies
+// increase.
+func (s *latencySampler) timedOut(height int64, round int32, timeout time.Duration) {
+	if s.start.IsZero() || !s.began(height, round) {
+		return
+	}
+	s.record(timeout)
+}
+
+func (s *latencySampler) record(latency time.Duration) {
+	s.samples[s.next] = latency
+	s.next = (s.next + 1) % len(s.samples)
+	s.full = s.full || s.next == 0
+	s.start = time.Time{}
+}
+
+// percentile returns the p-th percentile, in (0, 100], of the recorded
+// latencies, using the nearest-rank method. It returns false if no latency
+// was recorded.
+func (s *latencySampler) percentile(p float64) (time.Duration, bool) {
+	n := s.next
+	if s.full {
+		n = len(s.samples)
+	}
+	if n == 0 {
+		return 0, false
+	}
+	sorted := slices.Clone(s.samples[:n])
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p / 100 * float64(n)))
+	return sorted[max(rank, 1)-1], true
+}
+
+// adaptiveTimeouts derives the timeouts of the rounds from the latencies
+// observed in the most recent rounds. A nil adaptiveTimeouts records nothing
+// and derives the static timeouts of the configuration.
+//
+// The latencies are those the timeouts wait for: the proposal timeout starts
+// when entering the propose step, and the prevote (resp. precommit) timeout
+// when +2/3 prevotes (resp. precommits) for anything are received. A round
+// that times out is sampled at the timeout value.
+type adaptiveTimeouts struct {
+	// time to receive the complete proposal after entering the propose step
+	proposal *latencySampler
+	// time to receive +2/3 prevotes (resp. precommits) for a single block or
+	// nil after receiving +2/3 prevotes (resp. precommits) for anything
+	prevotes   *latencySampler
+	precommits *latencySampler
+}
+
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	if !config.AdaptiveTimeouts {
+		return nil
+	}
+	return &adaptiveTimeouts{
+		proposal:   newLatencySampler(config.AdaptiveTimeoutWindow),
+		prevotes:   newLatencySampler(config.AdaptiveTimeoutWindow),
+		precommits: newLatencySampler(config.AdaptiveTimeoutWindow),
+	}
+}
+
+// step starts measuring the latency of the proposal when entering the propose
+// step.
+func (at *adaptiveTimeouts) step(height int64, round int32, step cstypes.RoundStepType, now time.Time) {
+	if at == nil || step != cstypes.RoundStepPropose {
+		return
+	}
+	at.proposal.begin(height, round, now)
+}
+
+// proposalComplete records the latency of the complete proposal of the round.
+func (at *adaptiveTimeouts) proposalComplete(height int64, round int32, now time.Time) {
+	if at == nil {
+		return
+	}
+	at.proposal.end(height, round, now)
+}
+
+// voteAdded starts measuring the latency of the +2/3 majority of the votes of
+// the type and round of vote when +2/3 of them are received, and records it
+// when the majority is reached. The votes of the rounds before the current
+// round are ignored.
+func (at *adaptiveTimeouts) voteAdded(votes *cstypes.HeightVoteSet, vote *types.Vote, round int32, now time.Time) {
+	if at == nil || vote.Round < round {
+		return
+	}
+	var (
+		voteSet *types.VoteSet
+		sampler *latencySampler
+	)
+	switch vote.Type {
+	case types.PrevoteType:
+		voteSet, sampler = votes.Prevotes(vote.Round), at.prevotes
+	case types.PrecommitType:
+		voteSet, sampler = votes.Precommits(vote.Round), at.precommits
+	default:
+		return
+	}
+	if !voteSet.HasTwoThirdsAny() {
+		return
+	}
+	if !sampler.began(vote.Height, vote.Round) {
+		sampler.begin(vote.Height, vote.Round, now)
+	}
+	if voteSet.HasTwoThirdsMajority() {
+		sampler.end(vote.Height, vote.Round, now)
+	}
+}
+
+// timeout records the timeout of the proposal, or of the votes, as the latency
+// of its round.
+func (at *adaptiveTimeouts) timeout(ti timeoutInfo) {
+	if at == nil {
+		return
+	}
+	switch ti.Step {
+	case cstypes.RoundStepPropose:
+		at.proposal.timedOut(ti.Height, ti.Round, ti.Duration)
+	case cstypes.RoundStepPrevoteWait:
+		at.prevotes.timedOut(ti.Height, ti.Round, ti.Duration)
+	case cstypes.RoundStepPrecommitWait:
+		at.precommits.timedOut(ti.Height, ti.Round, ti.Duration)
+	}
+}
+
+// roundTimeouts returns the timeouts of the given round.
+func (at *adaptiveTimeouts) roundTimeouts(config *cfg.ConsensusConfig, round int32) cstypes.RoundTimeouts {
+	timeouts := cstypes.RoundTimeouts{
+		Propose:   config.Propose(round),
+		Prevote:   config.Prevote(round),
+		Precommit: config.Precommit(round),
+	}
+	if at == nil {
+		return timeouts
+	}
+	timeouts.Adaptive = true
+	p := config.AdaptiveTimeoutPercentile
+	if latency, ok := at.proposal.percentile(p); ok {
+		timeouts.Propose = adaptiveTimeout(latency, config.TimeoutProposeMin, config.TimeoutProposeMax,
+			config.TimeoutProposeDelta, round)
+	}
+	if latency, ok := at.prevotes.percentile(p); ok {
+		timeouts.Prevote = adaptiveTimeout(latency, config.TimeoutVoteMin, config.TimeoutVoteMax,
+			config.TimeoutVoteDelta, round)
+	}
+	if latency, ok := at.precommits.percentile(p); ok {
+		timeouts.Precommit = adaptiveTimeout(latency, config.TimeoutVoteMin, config.TimeoutVoteMax,
+			config.TimeoutVoteDelta, round)
+	}
+	return timeouts
+}
+
+// adaptiveTimeout bounds latency to [minTimeout, maxTimeout], and increases
+// it by delta for each round, so that the rounds eventually succeed even if
+// the latencies were underestimated.
+func adaptiveTimeout(latency, minTimeout, maxTimeout, delta time.Duration, round int32) time.Duration {
+	return min(max(latency, minTimeout), maxTimeout) + delta*time.Duration(round)
+}
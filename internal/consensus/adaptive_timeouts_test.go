@@ -0,0 +1,160 @@
+package consensus
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestLatencySamplerPercentile(t *testing.T) {
+	s := newLatencySampler(4)
+	_, ok := s.percentile(50)
+	assert.False(t, ok)
+
+	start := time.Now()
+	// Latencies of other rounds, or not being measured, are not recorded.
+	s.begin(1, 0, start)
+	s.end(1, 1, start.Add(time.Second))
+	s.end(1, 0, start.Add(time.Second))
+	s.end(1, 0, start.Add(2*time.Second))
+	for h := int64(2); h <= 5; h++ {
+		s.begin(h, 0, start)
+		s.end(h, 0, start.Add(time.Duration(h)*time.Second))
+	}
+
+	// A round that times out is sampled at the timeout, unless its latency was
+	// already recorded.
+	s.begin(6, 0, start)
+	s.timedOut(6, 0, 6*time.Second)
+	s.timedOut(6, 0, 7*time.Second)
+
+	// The oldest latencies were evicted: the samples are 3s, 4s, 5s and 6s.
+	for p, expected := range map[float64]time.Duration{
+		1:   3 * time.Second,
+		50:  4 * time.Second,
+		75:  5 * time.Second,
+		99:  6 * time.Second,
+		100: 6 * time.Second,
+	} {
+		latency, ok := s.percentile(p)
+		require.True(t, ok)
+		assert.Equal(t, expected, latency, "percentile %v", p)
+	}
+}
+
+func TestAdaptiveTimeoutsRoundTimeouts(t *testing.T) {
+	config := ResetConfig("consensus_adaptive_timeouts_test")
+	defer os.RemoveAll(config.RootDir)
+	c := config.Consensus
+
+	// Disabled: the static timeouts are used.
+	assert.Equal(t, cstypes.RoundTimeouts{
+		Propose:   c.Propose(1),
+		Prevote:   c.Prevote(1),
+		Precommit: c.Precommit(1),
+	}, newAdaptiveTimeouts(c).roundTimeouts(c, 1))
+
+	c.AdaptiveTimeouts = true
+	c.AdaptiveTimeoutPercentile = 100
+	c.TimeoutProposeMin, c.TimeoutProposeMax = time.Second, 2*time.Second
+	c.TimeoutVoteMin, c.TimeoutVoteMax = time.Second, 2*time.Second
+	at := newAdaptiveTimeouts(c)
+
+	// No latency observed yet: the static timeouts are used.
+	assert.Equal(t, cstypes.RoundTimeouts{
+		Adaptive:  true,
+		Propose:   c.Propose(1),
+		Prevote:   c.Prevote(1),
+		Precommit: c.Precommit(1),
+	}, at.roundTimeouts(c, 1))
+
+	start := time.Now()
+	at.step(1, 0, cstypes.RoundStepPropose, start)
+	at.proposalComplete(1, 0, start.Add(1500*time.Millisecond))
+	at.prevotes.begin(1, 0, start)
+	at.prevotes.end(1, 0, start.Add(time.Millisecond))
+	at.precommits.begin(1, 0, start)
+	at.timeout(timeoutInfo{Duration: time.Minute, Height: 1, Round: 0, Step: cstypes.RoundStepPrecommitWait})
+
+	// The latencies are bounded, and increased by the deltas with each round.
+	assert.Equal(t, cstypes.RoundTimeouts{
+		Adaptive:  true,
+		Propose:   1500*time.Millisecond + 2*c.TimeoutProposeDelta,
+		Prevote:   time.Second + 2*c.TimeoutVoteDelta,
+		Precommit: 2*time.Second + 2*c.TimeoutVoteDelta,
+	}, at.roundTimeouts(c, 2))
+}
+
+func TestStateAdaptiveTimeouts(t *testing.T) {
+	config := ResetConfig("consensus_state_adaptive_timeouts_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.AdaptiveTimeouts = true
+	config.Consensus.TimeoutProposeMin = 200 * time.Millisecond
+	config.Consensus.TimeoutProposeMax = 300 * time.Millisecond
+	config.Consensus.TimeoutVoteMin = 200 * time.Millisecond
+	config.Consensus.TimeoutVoteMax = 300 * time.Millisecond
+
+	state, privVals := randGenesisState(1, nil)
+	app := kvstore.NewInMemoryApplication()
+	resp, lanesInfo := fetchAppInfo(app)
+	state.AppHash = resp.LastBlockAppHash
+	cs := newStateWithConfig(config, state, privVals[0], app, lanesInfo)
+	height, round := cs.Height, cs.Round
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	// The latencies observed by a single validator are below the minimum.
+	ensureNewRound(newRoundCh, height+1, 0)
+	assert.Equal(t, cstypes.RoundTimeouts{
+		Adaptive:  true,
+		Propose:   config.Consensus.TimeoutProposeMin,
+		Prevote:   config.Consensus.TimeoutVoteMin,
+		Precommit: config.Consensus.TimeoutVoteMin,
+	}, cs.GetRoundState().Timeouts)
+}
+
+func TestAdaptiveTimeoutsVoteAdded(t *testing.T) {
+	cs, vss := randState(4)
+	incrementHeight(vss[0])
+	c := *cs.config
+	c.AdaptiveTimeouts = true
+	at := newAdaptiveTimeouts(&c)
+	votes := cstypes.NewHeightVoteSet(cs.state.ChainID, cs.Height, cs.Validators)
+	blockID := types.BlockID{
+		Hash:          cmtrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: cmtrand.Bytes(tmhash.Size)},
+	}
+
+	start := time.Now()
+	addVote := func(vs *validatorStub, blockID types.BlockID, now time.Time) {
+		t.Helper()
+		vote := signVote(vs, types.PrevoteType, cs.state.ChainID, blockID, false)
+		added, err := votes.AddVote(vote, "peer", false)
+		require.NoError(t, err)
+		require.True(t, added)
+		at.voteAdded(votes, vote, 0, now)
+	}
+
+	// The latency is measured from +2/3 prevotes for anything to +2/3
+	// prevotes for a single block.
+	addVote(vss[0], blockID, start)
+	addVote(vss[1], blockID, start.Add(time.Second))
+	addVote(vss[2], types.BlockID{}, start.Add(2*time.Second))
+	_, ok := at.prevotes.percentile(100)
+	assert.False(t, ok)
+	addVote(vss[3], blockID, start.Add(5*time.Second))
+	latency, ok := at.prevotes.percentile(100)
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Second, latency)
+}
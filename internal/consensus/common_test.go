@@ -458,12 +458,13 @@ func newStateWithConfig(
 	pv types.PrivValidator,
 	app abci.Application,
 	laneInfo *mempl.LanesInfo,
+	options ...StateOption,
 ) *State {
 	blockDB, err := cmtdb.NewInMem()
 	if err != nil {
 		panic(err)
 	}
-	return newStateWithConfigAndBlockStore(thisConfig, state, pv, app, blockDB, laneInfo)
+	return newStateWithConfigAndBlockStore(thisConfig, state, pv, app, blockDB, laneInfo, options...)
 }
 
 func newStateWithConfigAndBlockStore(
@@ -473,6 +474,7 @@ func newStateWithConfigAndBlockStore(
 	app abci.Application,
 	blockDB cmtdb.DB,
 	laneInfo *mempl.LanesInfo,
+	options ...StateOption,
 ) *State {
 	// Get BlockStore
 	blockStore := store.NewBlockStore(blockDB)
@@ -482,6 +484,8 @@ func newStateWithConfigAndBlockStore(
 
 	proxyAppConnCon := proxy.NewAppConnConsensus(abcicli.NewLocalClient(mtx, app), proxy.NopMetrics())
 	proxyAppConnMem := proxy.NewAppConnMempool(abcicli.NewLocalClient(mtx, app), proxy.NopMetrics())
+	// only used if optimistic execution is enabled
+	proxyAppConnSpec := proxy.NewAppConnConsensus(abcicli.NewLocalClient(mtx, app), proxy.NopMetrics())
 	// Make Mempool
 	memplMetrics := mempl.NopMetrics()
 
@@ -510,8 +514,15 @@ func newStateWithConfigAndBlockStore(
 		panic(err)
 	}
 
-	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyAppConnCon, mempool, evpool, blockStore)
-	cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool)
+	// The block executor times the proposed blocks with the clock set by the
+	// options, if any.
+	probe := &State{clock: cmttime.DefaultSource{}}
+	for _, option := range options {
+		option(probe)
+	}
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyAppConnCon, mempool, evpool, blockStore,
+		sm.BlockExecutorWithSpeculativeConn(proxyAppConnSpec), sm.BlockExecutorWithClock(probe.clock))
+	cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool, options...)
 	cs.SetLogger(consensusLogger())
 	cs.SetPrivValidator(pv)
 
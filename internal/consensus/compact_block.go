@@ -0,0 +1,380 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v2"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// Compact block propagation.
+//
+// When enabled, a node holding the complete proposal block sends it to the
+// peers that have the proposal as a compact block: the block without its txs,
+// plus the keys of the txs. The peer looks the txs up in its mempool, requests
+// the missing ones, rebuilds the block and feeds the resulting block parts to
+// the consensus state, which checks them against the proposal as usual.
+//
+// While the peer reconstructs the block, no block parts are sent to it. If it
+// has not got the block parts after the compact block timeout, for instance
+// because the reconstructed block did not match the proposal, the block parts
+// are sent to it as usual.
+
+const (
+	// maxMissingTxsBytes is the maximum size of the txs sent in a MissingTxs
+	// message, leaving room for the rest of the message.
+	maxMissingTxsBytes = maxMsgSize - 1024
+
+	// missingTxOverhead bounds the encoding overhead of each tx of a
+	// MissingTxs message.
+	missingTxOverhead = 16
+)
+
+// interface to the mempool, used to reconstruct compact blocks.
+type txSource interface {
+	GetTxByHash(hash []byte) types.Tx
+}
+
+// ReactorMempool sets the mempool the txs of compact blocks are looked up in.
+func ReactorMempool(mempool txSource) ReactorOption {
+	return func(conR *Reactor) { conR.mempool = mempool }
+}
+
+// compactBlockCache holds the compact block of the last proposal block sent to
+// peers, so that it is built only once.
+type compactBlockCache struct {
+	height int64
+	round  int32
+	header types.PartSetHeader
+	msg    *cmtcons.CompactBlock // nil if the block cannot be sent as a compact block
+}
+
+// pendingCompactBlock is a compact block received from a peer, waiting for the
+// txs missing from the mempool.
+// It is only accessed by the routine receiving the messages of that peer.
+//
+// Only one is kept per peer: compact blocks are only accepted for the current
+// height and round, and a peer sends at most one per round, so a compact block
+// replaces the one of a previous round, which is no longer of use.
+type pendingCompactBlock struct {
+	msg     *CompactBlockMessage
+	txs     types.Txs
+	missing int
+}
+
+// compactBlockPropagation returns true if compact blocks are exchanged with the
+// peer, which is the case when both sides enabled compact block propagation.
+func (conR *Reactor) compactBlockPropagation(peer p2p.Peer) bool {
+	return conR.conS.config.CompactBlockPropagation && peer.HasChannel(CompactBlockChannel)
+}
+
+// sendCompactBlock sends the proposal block as a compact block to the peer, if
+// the peer has the proposal but not all of its block parts, and we did not send
+// it the compact block already. Returns true if the compact block was sent.
+func (conR *Reactor) sendCompactBlock(
+	logger log.Logger,
+	rs *cstypes.RoundState,
+	ps *PeerState,
+	prs *cstypes.PeerRoundState,
+) bool {
+	if rs.Height != prs.Height || rs.Round != prs.Round || !prs.Proposal {
+		return false
+	}
+	if rs.ProposalBlock == nil || !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) ||
+		!rs.ProposalBlockParts.IsComplete() {
+		return false
+	}
+	if prs.ProposalBlockParts.IsFull() || ps.CompactBlockSent(prs.Height, prs.Round) {
+		return false
+	}
+
+	msg := conR.compactBlock(logger, rs)
+	if msg == nil {
+		// The block parts are sent right away.
+		ps.SetCompactBlockSent(prs.Height, prs.Round, time.Time{})
+		return false
+	}
+	logger.Debug("Sending compact block", "height", prs.Height, "round", prs.Round)
+	if err := ps.peer.Send(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message:   msg,
+	}); err != nil {
+		return false
+	}
+	ps.SetCompactBlockSent(prs.Height, prs.Round, cmttime.Now().Add(conR.conS.config.CompactBlockTimeout))
+	return true
+}
+
+// compactBlock returns the compact block of the proposal block, or nil if the
+// block cannot be sent as a compact block.
+func (conR *Reactor) compactBlock(logger log.Logger, rs *cstypes.RoundState) *cmtcons.CompactBlock {
+	header := rs.ProposalBlockParts.Header()
+
+	conR.compactBlockMtx.Lock()
+	defer conR.compactBlockMtx.Unlock()
+	cache := &conR.compactBlockCache
+	if cache.height == rs.Height && cache.round == rs.Round && cache.header.Equals(header) {
+		return cache.msg
+	}
+
+	*cache = compactBlockCache{height: rs.Height, round: rs.Round, header: header}
+	pb, err := rs.ProposalBlock.ToProto()
+	if err != nil {
+		logger.Error("Could not convert block to proto", "height", rs.Height, "err", err)
+		return nil
+	}
+	pb.Data.Txs = nil
+	txKeys := make([][]byte, len(rs.ProposalBlock.Txs))
+	for i, tx := range rs.ProposalBlock.Txs {
+		key := tx.Key()
+		txKeys[i] = key[:]
+	}
+	msg := &cmtcons.CompactBlock{
+		Height:             rs.Height,
+		Round:              rs.Round,
+		BlockPartSetHeader: header.ToProto(),
+		Block:              pb,
+		TxKeys:             txKeys,
+	}
+	if msg.Size() > maxMsgSize-1024 {
+		logger.Debug("Proposal block too big to be sent as a compact block", "height", rs.Height)
+		return nil
+	}
+	cache.msg = msg
+	return msg
+}
+
+// receiveCompactBlock looks up the txs of the compact block in the mempool, and
+// either reconstructs the block or requests the missing txs from the peer.
+func (conR *Reactor) receiveCompactBlock(peer p2p.Peer, ps *PeerState, msg *CompactBlockMessage) {
+	ps.setPendingCompactBlock(nil)
+	if !conR.expectsCompactBlock(msg) {
+		return
+	}
+
+	cb := &pendingCompactBlock{msg: msg, txs: make(types.Txs, len(msg.TxKeys))}
+	var missing []uint32
+	for i, key := range msg.TxKeys {
+		var tx types.Tx
+		if conR.mempool != nil {
+			tx = conR.mempool.GetTxByHash(key[:])
+		}
+		if tx == nil {
+			missing = append(missing, uint32(i))
+			continue
+		}
+		cb.txs[i] = tx
+	}
+	if len(missing) == 0 {
+		conR.reconstructCompactBlock(peer, ps, cb)
+		return
+	}
+
+	cb.missing = len(missing)
+	ps.setPendingCompactBlock(cb)
+	conR.Metrics.CompactBlockMissingTxs.Add(float64(len(missing)))
+	conR.Logger.Debug("Requesting txs missing from compact block",
+		"height", msg.Height, "round", msg.Round, "missing", len(missing), "peer", peer.ID())
+	_ = peer.TrySend(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message: &cmtcons.RequestMissingTxs{
+			Height:             msg.Height,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Indexes:            missing,
+		},
+	})
+}
+
+// sendMissingTxs sends the requested txs of the proposal block to the peer,
+// split in as many messages as needed. Txs too big to be sent are skipped: the
+// peer falls back to the block parts.
+func (conR *Reactor) sendMissingTxs(peer p2p.Peer, msg *RequestMissingTxsMessage) {
+	rs := conR.getRoundState()
+	if rs.Height != msg.Height || rs.ProposalBlock == nil ||
+		!rs.ProposalBlockParts.HasHeader(msg.BlockPartSetHeader) {
+		return
+	}
+
+	txs := rs.ProposalBlock.Txs
+	resp := &cmtcons.MissingTxs{Height: msg.Height, BlockPartSetHeader: msg.BlockPartSetHeader.ToProto()}
+	size := 0
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			continue
+		}
+		txSize := len(txs[index]) + missingTxOverhead
+		if txSize > maxMissingTxsBytes {
+			continue
+		}
+		if size+txSize > maxMissingTxsBytes {
+			_ = peer.TrySend(p2p.Envelope{ChannelID: CompactBlockChannel, Message: resp})
+			resp = &cmtcons.MissingTxs{Height: msg.Height, BlockPartSetHeader: msg.BlockPartSetHeader.ToProto()}
+			size = 0
+		}
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, txs[index])
+		size += txSize
+	}
+	if len(resp.Txs) > 0 {
+		_ = peer.TrySend(p2p.Envelope{ChannelID: CompactBlockChannel, Message: resp})
+	}
+}
+
+// receiveMissingTxs adds the txs to the pending compact block of the peer, and
+// reconstructs the block once no tx is missing.
+func (conR *Reactor) receiveMissingTxs(peer p2p.Peer, ps *PeerState, msg *MissingTxsMessage) {
+	cb := ps.pendingCompactBlockFor(msg.Height, msg.BlockPartSetHeader)
+	if cb == nil {
+		return
+	}
+	for i, index := range msg.Indexes {
+		if int(index) >= len(cb.txs) || cb.txs[index] != nil {
+			continue
+		}
+		if msg.Txs[i].Key() != cb.msg.TxKeys[index] {
+			ps.setPendingCompactBlock(nil)
+			conR.Switch.StopPeerForError(peer, ErrMissingTxKeyMismatch)
+			return
+		}
+		cb.txs[index] = msg.Txs[i]
+		cb.missing--
+	}
+	if cb.missing == 0 {
+		ps.setPendingCompactBlock(nil)
+		if conR.expectsCompactBlock(cb.msg) {
+			conR.reconstructCompactBlock(peer, ps, cb)
+		}
+	}
+}
+
+// expectsCompactBlock returns true if the block of the compact block may be the
+// proposal block we are waiting for: the compact block is of the current height
+// and round, we do not have the proposal block yet and, if we have the
+// proposal, it is the block of the proposal.
+func (conR *Reactor) expectsCompactBlock(msg *CompactBlockMessage) bool {
+	rs := conR.getRoundState()
+	if msg.Height != rs.Height || msg.Round != rs.Round {
+		return false
+	}
+	if rs.ProposalBlockParts == nil {
+		return rs.Proposal == nil
+	}
+	return rs.ProposalBlockParts.HasHeader(msg.BlockPartSetHeader) && !rs.ProposalBlockParts.IsComplete()
+}
+
+// reconstructCompactBlock rebuilds the block of the compact block and, if it
+// matches the part set header of the compact block, passes its parts to the
+// consensus state, which checks them against the proposal. Otherwise, we wait
+// for the block parts.
+func (conR *Reactor) reconstructCompactBlock(peer p2p.Peer, ps *PeerState, cb *pendingCompactBlock) {
+	msg := cb.msg
+	partSet, err := func() (*types.PartSet, error) {
+		pb := *msg.Block // shallow copy, the block of the message is left without txs
+		pb.Data.Txs = cb.txs.ToSliceOfBytes()
+		block, err := types.BlockFromProto(&pb)
+		if err != nil {
+			return nil, err
+		}
+		partSet, err := block.MakePartSetAs(msg.BlockPartSetHeader, types.BlockPartSizeBytes)
+		if err != nil {
+			return nil, err
+		}
+		if !partSet.HasHeader(msg.BlockPartSetHeader) {
+			return nil, fmt.Errorf("expected part set header %v, got %v", msg.BlockPartSetHeader, partSet.Header())
+		}
+		for i := 0; i < int(partSet.Total()); i++ {
+			if err := partSet.GetPart(i).ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("invalid part #%d: %w", i, err)
+			}
+		}
+		return partSet, nil
+	}()
+	if err != nil {
+		conR.Metrics.CompactBlocks.With("status", "failed").Add(1)
+		conR.Logger.Info("Failed to reconstruct compact block, waiting for block parts",
+			"height", msg.Height, "round", msg.Round, "peer", peer.ID(), "err", err)
+		return
+	}
+
+	conR.Metrics.CompactBlocks.With("status", "reconstructed").Add(1)
+	conR.Logger.Debug("Reconstructed compact block", "height", msg.Height, "round", msg.Round, "peer", peer.ID())
+	// The peer has the parts only if the compact block is the block of the
+	// proposal it has.
+	if ps.GetRoundState().ProposalBlockPartSetHeader.Equals(msg.BlockPartSetHeader) {
+		for i := 0; i < int(partSet.Total()); i++ {
+			ps.SetHasProposalBlockPart(msg.Height, msg.Round, i)
+		}
+	}
+	// The parts are passed from another routine, so as not to block the
+	// reception of the messages of the peer while the consensus state is busy.
+	go func() {
+		for i := 0; i < int(partSet.Total()); i++ {
+			select {
+			case conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{
+				Height: msg.Height,
+				Round:  msg.Round,
+				Part:   partSet.GetPart(i),
+			}, peer.ID(), time.Time{}}:
+			case <-conR.conS.Quit():
+				return
+			}
+		}
+	}()
+}
+
+// SetCompactBlockSent records that the compact block of the given height and
+// round was sent to the peer, and that the block parts must not be sent to it
+// before the deadline.
+func (ps *PeerState) SetCompactBlockSent(height int64, round int32, deadline time.Time) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlockHeight = height
+	ps.compactBlockRound = round
+	ps.compactBlockDeadline = deadline
+}
+
+// CompactBlockSent returns true if the compact block of the given height and
+// round was sent to the peer.
+func (ps *PeerState) CompactBlockSent(height int64, round int32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.compactBlockHeight == height && ps.compactBlockRound == round
+}
+
+// AwaitingCompactBlock returns true if the peer may still be reconstructing the
+// block of the given height and round from the compact block sent to it.
+func (ps *PeerState) AwaitingCompactBlock(height int64, round int32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.compactBlockHeight == height && ps.compactBlockRound == round &&
+		cmttime.Now().Before(ps.compactBlockDeadline)
+}
+
+func (ps *PeerState) setPendingCompactBlock(cb *pendingCompactBlock) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.pendingCompactBlock = cb
+}
+
+// pendingCompactBlockFor returns the compact block received from the peer that
+// is waiting for missing txs, if it is the block of the given height and part
+// set header.
+func (ps *PeerState) pendingCompactBlockFor(height int64, header types.PartSetHeader) *pendingCompactBlock {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	cb := ps.pendingCompactBlock
+	if cb == nil || cb.msg.Height != height || !cb.msg.BlockPartSetHeader.Equals(header) {
+		return nil
+	}
+	return cb
+}
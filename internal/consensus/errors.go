@@ -12,6 +12,7 @@ var (
 	ErrCommitQuorumNotMet            = errors.New("extended commit does not have +2/3 majority")
 	ErrNilPrivValidator              = errors.New("entered createProposalBlock with privValidator being nil")
 	ErrProposalWithoutPreviousCommit = errors.New("propose step; cannot propose anything without commit for the previous block")
+	ErrMissingTxKeyMismatch          = errors.New("missing tx does not match the requested tx key")
 )
 
 // Consensus sentinel errors.
@@ -21,6 +22,7 @@ var (
 	ErrSignatureFoundInPastBlocks = errors.New("found signature from the same key")
 	ErrPubKeyIsNotSet             = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
 	ErrProposalTooManyParts       = errors.New("proposal block has too many parts")
+	ErrProposalErasureCoded       = errors.New("proposal block parts are erasure-coded before erasure coding is enabled")
 )
 
 type ErrInvalidVote struct {
@@ -31,6 +33,16 @@ func (e ErrInvalidVote) Error() string {
 	return "invalid vote: " + e.Reason
 }
 
+// ErrHeightNotTraced is returned when the timeline of a height is not in the
+// consensus trace.
+type ErrHeightNotTraced struct {
+	Height int64
+}
+
+func (e ErrHeightNotTraced) Error() string {
+	return fmt.Sprintf("height %d is not in the consensus trace", e.Height)
+}
+
 // ErrAddingVote is returned when adding a vote fails.
 type ErrAddingVote struct {
 	Err error
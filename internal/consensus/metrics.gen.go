@@ -206,6 +206,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: []float64{-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0},
 		}, append(labels, "is_timely")).With(labelsAndValues...),
+		CompactBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks",
+			Help:      "Number of compact blocks received, labeled by whether the block could be reconstructed from them or not.",
+		}, append(labels, "status")).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of txs of compact blocks that were missing from the mempool and requested from peers.",
+		}, labels).With(labelsAndValues...),
+		RoundTimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "round_timeout_seconds",
+			Help:      "Timeout of each step of the current round, in seconds, labeled by step. The timeouts are derived from the observed latencies if adaptive timeouts are enabled.",
+		}, append(labels, "step")).With(labelsAndValues...),
 	}
 }
 
@@ -242,5 +260,8 @@ func NopMetrics() *Metrics {
 		RoundVotingPowerPercent:     discard.NewGauge(),
 		LateVotes:                   discard.NewCounter(),
 		ProposalTimestampDifference: discard.NewHistogram(),
+		CompactBlocks:               discard.NewCounter(),
+		CompactBlockMissingTxs:      discard.NewCounter(),
+		RoundTimeoutSeconds:         discard.NewGauge(),
 	}
 }
@@ -139,6 +139,19 @@ type Metrics struct {
 	// parameter SynchronyParams.MessageDelay, used by the PBTS algorithm.
 	// metrics:Difference in seconds between the local time when a proposal message is received and the timestamp in the proposal message.
 	ProposalTimestampDifference metrics.Histogram `metrics_bucketsizes:"-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0" metrics_labels:"is_timely"`
+
+	// Number of compact blocks received, labeled by whether the block could be
+	// reconstructed from them or not.
+	CompactBlocks metrics.Counter `metrics_labels:"status"`
+
+	// Number of txs of compact blocks that were missing from the mempool and
+	// requested from peers.
+	CompactBlockMissingTxs metrics.Counter
+
+	// Timeout of each step of the current round, in seconds, labeled by step.
+	// The timeouts are derived from the observed latencies if adaptive
+	// timeouts are enabled.
+	RoundTimeoutSeconds metrics.Gauge `metrics_labels:"step"`
 }
 
 func (m *Metrics) MarkProposalProcessed(accepted bool) {
@@ -188,3 +201,9 @@ func (m *Metrics) MarkStep(s cstypes.RoundStepType) {
 	}
 	m.stepStart = cmttime.Now()
 }
+
+func (m *Metrics) MarkRoundTimeouts(t cstypes.RoundTimeouts) {
+	m.RoundTimeoutSeconds.With("step", "propose").Set(t.Propose.Seconds())
+	m.RoundTimeoutSeconds.With("step", "prevote").Set(t.Prevote.Seconds())
+	m.RoundTimeoutSeconds.With("step", "precommit").Set(t.Precommit.Seconds())
+}
@@ -118,6 +118,34 @@ func MsgToWrappedProto(msg Message) (cmtcons.Message, error) {
 
 		pb.Sum = &cmtcons.Message_VoteSetBits{VoteSetBits: vsb}
 
+	case *CompactBlockMessage:
+		txKeys := make([][]byte, len(msg.TxKeys))
+		for i := range msg.TxKeys {
+			txKeys[i] = msg.TxKeys[i][:]
+		}
+		pb.Sum = &cmtcons.Message_CompactBlock{CompactBlock: &cmtcons.CompactBlock{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Block:              msg.Block,
+			TxKeys:             txKeys,
+		}}
+
+	case *RequestMissingTxsMessage:
+		pb.Sum = &cmtcons.Message_RequestMissingTxs{RequestMissingTxs: &cmtcons.RequestMissingTxs{
+			Height:             msg.Height,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Indexes:            msg.Indexes,
+		}}
+
+	case *MissingTxsMessage:
+		pb.Sum = &cmtcons.Message_MissingTxs{MissingTxs: &cmtcons.MissingTxs{
+			Height:             msg.Height,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Indexes:            msg.Indexes,
+			Txs:                msg.Txs.ToSliceOfBytes(),
+		}}
+
 	default:
 		return pb, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -239,6 +267,49 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "CompactBlock", Err: err}
+		}
+		txKeys := make([]types.TxKey, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			if len(key) != types.TxKeySize {
+				return nil, cmterrors.ErrMsgToProto{
+					MessageName: "CompactBlock",
+					Err:         fmt.Errorf("invalid tx key size %d, expected %d", len(key), types.TxKeySize),
+				}
+			}
+			txKeys[i] = types.TxKey(key)
+		}
+		pb = &CompactBlockMessage{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: *psh,
+			Block:              msg.Block,
+			TxKeys:             txKeys,
+		}
+	case *cmtcons.RequestMissingTxs:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "RequestMissingTxs", Err: err}
+		}
+		pb = &RequestMissingTxsMessage{
+			Height:             msg.Height,
+			BlockPartSetHeader: *psh,
+			Indexes:            msg.Indexes,
+		}
+	case *cmtcons.MissingTxs:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "MissingTxs", Err: err}
+		}
+		pb = &MissingTxsMessage{
+			Height:             msg.Height,
+			BlockPartSetHeader: *psh,
+			Indexes:            msg.Indexes,
+			Txs:                types.ToTxs(msg.Txs),
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
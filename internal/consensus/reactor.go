@@ -9,6 +9,7 @@ import (
 	"time"
 
 	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v2"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/internal/bits"
 	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
 	cmtevents "github.com/cometbft/cometbft/internal/events"
@@ -29,6 +30,8 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel is only open when compact block propagation is enabled.
+	CompactBlockChannel = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -51,6 +54,11 @@ type Reactor struct {
 	rs            cstypes.RoundState // copy of consensus state
 	initialHeight atomic.Int64
 
+	// compact block propagation, see compact_block.go
+	mempool           txSource
+	compactBlockMtx   cmtsync.Mutex
+	compactBlockCache compactBlockCache
+
 	Metrics *Metrics
 }
 
@@ -153,9 +161,9 @@ conR:
 }
 
 // StreamDescriptors implements Reactor.
-func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
+func (conR *Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 	// TODO optimize
-	return []p2p.StreamDescriptor{
+	descriptors := []p2p.StreamDescriptor{
 		tcpconn.StreamDescriptor{
 			ID:                  StateChannel,
 			Priority:            6,
@@ -189,6 +197,17 @@ func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 			MessageTypeI:        &cmtcons.Message{},
 		},
 	}
+	if conR.conS.config.CompactBlockPropagation {
+		descriptors = append(descriptors, tcpconn.StreamDescriptor{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageTypeI:        &cmtcons.Message{},
+		})
+	}
+	return descriptors
 }
 
 // InitPeer implements Reactor by creating a state for the peer.
@@ -393,6 +412,22 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
 
+	case CompactBlockChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *CompactBlockMessage:
+			conR.receiveCompactBlock(e.Src, ps, msg)
+		case *RequestMissingTxsMessage:
+			conR.sendMissingTxs(e.Src, msg)
+		case *MissingTxsMessage:
+			conR.receiveMissingTxs(e.Src, ps, msg)
+		default:
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
 	default:
 		conR.Logger.Error(fmt.Sprintf("Unknown chId %X", e.ChannelID))
 	}
@@ -617,6 +652,15 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// --------------------
+		// Send compact block?
+		// (If compact block propagation is enabled, and the peer has the proposal but not the block)
+		// --------------------
+
+		if conR.compactBlockPropagation(peer) && conR.sendCompactBlock(logger, &rs, ps, prs) {
+			continue OUTER_LOOP
+		}
+
 		// --------------------
 		// Send block part?
 		// (Note these can match on hash so round doesn't matter)
@@ -825,8 +869,10 @@ func pickPartToSend(
 	prs *cstypes.PeerRoundState,
 	rng *rand.Rand,
 ) (*types.Part, bool) {
-	// If peer has same part set header as us, send block parts
-	if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) && !rs.ProposalBlockParts.IsLocked() {
+	// If peer has same part set header as us, send block parts,
+	// unless it is reconstructing the block from a compact block we sent
+	if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) && !rs.ProposalBlockParts.IsLocked() &&
+		!ps.AwaitingCompactBlock(prs.Height, prs.Round) {
 		if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(rng); ok {
 			part := rs.ProposalBlockParts.GetPart(index)
 			// If sending this part fails, restart the OUTER_LOOP (busy-waiting).
@@ -1093,6 +1139,12 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	// compact block propagation, see compact_block.go
+	compactBlockHeight   int64     // height of the last compact block sent to the peer
+	compactBlockRound    int32     // round of the last compact block sent to the peer
+	compactBlockDeadline time.Time // no block parts are sent to the peer before
+	pendingCompactBlock  *pendingCompactBlock
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1318,14 +1370,25 @@ func (ps *PeerState) PickVoteToSend(votes types.VoteSetReader, rng *rand.Rand) *
 	if psVotes == nil {
 		return nil // Not something worth sending
 	}
-	if index, ok := votes.BitArray().Sub(psVotes).PickRandom(rng); ok {
+	candidates := votes.BitArray().Sub(psVotes)
+	for {
+		index, ok := candidates.PickRandom(rng)
+		if !ok {
+			return nil
+		}
 		vote := votes.GetByIndex(int32(index))
 		if vote == nil {
 			ps.logger.Error("votes.GetByIndex returned nil", "votes", votes, "index", index)
+			return nil
+		}
+		// The votes of an aggregated commit have no signature of their own,
+		// and can't be sent individually: pick another one.
+		if len(vote.Signature) == 0 {
+			candidates.SetIndex(index, false)
+			continue
 		}
 		return vote
 	}
-	return nil
 }
 
 func (ps *PeerState) getVoteBitArray(height int64, round int32, votesType types.SignedMsgType) *bits.BitArray {
@@ -1677,6 +1740,9 @@ func init() {
 	cmtjson.RegisterType(&HasProposalBlockPartMessage{}, "tendermint/HasProposalBlockPart")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&RequestMissingTxsMessage{}, "tendermint/RequestMissingTxs")
+	cmtjson.RegisterType(&MissingTxsMessage{}, "tendermint/MissingTxs")
 }
 
 // -------------------------------------
@@ -2009,6 +2075,106 @@ func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
 
+// -------------------------------------
+
+// CompactBlockMessage is sent instead of the parts of a proposal block when
+// compact block propagation is enabled. It carries the block without its txs,
+// which are identified by their keys.
+type CompactBlockMessage struct {
+	Height             int64
+	Round              int32
+	BlockPartSetHeader types.PartSetHeader
+	Block              *cmtproto.Block // without txs
+	TxKeys             []types.TxKey
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if m.Block == nil {
+		return cmterrors.ErrRequiredField{Field: "Block"}
+	}
+	if m.Block.Header.Height != m.Height {
+		return fmt.Errorf("block height %d does not match message height %d", m.Block.Header.Height, m.Height)
+	}
+	if len(m.Block.Data.Txs) > 0 {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: "must not contain txs"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v BP:%v Txs:%d]", m.Height, m.Round, m.BlockPartSetHeader, len(m.TxKeys))
+}
+
+// -------------------------------------
+
+// RequestMissingTxsMessage is sent to request the txs of a compact block that
+// are missing from the mempool.
+type RequestMissingTxsMessage struct {
+	Height             int64
+	BlockPartSetHeader types.PartSetHeader
+	Indexes            []uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *RequestMissingTxsMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if len(m.Indexes) == 0 {
+		return cmterrors.ErrRequiredField{Field: "Indexes"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *RequestMissingTxsMessage) String() string {
+	return fmt.Sprintf("[RequestMissingTxs H:%v BP:%v Txs:%d]", m.Height, m.BlockPartSetHeader, len(m.Indexes))
+}
+
+// -------------------------------------
+
+// MissingTxsMessage is sent in response to a RequestMissingTxsMessage, with
+// the txs at the given indexes of the block.
+type MissingTxsMessage struct {
+	Height             int64
+	BlockPartSetHeader types.PartSetHeader
+	Indexes            []uint32
+	Txs                types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *MissingTxsMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return fmt.Errorf("number of indexes %d does not match number of txs %d", len(m.Indexes), len(m.Txs))
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *MissingTxsMessage) String() string {
+	return fmt.Sprintf("[MissingTxs H:%v BP:%v Txs:%d]", m.Height, m.BlockPartSetHeader, len(m.Txs))
+}
+
 var (
 	_ types.Wrapper = &cmtcons.BlockPart{}
 	_ types.Wrapper = &cmtcons.HasVote{}
@@ -2019,4 +2185,7 @@ var (
 	_ types.Wrapper = &cmtcons.ProposalPOL{}
 	_ types.Wrapper = &cmtcons.VoteSetBits{}
 	_ types.Wrapper = &cmtcons.VoteSetMaj23{}
+	_ types.Wrapper = &cmtcons.CompactBlock{}
+	_ types.Wrapper = &cmtcons.RequestMissingTxs{}
+	_ types.Wrapper = &cmtcons.MissingTxs{}
 )
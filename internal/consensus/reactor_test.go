@@ -17,6 +17,7 @@ import (
 	"github.com/cometbft/cometbft/abci/example/kvstore"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v2"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtdb "github.com/cometbft/cometbft/db"
@@ -56,7 +57,7 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		// logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		// if err != nil {	t.Fatal(err)}
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		reactors[i] = NewReactor(css[i], true, ReactorMempool(assertMempool(css[i].txNotifier))) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -447,6 +448,203 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	assert.Greater(t, ps.BlockPartsSent(), 0, "number of votes sent should have increased")
 }
 
+// Ensure a testnet with compact block propagation makes blocks with txs,
+// whether the peers have them in their mempool or not.
+func TestReactorCompactBlocks(t *testing.T) {
+	n := 4
+	css, cleanup := randConsensusNet(t, n, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) { c.Consensus.CompactBlockPropagation = true })
+	defer cleanup()
+
+	// tx1 is in every mempool, tx2 only in the mempool of the first validator.
+	tx1, tx2 := kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)
+	for i := 0; i < n; i++ {
+		_, err := assertMempool(css[i].txNotifier).CheckTx(tx1, "")
+		require.NoError(t, err)
+	}
+	_, err := assertMempool(css[0].txNotifier).CheckTx(tx2, "")
+	require.NoError(t, err)
+
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, n)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	timeoutWaitGroup(n, func(j int) {
+		for {
+			msg := <-blocksSubs[j].Out()
+			block := msg.Data().(types.EventDataNewBlock).Block
+			if block.Txs.Index(tx2) >= 0 {
+				return
+			}
+		}
+	})
+}
+
+// compactBlockPeer is a mock peer recording the messages sent to it.
+type compactBlockPeer struct {
+	*p2pmock.Peer
+
+	mtx  cmtsync.Mutex
+	sent []p2p.Envelope
+}
+
+func newCompactBlockPeer() *compactBlockPeer {
+	return &compactBlockPeer{Peer: p2pmock.NewPeer(nil)}
+}
+
+func (p *compactBlockPeer) TrySend(e p2p.Envelope) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.sent = append(p.sent, e)
+	return nil
+}
+
+func (p *compactBlockPeer) Send(e p2p.Envelope) error {
+	return p.TrySend(e)
+}
+
+// takeSent returns the messages sent to the peer since the last call.
+func (p *compactBlockPeer) takeSent() []p2p.Envelope {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	sent := p.sent
+	p.sent = nil
+	return sent
+}
+
+func TestReactorCompactBlockReconstruction(t *testing.T) {
+	css, cleanup := randConsensusNet(t, 1, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) { c.Consensus.CompactBlockPropagation = true })
+	defer cleanup()
+	cs := css[0]
+	mp := assertMempool(cs.txNotifier)
+
+	txs := make(types.Txs, 3)
+	for i := range txs {
+		txs[i] = kvstore.NewTxFromID(i)
+		_, err := mp.CheckTx(txs[i], "")
+		require.NoError(t, err)
+	}
+	block, blockParts, _ := createProposalBlock(t, cs)
+	require.Len(t, block.Txs, len(txs))
+
+	// The sender has the complete proposal block.
+	rs := cs.GetRoundState()
+	rs.ProposalBlock, rs.ProposalBlockParts = block, blockParts
+	sender := NewReactor(cs, true)
+	sender.updateRoundState(&rs)
+	pb := sender.compactBlock(log.TestingLogger(), &rs)
+	require.NotNil(t, pb)
+	assert.Empty(t, pb.Block.Data.Txs)
+	assert.Same(t, pb, sender.compactBlock(log.TestingLogger(), &rs), "compact block should be cached")
+
+	compactBlock := func() *CompactBlockMessage {
+		msg, err := MsgFromProto(pb)
+		require.NoError(t, err)
+		return msg.(*CompactBlockMessage)
+	}
+
+	// The receiver misses the second tx, and requests it.
+	require.NoError(t, mp.RemoveTxByKey(txs[1].Key()))
+	receiver := NewReactor(cs, true, ReactorMempool(mp))
+	peer := newCompactBlockPeer()
+	ps := NewPeerState(peer)
+	receiver.receiveCompactBlock(peer, ps, compactBlock())
+	sent := peer.takeSent()
+	require.Len(t, sent, 1)
+	require.Equal(t, CompactBlockChannel, sent[0].ChannelID)
+	req, err := MsgFromProto(sent[0].Message)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1}, req.(*RequestMissingTxsMessage).Indexes)
+	assert.Empty(t, cs.peerMsgQueue)
+
+	sender.sendMissingTxs(peer, req.(*RequestMissingTxsMessage))
+	sent = peer.takeSent()
+	require.Len(t, sent, 1)
+	resp, err := MsgFromProto(sent[0].Message)
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[1]}, resp.(*MissingTxsMessage).Txs)
+
+	// Once the missing tx is received, the block parts are passed to consensus.
+	receiver.receiveMissingTxs(peer, ps, resp.(*MissingTxsMessage))
+	for i := 0; i < int(blockParts.Total()); i++ {
+		var mi msgInfo
+		select {
+		case mi = <-cs.peerMsgQueue:
+		case <-time.After(time.Second):
+			t.Fatal("block part not passed to consensus")
+		}
+		assert.Equal(t, peer.ID(), mi.PeerID)
+		part := mi.Msg.(*BlockPartMessage).Part
+		assert.Equal(t, blockParts.GetPart(i).Bytes, part.Bytes)
+		assert.Equal(t, blockParts.GetPart(i).Proof, part.Proof)
+	}
+
+	// A compact block that does not match its part set header is dropped, and
+	// we wait for the block parts.
+	mp.Flush()
+	for _, tx := range txs {
+		_, err := mp.CheckTx(tx, "")
+		require.NoError(t, err)
+	}
+	msg := compactBlock()
+	msg.TxKeys[0], msg.TxKeys[2] = msg.TxKeys[2], msg.TxKeys[0]
+	receiver.receiveCompactBlock(peer, ps, msg)
+	assert.Empty(t, peer.takeSent())
+	assert.Empty(t, cs.peerMsgQueue)
+
+	// Compact blocks of another round, or of another block than the proposal,
+	// are ignored.
+	msg = compactBlock()
+	msg.Round++
+	require.NoError(t, mp.RemoveTxByKey(txs[1].Key()))
+	receiver.receiveCompactBlock(peer, ps, msg)
+	assert.Empty(t, peer.takeSent())
+	rs = cs.GetRoundState()
+	rs.Proposal = types.NewProposal(rs.Height, rs.Round, -1, types.BlockID{}, block.Time)
+	rs.ProposalBlockParts = types.NewPartSetFromHeader(types.PartSetHeader{Total: 1, Hash: cmtrand.Bytes(32)})
+	receiver.updateRoundState(&rs)
+	receiver.receiveCompactBlock(peer, ps, compactBlock())
+	assert.Empty(t, peer.takeSent())
+	assert.Empty(t, cs.peerMsgQueue)
+}
+
+func TestReactorCompactBlockHoldsBlockParts(t *testing.T) {
+	css, cleanup := randConsensusNet(t, 1, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) {
+			c.Consensus.CompactBlockPropagation = true
+			c.Consensus.CompactBlockTimeout = 50 * time.Millisecond
+		})
+	defer cleanup()
+	cs := css[0]
+
+	block, blockParts, blockID := createProposalBlock(t, cs)
+	rs := cs.GetRoundState()
+	rs.ProposalBlock, rs.ProposalBlockParts = block, blockParts
+	conR := NewReactor(cs, true)
+
+	peer := newCompactBlockPeer()
+	ps := NewPeerState(peer)
+	ps.PRS.Height, ps.PRS.Round = rs.Height, rs.Round
+	ps.SetHasProposal(types.NewProposal(rs.Height, rs.Round, -1, blockID, block.Time))
+	prs := ps.GetRoundState()
+
+	// The compact block is sent once.
+	require.True(t, conR.sendCompactBlock(log.TestingLogger(), &rs, ps, prs))
+	sent := peer.takeSent()
+	require.Len(t, sent, 1)
+	require.IsType(t, &cmtcons.CompactBlock{}, sent[0].Message)
+	require.False(t, conR.sendCompactBlock(log.TestingLogger(), &rs, ps, prs))
+
+	// No block part is sent until the peer times out.
+	rng := cmtrand.NewStdlibRand()
+	part, _ := pickPartToSend(log.TestingLogger(), cs.blockStore, &rs, ps, prs, rng)
+	assert.Nil(t, part)
+	require.Eventually(t, func() bool {
+		part, _ := pickPartToSend(log.TestingLogger(), cs.blockStore, &rs, ps, prs, rng)
+		return part != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
 // -------------------------------------------------------------
 // ensure we can make blocks despite cycling a validator set
 
@@ -973,6 +1171,37 @@ func TestBlockPartMessageValidateBasic(t *testing.T) {
 	require.Error(t, message.ValidateBasic())
 }
 
+func TestCompactBlockMessageValidateBasic(t *testing.T) {
+	psh := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("blockparts"))}
+	testCases := []struct {
+		testName   string
+		malleateFn func(*CompactBlockMessage)
+		expectErr  bool
+	}{
+		{"Valid Message", func(*CompactBlockMessage) {}, false},
+		{"Invalid Height", func(m *CompactBlockMessage) { m.Height = 0 }, true},
+		{"Invalid Round", func(m *CompactBlockMessage) { m.Round = -1 }, true},
+		{"Invalid BlockPartSetHeader", func(m *CompactBlockMessage) { m.BlockPartSetHeader.Hash = []byte{1} }, true},
+		{"Missing Block", func(m *CompactBlockMessage) { m.Block = nil }, true},
+		{"Block Height Mismatch", func(m *CompactBlockMessage) { m.Block.Header.Height = 2 }, true},
+		{"Block With Txs", func(m *CompactBlockMessage) { m.Block.Data.Txs = [][]byte{{1}} }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			message := CompactBlockMessage{
+				Height:             1,
+				Round:              0,
+				BlockPartSetHeader: psh,
+				Block:              &cmtproto.Block{Header: cmtproto.Header{Height: 1}},
+				TxKeys:             []types.TxKey{types.Tx("tx").Key()},
+			}
+			tc.malleateFn(&message)
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestHasVoteMessageValidateBasic(t *testing.T) {
 	const (
 		validSignedMsgType   types.SignedMsgType = 0x01
@@ -1123,6 +1352,53 @@ func TestMarshalJSONPeerState(t *testing.T) {
 		}`, string(data))
 }
 
+// unsignedVotes strips the signature of some votes of a vote set, like the
+// votes of an aggregated commit.
+type unsignedVotes struct {
+	*types.VoteSet
+	unsigned map[int32]bool
+}
+
+func (votes unsignedVotes) GetByIndex(idx int32) *types.Vote {
+	vote := votes.VoteSet.GetByIndex(idx)
+	if vote == nil || !votes.unsigned[idx] {
+		return vote
+	}
+	vote = vote.Copy()
+	vote.Signature = nil
+	return vote
+}
+
+func TestPeerStatePickVoteToSendSkipsUnsignedVotes(t *testing.T) {
+	cs, vss := randState(4)
+	incrementHeight(vss[0])
+	blockID := types.BlockID{
+		Hash:          cmtrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: cmtrand.Bytes(tmhash.Size)},
+	}
+	voteSet := types.NewVoteSet(cs.state.ChainID, cs.Height, 0, types.PrecommitType, cs.Validators)
+	for _, vs := range vss {
+		added, err := voteSet.AddVote(signVote(vs, types.PrecommitType, cs.state.ChainID, blockID, false))
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	votes := unsignedVotes{VoteSet: voteSet, unsigned: map[int32]bool{0: true, 1: true, 2: true}}
+
+	ps := NewPeerState(nil)
+	ps.PRS.Height = cs.Height
+	ps.PRS.Round = 0
+	rng := cmtrand.NewStdlibRand()
+	for i := 0; i < 10; i++ {
+		vote := ps.PickVoteToSend(votes, rng)
+		require.NotNil(t, vote)
+		assert.EqualValues(t, 3, vote.ValidatorIndex)
+	}
+
+	// No vote is picked once the peer has all the signed ones.
+	ps.SetHasVote(voteSet.GetByIndex(3))
+	assert.Nil(t, ps.PickVoteToSend(votes, rng))
+}
+
 func TestVoteMessageValidateBasic(t *testing.T) {
 	cs, vss := randState(2)
 	chainID := cs.state.ChainID
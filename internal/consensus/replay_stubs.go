@@ -39,6 +39,8 @@ func (emptyMempool) Update(
 ) error {
 	return nil
 }
+func (emptyMempool) UpdateLanes(*mempl.LanesInfo) error       { return nil }
+func (emptyMempool) Lanes() []mempl.LaneStats                 { return nil }
 func (emptyMempool) Flush()                                   {}
 func (emptyMempool) FlushAppConn() error                      { return nil }
 func (emptyMempool) Contains(types.TxKey) bool                { return false }
@@ -1229,6 +1229,8 @@ func (bs *mockBlockStore) PruneBlocks(height int64, _ sm.State) (uint64, int64,
 func (*mockBlockStore) DeleteLatestBlock() error { return nil }
 func (*mockBlockStore) Close() error             { return nil }
 
+func (bs *mockBlockStore) NewSnapshot() (sm.BlockStoreSnapshot, error) { return bs, nil }
+
 // ---------------------------------------
 // Test handshake/init chain
 
@@ -0,0 +1,707 @@
+package consensus
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// A simulation runs the consensus of several validators in the test's
+// goroutine, one event at a time: the delivery of a message over a virtual
+// network, a timeout, or an action of the test. The time is virtual, and jumps
+// to the time of each event. All the randomness, from the keys of the
+// validators to the delays of the network, derives from a seed: a run only
+// depends on its seed and the rules of the test, and a failed run can be
+// replayed by setting CMT_SIM_SEED to the seed it logs.
+//
+// Like the reactor, the network gossips the proposals, block parts and votes
+// to the validators that need them, but it knows their round states instead of
+// learning them from their peers. It doesn't implement block sync: a
+// validator lagging more than one height behind the others can't catch up.
+
+const simSeedEnvVar = "CMT_SIM_SEED"
+
+var simGenesisTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const (
+	simDefaultLatency        = 10 * time.Millisecond
+	simDefaultJitter         = 20 * time.Millisecond
+	simDefaultGossipInterval = 100 * time.Millisecond
+	simVotingPower           = 10
+)
+
+// withClock sets the source of the current time of the State.
+func withClock(clock cmttime.Source) StateOption {
+	return func(cs *State) { cs.clock = clock }
+}
+
+// simClock is the virtual clock of a simulation.
+type simClock struct {
+	now time.Time
+}
+
+func (c *simClock) Now() time.Time {
+	return c.now
+}
+
+// simEvent is an event of a simulation, for a validator unless it is an
+// action of the test.
+type simEvent struct {
+	time      time.Time
+	seq       uint64 // orders the events of the same time
+	node      int
+	cancelled bool
+
+	msg     *simMessage  // delivery of a message
+	timeout *timeoutInfo // timeout of the validator
+	gossip  bool         // periodic gossip of the validator
+	action  func()       // action of the test
+}
+
+// simEventQueue is a priority queue of events, by time.
+type simEventQueue []*simEvent
+
+func (q simEventQueue) Len() int { return len(q) }
+
+func (q simEventQueue) Less(i, j int) bool {
+	if q[i].time.Equal(q[j].time) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].time.Before(q[j].time)
+}
+
+func (q simEventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *simEventQueue) Push(x any) { *q = append(*q, x.(*simEvent)) }
+
+func (q *simEventQueue) Pop() any {
+	old := *q
+	ev := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return ev
+}
+
+// simMessage is a proposal, block part or vote sent over the network.
+type simMessage struct {
+	from int
+	msg  Message
+	key  string
+	// header of the part set of a block part
+	partSet types.PartSetHeader
+}
+
+func newSimMessage(from int, msg Message) *simMessage {
+	pb, err := MsgToWrappedProto(msg)
+	if err != nil {
+		panic(err)
+	}
+	bz, err := proto.Marshal(&pb)
+	if err != nil {
+		panic(err)
+	}
+	return &simMessage{from: from, msg: msg, key: string(tmhash.Sum(bz))}
+}
+
+// simMsgHeightRound returns the height and round of a proposal, block part
+// or vote.
+func simMsgHeightRound(msg Message) (int64, int32) {
+	switch msg := msg.(type) {
+	case *ProposalMessage:
+		return msg.Proposal.Height, msg.Proposal.Round
+	case *BlockPartMessage:
+		return msg.Height, msg.Round
+	case *VoteMessage:
+		return msg.Vote.Height, msg.Vote.Round
+	default:
+		panic(fmt.Sprintf("unexpected message %T", msg))
+	}
+}
+
+// simAction is what the network does with a message.
+type simAction struct {
+	Drop  bool
+	Delay time.Duration
+}
+
+// simRule returns the action of the network on a message sent from a
+// validator to another, or false if it doesn't apply to the message.
+type simRule func(from, to int, msg Message) (simAction, bool)
+
+// simMatcher selects the messages sent from a validator to another.
+type simMatcher func(from, to int, msg Message) bool
+
+func simFrom(nodes ...int) simMatcher {
+	return func(from, _ int, _ Message) bool { return slices.Contains(nodes, from) }
+}
+
+func simTo(nodes ...int) simMatcher {
+	return func(_, to int, _ Message) bool { return slices.Contains(nodes, to) }
+}
+
+// simProposals matches the proposals and their block parts.
+func simProposals() simMatcher {
+	return func(_, _ int, msg Message) bool {
+		switch msg.(type) {
+		case *ProposalMessage, *BlockPartMessage:
+			return true
+		}
+		return false
+	}
+}
+
+// simBlockParts matches the block parts of the given indexes.
+func simBlockParts(indexes ...uint32) simMatcher {
+	return func(_, _ int, msg Message) bool {
+		part, ok := msg.(*BlockPartMessage)
+		return ok && slices.Contains(indexes, part.Part.Index)
+	}
+}
+
+func simVotes(voteType types.SignedMsgType) simMatcher {
+	return func(_, _ int, msg Message) bool {
+		vote, ok := msg.(*VoteMessage)
+		return ok && vote.Vote.Type == voteType
+	}
+}
+
+func simHeight(height int64) simMatcher {
+	return func(_, _ int, msg Message) bool {
+		h, _ := simMsgHeightRound(msg)
+		return h == height
+	}
+}
+
+func simAnd(matchers ...simMatcher) simMatcher {
+	return func(from, to int, msg Message) bool {
+		for _, match := range matchers {
+			if !match(from, to, msg) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func simDrop(match simMatcher) simRule {
+	return func(from, to int, msg Message) (simAction, bool) {
+		return simAction{Drop: true}, match(from, to, msg)
+	}
+}
+
+func simDelay(match simMatcher, delay time.Duration) simRule {
+	return func(from, to int, msg Message) (simAction, bool) {
+		return simAction{Delay: delay}, match(from, to, msg)
+	}
+}
+
+// simTicker is the TimeoutTicker of a validator, scheduling its timeouts as
+// events of the simulation. Like timeoutTicker, it only schedules timeouts of
+// later heights, rounds or steps than the last one.
+type simTicker struct {
+	sim     *simulation
+	node    int
+	ti      timeoutInfo
+	pending *simEvent
+}
+
+var _ TimeoutTicker = (*simTicker)(nil)
+
+func (*simTicker) Start() error { return nil }
+
+func (*simTicker) Stop() error { return nil }
+
+func (*simTicker) Chan() <-chan timeoutInfo { return nil }
+
+func (*simTicker) SetLogger(log.Logger) {}
+
+func (t *simTicker) ScheduleTimeout(ti timeoutInfo) {
+	if shouldSkipTick(ti, t.ti) {
+		return
+	}
+	if t.pending != nil {
+		t.pending.cancelled = true
+	}
+	t.ti = ti
+	t.pending = t.sim.schedule(&simEvent{
+		time:    t.sim.clock.now.Add(max(ti.Duration, 0)),
+		node:    t.node,
+		timeout: &ti,
+	})
+}
+
+// simNode is a validator of a simulation.
+type simNode struct {
+	index   int
+	sim     *simulation
+	privVal types.PrivValidator
+	cs      *State
+	ticker  *simTicker
+	crashed bool
+
+	// messages of the last two heights the validator has, in the order it
+	// got them, to gossip to the validators that need them
+	known     []*simMessage
+	knownKeys map[string]struct{}
+	// messages sent to each validator, and not delivered yet
+	inFlight []map[string]struct{}
+}
+
+// has returns whether the validator has the message in its state, or in its
+// block store for the block parts of committed blocks. It sets the part set
+// header of block parts.
+func (n *simNode) has(m *simMessage) bool {
+	rs := n.cs.GetRoundState()
+	switch msg := m.msg.(type) {
+	case *ProposalMessage:
+		return rs.Height == msg.Proposal.Height && rs.Proposal != nil &&
+			rs.Proposal.Round == msg.Proposal.Round && bytes.Equal(rs.Proposal.Signature, msg.Proposal.Signature)
+
+	case *BlockPartMessage:
+		var part *types.Part
+		switch {
+		case rs.Height == msg.Height && rs.ProposalBlockParts != nil:
+			part = rs.ProposalBlockParts.GetPart(int(msg.Part.Index))
+			m.partSet = rs.ProposalBlockParts.Header()
+		case rs.Height > msg.Height:
+			part = n.cs.blockStore.LoadBlockPart(msg.Height, int(msg.Part.Index))
+			if meta := n.cs.blockStore.LoadBlockMeta(msg.Height); meta != nil {
+				m.partSet = meta.BlockID.PartSetHeader
+			}
+		}
+		return part != nil && bytes.Equal(part.Bytes, msg.Part.Bytes)
+
+	case *VoteMessage:
+		vote := simGetVote(&rs, msg.Vote)
+		return vote != nil && bytes.Equal(vote.Signature, msg.Vote.Signature)
+	}
+	return false
+}
+
+// simGetVote returns the vote of the same validator, height, round and type as
+// vote in rs, if any.
+func simGetVote(rs *cstypes.RoundState, vote *types.Vote) *types.Vote {
+	var votes *types.VoteSet
+	switch {
+	case rs.Height == vote.Height && vote.Type == types.PrevoteType:
+		votes = rs.Votes.Prevotes(vote.Round)
+	case rs.Height == vote.Height && vote.Type == types.PrecommitType:
+		votes = rs.Votes.Precommits(vote.Round)
+	case rs.Height == vote.Height+1 && vote.Type == types.PrecommitType:
+		votes = rs.LastCommit
+	}
+	if votes == nil || votes.GetRound() != vote.Round {
+		return nil
+	}
+	return votes.GetByIndex(vote.ValidatorIndex)
+}
+
+// needs returns whether a validator of round state rs needs the message.
+func simNeeds(rs *cstypes.RoundState, m *simMessage) bool {
+	switch msg := m.msg.(type) {
+	case *ProposalMessage:
+		return rs.Height == msg.Proposal.Height && rs.Round == msg.Proposal.Round && rs.Proposal == nil
+
+	case *BlockPartMessage:
+		return rs.Height == msg.Height && rs.ProposalBlockParts != nil &&
+			rs.ProposalBlockParts.HasHeader(m.partSet) &&
+			!rs.ProposalBlockParts.BitArray().GetIndex(int(msg.Part.Index))
+
+	case *VoteMessage:
+		vote := msg.Vote
+		if rs.Height == vote.Height {
+			// Votes of rounds the validator doesn't track yet may make it skip to
+			// that round.
+			return simGetVote(rs, vote) == nil
+		}
+		return rs.Height == vote.Height+1 && vote.Type == types.PrecommitType &&
+			rs.LastCommit != nil && rs.LastCommit.GetRound() == vote.Round &&
+			simGetVote(rs, vote) == nil
+	}
+	return false
+}
+
+// learn adds the message to the known messages, if the validator has it.
+func (n *simNode) learn(m *simMessage) {
+	if _, ok := n.knownKeys[m.key]; ok || !n.has(m) {
+		return
+	}
+	n.known = append(n.known, m)
+	n.knownKeys[m.key] = struct{}{}
+}
+
+// drain handles the messages the validator sent itself.
+func (n *simNode) drain() {
+	for {
+		select {
+		case mi := <-n.cs.internalMsgQueue:
+			n.cs.handleMsg(mi)
+			n.learn(newSimMessage(n.index, mi.Msg))
+		default:
+			// Statistics are for the reactor.
+			for len(n.cs.statsMsgQueue) > 0 {
+				<-n.cs.statsMsgQueue
+			}
+			return
+		}
+	}
+}
+
+// gossip sends the known messages to the validators that need them, if they
+// are not already on their way.
+func (n *simNode) gossip() {
+	height := n.cs.GetRoundState().Height
+	n.known = slices.DeleteFunc(n.known, func(m *simMessage) bool {
+		h, _ := simMsgHeightRound(m.msg)
+		if h < height-1 {
+			delete(n.knownKeys, m.key)
+			return true
+		}
+		return false
+	})
+
+	for _, peer := range n.sim.nodes {
+		if peer == n || peer.crashed {
+			continue
+		}
+		rs := peer.cs.GetRoundState()
+		for _, m := range n.known {
+			if _, ok := n.inFlight[peer.index][m.key]; ok || !simNeeds(&rs, m) {
+				continue
+			}
+			n.sim.send(n.index, peer.index, m)
+		}
+	}
+}
+
+// simulation is a deterministic simulation of the consensus of several
+// validators.
+type simulation struct {
+	t     *testing.T
+	seed  int64
+	rng   *rand.Rand
+	clock *simClock
+	nodes []*simNode
+
+	queue simEventQueue
+	seq   uint64
+	rules []*simRule
+
+	// Delay of the messages, to which a random delay up to Jitter is added.
+	Latency time.Duration
+	Jitter  time.Duration
+	// Interval between two gossips of the messages of a validator, so that
+	// messages dropped are eventually sent again.
+	GossipInterval time.Duration
+
+	trace []string
+}
+
+// simOption modifies the genesis of a simulation.
+type simOption func(genDoc *types.GenesisDoc)
+
+// newSimulation returns a simulation of numValidators validators of the same
+// voting power, seeded with CMT_SIM_SEED if set.
+func newSimulation(t *testing.T, numValidators int, options ...simOption) *simulation {
+	t.Helper()
+	seed := time.Now().UnixNano()
+	if s := os.Getenv(simSeedEnvVar); s != "" {
+		var err error
+		seed, err = strconv.ParseInt(s, 10, 64)
+		require.NoError(t, err)
+	}
+	return newSimulationWithSeed(t, numValidators, seed, options...)
+}
+
+func newSimulationWithSeed(t *testing.T, numValidators int, seed int64, options ...simOption) *simulation {
+	t.Helper()
+	sim := &simulation{
+		t:              t,
+		seed:           seed,
+		rng:            rand.New(rand.NewSource(seed)), //nolint:gosec
+		clock:          &simClock{now: simGenesisTime},
+		Latency:        simDefaultLatency,
+		Jitter:         simDefaultJitter,
+		GossipInterval: simDefaultGossipInterval,
+	}
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Logf("replay the simulation with %s=%d, last events:\n%s",
+				simSeedEnvVar, seed, strings.Join(sim.trace[max(len(sim.trace)-100, 0):], "\n"))
+		}
+	})
+
+	validators := make([]types.GenesisValidator, numValidators)
+	privVals := make([]types.PrivValidator, numValidators)
+	for i := range privVals {
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("%d/%d", seed, i)))
+		validators[i] = types.GenesisValidator{PubKey: privKey.PubKey(), Power: simVotingPower}
+		privVals[i] = types.NewMockPVWithParams(privKey, false, false)
+	}
+	sort.Sort(types.PrivValidatorsByAddress(privVals))
+
+	genDoc := &types.GenesisDoc{
+		GenesisTime:     simGenesisTime,
+		InitialHeight:   1,
+		ChainID:         test.DefaultTestChainID,
+		Validators:      validators,
+		ConsensusParams: test.ConsensusParams(),
+	}
+	for _, option := range options {
+		option(genDoc)
+	}
+	config := ResetConfig("consensus_simulation_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+
+	for i, privVal := range privVals {
+		state, err := sm.MakeGenesisState(genDoc)
+		require.NoError(t, err)
+		app := kvstore.NewInMemoryApplication()
+		resp, lanesInfo := fetchAppInfo(app)
+		state.AppHash = resp.LastBlockAppHash
+
+		cs := newStateWithConfig(config, state, privVal, app, lanesInfo, withClock(sim.clock))
+		cs.SetLogger(log.NewNopLogger())
+		t.Cleanup(func() { _ = cs.eventBus.Stop() })
+		ticker := &simTicker{sim: sim, node: i}
+		cs.SetTimeoutTicker(ticker)
+
+		node := &simNode{
+			index:     i,
+			sim:       sim,
+			privVal:   privVal,
+			cs:        cs,
+			ticker:    ticker,
+			knownKeys: make(map[string]struct{}),
+			inFlight:  make([]map[string]struct{}, numValidators),
+		}
+		for j := range node.inFlight {
+			node.inFlight[j] = make(map[string]struct{})
+		}
+		sim.nodes = append(sim.nodes, node)
+	}
+	return sim
+}
+
+func simPeerID(node int) p2p.ID {
+	return p2p.ID(fmt.Sprintf("sim%d", node))
+}
+
+func (sim *simulation) logf(format string, args ...any) {
+	elapsed := sim.clock.now.Sub(simGenesisTime)
+	sim.trace = append(sim.trace, fmt.Sprintf("%v: ", elapsed)+fmt.Sprintf(format, args...))
+}
+
+func (sim *simulation) schedule(ev *simEvent) *simEvent {
+	sim.seq++
+	ev.seq = sim.seq
+	heap.Push(&sim.queue, ev)
+	return ev
+}
+
+// addRule adds a rule of the network, which applies if no rule added before
+// applies. It returns a function removing the rule.
+func (sim *simulation) addRule(rule simRule) (remove func()) {
+	r := &rule
+	sim.rules = append(sim.rules, r)
+	return func() {
+		sim.rules = slices.DeleteFunc(sim.rules, func(other *simRule) bool { return other == r })
+	}
+}
+
+// reorder returns a rule delaying the matching messages by a random duration
+// up to maxDelay, so that they may be delivered out of order.
+func (sim *simulation) reorder(match simMatcher, maxDelay time.Duration) simRule {
+	return func(from, to int, msg Message) (simAction, bool) {
+		if !match(from, to, msg) {
+			return simAction{}, false
+		}
+		return simAction{Delay: time.Duration(sim.rng.Int63n(int64(maxDelay) + 1))}, true
+	}
+}
+
+// partition drops the messages between the groups of validators, the
+// validators in no group being isolated. It returns a function healing the
+// partition.
+func (sim *simulation) partition(groups ...[]int) (heal func()) {
+	group := func(node int) int {
+		for i, g := range groups {
+			if slices.Contains(g, node) {
+				return i
+			}
+		}
+		return -1 - node
+	}
+	return sim.addRule(simDrop(func(from, to int, _ Message) bool {
+		return group(from) != group(to)
+	}))
+}
+
+// send sends a message from a validator to another, unless a rule drops it.
+func (sim *simulation) send(from, to int, m *simMessage) {
+	action := simAction{Delay: sim.Latency}
+	if sim.Jitter > 0 {
+		action.Delay += time.Duration(sim.rng.Int63n(int64(sim.Jitter) + 1))
+	}
+	for _, rule := range sim.rules {
+		if a, ok := (*rule)(from, to, m.msg); ok {
+			action = a
+			break
+		}
+	}
+	if action.Drop {
+		sim.logf("node %d: dropped message to node %d: %v", from, to, m.msg)
+		return
+	}
+	sim.nodes[from].inFlight[to][m.key] = struct{}{}
+	sim.schedule(&simEvent{time: sim.clock.now.Add(action.Delay), node: to, msg: m})
+}
+
+// inject sends a message from a validator to another, as if the sender had
+// it. The message may be forged, to simulate a byzantine validator.
+func (sim *simulation) inject(from, to int, msg Message) {
+	sim.send(from, to, newSimMessage(from, msg))
+}
+
+// at schedules an action of the test, after the given virtual duration.
+func (sim *simulation) at(after time.Duration, action func()) {
+	sim.schedule(&simEvent{time: sim.clock.now.Add(after), action: action})
+}
+
+// crash stops the validator: it no longer handles events nor sends messages.
+func (sim *simulation) crash(node int) {
+	sim.logf("node %d: crashed", node)
+	sim.nodes[node].crashed = true
+}
+
+// start schedules the first round of all the validators, and their gossip.
+func (sim *simulation) start() {
+	for _, n := range sim.nodes {
+		rs := n.cs.GetRoundState()
+		n.cs.scheduleRound0(&rs)
+		sim.schedule(&simEvent{time: sim.clock.now.Add(sim.GossipInterval), node: n.index, gossip: true})
+	}
+}
+
+// step processes the next event, and returns false if there is none.
+func (sim *simulation) step() bool {
+	for sim.queue.Len() > 0 {
+		ev := heap.Pop(&sim.queue).(*simEvent)
+		if ev.cancelled {
+			continue
+		}
+		sim.clock.now = ev.time
+		sim.process(ev)
+		return true
+	}
+	return false
+}
+
+func (sim *simulation) process(ev *simEvent) {
+	if ev.action != nil {
+		ev.action()
+		return
+	}
+	n := sim.nodes[ev.node]
+	switch {
+	case ev.timeout != nil:
+		if n.ticker.pending == ev {
+			n.ticker.pending = nil
+		}
+		if n.crashed {
+			return
+		}
+		sim.logf("node %d: timeout %v", n.index, *ev.timeout)
+		n.cs.handleTimeout(*ev.timeout, n.cs.GetRoundState())
+
+	case ev.msg != nil:
+		delete(sim.nodes[ev.msg.from].inFlight[n.index], ev.msg.key)
+		if n.crashed {
+			return
+		}
+		sim.logf("node %d: received from node %d: %v", n.index, ev.msg.from, ev.msg.msg)
+		n.cs.handleMsg(msgInfo{Msg: ev.msg.msg, PeerID: simPeerID(ev.msg.from), ReceiveTime: sim.clock.now})
+		n.learn(newSimMessage(n.index, ev.msg.msg))
+
+	case ev.gossip:
+		sim.schedule(&simEvent{time: sim.clock.now.Add(sim.GossipInterval), node: n.index, gossip: true})
+		if n.crashed {
+			return
+		}
+	}
+
+	height := n.cs.blockStore.Height()
+	n.drain()
+	if newHeight := n.cs.blockStore.Height(); newHeight > height {
+		sim.logf("node %d: committed height %d: %v", n.index, newHeight, n.cs.blockStore.LoadBlockMeta(newHeight).BlockID)
+	}
+	n.gossip()
+}
+
+// runUntil processes the events until cond holds, and returns false if it
+// doesn't within the virtual duration timeout.
+func (sim *simulation) runUntil(cond func() bool, timeout time.Duration) bool {
+	deadline := sim.clock.now.Add(timeout)
+	for !cond() {
+		if sim.queue.Len() == 0 || sim.queue[0].time.After(deadline) {
+			sim.clock.now = deadline
+			return false
+		}
+		sim.step()
+	}
+	return true
+}
+
+// runFor processes the events of the virtual duration d.
+func (sim *simulation) runFor(d time.Duration) {
+	sim.runUntil(func() bool { return false }, d)
+}
+
+// heights returns the heights committed by the validators.
+func (sim *simulation) heights() []int64 {
+	heights := make([]int64, len(sim.nodes))
+	for i, n := range sim.nodes {
+		heights[i] = n.cs.blockStore.Height()
+	}
+	return heights
+}
+
+// requireHeight runs the simulation until the validators that did not crash
+// commit height, and fails the test if they don't within the virtual
+// duration timeout.
+func (sim *simulation) requireHeight(height int64, timeout time.Duration) {
+	sim.t.Helper()
+	committed := func() bool {
+		for _, n := range sim.nodes {
+			if !n.crashed && n.cs.blockStore.Height() < height {
+				return false
+			}
+		}
+		return true
+	}
+	if !sim.runUntil(committed, timeout) {
+		sim.t.Fatalf("validators did not commit height %d within %v: heights %v", height, timeout, sim.heights())
+	}
+}
@@ -0,0 +1,130 @@
+package consensus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSimulationDeterminism(t *testing.T) {
+	run := func(seed int64) ([]string, []byte) {
+		sim := newSimulationWithSeed(t, 4, seed)
+		sim.addRule(sim.reorder(simVotes(types.PrevoteType), 200*time.Millisecond))
+		sim.start()
+		sim.requireHeight(3, time.Minute)
+		return sim.trace, sim.nodes[0].cs.blockStore.LoadBlockMeta(3).BlockID.Hash
+	}
+
+	trace, hash := run(1)
+	replayedTrace, replayedHash := run(1)
+	assert.Equal(t, trace, replayedTrace)
+	assert.Equal(t, hash, replayedHash)
+
+	otherTrace, _ := run(2)
+	assert.NotEqual(t, trace, otherTrace)
+}
+
+func TestSimulationPartition(t *testing.T) {
+	sim := newSimulation(t, 4)
+	heal := sim.partition([]int{0, 1}, []int{2, 3})
+	sim.start()
+
+	// Neither half has +2/3 of the voting power.
+	sim.runFor(10 * time.Second)
+	assert.Equal(t, []int64{0, 0, 0, 0}, sim.heights())
+
+	heal()
+	sim.requireHeight(2, time.Minute)
+}
+
+func TestSimulationCrashedValidators(t *testing.T) {
+	sim := newSimulation(t, 4)
+	sim.start()
+	sim.requireHeight(1, time.Minute)
+
+	// The validators commit without the votes of one of them.
+	sim.crash(3)
+	sim.requireHeight(3, time.Minute)
+
+	// But not without the votes of two of them.
+	sim.crash(2)
+	heights := sim.heights()
+	sim.runFor(10 * time.Second)
+	assert.Equal(t, heights[:2], sim.heights()[:2])
+}
+
+func TestSimulationDroppedProposals(t *testing.T) {
+	sim := newSimulation(t, 4)
+	// The proposal of the first round of height 1 never reaches the other
+	// validators, which commit the block of another round.
+	proposer := -1
+	for i, n := range sim.nodes {
+		if n.cs.isProposer(n.cs.privValidatorPubKey.Address()) {
+			proposer = i
+		}
+	}
+	require.NotEqual(t, -1, proposer)
+	sim.addRule(simDrop(simAnd(simFrom(proposer), simProposals(), simHeight(1))))
+	sim.start()
+	sim.requireHeight(1, time.Minute)
+
+	commit := sim.nodes[0].cs.blockStore.LoadSeenCommit(1)
+	require.NotNil(t, commit)
+	assert.Positive(t, commit.Round)
+}
+
+func TestSimulationLateVotes(t *testing.T) {
+	sim := newSimulation(t, 4)
+	// The votes of a validator arrive after the timeouts: the others commit
+	// without them, and it catches up with them.
+	sim.addRule(simDelay(simFrom(0), 5*time.Second))
+	sim.start()
+	sim.requireHeight(3, time.Minute)
+}
+
+func TestSimulationHealedPartitionAfterCommit(t *testing.T) {
+	sim := newSimulation(t, 4)
+	// A validator partitioned from the others while they commit a height
+	// catches up with the block and precommits of the height once healed.
+	heal := sim.partition([]int{0, 1, 2}, []int{3})
+	sim.start()
+	require.True(t, sim.runUntil(func() bool { return sim.nodes[0].cs.blockStore.Height() >= 1 }, time.Minute))
+	assert.Equal(t, int64(0), sim.nodes[3].cs.blockStore.Height())
+	heal()
+	sim.requireHeight(3, time.Minute)
+}
+
+func TestSimulationErasureCodedBlocks(t *testing.T) {
+	sim := newSimulation(t, 4, func(genDoc *types.GenesisDoc) {
+		genDoc.ConsensusParams.Feature.ErasureCodingEnableHeight = 1
+	})
+	txs := make(types.Txs, 5)
+	for i := range txs {
+		txs[i] = kvstore.NewTx(fmt.Sprint(i), strings.Repeat("v", int(types.BlockPartSizeBytes)/2))
+		for _, n := range sim.nodes {
+			_, err := assertMempool(n.cs.txNotifier).CheckTx(txs[i], "")
+			require.NoError(t, err)
+		}
+	}
+	// The first data parts never reach the validators, which reconstruct the
+	// block from the other data parts and the parity parts.
+	sim.addRule(simDrop(simAnd(simHeight(1), simBlockParts(0, 1))))
+	sim.start()
+	sim.requireHeight(2, time.Minute)
+
+	for _, n := range sim.nodes {
+		block, meta := n.cs.blockStore.LoadBlock(1)
+		require.NotNil(t, block)
+		psh := meta.BlockID.PartSetHeader
+		assert.True(t, psh.IsErasureCoded())
+		assert.Equal(t, psh.DataTotal(), psh.Parity)
+		assert.ElementsMatch(t, txs, block.Txs)
+	}
+}
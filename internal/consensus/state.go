@@ -63,6 +63,9 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports conflicting proposals, signed by the validator of the given
+	// address, to the evidence pool to be processed into evidence
+	ReportConflictingProposals(proposalA, proposalB *types.Proposal, proposerAddress types.Address)
 }
 
 // State handles execution of the consensus algorithm.
@@ -102,6 +105,8 @@ type State struct {
 	peerMsgQueue     chan msgInfo
 	internalMsgQueue chan msgInfo
 	timeoutTicker    TimeoutTicker
+	// the current time, from the system clock except in simulations
+	clock cmttime.Source
 
 	// information about added votes and block parts are written on this channel
 	// so statistics can be computed by reactor
@@ -135,6 +140,12 @@ type State struct {
 	// for reporting metrics
 	metrics *Metrics
 
+	// timelines of the most recent heights, for diagnosing slow rounds
+	tracer *heightTracer
+
+	// latencies of the most recent rounds, if timeouts are adaptive
+	adaptiveTimeouts *adaptiveTimeouts
+
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
 
@@ -164,6 +175,7 @@ func NewState(
 		peerMsgQueue:     make(chan msgInfo, msgQueueSize),
 		internalMsgQueue: make(chan msgInfo, msgQueueSize),
 		timeoutTicker:    NewTimeoutTicker(),
+		clock:            cmttime.DefaultSource{},
 		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
 		done:             make(chan struct{}),
 		doWALCatchup:     true,
@@ -171,6 +183,8 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		tracer:           newHeightTracer(config.TraceNumHeights),
+		adaptiveTimeouts: newAdaptiveTimeouts(config),
 	}
 	for _, option := range options {
 		option(cs)
@@ -276,6 +290,16 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return cmtjson.Marshal(cs.RoundState.RoundStateSimple())
 }
 
+// GetTraceJSON returns a json of the timeline of the given height, or of all
+// the heights kept in the trace if height is 0.
+func (cs *State) GetTraceJSON(height int64) ([]byte, error) {
+	timelines := cs.tracer.Timelines(height)
+	if height != 0 && len(timelines) == 0 {
+		return nil, ErrHeightNotTraced{Height: height}
+	}
+	return cmtjson.Marshal(timelines)
+}
+
 // GetValidators returns a copy of the current validators.
 func (cs *State) GetValidators() (int64, []*types.Validator) {
 	cs.mtx.RLock()
@@ -494,9 +518,9 @@ func (cs *State) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 // SetProposal inputs a proposal.
 func (cs *State) SetProposal(proposal *types.Proposal, peerID p2p.ID) error {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, "", cmttime.Now()}
+		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, "", cs.clock.Now()}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID, cmttime.Now()}
+		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID, cs.clock.Now()}
 	}
 
 	// TODO: wait for event?!
@@ -552,6 +576,14 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 		if cs.Step != step {
 			cs.metrics.MarkStep(cs.Step)
 		}
+		now := cs.clock.Now()
+		if step == cstypes.RoundStepNewRound {
+			cs.tracer.startRound(cs.Height, round, now)
+		}
+		if cs.Step != step || cs.Round != round {
+			cs.tracer.step(cs.Height, round, step, now)
+			cs.adaptiveTimeouts.step(cs.Height, round, step, now)
+		}
 	}
 	cs.Round = round
 	cs.Step = step
@@ -560,7 +592,7 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
 	// cs.Logger.Info("scheduleRound0", "now", cmttime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(cmttime.Now())
+	sleepDuration := rs.StartTime.Sub(cs.clock.Now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
@@ -640,7 +672,10 @@ func (cs *State) votesFromSeenCommit(state sm.State) (*types.VoteSet, error) {
 		return nil, fmt.Errorf("heights don't match in votesFromSeenCommit %v!=%v",
 			commit.Height, state.LastBlockHeight)
 	}
-	vs := commit.ToVoteSet(state.ChainID, state.LastValidators)
+	vs, err := commit.ToVoteSet(state.ChainID, state.LastValidators)
+	if err != nil {
+		return nil, err
+	}
 	if !vs.HasTwoThirdsMajority() {
 		return nil, ErrCommitQuorumNotMet
 	}
@@ -722,6 +757,9 @@ func (cs *State) updateToState(state sm.State) {
 
 	// RoundState fields
 	cs.updateHeight(height)
+	if !cs.replayMode {
+		cs.tracer.startHeight(height, cs.clock.Now())
+	}
 	cs.updateRoundStep(0, cstypes.RoundStepNewHeight)
 
 	timeoutCommit := state.NextBlockDelay
@@ -735,7 +773,7 @@ func (cs *State) updateToState(state sm.State) {
 		// We add timeoutCommit to allow transactions to be gathered for
 		// the first block. An alternative solution that relies on clocks:
 		// `cs.StartTime = state.LastBlockTime.Add(timeoutCommit)`
-		cs.StartTime = cmttime.Now().Add(timeoutCommit)
+		cs.StartTime = cs.clock.Now().Add(timeoutCommit)
 	} else {
 		cs.StartTime = cs.CommitTime.Add(timeoutCommit)
 	}
@@ -898,10 +936,16 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
 		err = cs.setProposal(msg.Proposal, mi.ReceiveTime)
+		if err == nil && cs.Proposal == msg.Proposal && !cs.replayMode {
+			cs.tracer.proposal(msg.Proposal, cs.Validators.GetProposer().Address, peerID, cs.clock.Now())
+		}
 
 	case *BlockPartMessage:
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
 		added, err = cs.addProposalBlockPart(msg, peerID)
+		if added && !cs.replayMode {
+			cs.tracer.blockPart(msg, peerID, cs.clock.Now())
+		}
 
 		// We unlock here to yield to any routines that need to read the RoundState.
 		// Previously, this code held the lock from the point at which the final block
@@ -987,6 +1031,15 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	// The state may have moved on while waiting for the lock, in which case
+	// the timeout is ignored by the state transitions and is neither traced
+	// nor sampled.
+	if !cs.replayMode && ti.Height == cs.Height &&
+		(ti.Round > cs.Round || (ti.Round == cs.Round && ti.Step >= cs.Step)) {
+		cs.tracer.timeout(ti, cs.clock.Now())
+		cs.adaptiveTimeouts.timeout(ti)
+	}
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -1040,7 +1093,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(cmttime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.clock.Now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 
 	case cstypes.RoundStepNewRound: // after timeoutCommit
@@ -1071,7 +1124,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := cmttime.Now(); cs.StartTime.After(now) {
+	if now := cs.clock.Now(); cs.StartTime.After(now) {
 		logger.Debug("Need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
 
@@ -1107,6 +1160,8 @@ func (cs *State) enterNewRound(height int64, round int32) {
 
 	cs.Votes.SetRound(cmtmath.SafeAddInt32(round, 1)) // also track next round (round+1) to allow round-skipping
 	cs.TriggeredTimeoutPrecommit = false
+	cs.Timeouts = cs.adaptiveTimeouts.roundTimeouts(cs.config, round)
+	cs.metrics.MarkRoundTimeouts(cs.Timeouts)
 
 	if err := cs.eventBus.PublishEventNewRound(cs.NewRoundEvent()); err != nil {
 		cs.Logger.Error("Failed publishing new round", "err", err)
@@ -1163,7 +1218,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	// If this validator is the proposer of this round, and the previous block time is later than
 	// our local clock time, wait to propose until our local clock time has passed the block time.
 	if cs.isPBTSEnabled(height) && cs.privValidatorPubKey != nil && cs.isProposer(cs.privValidatorPubKey.Address()) {
-		proposerWaitTime := proposerWaitTime(cmttime.DefaultSource{}, cs.state.LastBlockTime)
+		proposerWaitTime := proposerWaitTime(cs.clock, cs.state.LastBlockTime)
 		if proposerWaitTime > 0 {
 			cs.scheduleTimeout(proposerWaitTime, height, round, cstypes.RoundStepNewRound)
 			return
@@ -1186,7 +1241,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.Timeouts.Propose, height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1242,7 +1297,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 			panic("Method createProposalBlock should not provide a nil block without errors")
 		}
 		cs.metrics.ProposalCreateCount.Add(1)
-		blockParts, err = block.MakePartSet(types.BlockPartSizeBytes)
+		blockParts, err = cs.state.MakePartSet(block)
 		if err != nil {
 			cs.Logger.Error("unable to create proposal block part set", "error", err)
 			return
@@ -1263,7 +1318,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 		proposal.Signature = p.Signature
 
 		// send proposal and block parts on internal msg queue
-		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cmttime.Now()})
+		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cs.clock.Now()})
 
 		for i := 0; i < int(blockParts.Total()); i++ {
 			part := blockParts.GetPart(i)
@@ -1431,7 +1486,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		if cs.LockedRound == -1 {
 			if cs.ValidRound != -1 && cs.ProposalBlock.HashesTo(cs.ValidBlock.Hash()) {
 				logger.Debug("Prevote step: ProposalBlock matches our valid block; prevoting the proposal")
-				cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+				cs.prevoteProposalBlock()
 				return
 			}
 
@@ -1497,13 +1552,13 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 			}
 
 			logger.Debug("Prevote step: ProposalBlock is valid and there is no locked block; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposalBlock()
 			return
 		}
 
 		if cs.ProposalBlock.HashesTo(cs.LockedBlock.Hash()) {
 			logger.Debug("Prevote step: ProposalBlock is valid (POLRound is -1) and matches our locked block; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposalBlock()
 			return
 		}
 
@@ -1544,12 +1599,12 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		if cs.LockedRound < cs.Proposal.POLRound {
 			logger.Debug("Prevote step: ProposalBlock is valid and received a 2/3" +
 				"majority in a round later than the locked round; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposalBlock()
 			return
 		}
 		if cs.ProposalBlock.HashesTo(cs.LockedBlock.Hash()) {
 			logger.Debug("Prevote step: ProposalBlock is valid and matches our locked block; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposalBlock()
 			return
 		}
 		// If v_r = lockedRound_p we expect v to match lockedValue_p. If it is not the case,
@@ -1560,7 +1615,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 			logger.Info("Prevote step: ProposalBlock is valid and received a 2/3" +
 				"majority at our locked round, while not matching our locked value;" +
 				"this can only happen when 1/3 or more validators are double signing; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposalBlock()
 			return
 		}
 	}
@@ -1570,6 +1625,16 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 	cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{}, nil)
 }
 
+// prevoteProposalBlock prevotes the proposal block, which is valid, and starts
+// executing it optimistically if enabled. Blocks decided without this node
+// prevoting them are only executed once decided.
+func (cs *State) prevoteProposalBlock() {
+	if cs.config.OptimisticExecution && !cs.replayMode {
+		cs.blockExec.ExecuteOptimistically(cs.state, cs.ProposalBlock)
+	}
+	cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1598,7 +1663,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.Timeouts.Prevote, height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1736,7 +1801,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.Timeouts.Precommit, height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block.
@@ -1758,7 +1823,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = cmttime.Now()
+		cs.CommitTime = cs.clock.Now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -2046,9 +2111,13 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 // -----------------------------------------------------------------------------
 
 func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time) error {
+	if proposal == nil {
+		return nil
+	}
+
 	// Already have one
-	// TODO: possibly catch double proposals
-	if cs.Proposal != nil || proposal == nil {
+	if cs.Proposal != nil {
+		cs.checkConflictingProposal(proposal)
 		return nil
 	}
 
@@ -2072,12 +2141,21 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time
 		return ErrInvalidProposalSignature
 	}
 
+	psh := proposal.BlockID.PartSetHeader
+	if psh.IsErasureCoded() && !cs.state.ConsensusParams.Feature.ErasureCodingEnabled(proposal.Height) {
+		return ErrProposalErasureCoded
+	}
+
 	// Validate the proposed block size, derived from its PartSetHeader
 	maxBytes := cs.state.ConsensusParams.Block.MaxBytes
 	if maxBytes == -1 {
 		maxBytes = int64(types.MaxBlockSizeBytes)
 	}
-	if int64(proposal.BlockID.PartSetHeader.Total) > (maxBytes-1)/int64(types.BlockPartSizeBytes)+1 {
+	if psh.IsErasureCoded() {
+		// The data parts also hold the length of the block.
+		maxBytes += types.ErasureCodingPrefixSize
+	}
+	if int64(psh.DataTotal()) > (maxBytes-1)/int64(types.BlockPartSizeBytes)+1 {
 		return ErrProposalTooManyParts
 	}
 
@@ -2102,6 +2180,25 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time
 	return nil
 }
 
+// checkConflictingProposal reports the given proposal to the evidence pool if
+// it is signed by the proposer of the round, and for another block than the
+// proposal of the round.
+func (cs *State) checkConflictingProposal(proposal *types.Proposal) {
+	if proposal.Height != cs.Proposal.Height || proposal.Round != cs.Proposal.Round ||
+		proposal.BlockID.Equals(cs.Proposal.BlockID) {
+		return
+	}
+	proposer := cs.Validators.GetProposer()
+	if !proposer.PubKey.VerifySignature(
+		types.ProposalSignBytes(cs.state.ChainID, proposal.ToProto()), proposal.Signature,
+	) {
+		return
+	}
+	cs.Logger.Error("Found conflicting proposal from the proposer",
+		"proposal", cs.Proposal, "conflicting", proposal, "proposer", proposer.Address)
+	cs.evpool.ReportConflictingProposals(cs.Proposal, proposal, proposer.Address)
+}
+
 func (cs *State) readSerializedBlockFromBlockParts() ([]byte, error) {
 	// reuse a serialized block buffer from cs
 	var serializedBlockBuffer []byte
@@ -2176,12 +2273,22 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 	if maxBytes == -1 {
 		maxBytes = int64(types.MaxBlockSizeBytes)
 	}
+	if cs.ProposalBlockParts.Header().IsErasureCoded() && !cs.ProposalBlockParts.IsComplete() {
+		// The parts received so far may also hold the length of the block.
+		maxBytes += types.ErasureCodingPrefixSize
+	}
 	if cs.ProposalBlockParts.ByteSize() > maxBytes {
 		return added, fmt.Errorf("total size of proposal block parts exceeds maximum block bytes (%d > %d)",
 			cs.ProposalBlockParts.ByteSize(), maxBytes,
 		)
 	}
 	if added && cs.ProposalBlockParts.IsComplete() {
+		if cs.ProposalBlockParts.Header().IsErasureCoded() {
+			// The parts not received were decoded: let the peers know that
+			// they need not send them.
+			cs.evsw.FireEvent(types.EventValidBlock, cs.RoundState)
+		}
+
 		bz, err := cs.readSerializedBlockFromBlockParts()
 		if err != nil {
 			return added, err
@@ -2238,6 +2345,9 @@ func (cs *State) handleCompleteProposal(blockHeight int64) {
 	}
 
 	if cs.Step <= cstypes.RoundStepPropose && cs.isProposalComplete() {
+		if !cs.replayMode {
+			cs.adaptiveTimeouts.proposalComplete(blockHeight, cs.Round, cs.clock.Now())
+		}
 		// Move onto the next step
 		cs.enterPrevote(blockHeight, cs.Round)
 		if hasTwoThirds { // this is optimisation as this will be triggered when prevote is added
@@ -2330,6 +2440,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			}
 			return added, err
 		}
+		if !cs.replayMode {
+			cs.tracer.vote(vote, peerID, cs.clock.Now())
+		}
 
 		cs.Logger.Debug("Added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
 		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
@@ -2415,6 +2528,11 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 		return added, err
 	}
+	if !cs.replayMode {
+		now := cs.clock.Now()
+		cs.tracer.vote(vote, peerID, now)
+		cs.adaptiveTimeouts.voteAdded(cs.Votes, vote, cs.Round, now)
+	}
 	if vote.Round == cs.Round {
 		vals := cs.state.Validators
 		_, val := vals.GetByIndex(vote.ValidatorIndex)
@@ -2577,9 +2695,9 @@ func (cs *State) signVote(
 
 func (cs *State) voteTime(height int64) time.Time {
 	if cs.isPBTSEnabled(height) {
-		return cmttime.Now()
+		return cs.clock.Now()
 	}
-	now := cmttime.Now()
+	now := cs.clock.Now()
 	minVoteTime := now
 
 	// Minimum time increment between blocks
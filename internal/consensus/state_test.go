@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/cometbft/cometbft/libs/protoio"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -336,6 +338,72 @@ func TestStateOversizedBlock(t *testing.T) {
 	}
 }
 
+func TestStateErasureCodedProposal(t *testing.T) {
+	cs1, vss := randState(2)
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+
+	blockID := types.BlockID{
+		Hash:          cmtrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 4, Hash: cmtrand.Bytes(tmhash.Size), Parity: 2},
+	}
+	proposal := types.NewProposal(height, round, -1, blockID, time.Now())
+	signProposal(t, proposal, chainID, vss[0])
+
+	// Erasure-coded parts are rejected before erasure coding is enabled.
+	require.ErrorIs(t, cs1.defaultSetProposal(proposal, time.Now()), ErrProposalErasureCoded)
+	assert.Nil(t, cs1.Proposal)
+
+	cs1.state.ConsensusParams.Feature.ErasureCodingEnableHeight = height
+	require.NoError(t, cs1.defaultSetProposal(proposal, time.Now()))
+	assert.Equal(t, blockID.PartSetHeader, cs1.ProposalBlockParts.Header())
+}
+
+// conflictingProposalsPool records the conflicting proposals reported by consensus.
+type conflictingProposalsPool struct {
+	sm.EmptyEvidencePool
+	proposals [][2]*types.Proposal
+	proposers []types.Address
+}
+
+func (p *conflictingProposalsPool) ReportConflictingProposals(proposalA, proposalB *types.Proposal, proposerAddress types.Address) {
+	p.proposals = append(p.proposals, [2]*types.Proposal{proposalA, proposalB})
+	p.proposers = append(p.proposers, proposerAddress)
+}
+
+func TestStateConflictingProposals(t *testing.T) {
+	cs1, vss := randState(2)
+	evpool := &conflictingProposalsPool{}
+	cs1.evpool = evpool
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+
+	makeProposal := func(vs *validatorStub) *types.Proposal {
+		blockID := types.BlockID{
+			Hash:          cmtrand.Bytes(tmhash.Size),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: cmtrand.Bytes(tmhash.Size)},
+		}
+		proposal := types.NewProposal(height, round, -1, blockID, time.Now())
+		signProposal(t, proposal, chainID, vs)
+		return proposal
+	}
+
+	proposal := makeProposal(vss[0])
+	require.NoError(t, cs1.defaultSetProposal(proposal, time.Now()))
+
+	// The same proposal, and proposals not signed by the proposer, are no misbehavior.
+	require.NoError(t, cs1.defaultSetProposal(proposal, time.Now()))
+	require.NoError(t, cs1.defaultSetProposal(makeProposal(vss[1]), time.Now()))
+	assert.Empty(t, evpool.proposals)
+
+	conflicting := makeProposal(vss[0])
+	require.NoError(t, cs1.defaultSetProposal(conflicting, time.Now()))
+	pubKey, err := vss[0].GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, [][2]*types.Proposal{{proposal, conflicting}}, evpool.proposals)
+	assert.Equal(t, []types.Address{pubKey.Address()}, evpool.proposers)
+	// The first proposal remains the proposal of the round.
+	assert.Equal(t, proposal, cs1.Proposal)
+}
+
 // ----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -2470,6 +2538,60 @@ func TestFinalizeBlockCalled(t *testing.T) {
 	}
 }
 
+// TestOptimisticExecution tests that, with optimistic execution enabled, the
+// proposal block is executed on the speculative connection once accepted by
+// ProcessProposal, before being committed, and then on the consensus
+// connection once committed.
+func TestOptimisticExecution(t *testing.T) {
+	m := abcimocks.NewApplication(t)
+	m.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ProcessProposalResponse{
+		Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+	}, nil)
+	m.On("PrepareProposal", mock.Anything, mock.Anything).Return(&abci.PrepareProposalResponse{}, nil)
+	m.On("ExtendVote", mock.Anything, mock.Anything).Return(&abci.ExtendVoteResponse{}, nil)
+	m.On("VerifyVoteExtension", mock.Anything, mock.Anything).Return(&abci.VerifyVoteExtensionResponse{
+		Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+	}, nil)
+	r := &abci.FinalizeBlockResponse{AppHash: []byte("the_hash")}
+	var finalized atomic.Int32
+	m.On("FinalizeBlock", mock.Anything, mock.Anything).Return(r, nil).Run(func(mock.Arguments) {
+		finalized.Add(1)
+	})
+	m.On("Commit", mock.Anything, mock.Anything).Return(&abci.CommitResponse{}, nil)
+	m.On("Info", mock.Anything, mock.Anything).Return(&abci.InfoResponse{}, nil).Maybe()
+
+	cs1, vss := randStateWithApp(4, m)
+	cs1.config.OptimisticExecution = true
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pv1.Address())
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	blockID := types.BlockID{
+		Hash:          rs.ProposalBlock.Hash(),
+		PartSetHeader: rs.ProposalBlockParts.Header(),
+	}
+	ensurePrevoteMatch(t, voteCh, height, round, blockID.Hash)
+
+	// The block is executed while it is being voted on.
+	require.Eventually(t, func() bool { return finalized.Load() == 1 }, ensureTimeout, 10*time.Millisecond)
+
+	signAddVotes(cs1, types.PrevoteType, chainID, blockID, false, vss[1:]...)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, types.PrecommitType, chainID, blockID, true, vss[1:]...)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	assert.EqualValues(t, 2, finalized.Load())
+	m.AssertCalled(t, "Commit", mock.Anything, mock.Anything)
+}
+
 // TestVoteExtensionEnableHeight tests that 'ExtensionRequireHeight' correctly
 // enforces that vote extensions be present in consensus for heights greater than
 // or equal to the configured value.
@@ -0,0 +1,221 @@
+package consensus
+
+import (
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TraceEventType is the type of an event of a height timeline.
+type TraceEventType string
+
+const (
+	TraceEventRound     TraceEventType = "round"
+	TraceEventStep      TraceEventType = "step"
+	TraceEventProposal  TraceEventType = "proposal"
+	TraceEventBlockPart TraceEventType = "block_part"
+	TraceEventVote      TraceEventType = "vote"
+	TraceEventTimeout   TraceEventType = "timeout"
+)
+
+// TraceEvent is an event of the consensus at a given height.
+type TraceEvent struct {
+	Type  TraceEventType `json:"type"`
+	Time  time.Time      `json:"time"`
+	Round int32          `json:"round"`
+	// Time elapsed since the start of Round, or since the start of the height
+	// if the round was not started when the event occurred.
+	Latency time.Duration `json:"latency"`
+
+	// Step entered, or step of the timeout.
+	Step string `json:"step,omitempty"`
+	// Duration of the timeout.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Peer the proposal, block part or vote was received from. Empty if it
+	// comes from this node.
+	Peer p2p.ID `json:"peer,omitempty"`
+	// Address of the validator that signed the proposal or the vote.
+	Validator types.Address `json:"validator,omitempty"`
+	// Type of the vote.
+	VoteType string `json:"vote_type,omitempty"`
+	// Index of the block part.
+	PartIndex *uint32 `json:"part_index,omitempty"`
+}
+
+// maxTraceEventsPerHeight is the maximum number of events kept in the
+// timeline of a height, so that a height with many rounds, or peers flooding
+// the node with votes, cannot make the trace grow unbounded.
+const maxTraceEventsPerHeight = 10000
+
+// HeightTimeline is the sequence of the events of the consensus at a height.
+type HeightTimeline struct {
+	Height    int64        `json:"height"`
+	StartTime time.Time    `json:"start_time"`
+	Events    []TraceEvent `json:"events"`
+	// Number of events not recorded because the timeline was full.
+	DroppedEvents int `json:"dropped_events,omitempty"`
+
+	roundStarts map[int32]time.Time
+}
+
+func (tl *HeightTimeline) add(ev TraceEvent) {
+	start, ok := tl.roundStarts[ev.Round]
+	if !ok {
+		start = tl.StartTime
+	}
+	if len(tl.Events) >= maxTraceEventsPerHeight {
+		tl.DroppedEvents++
+		return
+	}
+	ev.Latency = ev.Time.Sub(start)
+	tl.Events = append(tl.Events, ev)
+}
+
+func (tl *HeightTimeline) copy() *HeightTimeline {
+	return &HeightTimeline{
+		Height:        tl.Height,
+		StartTime:     tl.StartTime,
+		Events:        append([]TraceEvent(nil), tl.Events...),
+		DroppedEvents: tl.DroppedEvents,
+	}
+}
+
+// heightTracer keeps the timelines of the most recent heights in a ring
+// buffer. A nil heightTracer records nothing.
+type heightTracer struct {
+	mtx       cmtsync.Mutex
+	timelines []*HeightTimeline // oldest first
+	size      int
+}
+
+func newHeightTracer(numHeights int) *heightTracer {
+	if numHeights <= 0 {
+		return nil
+	}
+	return &heightTracer{size: numHeights}
+}
+
+// timeline returns the timeline of the given height, if it is still
+// buffered. CONTRACT: t.mtx must be held.
+func (t *heightTracer) timeline(height int64) *HeightTimeline {
+	for i := len(t.timelines) - 1; i >= 0; i-- {
+		if t.timelines[i].Height == height {
+			return t.timelines[i]
+		}
+	}
+	return nil
+}
+
+func (t *heightTracer) record(height int64, ev TraceEvent) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if tl := t.timeline(height); tl != nil {
+		tl.add(ev)
+	}
+}
+
+func (t *heightTracer) startHeight(height int64, now time.Time) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.timeline(height) != nil {
+		return
+	}
+	if len(t.timelines) == t.size {
+		t.timelines[0] = nil
+		t.timelines = t.timelines[1:]
+	}
+	t.timelines = append(t.timelines, &HeightTimeline{
+		Height:      height,
+		StartTime:   now,
+		Events:      []TraceEvent{},
+		roundStarts: make(map[int32]time.Time),
+	})
+}
+
+func (t *heightTracer) startRound(height int64, round int32, now time.Time) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	tl := t.timeline(height)
+	if tl == nil {
+		return
+	}
+	if _, ok := tl.roundStarts[round]; !ok {
+		tl.roundStarts[round] = now
+	}
+	tl.add(TraceEvent{Type: TraceEventRound, Time: now, Round: round})
+}
+
+func (t *heightTracer) step(height int64, round int32, step cstypes.RoundStepType, now time.Time) {
+	t.record(height, TraceEvent{Type: TraceEventStep, Time: now, Round: round, Step: step.String()})
+}
+
+func (t *heightTracer) proposal(proposal *types.Proposal, proposer types.Address, peerID p2p.ID, now time.Time) {
+	t.record(proposal.Height, TraceEvent{
+		Type:      TraceEventProposal,
+		Time:      now,
+		Round:     proposal.Round,
+		Peer:      peerID,
+		Validator: proposer,
+	})
+}
+
+func (t *heightTracer) blockPart(msg *BlockPartMessage, peerID p2p.ID, now time.Time) {
+	index := msg.Part.Index
+	t.record(msg.Height, TraceEvent{
+		Type:      TraceEventBlockPart,
+		Time:      now,
+		Round:     msg.Round,
+		Peer:      peerID,
+		PartIndex: &index,
+	})
+}
+
+func (t *heightTracer) vote(vote *types.Vote, peerID p2p.ID, now time.Time) {
+	t.record(vote.Height, TraceEvent{
+		Type:      TraceEventVote,
+		Time:      now,
+		Round:     vote.Round,
+		Peer:      peerID,
+		Validator: vote.ValidatorAddress,
+		VoteType:  types.SignedMsgTypeToShortString(vote.Type),
+	})
+}
+
+func (t *heightTracer) timeout(ti timeoutInfo, now time.Time) {
+	t.record(ti.Height, TraceEvent{
+		Type:     TraceEventTimeout,
+		Time:     now,
+		Round:    ti.Round,
+		Step:     ti.Step.String(),
+		Duration: ti.Duration,
+	})
+}
+
+// Timelines returns a copy of the timeline of the given height, or of all the
+// buffered heights if height is 0.
+func (t *heightTracer) Timelines(height int64) []*HeightTimeline {
+	if t == nil {
+		return []*HeightTimeline{}
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	timelines := make([]*HeightTimeline, 0, len(t.timelines))
+	for _, tl := range t.timelines {
+		if height == 0 || tl.Height == height {
+			timelines = append(timelines, tl.copy())
+		}
+	}
+	return timelines
+}
@@ -0,0 +1,94 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestHeightTracerRingBuffer(t *testing.T) {
+	tracer := newHeightTracer(2)
+	start := time.Now()
+	for h := int64(1); h <= 3; h++ {
+		tracer.startHeight(h, start)
+	}
+
+	timelines := tracer.Timelines(0)
+	require.Len(t, timelines, 2)
+	assert.Equal(t, int64(2), timelines[0].Height)
+	assert.Equal(t, int64(3), timelines[1].Height)
+
+	// Events of heights no longer buffered are dropped.
+	tracer.step(1, 0, cstypes.RoundStepPropose, start)
+	assert.Empty(t, tracer.Timelines(1))
+
+	// Latencies are relative to the start of the round, or of the height if
+	// the round was not started.
+	tracer.startRound(3, 0, start.Add(time.Second))
+	tracer.vote(&types.Vote{Type: types.PrevoteType, Height: 3, Round: 0}, "peer", start.Add(3*time.Second))
+	tracer.vote(&types.Vote{Type: types.PrevoteType, Height: 3, Round: 1}, "peer", start.Add(4*time.Second))
+	timelines = tracer.Timelines(3)
+	require.Len(t, timelines, 1)
+	events := timelines[0].Events
+	require.Len(t, events, 3)
+	assert.Equal(t, TraceEventRound, events[0].Type)
+	assert.Equal(t, 2*time.Second, events[1].Latency)
+	assert.Equal(t, "prevote", events[1].VoteType)
+	assert.Equal(t, 4*time.Second, events[2].Latency)
+
+	// The events of a height are capped.
+	for i := 0; i < maxTraceEventsPerHeight; i++ {
+		tracer.step(3, 0, cstypes.RoundStepPrevote, start)
+	}
+	timelines = tracer.Timelines(3)
+	assert.Len(t, timelines[0].Events, maxTraceEventsPerHeight)
+	assert.Equal(t, 3, timelines[0].DroppedEvents)
+
+	// A disabled tracer records nothing.
+	tracer = newHeightTracer(0)
+	tracer.startHeight(1, start)
+	assert.Empty(t, tracer.Timelines(0))
+}
+
+func TestStateTrace(t *testing.T) {
+	cs, _ := randState(1)
+	height, round := cs.Height, cs.Round
+
+	voteCh := subscribe(cs.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	bz, err := cs.GetTraceJSON(height)
+	require.NoError(t, err)
+	var timelines []*HeightTimeline
+	require.NoError(t, cmtjson.Unmarshal(bz, &timelines))
+	require.Len(t, timelines, 1)
+
+	counts := make(map[TraceEventType]int)
+	for _, ev := range timelines[0].Events {
+		counts[ev.Type]++
+		assert.GreaterOrEqual(t, ev.Latency, time.Duration(0))
+		if ev.Type == TraceEventVote {
+			assert.Equal(t, cs.privValidatorPubKey.Address(), ev.Validator)
+		}
+	}
+	assert.Equal(t, 1, counts[TraceEventRound])
+	assert.Equal(t, 1, counts[TraceEventProposal])
+	assert.Positive(t, counts[TraceEventBlockPart])
+	assert.Equal(t, 2, counts[TraceEventVote])
+	assert.Positive(t, counts[TraceEventStep])
+
+	_, err = cs.GetTraceJSON(height + 100)
+	require.ErrorAs(t, err, &ErrHeightNotTraced{})
+}
@@ -101,6 +101,16 @@ type RoundState struct {
 	LastCommit                *types.VoteSet      `json:"last_commit"`  // Last precommits at Height-1
 	LastValidators            *types.ValidatorSet `json:"last_validators"`
 	TriggeredTimeoutPrecommit bool                `json:"triggered_timeout_precommit"`
+	Timeouts                  RoundTimeouts       `json:"timeouts"` // Timeouts of Round
+}
+
+// RoundTimeouts are the timeouts of the steps of a round.
+type RoundTimeouts struct {
+	// Whether the timeouts are derived from the observed latencies.
+	Adaptive  bool          `json:"adaptive"`
+	Propose   time.Duration `json:"propose"`
+	Prevote   time.Duration `json:"prevote"`
+	Precommit time.Duration `json:"precommit"`
 }
 
 // Compressed version of the RoundState for use in RPC.
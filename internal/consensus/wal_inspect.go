@@ -0,0 +1,313 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	auto "github.com/cometbft/cometbft/internal/autofile"
+	"github.com/cometbft/cometbft/types"
+)
+
+// errStopWALWalk is returned by the callbacks of WalkWAL to stop the walk
+// early without failing it.
+var errStopWALWalk = errors.New("stop WAL walk")
+
+// WALEntry is a message decoded from a WAL file, along with its position
+// inside that file.
+type WALEntry struct {
+	File   string
+	Offset int64 // offset of the first byte of the entry in File
+	Size   int64 // size of the encoded entry, header included
+
+	// Height is the height the message belongs to. For the messages which do
+	// not carry one, it is derived from the last EndHeightMessage.
+	Height int64
+	// Round is the round the message belongs to, or -1 if it carries none.
+	Round int32
+	// Type is a short name of the message, e.g. "EndHeight" or "Vote".
+	Type string
+
+	Msg *TimedWALMessage
+}
+
+// WALCorruption describes a corrupted entry found in a WAL file.
+type WALCorruption struct {
+	File   string `json:"file"`
+	Offset int64  `json:"offset"`
+	Err    string `json:"error"`
+}
+
+// WalkWAL decodes the files of the WAL group with the given head path, oldest
+// first, and calls onEntry for every valid entry. The decoding of a file
+// stops at its first corrupted entry, which is passed to onCorruption if not
+// nil, and goes on with the next file of the group.
+//
+// The walk stops at the first error returned by a callback, which is returned.
+func WalkWAL(
+	walFile string,
+	onEntry func(*WALEntry) error,
+	onCorruption func(WALCorruption) error,
+) error {
+	paths, err := auto.GroupFilePaths(walFile)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no WAL found at %v", walFile)
+	}
+
+	var height int64
+	for _, path := range paths {
+		if err := walkWALFile(path, &height, onEntry, onCorruption); err != nil {
+			if errors.Is(err, errStopWALWalk) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func walkWALFile(
+	path string,
+	height *int64,
+	onEntry func(*WALEntry) error,
+	onCorruption func(WALCorruption) error,
+) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	cr := &countingReader{rd: f}
+	dec := NewWALDecoder(cr)
+	for {
+		offset := cr.n
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if IsDataCorruptionError(err) {
+			if onCorruption == nil {
+				return nil
+			}
+			return onCorruption(WALCorruption{File: path, Offset: offset, Err: err.Error()})
+		} else if err != nil {
+			return err
+		}
+
+		entry := &WALEntry{
+			File:   path,
+			Offset: offset,
+			Size:   cr.n - offset,
+			Round:  -1,
+			Type:   walMessageType(msg.Msg),
+			Msg:    msg,
+		}
+		if h, r, ok := walMessageHeightRound(msg.Msg); ok {
+			*height = h
+			entry.Round = r
+		}
+		entry.Height = *height
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			entry.Height = m.Height
+			*height = m.Height + 1
+		}
+
+		if err := onEntry(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// walMessageType returns a short name for the type of a WAL message.
+func walMessageType(msg WALMessage) string {
+	switch msg := msg.(type) {
+	case EndHeightMessage:
+		return "EndHeight"
+	case timeoutInfo:
+		return "Timeout"
+	case types.EventDataRoundState:
+		return "RoundState"
+	case msgInfo:
+		t := reflect.TypeOf(msg.Msg)
+		if t == nil {
+			return "MsgInfo"
+		}
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		return strings.TrimSuffix(t.Name(), "Message")
+	default:
+		return fmt.Sprintf("%T", msg)
+	}
+}
+
+// walMessageHeightRound returns the height and round carried by a WAL
+// message, if any.
+func walMessageHeightRound(msg WALMessage) (height int64, round int32, ok bool) {
+	switch msg := msg.(type) {
+	case timeoutInfo:
+		return msg.Height, msg.Round, true
+	case types.EventDataRoundState:
+		return msg.Height, msg.Round, true
+	case msgInfo:
+		switch m := msg.Msg.(type) {
+		case *ProposalMessage:
+			return m.Proposal.Height, m.Proposal.Round, true
+		case *BlockPartMessage:
+			return m.Height, m.Round, true
+		case *VoteMessage:
+			return m.Vote.Height, m.Vote.Round, true
+		case *CompactBlockMessage:
+			return m.Height, m.Round, true
+		}
+	}
+	return 0, -1, false
+}
+
+// WALVerifyReport is the result of the verification of a WAL.
+type WALVerifyReport struct {
+	Files         []string        `json:"files"`
+	Entries       int             `json:"entries"`
+	LastEndHeight int64           `json:"last_end_height"`
+	Corruptions   []WALCorruption `json:"corruptions"`
+}
+
+// OK returns true if no corrupted entry was found.
+func (r *WALVerifyReport) OK() bool {
+	return len(r.Corruptions) == 0
+}
+
+// VerifyWAL decodes all the files of the WAL group with the given head path,
+// checking the checksum and length of each entry, and reports the position of
+// the first corrupted entry of every file.
+func VerifyWAL(walFile string) (*WALVerifyReport, error) {
+	report := &WALVerifyReport{Files: []string{}, Corruptions: []WALCorruption{}}
+	lastFile := ""
+	err := WalkWAL(walFile,
+		func(entry *WALEntry) error {
+			if entry.File != lastFile {
+				report.Files = append(report.Files, entry.File)
+				lastFile = entry.File
+			}
+			report.Entries++
+			if m, ok := entry.Msg.Msg.(EndHeightMessage); ok {
+				report.LastEndHeight = m.Height
+			}
+			return nil
+		},
+		func(c WALCorruption) error {
+			if c.File != lastFile {
+				report.Files = append(report.Files, c.File)
+				lastFile = c.File
+			}
+			report.Corruptions = append(report.Corruptions, c)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// WALTruncation describes the truncation of a corrupted WAL.
+type WALTruncation struct {
+	Corruption WALCorruption `json:"corruption"`
+	// Height of the last valid EndHeightMessage, which the WAL now ends with.
+	Height int64 `json:"height"`
+	// File and Offset the WAL was truncated at.
+	File   string `json:"file"`
+	Offset int64  `json:"offset"`
+	// RemovedFiles are the files of the group following the truncated one.
+	RemovedFiles []string `json:"removed_files"`
+}
+
+// TruncateWAL truncates the WAL group with the given head path right after
+// the last valid EndHeightMessage preceding its first corrupted entry. The
+// files of the group following the truncated one are removed, and the
+// truncated file becomes the head of the group.
+//
+// It returns nil if the WAL is not corrupted. If dryRun is set, the
+// truncation is computed but the WAL is left untouched.
+//
+// The node must be stopped.
+func TruncateWAL(walFile string, dryRun bool) (*WALTruncation, error) {
+	var (
+		truncation *WALTruncation
+		lastEnd    *WALEntry
+	)
+	err := WalkWAL(walFile,
+		func(entry *WALEntry) error {
+			if _, ok := entry.Msg.Msg.(EndHeightMessage); ok {
+				lastEnd = entry
+			}
+			return nil
+		},
+		func(c WALCorruption) error {
+			truncation = &WALTruncation{Corruption: c, RemovedFiles: []string{}}
+			return errStopWALWalk
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if truncation == nil {
+		return nil, nil
+	}
+	if lastEnd == nil {
+		return nil, fmt.Errorf("no valid EndHeightMessage before the corrupted entry at %v:%d",
+			truncation.Corruption.File, truncation.Corruption.Offset)
+	}
+	truncation.Height = lastEnd.Msg.Msg.(EndHeightMessage).Height
+	truncation.File = lastEnd.File
+	truncation.Offset = lastEnd.Offset + lastEnd.Size
+
+	paths, err := auto.GroupFilePaths(walFile)
+	if err != nil {
+		return nil, err
+	}
+	truncated := false
+	for _, path := range paths {
+		if truncated {
+			truncation.RemovedFiles = append(truncation.RemovedFiles, path)
+		}
+		truncated = truncated || path == truncation.File
+	}
+	if dryRun {
+		return truncation, nil
+	}
+
+	if err := os.Truncate(truncation.File, truncation.Offset); err != nil {
+		return nil, err
+	}
+	for _, path := range truncation.RemovedFiles {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	if truncation.File != walFile {
+		if err := os.Rename(truncation.File, walFile); err != nil {
+			return nil, err
+		}
+	}
+	return truncation, nil
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	rd io.Reader
+	n  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.rd.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,106 @@
+package consensus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWALInspect(t *testing.T) {
+	walBody, err := WALWithNBlocks(t, 4, getConfig(t))
+	require.NoError(t, err)
+
+	// Split the WAL into a rotated file and the head, at the first entry of
+	// the second height.
+	tmpFile := tempWALWithData(walBody)
+	defer os.Remove(tmpFile)
+	var split int64
+	err = WalkWAL(tmpFile, func(entry *WALEntry) error {
+		if split == 0 && entry.Height == 2 {
+			split = entry.Offset
+		}
+		return nil
+	}, nil)
+	require.NoError(t, err)
+	require.NotZero(t, split)
+
+	walFile := filepath.Join(t.TempDir(), "wal")
+	require.NoError(t, os.WriteFile(walFile+".000", walBody[:split], 0o600))
+	require.NoError(t, os.WriteFile(walFile, walBody[split:], 0o600))
+
+	t.Run("walk", func(t *testing.T) {
+		var (
+			endHeights []int64
+			files      []string
+		)
+		err := WalkWAL(walFile, func(entry *WALEntry) error {
+			if len(files) == 0 || files[len(files)-1] != entry.File {
+				files = append(files, entry.File)
+			}
+			if m, ok := entry.Msg.Msg.(EndHeightMessage); ok {
+				assert.Equal(t, "EndHeight", entry.Type)
+				assert.Equal(t, m.Height, entry.Height)
+				endHeights = append(endHeights, m.Height)
+			}
+			if vote, ok := entry.Msg.Msg.(msgInfo); ok && entry.Type == "Vote" {
+				assert.Equal(t, vote.Msg.(*VoteMessage).Vote.Height, entry.Height)
+				assert.Equal(t, vote.Msg.(*VoteMessage).Vote.Round, entry.Round)
+			}
+			return nil
+		}, nil)
+		require.NoError(t, err)
+		for i := range endHeights {
+			assert.Equal(t, int64(i), endHeights[i])
+		}
+		assert.Equal(t, []string{walFile + ".000", walFile}, files)
+	})
+
+	report, err := VerifyWAL(walFile)
+	require.NoError(t, err)
+	require.True(t, report.OK())
+	lastEndHeight := report.LastEndHeight
+	require.Positive(t, lastEndHeight)
+
+	truncation, err := TruncateWAL(walFile, false)
+	require.NoError(t, err)
+	assert.Nil(t, truncation, "a valid WAL must not be truncated")
+
+	// Corrupt the checksum of the last entry of the rotated file.
+	var last *WALEntry
+	err = WalkWAL(walFile, func(entry *WALEntry) error {
+		if entry.File == walFile+".000" {
+			last = entry
+		}
+		return nil
+	}, nil)
+	require.NoError(t, err)
+	rotated, err := os.ReadFile(walFile + ".000")
+	require.NoError(t, err)
+	rotated[last.Offset] ^= 0xFF
+	require.NoError(t, os.WriteFile(walFile+".000", rotated, 0o600))
+
+	report, err = VerifyWAL(walFile)
+	require.NoError(t, err)
+	require.Len(t, report.Corruptions, 1)
+	assert.Equal(t, walFile+".000", report.Corruptions[0].File)
+	assert.Equal(t, last.Offset, report.Corruptions[0].Offset)
+
+	truncation, err = TruncateWAL(walFile, false)
+	require.NoError(t, err)
+	require.NotNil(t, truncation)
+	assert.Equal(t, walFile+".000", truncation.File)
+	assert.Equal(t, []string{walFile}, truncation.RemovedFiles)
+	assert.Less(t, truncation.Height, lastEndHeight)
+
+	// The rotated file is now the head, ending with the EndHeightMessage.
+	_, err = os.Stat(walFile + ".000")
+	assert.True(t, os.IsNotExist(err))
+	report, err = VerifyWAL(walFile)
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, truncation.Height, report.LastEndHeight)
+	assert.Equal(t, []string{walFile}, report.Files)
+}
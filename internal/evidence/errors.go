@@ -22,6 +22,10 @@ type (
 		Height int64
 	}
 
+	ErrNoBlockAtHeight struct {
+		Height int64
+	}
+
 	ErrUnrecognizedEvidenceType struct {
 		Evidence types.Evidence
 	}
@@ -66,6 +70,12 @@ type (
 		VoteA types.Vote
 		VoteB types.Vote
 	}
+
+	// ErrDuplicateProposalHRMismatch is returned when duplicate proposal evidence's proposals are not from the same height or round.
+	ErrDuplicateProposalHRMismatch struct {
+		ProposalA types.Proposal
+		ProposalB types.Proposal
+	}
 )
 
 func (e ErrNoHeaderAtHeight) Error() string {
@@ -76,6 +86,10 @@ func (e ErrNoCommitAtHeight) Error() string {
 	return fmt.Sprintf("don't have commit at height #%d", e.Height)
 }
 
+func (e ErrNoBlockAtHeight) Error() string {
+	return fmt.Sprintf("don't have block at height #%d", e.Height)
+}
+
 func (e ErrUnrecognizedEvidenceType) Error() string {
 	return fmt.Sprintf("unrecognized evidence type: %T", e.Evidence)
 }
@@ -123,3 +137,9 @@ func (e ErrDuplicateEvidenceHRTMismatch) Error() string {
 		e.VoteA.Height, e.VoteA.Round, e.VoteA.Type,
 		e.VoteB.Height, e.VoteB.Round, e.VoteB.Type)
 }
+
+func (e ErrDuplicateProposalHRMismatch) Error() string {
+	return fmt.Sprintf("h/r does not match: %d/%d vs %d/%d",
+		e.ProposalA.Height, e.ProposalA.Round,
+		e.ProposalB.Height, e.ProposalB.Round)
+}
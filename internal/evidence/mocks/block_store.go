@@ -30,6 +30,38 @@ func (_m *BlockStore) Height() int64 {
 	return r0
 }
 
+// LoadBlock provides a mock function with given fields: height
+func (_m *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadBlock")
+	}
+
+	var r0 *types.Block
+	var r1 *types.BlockMeta
+	if rf, ok := ret.Get(0).(func(int64) (*types.Block, *types.BlockMeta)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *types.Block); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) *types.BlockMeta); ok {
+		r1 = rf(height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*types.BlockMeta)
+		}
+	}
+
+	return r0, r1
+}
+
 // LoadBlockCommit provides a mock function with given fields: height
 func (_m *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 	ret := _m.Called(height)
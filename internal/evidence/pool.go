@@ -2,6 +2,7 @@ package evidence
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -15,6 +16,7 @@ import (
 	cmtdb "github.com/cometbft/cometbft/db"
 	"github.com/cometbft/cometbft/internal/clist"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
 	cmterrors "github.com/cometbft/cometbft/types/errors"
@@ -40,11 +42,31 @@ type Pool struct {
 	// before being flushed to the pool. This prevents broadcasting and proposing of
 	// evidence before the height with which the evidence happened is finished.
 	consensusBuffer []duplicateVoteSet
+	// conflicting proposals from consensus are buffered alike.
+	proposalBuffer []duplicateProposalSet
 
 	pruningHeight int64
 	pruningTime   time.Time
 
 	dbKeyLayout KeyLayout
+
+	eventBus types.EvidenceEventPublisher
+
+	// peers the evidence was received from, by evidence hash, until the
+	// evidence expires. They are not persisted.
+	sourcesMtx sync.Mutex
+	sources    map[string]evidenceSource
+
+	// results of verifying the pending evidence, by evidence hash, so it is
+	// not verified again when inspected. They are not persisted.
+	verifiedMtx sync.Mutex
+	verified    map[string]error
+}
+
+type evidenceSource struct {
+	peer   p2p.ID
+	height int64
+	time   time.Time
 }
 
 func isEmpty(evidenceDB cmtdb.DB) bool {
@@ -113,6 +135,10 @@ func NewPool(evidenceDB cmtdb.DB, stateDB sm.Store, blockStore BlockStore, optio
 		evidenceStore:   evidenceDB,
 		evidenceList:    clist.New(),
 		consensusBuffer: make([]duplicateVoteSet, 0),
+		proposalBuffer:  make([]duplicateProposalSet, 0),
+		eventBus:        types.NopEventBus{},
+		sources:         make(map[string]evidenceSource),
+		verified:        make(map[string]error),
 	}
 
 	for _, option := range options {
@@ -153,8 +179,8 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
-//  1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
-//     DuplicateVoteEvidence and add it to the pool.
+//  1. Take any conflicting votes and proposals from consensus and use the state's LastBlockTime
+//     to form DuplicateVoteEvidence and DuplicateProposalEvidence and add it to the pool.
 //  2. Update the pool's state which contains evidence params relating to expiry.
 //  3. Moves pending evidence that has now been committed into the committed pool.
 //  4. Removes any expired evidence based on both height and time.
@@ -170,8 +196,8 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.logger.Debug("Updating evidence pool", "last_block_height", state.LastBlockHeight,
 		"last_block_time", state.LastBlockTime)
 
-	// flush conflicting vote pairs from the buffer, producing DuplicateVoteEvidence and
-	// adding it to the pool
+	// flush conflicting vote and proposal pairs from the buffers, producing DuplicateVoteEvidence
+	// and DuplicateProposalEvidence and adding it to the pool
 	evpool.processConsensusBuffer(state)
 	// update state
 	evpool.updateState(state)
@@ -184,11 +210,21 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 		state.LastBlockTime.After(evpool.pruningTime) {
 		evpool.pruningHeight, evpool.pruningTime = evpool.removeExpiredPendingEvidence()
 	}
+
+	// forget the peers committed evidence was received from once it has expired
+	evpool.removeExpiredSources()
 }
 
 // AddEvidence checks the evidence is valid and adds it to the pool.
 func (evpool *Pool) AddEvidence(ev types.Evidence) error {
-	evpool.logger.Info("Attempting to add evidence", "ev", ev)
+	return evpool.AddEvidenceFromPeer(ev, "")
+}
+
+// AddEvidenceFromPeer checks the evidence received from the given peer is
+// valid and adds it to the pool, recording the peer it came from. If the
+// evidence is already pending, the peer it first came from is kept.
+func (evpool *Pool) AddEvidenceFromPeer(ev types.Evidence, peerID p2p.ID) error {
+	evpool.logger.Info("Attempting to add evidence", "ev", ev, "peer", peerID)
 
 	// We have already verified this piece of evidence - no need to do it again
 	if evpool.isPending(ev) {
@@ -220,6 +256,13 @@ func (evpool *Pool) AddEvidence(ev types.Evidence) error {
 
 	evpool.logger.Info("Verified new evidence of byzantine behavior", "evidence", ev)
 
+	if peerID != "" {
+		evpool.sourcesMtx.Lock()
+		evpool.sources[evMapKey(ev)] = evidenceSource{peer: peerID, height: ev.Height(), time: ev.Time()}
+		evpool.sourcesMtx.Unlock()
+	}
+	evpool.publishEvidenceEvent(types.EventEvidenceAdded, ev)
+
 	return nil
 }
 
@@ -241,6 +284,24 @@ func (evpool *Pool) ReportConflictingVotes(voteA, voteB *types.Vote) {
 	})
 }
 
+// ReportConflictingProposals takes two conflicting proposals signed by the
+// validator of the given address and forms duplicate proposal evidence, adding
+// it eventually to the evidence pool.
+//
+// As for conflicting votes, the evidence is formed once consensus at the height
+// of the proposals has been reached and `Update()` with the new state called.
+//
+// Proposals are not verified.
+func (evpool *Pool) ReportConflictingProposals(proposalA, proposalB *types.Proposal, proposerAddress types.Address) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.proposalBuffer = append(evpool.proposalBuffer, duplicateProposalSet{
+		ProposalA:       proposalA,
+		ProposalB:       proposalB,
+		ProposerAddress: proposerAddress,
+	})
+}
+
 // CheckEvidence takes an array of evidence from a block and verifies all the evidence there.
 // If it has already verified the evidence then it jumps to the next one. It ensures that no
 // evidence has already been committed or is being proposed twice. It also adds any
@@ -267,6 +328,8 @@ func (evpool *Pool) CheckEvidence(evList types.EvidenceList) error {
 				// Something went wrong with adding the evidence but we already know it is valid
 				// hence we log an error and continue
 				evpool.logger.Error("Can't add evidence to pending list", "err", err, "ev", ev)
+			} else {
+				evpool.publishEvidenceEvent(types.EventEvidenceAdded, ev)
 			}
 
 			evpool.logger.Info("Check evidence: verified evidence of byzantine behavior", "evidence", ev)
@@ -284,6 +347,99 @@ func (evpool *Pool) CheckEvidence(evList types.EvidenceList) error {
 	return nil
 }
 
+// Status tells whether evidence is pending or committed.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusCommitted Status = "committed"
+)
+
+// Info describes evidence held by the pool.
+type Info struct {
+	Evidence types.Evidence
+	Status   Status
+	// VerifyErr is the error verifying pending evidence when it was added to
+	// the pool, or the error that it has expired since, nil if the evidence
+	// is valid. Committed evidence is not verified again.
+	VerifyErr error
+	// The evidence expires once the last block height is greater than
+	// ExpiryHeight and the last block time is after ExpiryTime, according to
+	// the current evidence parameters.
+	ExpiryHeight int64
+	ExpiryTime   time.Time
+	// Peer the evidence was received from. It is empty if the evidence was not
+	// received from a peer, or before the node restarted.
+	Peer p2p.ID
+}
+
+// PendingEvidenceInfo returns all the pending evidence, from oldest to newest,
+// with the result of its verification when it was added to the pool, unless
+// it has expired since.
+func (evpool *Pool) PendingEvidenceInfo() ([]Info, error) {
+	evList, _, err := evpool.listEvidence(evpool.dbKeyLayout.PrefixToBytesPending(), -1)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]Info, len(evList))
+	for i, ev := range evList {
+		infos[i] = evpool.info(ev, StatusPending)
+		infos[i].VerifyErr = evpool.verification(ev)
+	}
+	return infos, nil
+}
+
+// verification returns the result of verifying the pending evidence, or an
+// error if it has expired. The evidence pending before the node restarted is
+// verified once, the first time it is inspected.
+func (evpool *Pool) verification(ev types.Evidence) error {
+	key := evMapKey(ev)
+	evpool.verifiedMtx.Lock()
+	err, ok := evpool.verified[key]
+	evpool.verifiedMtx.Unlock()
+	if !ok {
+		err = evpool.verify(ev)
+		evpool.setVerified(ev, err)
+	}
+	if err == nil && evpool.isExpired(ev.Height(), ev.Time()) {
+		return ErrInvalidEvidence{fmt.Errorf("evidence from height %d (created at: %v) has expired",
+			ev.Height(), ev.Time())}
+	}
+	return err
+}
+
+// setVerified caches the result of verifying the pending evidence.
+func (evpool *Pool) setVerified(ev types.Evidence, err error) {
+	evpool.verifiedMtx.Lock()
+	defer evpool.verifiedMtx.Unlock()
+	evpool.verified[evMapKey(ev)] = err
+}
+
+// CommittedEvidenceInfo returns the evidence committed in the block at the
+// given height.
+func (evpool *Pool) CommittedEvidenceInfo(height int64) ([]Info, error) {
+	block, _ := evpool.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, ErrNoBlockAtHeight{Height: height}
+	}
+	infos := make([]Info, len(block.Evidence.Evidence))
+	for i, ev := range block.Evidence.Evidence {
+		infos[i] = evpool.info(ev, StatusCommitted)
+	}
+	return infos, nil
+}
+
+func (evpool *Pool) info(ev types.Evidence, status Status) Info {
+	params := evpool.State().ConsensusParams.Evidence
+	return Info{
+		Evidence:     ev,
+		Status:       status,
+		ExpiryHeight: ev.Height() + params.MaxAgeNumBlocks,
+		ExpiryTime:   ev.Time().Add(params.MaxAgeDuration),
+		Peer:         evpool.source(ev),
+	}
+}
+
 // EvidenceFront goes to the first evidence in the clist.
 func (evpool *Pool) EvidenceFront() *clist.CElement {
 	return evpool.evidenceList.Front()
@@ -299,6 +455,12 @@ func (evpool *Pool) SetLogger(l log.Logger) {
 	evpool.logger = l
 }
 
+// SetEventBus sets the event bus on which the evidence added to the pool,
+// committed or expired is published.
+func (evpool *Pool) SetEventBus(b types.EvidenceEventPublisher) {
+	evpool.eventBus = b
+}
+
 // Size returns the number of evidence in the pool.
 func (evpool *Pool) Size() uint32 {
 	return atomic.LoadUint32(&evpool.evidenceSize)
@@ -365,6 +527,8 @@ func (evpool *Pool) addPendingEvidence(ev types.Evidence) error {
 		return fmt.Errorf("can't persist evidence: %w", err)
 	}
 	atomic.AddUint32(&evpool.evidenceSize, 1)
+	// the evidence is always verified before being added
+	evpool.setVerified(ev, nil)
 	return nil
 }
 
@@ -376,6 +540,10 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 		atomic.AddUint32(&evpool.evidenceSize, ^uint32(0))
 		evpool.logger.Debug("Deleted pending evidence", "evidence", evidence)
 	}
+
+	evpool.verifiedMtx.Lock()
+	delete(evpool.verified, evMapKey(evidence))
+	evpool.verifiedMtx.Unlock()
 }
 
 // markEvidenceAsCommitted processes all the evidence in the block, marking it as
@@ -401,7 +569,10 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 
 		if err := evpool.evidenceStore.Set(key, evBytes); err != nil {
 			evpool.logger.Error("Unable to save committed evidence", "err", err, "key(height/hash)", key)
+			continue
 		}
+
+		evpool.publishEvidenceEvent(types.EventEvidenceCommitted, ev)
 	}
 
 	// remove committed evidence from the clist
@@ -480,6 +651,7 @@ func (evpool *Pool) removeExpiredPendingEvidence() (int64, time.Time) {
 		}
 		evpool.removePendingEvidence(ev)
 		blockEvidenceMap[evMapKey(ev)] = struct{}{}
+		evpool.publishEvidenceEvent(types.EventEvidenceExpired, ev)
 	}
 	// We either have no pending evidence or all evidence has expired
 	if len(blockEvidenceMap) != 0 {
@@ -507,9 +679,10 @@ func (evpool *Pool) updateState(state sm.State) {
 	evpool.state = state
 }
 
-// processConsensusBuffer converts all the duplicate votes witnessed from consensus
-// into DuplicateVoteEvidence. It sets the evidence timestamp to the block height
-// from the most recently committed block.
+// processConsensusBuffer converts all the duplicate votes and proposals
+// witnessed from consensus into DuplicateVoteEvidence and
+// DuplicateProposalEvidence. It sets the evidence timestamp to the block time
+// of the height of the infraction.
 // Evidence is then added to the pool so as to be ready to be broadcasted and proposed.
 func (evpool *Pool) processConsensusBuffer(state sm.State) {
 	evpool.mtx.Lock()
@@ -517,77 +690,126 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 	for _, voteSet := range evpool.consensusBuffer {
 		// Check the height of the conflicting votes and fetch the corresponding time and validator set
 		// to produce the valid evidence
-		var (
-			dve *types.DuplicateVoteEvidence
-			err error
-		)
-		switch {
-		case voteSet.VoteA.Height == state.LastBlockHeight:
-			dve, err = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				state.LastBlockTime,
-				state.LastValidators,
-			)
-
-		case voteSet.VoteA.Height < state.LastBlockHeight:
-			var valSet *types.ValidatorSet
-			valSet, err = evpool.stateDB.LoadValidators(voteSet.VoteA.Height)
-			if err != nil {
-				evpool.logger.Error("failed to load validator set for conflicting votes", "height",
-					voteSet.VoteA.Height, "err", err,
-				)
-				continue
-			}
-			blockMeta := evpool.blockStore.LoadBlockMeta(voteSet.VoteA.Height)
-			if blockMeta == nil {
-				evpool.logger.Error("failed to load block time for conflicting votes", "height", voteSet.VoteA.Height)
-				continue
-			}
-			dve, err = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				blockMeta.Header.Time,
-				valSet,
-			)
-
-		default:
-			// evidence pool shouldn't expect to get votes from consensus of a height that is above the current
-			// state. If this error is seen then perhaps consider keeping the votes in the buffer and retry
-			// in following heights
-			evpool.logger.Error("inbound duplicate votes from consensus are of a greater height than current state",
-				"duplicate vote height", voteSet.VoteA.Height,
-				"state.LastBlockHeight", state.LastBlockHeight)
+		evTime, valSet, err := evpool.consensusEvidenceInfo(state, voteSet.VoteA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to process conflicting votes", "height", voteSet.VoteA.Height, "err", err)
 			continue
 		}
+		dve, err := types.NewDuplicateVoteEvidence(voteSet.VoteA, voteSet.VoteB, evTime, valSet)
 		if err != nil {
 			evpool.logger.Error("error in generating evidence from votes", "err", err)
 			continue
 		}
-
-		// check if we already have this evidence
-		if evpool.isPending(dve) {
-			evpool.logger.Info("evidence already pending; ignoring", "evidence", dve)
+		evpool.addConsensusEvidence(dve)
+	}
+	for _, proposalSet := range evpool.proposalBuffer {
+		evTime, valSet, err := evpool.consensusEvidenceInfo(state, proposalSet.ProposalA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to process conflicting proposals", "height", proposalSet.ProposalA.Height, "err", err)
 			continue
 		}
-
-		// check that the evidence is not already committed on chain
-		if evpool.isCommitted(dve) {
-			evpool.logger.Info("evidence already committed; ignoring", "evidence", dve)
+		dpe, err := types.NewDuplicateProposalEvidence(proposalSet.ProposalA, proposalSet.ProposalB,
+			proposalSet.ProposerAddress, evTime, valSet)
+		if err != nil {
+			evpool.logger.Error("error in generating evidence from proposals", "err", err)
 			continue
 		}
+		evpool.addConsensusEvidence(dpe)
+	}
+	// reset consensus buffers
+	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+	evpool.proposalBuffer = make([]duplicateProposalSet, 0)
+}
 
-		if err := evpool.addPendingEvidence(dve); err != nil {
-			evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
-			continue
+// consensusEvidenceInfo returns the block time and the validator set of the
+// given height, at most the last block height of the state, to form evidence
+// of a misbehavior witnessed by consensus at that height.
+func (evpool *Pool) consensusEvidenceInfo(state sm.State, height int64) (time.Time, *types.ValidatorSet, error) {
+	switch {
+	case height == state.LastBlockHeight:
+		return state.LastBlockTime, state.LastValidators, nil
+
+	case height < state.LastBlockHeight:
+		valSet, err := evpool.stateDB.LoadValidators(height)
+		if err != nil {
+			return time.Time{}, nil, fmt.Errorf("failed to load validator set: %w", err)
+		}
+		blockMeta := evpool.blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return time.Time{}, nil, errors.New("failed to load block time")
 		}
+		return blockMeta.Header.Time, valSet, nil
 
-		evpool.evidenceList.PushBack(dve)
+	default:
+		// evidence pool shouldn't expect to get evidence from consensus of a height that is above the current
+		// state. If this error is seen then perhaps consider keeping the evidence in the buffer and retry
+		// in following heights
+		return time.Time{}, nil, fmt.Errorf("evidence from consensus is of a greater height than current state (%d > %d)",
+			height, state.LastBlockHeight)
+	}
+}
 
-		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+// addConsensusEvidence adds evidence formed from consensus to the pool, unless
+// it is already pending or committed.
+func (evpool *Pool) addConsensusEvidence(ev types.Evidence) {
+	// check if we already have this evidence
+	if evpool.isPending(ev) {
+		evpool.logger.Info("evidence already pending; ignoring", "evidence", ev)
+		return
+	}
+
+	// check that the evidence is not already committed on chain
+	if evpool.isCommitted(ev) {
+		evpool.logger.Info("evidence already committed; ignoring", "evidence", ev)
+		return
+	}
+
+	if err := evpool.addPendingEvidence(ev); err != nil {
+		evpool.logger.Error("failed to flush evidence from consensus buffer to pending list", "err", err)
+		return
+	}
+
+	evpool.evidenceList.PushBack(ev)
+
+	evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", ev)
+
+	evpool.publishEvidenceEvent(types.EventEvidenceAdded, ev)
+}
+
+// removeExpiredSources forgets the peers of the evidence that has expired.
+func (evpool *Pool) removeExpiredSources() {
+	evpool.sourcesMtx.Lock()
+	defer evpool.sourcesMtx.Unlock()
+	for key, source := range evpool.sources {
+		if evpool.isExpired(source.height, source.time) {
+			delete(evpool.sources, key)
+		}
+	}
+}
+
+// source returns the peer the evidence was received from, if known.
+func (evpool *Pool) source(ev types.Evidence) p2p.ID {
+	evpool.sourcesMtx.Lock()
+	defer evpool.sourcesMtx.Unlock()
+	return evpool.sources[evMapKey(ev)].peer
+}
+
+// publishEvidenceEvent publishes an event of the given type about the
+// evidence. Errors are only logged.
+func (evpool *Pool) publishEvidenceEvent(eventType string, ev types.Evidence) {
+	data := types.EventDataEvidence{Evidence: ev, Peer: string(evpool.source(ev))}
+	var err error
+	switch eventType {
+	case types.EventEvidenceAdded:
+		err = evpool.eventBus.PublishEventEvidenceAdded(data)
+	case types.EventEvidenceCommitted:
+		err = evpool.eventBus.PublishEventEvidenceCommitted(data)
+	case types.EventEvidenceExpired:
+		err = evpool.eventBus.PublishEventEvidenceExpired(data)
+	}
+	if err != nil {
+		evpool.logger.Error("Failed to publish evidence event", "event", eventType, "err", err)
 	}
-	// reset consensus buffer
-	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
 }
 
 type duplicateVoteSet struct {
@@ -595,6 +817,12 @@ type duplicateVoteSet struct {
 	VoteB *types.Vote
 }
 
+type duplicateProposalSet struct {
+	ProposalA       *types.Proposal
+	ProposalB       *types.Proposal
+	ProposerAddress types.Address
+}
+
 func bytesToEv(evBytes []byte) (types.Evidence, error) {
 	var evpb cmtproto.Evidence
 	err := evpb.Unmarshal(evBytes)
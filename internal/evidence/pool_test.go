@@ -1,6 +1,7 @@
 package evidence_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/cometbft/cometbft/internal/evidence/mocks"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	"github.com/cometbft/cometbft/p2p"
 	sm "github.com/cometbft/cometbft/state"
 	smmocks "github.com/cometbft/cometbft/state/mocks"
 	"github.com/cometbft/cometbft/store"
@@ -200,6 +203,39 @@ func TestReportConflictingVotes(t *testing.T) {
 	require.NotNil(t, next)
 }
 
+func TestReportConflictingProposals(t *testing.T) {
+	var height int64 = 10
+
+	pool, pv := defaultTestPool(t, height)
+	val := types.NewValidator(pv.PrivKey.PubKey(), 10)
+	ev, err := types.NewMockDuplicateProposalEvidenceWithValidator(height+1, defaultEvidenceTime, pv, evidenceChainID)
+	require.NoError(t, err)
+
+	pool.ReportConflictingProposals(ev.ProposalA, ev.ProposalB, ev.ProposerAddress)
+
+	// shouldn't be able to submit the same evidence twice
+	pool.ReportConflictingProposals(ev.ProposalB, ev.ProposalA, ev.ProposerAddress)
+
+	// evidence from consensus should not be added immediately but reside in the consensus buffer
+	evList, evSize := pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Empty(t, evList)
+	require.Zero(t, evSize)
+
+	// move to next height and update state and evidence pool
+	state := pool.State()
+	state.LastBlockHeight++
+	state.LastBlockTime = ev.Time()
+	state.LastValidators = types.NewValidatorSet([]*types.Validator{val})
+	pool.Update(state, []types.Evidence{})
+
+	// should be able to retrieve evidence from pool
+	evList, _ = pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Equal(t, []types.Evidence{ev}, evList)
+
+	next := pool.EvidenceFront()
+	require.NotNil(t, next)
+}
+
 func TestEvidencePoolUpdate(t *testing.T) {
 	height := int64(21)
 	pool, val := defaultTestPool(t, height)
@@ -235,6 +271,161 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	}
 }
 
+func TestEvidencePoolInfoAndEvents(t *testing.T) {
+	height := int64(21)
+	pool, val := defaultTestPool(t, height)
+	state := pool.State()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	pool.SetEventBus(eventBus)
+	subscribe := func(query cmtpubsub.Query) types.Subscription {
+		sub, err := eventBus.Subscribe(context.Background(), "test", query, 10)
+		require.NoError(t, err)
+		return sub
+	}
+	added := subscribe(types.EventQueryEvidenceAdded)
+	committed := subscribe(types.EventQueryEvidenceCommitted)
+	expired := subscribe(types.EventQueryEvidenceExpired)
+	requireEvent := func(sub types.Subscription, ev types.Evidence, peer string) {
+		t.Helper()
+		select {
+		case msg := <-sub.Out():
+			data := msg.Data().(types.EventDataEvidence)
+			assert.Equal(t, ev.Hash(), data.Evidence.Hash())
+			assert.Equal(t, peer, data.Peer)
+		case <-time.After(time.Second):
+			t.Fatal("did not receive an evidence event after 1 sec.")
+		}
+	}
+
+	ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime.Add(21*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidenceFromPeer(ev, "peer1"))
+	requireEvent(added, ev, "peer1")
+	oldEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(2, defaultEvidenceTime.Add(2*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(oldEv))
+	requireEvent(added, oldEv, "")
+
+	infos, err := pool.PendingEvidenceInfo()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	for _, info := range infos {
+		assert.Equal(t, evidence.StatusPending, info.Status)
+		require.NoError(t, info.VerifyErr)
+		assert.Equal(t, info.Evidence.Height()+20, info.ExpiryHeight)
+		assert.Equal(t, info.Evidence.Time().Add(20*time.Minute), info.ExpiryTime)
+		if info.Evidence.Height() == height {
+			assert.Equal(t, p2p.ID("peer1"), info.Peer)
+		} else {
+			assert.Empty(t, info.Peer)
+		}
+	}
+
+	// The evidence is committed, and the peer it came from is remembered.
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = defaultEvidenceTime.Add(22 * time.Minute)
+	pool.Update(state, types.EvidenceList{ev})
+	requireEvent(committed, ev, "peer1")
+
+	// The old evidence expires before being committed.
+	state.LastBlockHeight = height + 3
+	state.LastBlockTime = defaultEvidenceTime.Add(24 * time.Minute)
+	pool.Update(state, nil)
+	requireEvent(expired, oldEv, "")
+	infos, err = pool.PendingEvidenceInfo()
+	require.NoError(t, err)
+	assert.Empty(t, infos)
+}
+
+func TestPendingEvidenceInfoIsNotVerifiedAgain(t *testing.T) {
+	var (
+		height     = int64(1)
+		stateStore = &smmocks.Store{}
+		blockStore = &mocks.BlockStore{}
+	)
+
+	evidenceDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+
+	valSet, privVals := types.RandValidatorSet(1, 10)
+	blockStore.On("LoadBlockMeta", mock.AnythingOfType("int64")).Return(
+		&types.BlockMeta{Header: types.Header{Time: defaultEvidenceTime}},
+	)
+	stateStore.On("LoadValidators", mock.AnythingOfType("int64")).Return(valSet, nil)
+	stateStore.On("Load").Return(createState(height+1, valSet), nil)
+
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+	ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime, privVals[0], evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(ev))
+	blockStore.AssertNumberOfCalls(t, "LoadBlockMeta", 1)
+
+	// The result of the verification when the evidence was added is reused.
+	for i := 0; i < 2; i++ {
+		infos, err := pool.PendingEvidenceInfo()
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.NoError(t, infos[0].VerifyErr)
+	}
+	blockStore.AssertNumberOfCalls(t, "LoadBlockMeta", 1)
+
+	// After a restart, the pending evidence is verified once.
+	pool, err = evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		infos, err := pool.PendingEvidenceInfo()
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.NoError(t, infos[0].VerifyErr)
+	}
+	blockStore.AssertNumberOfCalls(t, "LoadBlockMeta", 2)
+}
+
+func TestCommittedEvidenceInfo(t *testing.T) {
+	var (
+		height     = int64(2)
+		stateStore = &smmocks.Store{}
+		blockStore = &mocks.BlockStore{}
+	)
+
+	evidenceDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+
+	valSet, privVals := types.RandValidatorSet(1, 10)
+	ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(1, defaultEvidenceTime, privVals[0], evidenceChainID)
+	require.NoError(t, err)
+	block := types.MakeBlock(height, []types.Tx{}, &types.Commit{Height: height - 1}, []types.Evidence{ev})
+	blockStore.On("LoadBlock", height).Return(block, &types.BlockMeta{})
+	blockStore.On("LoadBlock", mock.AnythingOfType("int64")).Return(nil, nil)
+	stateStore.On("Load").Return(createState(height, valSet), nil)
+
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+
+	params := types.DefaultEvidenceParams()
+	infos, err := pool.CommittedEvidenceInfo(height)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	assert.Equal(t, ev, infos[0].Evidence)
+	assert.Equal(t, evidence.StatusCommitted, infos[0].Status)
+	require.NoError(t, infos[0].VerifyErr)
+	assert.Equal(t, 1+params.MaxAgeNumBlocks, infos[0].ExpiryHeight)
+	assert.Equal(t, defaultEvidenceTime.Add(params.MaxAgeDuration), infos[0].ExpiryTime)
+
+	_, err = pool.CommittedEvidenceInfo(height + 1)
+	require.ErrorIs(t, err, evidence.ErrNoBlockAtHeight{Height: height + 1})
+}
+
 func TestVerifyPendingEvidencePasses(t *testing.T) {
 	var height int64 = 1
 	pool, val := defaultTestPool(t, height)
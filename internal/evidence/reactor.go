@@ -81,7 +81,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 	}
 
 	for _, ev := range evis {
-		err := evR.evpool.AddEvidence(ev)
+		err := evR.evpool.AddEvidenceFromPeer(ev, e.Src.ID())
 		switch err.(type) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
@@ -96,9 +96,11 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 	}
 }
 
-// SetEventBus implements events.Eventable.
+// SetEventBus implements events.Eventable. The evidence pool publishes its
+// events on it.
 func (evR *Reactor) SetEventBus(b *types.EventBus) {
 	evR.eventBus = b
+	evR.evpool.SetEventBus(b)
 }
 
 // Modeled after the mempool routine.
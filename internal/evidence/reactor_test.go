@@ -66,6 +66,33 @@ func TestReactorBroadcastEvidence(t *testing.T) {
 	waitForEvidence(t, evList, pools)
 }
 
+// Duplicate proposal evidence is gossiped like any other evidence.
+func TestReactorBroadcastDuplicateProposalEvidence(t *testing.T) {
+	config := cfg.TestConfig()
+	n := 3
+
+	stateDBs := make([]sm.Store, n)
+	val := types.NewMockPV()
+	height := int64(10)
+	for i := 0; i < n; i++ {
+		stateDBs[i] = initializeValidatorState(val, height)
+	}
+
+	reactors, pools := makeAndConnectReactorsAndPools(config, stateDBs)
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			ps := peerState{height}
+			peer.Set(types.PeerStateKey, ps)
+		}
+	}
+
+	ev, err := types.NewMockDuplicateProposalEvidenceWithValidator(height-1,
+		time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pools[0].AddEvidence(ev))
+	waitForEvidence(t, types.EvidenceList{ev}, pools)
+}
+
 // We have two evidence reactors connected to one another but are at different heights.
 // Reactor 1 which is ahead receives a number of evidence. It should only send the evidence
 // that is below the height of the peer to that peer.
@@ -367,6 +394,18 @@ func exampleVote(t byte) *types.Vote {
 	}
 }
 
+func exampleProposal() *types.Proposal {
+	vote := exampleVote(byte(types.ProposalType))
+	return &types.Proposal{
+		Type:      types.ProposalType,
+		Height:    vote.Height,
+		Round:     vote.Round,
+		POLRound:  -1,
+		BlockID:   vote.BlockID,
+		Timestamp: vote.Timestamp,
+	}
+}
+
 //nolint:lll //ignore line length for tests
 func TestEvidenceVectors(t *testing.T) {
 	val := &types.Validator{
@@ -384,12 +423,24 @@ func TestEvidenceVectors(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	proposalB := exampleProposal()
+	proposalB.BlockID.Hash = tmhash.Sum([]byte("other_blockID_hash"))
+	duplProposal, err := types.NewDuplicateProposalEvidence(
+		exampleProposal(),
+		proposalB,
+		val.Address,
+		defaultEvidenceTime,
+		valSet,
+	)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		testName     string
 		evidenceList []types.Evidence
 		expBytes     string
 	}{
 		{"DuplicateVoteEvidence", []types.Evidence{dupl}, "0a85020a82020a79080210031802224a0a208b01023386c371778ecb6368573e539afc3cc860ec3a2f614e54fe5652f4fc80122608c0843d122072db3d959635dff1bb567bedaa70573392c5159666a3f8caf11e413aac52207a2a0b08b1d381d20510809dca6f32146af1f4111082efb388211bc72c55bcd61e9ac3d538d5bb031279080110031802224a0a208b01023386c371778ecb6368573e539afc3cc860ec3a2f614e54fe5652f4fc80122608c0843d122072db3d959635dff1bb567bedaa70573392c5159666a3f8caf11e413aac52207a2a0b08b1d381d20510809dca6f32146af1f4111082efb388211bc72c55bcd61e9ac3d538d5bb03180a200a2a060880dbaae105"},
+		{"DuplicateProposalEvidence", []types.Evidence{duplProposal}, "0afd011afa010a6a08201003180220ffffffffffffffffff012a4a0a208b01023386c371778ecb6368573e539afc3cc860ec3a2f614e54fe5652f4fc80122608c0843d122072db3d959635dff1bb567bedaa70573392c5159666a3f8caf11e413aac52207a320b08b1d381d20510809dca6f126a08201003180220ffffffffffffffffff012a4a0a20a163d567db2cdbedf5a8f5d8fe1fd5c45081f3bda83b4bee681cb51811c696f0122608c0843d122072db3d959635dff1bb567bedaa70573392c5159666a3f8caf11e413aac52207a320b08b1d381d20510809dca6f1a146af1f4111082efb388211bc72c55bcd61e9ac3d5200a280a32060880dbaae105"},
 	}
 
 	for _, tc := range testCases {
@@ -9,5 +9,6 @@ import (
 type BlockStore interface {
 	LoadBlockMeta(height int64) *types.BlockMeta
 	LoadBlockCommit(height int64) *types.Commit
+	LoadBlock(height int64) (*types.Block, *types.BlockMeta)
 	Height() int64
 }
@@ -54,6 +54,13 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		}
 		return VerifyDuplicateVote(ev, state.ChainID, valSet)
 
+	case *types.DuplicateProposalEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+		return VerifyDuplicateProposal(ev, state.ChainID, valSet)
+
 	case *types.LightClientAttackEvidence:
 		commonHeader, err := getSignedHeader(evpool.blockStore, evidence.Height())
 		if err != nil {
@@ -118,7 +125,20 @@ func VerifyLightClientAttack(
 	// In the case of lunatic attack there will be a different commonHeader height. Therefore the node perform a single
 	// verification jump between the common header and the conflicting one
 	if commonHeader.Height != e.ConflictingBlock.Height {
-		err := commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		var err error
+		if commit := e.ConflictingBlock.Commit; commit.IsAggregated() {
+			// All the signatures of an aggregated commit are verified at once,
+			// with the conflicting validator set as the common one may not
+			// include all the signers.
+			cache := types.NewSignatureCache()
+			if err := e.ConflictingBlock.ValidatorSet.VerifyCommitLightWithCache(trustedHeader.ChainID, commit.BlockID,
+				e.ConflictingBlock.Height, commit, cache); err != nil {
+				return ErrConflictingBlock{fmt.Errorf("invalid commit from conflicting block: %w", err)}
+			}
+			err = commonVals.VerifyCommitLightTrustingWithCache(trustedHeader.ChainID, commit, light.DefaultTrustLevel, cache)
+		} else {
+			err = commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, commit, light.DefaultTrustLevel)
+		}
 		if err != nil {
 			return ErrConflictingBlock{fmt.Errorf("skipping verification of conflicting block failed: %w", err)}
 		}
@@ -213,6 +233,53 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	return nil
 }
 
+// VerifyDuplicateProposal verifies DuplicateProposalEvidence against the state
+// of full node. This involves the following checks:
+//   - the proposer is in the validator set at the height of the evidence
+//   - the height and round of the proposals must be the same
+//   - the block ID's must be different
+//   - The signatures must both be valid
+//
+// Whether the validator was the proposer of the round is not checked: the
+// proposals are signed by its key, which must never sign conflicting ones.
+func VerifyDuplicateProposal(e *types.DuplicateProposalEvidence, chainID string, valSet *types.ValidatorSet) error {
+	_, val := valSet.GetByAddress(e.ProposerAddress)
+	if val == nil {
+		return ErrAddressNotValidatorAtHeight{Address: e.ProposerAddress, Height: e.Height()}
+	}
+	pubKey := val.PubKey
+
+	// H/R must be the same
+	if e.ProposalA.Height != e.ProposalB.Height || e.ProposalA.Round != e.ProposalB.Round {
+		return ErrDuplicateProposalHRMismatch{*e.ProposalA, *e.ProposalB}
+	}
+
+	// BlockIDs must be different
+	if e.ProposalA.BlockID.Equals(e.ProposalB.BlockID) {
+		return ErrSameBlockIDs{e.ProposalA.BlockID}
+	}
+
+	// validator voting power and total voting power must match
+	if val.VotingPower != e.ValidatorPower {
+		return ErrVotingPowerDoesNotMatch{TrustedVotingPower: val.VotingPower, EvidenceVotingPower: e.ValidatorPower}
+	}
+	if valSet.TotalVotingPower() != e.TotalVotingPower {
+		return ErrVotingPowerDoesNotMatch{TrustedVotingPower: valSet.TotalVotingPower(), EvidenceVotingPower: e.TotalVotingPower}
+	}
+
+	pa := e.ProposalA.ToProto()
+	pb := e.ProposalB.ToProto()
+	// Signatures must be valid
+	if !pubKey.VerifySignature(types.ProposalSignBytes(chainID, pa), e.ProposalA.Signature) {
+		return fmt.Errorf("verifying ProposalA: %w", types.ErrInvalidProposalSignature)
+	}
+	if !pubKey.VerifySignature(types.ProposalSignBytes(chainID, pb), e.ProposalB.Signature) {
+		return fmt.Errorf("verifying ProposalB: %w", types.ErrInvalidProposalSignature)
+	}
+
+	return nil
+}
+
 // validateABCIEvidence validates the ABCI component of the light client attack
 // evidence i.e voting power and byzantine validators.
 func validateABCIEvidence(
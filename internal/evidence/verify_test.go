@@ -463,6 +463,96 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestVerifyDuplicateProposalEvidence(t *testing.T) {
+	val := types.NewMockPV()
+	val2 := types.NewMockPV()
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(1)})
+
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	const chainID = "mychain"
+
+	makeProposal := func(pv types.MockPV, chainID string, blockID types.BlockID) *types.Proposal {
+		proposal := types.NewProposal(10, 2, -1, blockID, defaultEvidenceTime)
+		p := proposal.ToProto()
+		require.NoError(t, pv.SignProposal(chainID, p))
+		proposal.Signature = p.Signature
+		return proposal
+	}
+
+	cases := []struct {
+		name    string
+		ev      func(ev *types.DuplicateProposalEvidence)
+		expErr  bool
+		wantErr error
+	}{
+		{"good evidence", func(*types.DuplicateProposalEvidence) {}, false, nil},
+		{"same block ids", func(ev *types.DuplicateProposalEvidence) {
+			ev.ProposalB = makeProposal(val, chainID, ev.ProposalA.BlockID)
+		}, true, nil},
+		{"wrong chain id", func(ev *types.DuplicateProposalEvidence) {
+			ev.ProposalB = makeProposal(val, "mychain2", ev.ProposalB.BlockID)
+		}, true, types.ErrInvalidProposalSignature},
+		{"wrong height", func(ev *types.DuplicateProposalEvidence) { ev.ProposalB.Height++ }, true, nil},
+		{"wrong round", func(ev *types.DuplicateProposalEvidence) { ev.ProposalB.Round++ }, true, nil},
+		{"signed by wrong key", func(ev *types.DuplicateProposalEvidence) {
+			ev.ProposalB = makeProposal(val2, chainID, ev.ProposalB.BlockID)
+		}, true, types.ErrInvalidProposalSignature},
+		{"not a validator", func(ev *types.DuplicateProposalEvidence) {
+			ev.ProposerAddress = val2.PrivKey.PubKey().Address()
+		}, true, nil},
+		{"wrong validator power", func(ev *types.DuplicateProposalEvidence) { ev.ValidatorPower = 2 }, true, nil},
+		{"wrong total voting power", func(ev *types.DuplicateProposalEvidence) { ev.TotalVotingPower = 2 }, true, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ev, err := types.NewDuplicateProposalEvidence(makeProposal(val, chainID, blockID),
+				makeProposal(val, chainID, blockID2), val.PrivKey.PubKey().Address(), defaultEvidenceTime, valSet)
+			require.NoError(t, err)
+			c.ev(ev)
+			err = evidence.VerifyDuplicateProposal(ev, chainID, valSet)
+			if !c.expErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			if c.wantErr != nil {
+				require.ErrorIs(t, err, c.wantErr)
+			}
+		})
+	}
+
+	// the evidence is checked against the state and block store by the pool
+	goodEv, err := types.NewMockDuplicateProposalEvidenceWithValidator(10, defaultEvidenceTime, val, chainID)
+	require.NoError(t, err)
+	goodEv.ValidatorPower = 1
+	goodEv.TotalVotingPower = 1
+	badEv, err := types.NewMockDuplicateProposalEvidenceWithValidator(10, defaultEvidenceTime, val, chainID)
+	require.NoError(t, err)
+	state := sm.State{
+		ChainID:         chainID,
+		LastBlockTime:   defaultEvidenceTime.Add(1 * time.Minute),
+		LastBlockHeight: 11,
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(10)).Return(valSet, nil)
+	stateStore.On("Load").Return(state, nil)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("LoadBlockMeta", int64(10)).Return(&types.BlockMeta{Header: types.Header{Time: defaultEvidenceTime}})
+
+	evidenceDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+
+	require.NoError(t, pool.CheckEvidence(types.EvidenceList{goodEv}))
+
+	// evidence with a different validator power should fail
+	require.Error(t, pool.CheckEvidence(types.EvidenceList{badEv}))
+}
+
 func makeLunaticEvidence(
 	t *testing.T,
 	height, commonHeight int64,
@@ -77,7 +77,10 @@ func NewFromConfig(cfg *config.Config) (*Inspector, error) {
 	if err != nil {
 		return nil, err
 	}
-	bs := store.NewBlockStore(bsDB, store.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout))
+	bs := store.NewBlockStore(bsDB,
+		store.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+		store.WithColdTier(cfg.ColdTierDir(), 0, cfg.Storage.ColdTierSegmentSize),
+	)
 	sDB, err := config.DefaultDBProvider(&config.DBContext{ID: "state", Config: cfg})
 	if err != nil {
 		return nil, err
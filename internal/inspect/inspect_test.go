@@ -20,6 +20,7 @@ import (
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	httpclient "github.com/cometbft/cometbft/rpc/client/http"
+	sm "github.com/cometbft/cometbft/state"
 	indexermocks "github.com/cometbft/cometbft/state/indexer/mocks"
 	statemocks "github.com/cometbft/cometbft/state/mocks"
 	txindexmocks "github.com/cometbft/cometbft/state/txindex/mocks"
@@ -73,6 +74,7 @@ func TestBlock(t *testing.T) {
 	blockStoreMock.On("Base").Return(int64(0))
 	blockStoreMock.On("LoadBlock", testHeight).Return(testBlock, &types.BlockMeta{})
 	blockStoreMock.On("Close").Return(nil)
+	blockStoreMock.On("NewSnapshot").Return(blockStoreMock, nil)
 
 	txIndexerMock := &txindexmocks.TxIndexer{}
 	txIndexerMock.On("Close").Return(nil)
@@ -267,6 +269,8 @@ func TestBlockResults(t *testing.T) {
 	testGasUsed := int64(100)
 	stateStoreMock := &statemocks.Store{}
 	stateStoreMock.On("Close").Return(nil)
+	stateStoreMock.On("NewSnapshot").Return(stateStoreMock, nil)
+	stateStoreMock.On("Load").Return(sm.State{}, nil)
 	//	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
 	stateStoreMock.On("LoadFinalizeBlockResponse", testHeight).Return(&abcitypes.FinalizeBlockResponse{
 		TxResults: []*abcitypes.ExecTxResult{
@@ -277,6 +281,7 @@ func TestBlockResults(t *testing.T) {
 	}, nil)
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
+	blockStoreMock.On("NewSnapshot").Return(blockStoreMock, nil)
 	blockStoreMock.On("Base").Return(int64(0))
 	blockStoreMock.On("Height").Return(testHeight)
 	txIndexerMock := &txindexmocks.TxIndexer{}
@@ -320,6 +325,7 @@ func TestCommit(t *testing.T) {
 	stateStoreMock.On("Close").Return(nil)
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
+	blockStoreMock.On("NewSnapshot").Return(blockStoreMock, nil)
 	blockStoreMock.On("Base").Return(int64(0))
 	blockStoreMock.On("Height").Return(testHeight)
 	blockStoreMock.On("LoadBlockMeta", testHeight).Return(&types.BlockMeta{}, nil)
@@ -372,6 +378,7 @@ func TestBlockByHash(t *testing.T) {
 	stateStoreMock.On("Close").Return(nil)
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
+	blockStoreMock.On("NewSnapshot").Return(blockStoreMock, nil)
 	blockStoreMock.On("LoadBlockByHash", testHash).Return(testBlock, &types.BlockMeta{
 		BlockID: types.BlockID{
 			Hash: testHash,
@@ -426,6 +433,7 @@ func TestBlockchain(t *testing.T) {
 
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
+	blockStoreMock.On("NewSnapshot").Return(blockStoreMock, nil)
 	blockStoreMock.On("Height").Return(testHeight)
 	blockStoreMock.On("Base").Return(int64(0))
 	blockStoreMock.On("LoadBlockMeta", testHeight).Return(&types.BlockMeta{
@@ -0,0 +1,93 @@
+package reedsolomon
+
+// matrix is a matrix over GF(2^8), as a slice of rows.
+type matrix [][]byte
+
+func newMatrix(rows, cols int) matrix {
+	m := make(matrix, rows)
+	for r := range m {
+		m[r] = make([]byte, cols)
+	}
+	return m
+}
+
+// vandermonde returns the matrix whose element (r, c) is r^c.
+func vandermonde(rows, cols int) matrix {
+	m := newMatrix(rows, cols)
+	for r := range m {
+		for c := range m[r] {
+			m[r][c] = galExp(byte(r), c)
+		}
+	}
+	return m
+}
+
+// subMatrix returns the rows of m from row start to row end (excluded).
+func (m matrix) subMatrix(start, end int) matrix {
+	sub := newMatrix(end-start, len(m[0]))
+	for r := range sub {
+		copy(sub[r], m[start+r])
+	}
+	return sub
+}
+
+// multiply returns the product of m with other.
+func (m matrix) multiply(other matrix) matrix {
+	res := newMatrix(len(m), len(other[0]))
+	for r := range res {
+		for c := range res[r] {
+			var v byte
+			for i := range other {
+				v ^= galMul(m[r][i], other[i][c])
+			}
+			res[r][c] = v
+		}
+	}
+	return res
+}
+
+// invert returns the inverse of the square matrix m, computed by Gauss-Jordan
+// elimination. m is left unchanged.
+func (m matrix) invert() (matrix, error) {
+	size := len(m)
+	// work is m augmented with the identity matrix.
+	work := newMatrix(size, 2*size)
+	for r := range m {
+		copy(work[r], m[r])
+		work[r][size+r] = 1
+	}
+
+	for c := 0; c < size; c++ {
+		if work[c][c] == 0 {
+			swapped := false
+			for r := c + 1; r < size; r++ {
+				if work[r][c] != 0 {
+					work[c], work[r] = work[r], work[c]
+					swapped = true
+					break
+				}
+			}
+			if !swapped {
+				return nil, ErrSingularMatrix
+			}
+		}
+		if pivot := work[c][c]; pivot != 1 {
+			for i := range work[c] {
+				work[c][i] = galDiv(work[c][i], pivot)
+			}
+		}
+		for r := 0; r < size; r++ {
+			if f := work[r][c]; r != c && f != 0 {
+				for i := range work[r] {
+					work[r][i] ^= galMul(f, work[c][i])
+				}
+			}
+		}
+	}
+
+	inv := make(matrix, size)
+	for r := range work {
+		inv[r] = work[r][size:]
+	}
+	return inv, nil
+}
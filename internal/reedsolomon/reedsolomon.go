@@ -0,0 +1,171 @@
+// Package reedsolomon implements a systematic Reed-Solomon erasure code over
+// GF(2^8): data shards are extended with parity shards so that any of the
+// shards, as many as the data shards, suffice to reconstruct all of them.
+package reedsolomon
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxShards is the maximum number of data and parity shards of an Encoder.
+const MaxShards = 256
+
+var (
+	ErrTooFewShards   = errors.New("too few shards to reconstruct the data")
+	ErrShardCount     = errors.New("unexpected number of shards")
+	ErrShardSize      = errors.New("shards must all have the same, non-zero size")
+	ErrSingularMatrix = errors.New("matrix is singular")
+)
+
+// Encoder encodes and reconstructs a fixed number of data and parity shards.
+type Encoder struct {
+	dataShards   int
+	parityShards int
+	// matrix has a row per shard, the rows of the data shards forming the
+	// identity matrix.
+	matrix matrix
+}
+
+// New returns an Encoder of the given number of data and parity shards.
+func New(dataShards, parityShards int) (*Encoder, error) {
+	if dataShards <= 0 || parityShards < 0 {
+		return nil, fmt.Errorf("invalid number of data (%d) or parity (%d) shards", dataShards, parityShards)
+	}
+	if dataShards+parityShards > MaxShards {
+		return nil, fmt.Errorf("too many shards: %d > %d", dataShards+parityShards, MaxShards)
+	}
+
+	// Any square matrix formed by rows of a Vandermonde matrix is invertible,
+	// which remains true once multiplied by the inverse of its top square.
+	vm := vandermonde(dataShards+parityShards, dataShards)
+	top, err := vm.subMatrix(0, dataShards).invert()
+	if err != nil {
+		return nil, err
+	}
+	return &Encoder{
+		dataShards:   dataShards,
+		parityShards: parityShards,
+		matrix:       vm.multiply(top),
+	}, nil
+}
+
+// DataShards returns the number of data shards.
+func (e *Encoder) DataShards() int { return e.dataShards }
+
+// ParityShards returns the number of parity shards.
+func (e *Encoder) ParityShards() int { return e.parityShards }
+
+// Encode computes the parity shards from the data shards, which are the first
+// of the given shards. The parity shards are allocated if nil.
+func (e *Encoder) Encode(shards [][]byte) error {
+	if len(shards) != e.dataShards+e.parityShards {
+		return ErrShardCount
+	}
+	size, err := shardSize(shards[:e.dataShards])
+	if err != nil {
+		return err
+	}
+	for i := e.dataShards; i < len(shards); i++ {
+		switch len(shards[i]) {
+		case 0:
+			shards[i] = make([]byte, size)
+		case size:
+			clear(shards[i])
+		default:
+			return ErrShardSize
+		}
+	}
+	e.codeShards(e.matrix[e.dataShards:], shards[:e.dataShards], shards[e.dataShards:])
+	return nil
+}
+
+// Reconstruct recreates the missing shards, which are nil or empty, from the
+// present ones. At least as many shards as the data shards must be present.
+func (e *Encoder) Reconstruct(shards [][]byte) error {
+	if len(shards) != e.dataShards+e.parityShards {
+		return ErrShardCount
+	}
+	var (
+		present []int
+		inputs  [][]byte
+	)
+	for i, shard := range shards {
+		if len(shard) > 0 {
+			present = append(present, i)
+			inputs = append(inputs, shard)
+		}
+	}
+	if len(present) < e.dataShards {
+		return ErrTooFewShards
+	}
+	size, err := shardSize(inputs)
+	if err != nil {
+		return err
+	}
+	if len(present) == len(shards) {
+		return nil
+	}
+	present, inputs = present[:e.dataShards], inputs[:e.dataShards]
+
+	// The data shards are the product of the inverse of the rows of the
+	// present shards with them.
+	sub := make(matrix, e.dataShards)
+	for i, idx := range present {
+		sub[i] = e.matrix[idx]
+	}
+	decode, err := sub.invert()
+	if err != nil {
+		return err
+	}
+	var (
+		rows    matrix
+		outputs [][]byte
+	)
+	for i := 0; i < e.dataShards; i++ {
+		if len(shards[i]) == 0 {
+			shards[i] = make([]byte, size)
+			rows = append(rows, decode[i])
+			outputs = append(outputs, shards[i])
+		}
+	}
+	e.codeShards(rows, inputs, outputs)
+
+	rows, outputs = nil, nil
+	for i := e.dataShards; i < len(shards); i++ {
+		if len(shards[i]) == 0 {
+			shards[i] = make([]byte, size)
+			rows = append(rows, e.matrix[i])
+			outputs = append(outputs, shards[i])
+		}
+	}
+	e.codeShards(rows, shards[:e.dataShards], outputs)
+	return nil
+}
+
+// codeShards sets each output to the product of the corresponding row with
+// the inputs. The outputs must be zeroed.
+func (*Encoder) codeShards(rows matrix, inputs, outputs [][]byte) {
+	for r, out := range outputs {
+		for c, in := range inputs {
+			mt := &mulTable[rows[r][c]]
+			out := out[:len(in)]
+			for i, b := range in {
+				out[i] ^= mt[b]
+			}
+		}
+	}
+}
+
+func shardSize(shards [][]byte) (int, error) {
+	size := len(shards[0])
+	if size == 0 {
+		return 0, ErrShardSize
+	}
+	for _, shard := range shards[1:] {
+		if len(shard) != size {
+			return 0, ErrShardSize
+		}
+	}
+	return size, nil
+}
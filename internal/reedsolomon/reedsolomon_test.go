@@ -0,0 +1,124 @@
+package reedsolomon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randShards(rng *rand.Rand, dataShards, parityShards, size int) [][]byte {
+	shards := make([][]byte, dataShards+parityShards)
+	for i := 0; i < dataShards; i++ {
+		shards[i] = make([]byte, size)
+		rng.Read(shards[i])
+	}
+	return shards
+}
+
+func copyShards(shards [][]byte) [][]byte {
+	res := make([][]byte, len(shards))
+	for i, shard := range shards {
+		res[i] = append([]byte(nil), shard...)
+	}
+	return res
+}
+
+func TestGalois(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			p := galMul(byte(a), byte(b))
+			require.Equal(t, byte(a), galDiv(p, byte(b)))
+		}
+		assert.Equal(t, galMul(byte(a), galMul(byte(a), byte(a))), galExp(byte(a), 3))
+	}
+	assert.Equal(t, byte(0), galMul(0, 7))
+	assert.Equal(t, byte(0), galDiv(0, 7))
+}
+
+func TestMatrixInvert(t *testing.T) {
+	m := vandermonde(5, 5)
+	inv, err := m.invert()
+	require.NoError(t, err)
+	id := m.multiply(inv)
+	for r := range id {
+		for c := range id[r] {
+			if r == c {
+				assert.Equal(t, byte(1), id[r][c])
+			} else {
+				assert.Equal(t, byte(0), id[r][c])
+			}
+		}
+	}
+
+	_, err = matrix{{1, 2}, {2, 4}}.invert()
+	require.ErrorIs(t, err, ErrSingularMatrix)
+}
+
+func TestEncodeReconstruct(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	testCases := []struct{ data, parity int }{
+		{1, 1},
+		{3, 2},
+		{4, 4},
+		{10, 10},
+		{128, 128},
+		{200, 56},
+	}
+	for _, tc := range testCases {
+		enc, err := New(tc.data, tc.parity)
+		require.NoError(t, err)
+		shards := randShards(rng, tc.data, tc.parity, 33)
+		require.NoError(t, enc.Encode(shards))
+
+		// The data shards are unchanged by the encoding.
+		for _, shard := range shards {
+			require.Len(t, shard, 33)
+		}
+
+		// Any of the shards, as many as the data shards, suffice.
+		for i := 0; i < 5; i++ {
+			damaged := copyShards(shards)
+			for _, idx := range rng.Perm(len(shards))[:tc.parity] {
+				damaged[idx] = nil
+			}
+			require.NoError(t, enc.Reconstruct(damaged))
+			require.Equal(t, shards, damaged)
+		}
+
+		damaged := copyShards(shards)
+		for _, idx := range rng.Perm(len(shards))[:tc.parity+1] {
+			damaged[idx] = nil
+		}
+		require.ErrorIs(t, enc.Reconstruct(damaged), ErrTooFewShards)
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	_, err := New(0, 1)
+	require.Error(t, err)
+	_, err = New(200, 57)
+	require.Error(t, err)
+
+	enc, err := New(2, 2)
+	require.NoError(t, err)
+	require.ErrorIs(t, enc.Encode(make([][]byte, 3)), ErrShardCount)
+	require.ErrorIs(t, enc.Encode([][]byte{{1, 2}, {1}, nil, nil}), ErrShardSize)
+	require.ErrorIs(t, enc.Encode([][]byte{{1, 2}, {1, 2}, {1}, nil}), ErrShardSize)
+	require.ErrorIs(t, enc.Reconstruct([][]byte{{1, 2}, nil, {1}, nil}), ErrShardSize)
+}
+
+func BenchmarkEncode(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	enc, err := New(64, 64)
+	require.NoError(b, err)
+	shards := randShards(rng, 64, 64, 65536)
+	b.SetBytes(64 * 65536)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := enc.Encode(shards); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
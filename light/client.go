@@ -155,6 +155,9 @@ type Client struct {
 	pruningSize uint16
 	// See ConfirmationFunction option
 	confirmationFn func(action string) bool
+	// Set by a Monitor following the client to take over the reporting of
+	// light client attacks.
+	attackHandler func(ctx context.Context, ev *types.LightClientAttackEvidence, faulty provider.Provider)
 
 	quit chan struct{}
 
@@ -223,6 +223,19 @@ func (c *Client) sendEvidence(ctx context.Context, ev *types.LightClientAttackEv
 	}
 }
 
+// reportAttack sends the evidence of an attack by the faulty provider to the
+// receiver or, if the client is followed by a Monitor, hands it over to the
+// monitor.
+//
+// NOTE: requires a providerMutex lock.
+func (c *Client) reportAttack(ctx context.Context, ev *types.LightClientAttackEvidence, faulty, receiver provider.Provider) {
+	if c.attackHandler != nil {
+		c.attackHandler(ctx, ev, faulty)
+		return
+	}
+	c.sendEvidence(ctx, ev, receiver)
+}
+
 // handleConflictingHeaders handles the primary style of attack, which is where a primary and witness have
 // two headers of the same height but with different hashes.
 func (c *Client) handleConflictingHeaders(
@@ -251,7 +264,7 @@ func (c *Client) handleConflictingHeaders(
 	evidenceAgainstPrimary := newLightClientAttackEvidence(primaryBlock, trustedBlock, commonBlock)
 	c.logger.Error("ATTEMPTED ATTACK DETECTED. Sending evidence against primary by witness", "ev", evidenceAgainstPrimary,
 		"primary", c.primary, "witness", supportingWitness)
-	c.sendEvidence(ctx, evidenceAgainstPrimary, supportingWitness)
+	c.reportAttack(ctx, evidenceAgainstPrimary, c.primary, supportingWitness)
 
 	if primaryBlock.Commit.Round != witnessTrace[len(witnessTrace)-1].Commit.Round {
 		c.logger.Info("The light client has detected, and prevented, an attempted amnesia attack." +
@@ -279,7 +292,7 @@ func (c *Client) handleConflictingHeaders(
 	evidenceAgainstWitness := newLightClientAttackEvidence(witnessBlock, trustedBlock, commonBlock)
 	c.logger.Error("Sending evidence against witness by primary", "ev", evidenceAgainstWitness,
 		"primary", c.primary, "witness", supportingWitness)
-	c.sendEvidence(ctx, evidenceAgainstWitness, c.primary)
+	c.reportAttack(ctx, evidenceAgainstWitness, supportingWitness, c.primary)
 	// We return the error and don't process anymore witnesses
 	return ErrLightClientAttack
 }
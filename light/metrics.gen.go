@@ -0,0 +1,65 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package light
+
+import (
+	"github.com/cometbft/cometbft/libs/metrics/discard"
+	prometheus "github.com/cometbft/cometbft/libs/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		LatestVerifiedHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "latest_verified_height",
+			Help:      "The height of the latest light block verified by the monitor.",
+		}, labels).With(labelsAndValues...),
+		ProviderHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "provider_height",
+			Help:      "The height of the latest light block of each provider.",
+		}, append(labels, "provider")).With(labelsAndValues...),
+		CheckFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "check_failures",
+			Help:      "Number of checks of the chain head that failed.",
+		}, labels).With(labelsAndValues...),
+		Attacks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "attacks",
+			Help:      "Number of light client attacks detected.",
+		}, labels).With(labelsAndValues...),
+		EvidenceReported: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evidence_reported",
+			Help:      "Number of times the evidence of an attack was reported to a provider.",
+		}, labels).With(labelsAndValues...),
+		EvidenceReportFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evidence_report_failures",
+			Help:      "Number of times the evidence of an attack could not be reported to a provider.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		LatestVerifiedHeight:   discard.NewGauge(),
+		ProviderHeight:         discard.NewGauge(),
+		CheckFailures:          discard.NewCounter(),
+		Attacks:                discard.NewCounter(),
+		EvidenceReported:       discard.NewCounter(),
+		EvidenceReportFailures: discard.NewCounter(),
+	}
+}
@@ -0,0 +1,30 @@
+package light
+
+import (
+	"github.com/cometbft/cometbft/libs/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "light_monitor"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains the metrics exposed by a Monitor.
+type Metrics struct {
+	// The height of the latest light block verified by the monitor.
+	LatestVerifiedHeight metrics.Gauge
+	// The height of the latest light block of each provider.
+	ProviderHeight metrics.Gauge `metrics_labels:"provider"`
+	// Number of checks of the chain head that failed.
+	CheckFailures metrics.Counter
+	// Number of light client attacks detected.
+	Attacks metrics.Counter
+	// Number of times the evidence of an attack was reported to a provider.
+	EvidenceReported metrics.Counter
+	// Number of times the evidence of an attack could not be reported to a
+	// provider.
+	EvidenceReportFailures metrics.Counter
+}
@@ -0,0 +1,263 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/types"
+)
+
+// DefaultMonitorInterval is the default interval between two checks of the
+// chain head by a Monitor.
+const DefaultMonitorInterval = 5 * time.Second
+
+// MonitorOption sets a parameter for the monitor.
+type MonitorOption func(*Monitor)
+
+// MonitorInterval sets the interval between two checks of the chain head.
+// Default: DefaultMonitorInterval.
+func MonitorInterval(d time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.interval = d
+	}
+}
+
+// MonitorMetrics sets the metrics of the monitor. Default: NopMetrics().
+func MonitorMetrics(metrics *Metrics) MonitorOption {
+	return func(m *Monitor) {
+		m.metrics = metrics
+	}
+}
+
+// MonitorAlerts sets the writer of the alert log, to which an Alert is
+// written, as a line of JSON, for every light client attack detected.
+func MonitorAlerts(w io.Writer) MonitorOption {
+	return func(m *Monitor) {
+		m.alerts = w
+	}
+}
+
+// Alert describes a light client attack detected by a Monitor.
+type Alert struct {
+	Time time.Time `json:"time"`
+	// The provider that served the conflicting block.
+	FaultyProvider string `json:"faulty_provider"`
+	// The providers to which the evidence was reported.
+	ReportedTo []string                         `json:"reported_to"`
+	Evidence   *types.LightClientAttackEvidence `json:"evidence"`
+}
+
+// Monitor follows the head of a chain with a light client, verifying every
+// new header in turn and cross-checking it against the witnesses of the client
+// with the divergence detection of the light client. Unlike the client on its
+// own, it keeps following the chain once an attack is detected: it reports the
+// evidence of the attack to all the providers of the client but the faulty
+// one, writes an alert to its alert log and, if the primary is faulty,
+// replaces it with a witness.
+type Monitor struct {
+	client   *Client
+	interval time.Duration
+	metrics  *Metrics
+	alerts   io.Writer
+
+	// Conflicting block times of the evidence already reported, by evidence
+	// hash, until they are out of the trusting period, so an attack detected
+	// again is not reported twice.
+	mtx      sync.Mutex
+	reported map[string]time.Time
+	// The primary against which evidence was reported, to be replaced.
+	faultyPrimary provider.Provider
+}
+
+// NewMonitor returns a monitor following the chain with the given client,
+// which must not be used for anything else. The monitor takes over the
+// reporting of the attacks detected by the client.
+func NewMonitor(c *Client, options ...MonitorOption) *Monitor {
+	m := &Monitor{
+		client:   c,
+		interval: DefaultMonitorInterval,
+		metrics:  NopMetrics(),
+		reported: make(map[string]time.Time),
+	}
+	for _, o := range options {
+		o(m)
+	}
+	c.attackHandler = m.reportAttack
+	return m
+}
+
+// Run checks the chain head at every interval until the context is done.
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Check(ctx, time.Now()); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			m.client.logger.Error("Failed to check the chain head", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check follows the head of the chain once: it records the latest height of
+// every provider, then verifies the light blocks of the primary from the last
+// trusted height to the latest one, one height after the other, cross-checking
+// each against the witnesses. It returns ErrLightClientAttack if an attack was
+// detected, whose evidence has then been reported. A faulty primary is then
+// replaced with a witness, from which the next check follows the chain.
+func (m *Monitor) Check(ctx context.Context, now time.Time) error {
+	m.recordProviderHeights(ctx)
+	m.pruneReported(now)
+
+	err := m.verifyToLatest(ctx, now)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrLightClientAttack):
+		m.replaceFaultyPrimary(ctx)
+		return err
+	default:
+		m.metrics.CheckFailures.Add(1)
+		return err
+	}
+}
+
+// verifyToLatest verifies every light block of the primary after the last
+// trusted one, up to the latest one.
+func (m *Monitor) verifyToLatest(ctx context.Context, now time.Time) error {
+	lastTrustedHeight, err := m.client.LastTrustedHeight()
+	if err != nil {
+		return ErrGetLastTrustedHeight{Err: err}
+	}
+	if lastTrustedHeight == -1 {
+		// no light blocks yet => wait
+		return nil
+	}
+
+	latestBlock, err := m.client.lightBlockFromPrimary(ctx, 0)
+	if err != nil {
+		return err
+	}
+	for height := lastTrustedHeight + 1; height <= latestBlock.Height; height++ {
+		l, err := m.client.VerifyLightBlockAtHeight(ctx, height, now)
+		if err != nil {
+			return err
+		}
+		m.metrics.LatestVerifiedHeight.Set(float64(l.Height))
+	}
+	return nil
+}
+
+// replaceFaultyPrimary replaces the primary with a witness if evidence was
+// reported against it.
+func (m *Monitor) replaceFaultyPrimary(ctx context.Context) {
+	m.mtx.Lock()
+	faulty := m.faultyPrimary
+	m.faultyPrimary = nil
+	m.mtx.Unlock()
+	if faulty == nil || faulty != m.client.Primary() {
+		return
+	}
+
+	if _, err := m.client.findNewPrimary(ctx, 0, true); err != nil {
+		m.client.logger.Error("Failed to replace the faulty primary", "primary", faulty, "err", err)
+		return
+	}
+	m.client.logger.Info("Replaced the faulty primary", "faulty", faulty, "primary", m.client.Primary())
+}
+
+// pruneReported forgets the evidence whose conflicting block is out of the
+// trusting period, as the attack can't be detected again.
+func (m *Monitor) pruneReported(now time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for hash, blockTime := range m.reported {
+		if HeaderExpired(&types.SignedHeader{Header: &types.Header{Time: blockTime}}, m.client.trustingPeriod, now) {
+			delete(m.reported, hash)
+		}
+	}
+}
+
+// recordProviderHeights records the height of the latest light block of every
+// provider, on a best effort basis.
+func (m *Monitor) recordProviderHeights(ctx context.Context) {
+	providers := append([]provider.Provider{m.client.Primary()}, m.client.Witnesses()...)
+	var wg sync.WaitGroup
+	for _, p := range providers {
+		wg.Add(1)
+		go func(p provider.Provider) {
+			defer wg.Done()
+			l, err := p.LightBlock(ctx, 0)
+			if err != nil {
+				m.client.logger.Debug("Failed to get the latest light block of provider", "provider", p, "err", err)
+				return
+			}
+			m.metrics.ProviderHeight.With("provider", fmt.Sprint(p)).Set(float64(l.Height))
+		}(p)
+	}
+	wg.Wait()
+}
+
+// reportAttack reports the evidence of an attack by the faulty provider to all
+// the other providers of the client and writes an alert.
+//
+// NOTE: called by the client with a providerMutex lock.
+func (m *Monitor) reportAttack(ctx context.Context, ev *types.LightClientAttackEvidence, faulty provider.Provider) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if faulty == m.client.primary {
+		m.faultyPrimary = faulty
+	}
+	if _, ok := m.reported[string(ev.Hash())]; ok {
+		m.client.logger.Debug("Evidence of attack already reported", "ev", ev, "faulty", faulty)
+		return
+	}
+	m.reported[string(ev.Hash())] = ev.ConflictingBlock.Time
+	m.metrics.Attacks.Add(1)
+	m.client.logger.Error("ATTEMPTED ATTACK DETECTED. Reporting evidence against provider", "ev", ev, "faulty", faulty)
+
+	alert := Alert{
+		Time:           time.Now(),
+		FaultyProvider: fmt.Sprint(faulty),
+		Evidence:       ev,
+	}
+	for _, p := range append([]provider.Provider{m.client.primary}, m.client.witnesses...) {
+		if p == faulty {
+			continue
+		}
+		if err := p.ReportEvidence(ctx, ev); err != nil {
+			m.metrics.EvidenceReportFailures.Add(1)
+			m.client.logger.Error("Failed to report evidence to provider", "ev", ev, "provider", p, "err", err)
+			continue
+		}
+		m.metrics.EvidenceReported.Add(1)
+		alert.ReportedTo = append(alert.ReportedTo, fmt.Sprint(p))
+	}
+	m.writeAlert(alert)
+}
+
+func (m *Monitor) writeAlert(alert Alert) {
+	if m.alerts == nil {
+		return
+	}
+	bz, err := cmtjson.Marshal(alert)
+	if err == nil {
+		_, err = m.alerts.Write(append(bz, '\n'))
+	}
+	if err != nil {
+		m.client.logger.Error("Failed to write alert", "err", err)
+	}
+}
@@ -0,0 +1,141 @@
+package light_test
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtdb "github.com/cometbft/cometbft/db"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	mockp "github.com/cometbft/cometbft/light/provider/mock"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestMonitorReportsAttackToAllProviders(t *testing.T) {
+	// primary performs an equivocation attack
+	var (
+		latestHeight      = int64(10)
+		valSize           = 5
+		divergenceHeight  = int64(6)
+		primaryHeaders    = make(map[int64]*types.SignedHeader, latestHeight)
+		primaryValidators = make(map[int64]*types.ValidatorSet, latestHeight)
+	)
+
+	witnessHeaders, witnessValidators, chainKeys := genMockNodeWithKeys(latestHeight+2, valSize, 2, bTime)
+	witness := mockp.New(chainID, witnessHeaders, witnessValidators)
+	otherWitness := witness.Copy(chainID)
+
+	for height := int64(1); height <= latestHeight; height++ {
+		if height < divergenceHeight {
+			primaryHeaders[height] = witnessHeaders[height]
+			primaryValidators[height] = witnessValidators[height]
+			continue
+		}
+		// 4/5 of the validators vote again for a different block
+		primaryHeaders[height] = chainKeys[height].GenSignedHeader(chainID, height,
+			bTime.Add(time.Duration(height)*time.Minute), []types.Tx{[]byte("abcd")},
+			witnessValidators[height], witnessValidators[height+1], hash("app_hash"),
+			hash("cons_hash"), hash("results_hash"), 0, len(chainKeys[height])-1)
+		primaryValidators[height] = witnessValidators[height]
+	}
+	primary := mockp.New(chainID, primaryHeaders, primaryValidators)
+
+	memDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 4 * time.Hour,
+			Height: 1,
+			Hash:   primaryHeaders[1].Hash(),
+		},
+		primary,
+		[]provider.Provider{witness, otherWitness},
+		dbs.New(memDB, chainID),
+		light.Logger(log.TestingLogger()),
+		light.MaxRetryAttempts(1),
+	)
+	require.NoError(t, err)
+
+	var alerts bytes.Buffer
+	m := light.NewMonitor(c, light.MonitorAlerts(&alerts))
+	require.Equal(t, light.ErrLightClientAttack, m.Check(ctx, bTime.Add(1*time.Hour)))
+
+	// The evidence against the primary is reported to both witnesses, and
+	// not only to the one which served the conflicting block.
+	evAgainstPrimary := &types.LightClientAttackEvidence{
+		ConflictingBlock: &types.LightBlock{
+			SignedHeader: primaryHeaders[divergenceHeight],
+			ValidatorSet: primaryValidators[divergenceHeight],
+		},
+		CommonHeight: divergenceHeight,
+	}
+	assert.True(t, witness.HasEvidence(evAgainstPrimary))
+	assert.True(t, otherWitness.HasEvidence(evAgainstPrimary))
+	assert.False(t, primary.HasEvidence(evAgainstPrimary))
+
+	// An alert is written for the evidence against the primary and the one
+	// against the witness.
+	var reported []light.Alert
+	scanner := bufio.NewScanner(&alerts)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var alert light.Alert
+		require.NoError(t, cmtjson.Unmarshal(scanner.Bytes(), &alert))
+		reported = append(reported, alert)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, reported, 2)
+	assert.Len(t, reported[0].ReportedTo, 2)
+	assert.Equal(t, evAgainstPrimary.Hash(), reported[0].Evidence.Hash())
+
+	// The faulty primary is replaced with a witness, from which the monitor
+	// follows the chain.
+	assert.NotEqual(t, primary, c.Primary())
+	require.NoError(t, m.Check(ctx, bTime.Add(1*time.Hour)))
+	height, err := c.LastTrustedHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, latestHeight+2, height)
+	assert.Zero(t, alerts.Len())
+}
+
+func TestMonitorFollowsHead(t *testing.T) {
+	primary := mockp.New(genMockNode(5, 3, 0, bTime))
+	witness := primary.Copy(chainID)
+	l1, err := primary.LightBlock(ctx, 1)
+	require.NoError(t, err)
+
+	memDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 4 * time.Hour,
+			Height: 1,
+			Hash:   l1.Hash(),
+		},
+		primary,
+		[]provider.Provider{witness},
+		dbs.New(memDB, chainID),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	var alerts bytes.Buffer
+	m := light.NewMonitor(c, light.MonitorAlerts(&alerts))
+	require.NoError(t, m.Check(ctx, bTime.Add(1*time.Hour)))
+	height, err := c.LastTrustedHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, height)
+	assert.Zero(t, alerts.Len())
+}
@@ -36,15 +36,18 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
+		"consensus_trace":      rpcserver.NewRPCFunc(makeConsensusTraceFunc(c), "height"),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
 		"unconfirmed_tx":       rpcserver.NewRPCFunc(makeUnconfirmedTxFunc(c), "hash"),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
+		"mempool_lanes":        rpcserver.NewRPCFunc(makeMempoolLanesFunc(c), ""),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
 		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx"),
 		"broadcast_tx_async":  rpcserver.NewRPCFunc(makeBroadcastTxAsyncFunc(c), "tx"),
+		"broadcast_bundle":    rpcserver.NewRPCFunc(makeBroadcastBundleFunc(c), "txs"),
 
 		// abci API
 		"abci_query": rpcserver.NewRPCFunc(makeABCIQueryFunc(c), "path,data,height,prove"),
@@ -52,6 +55,8 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), ""),
+		"committed_evidence": rpcserver.NewRPCFunc(makeCommittedEvidenceFunc(c), "height"),
 	}
 }
 
@@ -224,6 +229,14 @@ func makeConsensusStateFunc(c *lrpc.Client) rpcConsensusStateFunc {
 	}
 }
 
+type rpcConsensusTraceFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
+
+func makeConsensusTraceFunc(c *lrpc.Client) rpcConsensusTraceFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+		return c.ConsensusTrace(ctx.Context(), height)
+	}
+}
+
 type rpcConsensusParamsFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 
 func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
@@ -256,6 +269,14 @@ func makeNumUnconfirmedTxsFunc(c *lrpc.Client) rpcNumUnconfirmedTxsFunc {
 	}
 }
 
+type rpcMempoolLanesFunc func(ctx *rpctypes.Context) (*ctypes.ResultMempoolLanes, error)
+
+func makeMempoolLanesFunc(c *lrpc.Client) rpcMempoolLanesFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultMempoolLanes, error) {
+		return c.MempoolLanes(ctx.Context())
+	}
+}
+
 type rpcBroadcastTxCommitFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
 
 func makeBroadcastTxCommitFunc(c *lrpc.Client) rpcBroadcastTxCommitFunc {
@@ -280,6 +301,14 @@ func makeBroadcastTxAsyncFunc(c *lrpc.Client) rpcBroadcastTxAsyncFunc {
 	}
 }
 
+type rpcBroadcastBundleFunc func(ctx *rpctypes.Context, txs []types.Tx) (*ctypes.ResultBroadcastBundle, error)
+
+func makeBroadcastBundleFunc(c *lrpc.Client) rpcBroadcastBundleFunc {
+	return func(ctx *rpctypes.Context, txs []types.Tx) (*ctypes.ResultBroadcastBundle, error) {
+		return c.BroadcastBundle(ctx.Context(), txs)
+	}
+}
+
 type rpcABCIQueryFunc func(ctx *rpctypes.Context, path string,
 	data bytes.HexBytes, height int64, prove bool) (*ctypes.ResultABCIQuery, error)
 
@@ -309,3 +338,19 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(ctx.Context())
+	}
+}
+
+type rpcCommittedEvidenceFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommittedEvidence, error)
+
+func makeCommittedEvidenceFunc(c *lrpc.Client) rpcCommittedEvidenceFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommittedEvidence, error) {
+		return c.CommittedEvidence(ctx.Context(), height)
+	}
+}
@@ -200,6 +200,10 @@ func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.Resu
 	return c.next.BroadcastTxSync(ctx, tx)
 }
 
+func (c *Client) BroadcastBundle(ctx context.Context, txs types.Txs) (*ctypes.ResultBroadcastBundle, error) {
+	return c.next.BroadcastBundle(ctx, txs)
+}
+
 func (c *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
 	return c.next.UnconfirmedTx(ctx, hash)
 }
@@ -212,6 +216,10 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirm
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) MempoolLanes(ctx context.Context) (*ctypes.ResultMempoolLanes, error) {
+	return c.next.MempoolLanes(ctx)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -228,6 +236,10 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.next.ConsensusTrace(ctx, height)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -541,6 +553,14 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx)
+}
+
+func (c *Client) CommittedEvidence(ctx context.Context, height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	return c.next.CommittedEvidence(ctx, height)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int,
 ) (out <-chan ctypes.ResultEvent, err error) {
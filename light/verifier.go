@@ -53,6 +53,16 @@ func VerifyNonAdjacent(
 	}
 
 	verifiedSignatureCache := types.NewSignatureCache()
+	// An aggregated signature can only be verified with all of its signers,
+	// which the trusted validators may not include: verify it with the new
+	// validators first, so that the trusted validators only need to tally it.
+	if untrustedHeader.Commit.IsAggregated() {
+		if err := untrustedVals.VerifyCommitLightWithCache(trustedHeader.ChainID, untrustedHeader.Commit.BlockID,
+			untrustedHeader.Height, untrustedHeader.Commit, verifiedSignatureCache); err != nil {
+			return ErrInvalidHeader{err}
+		}
+	}
+
 	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
 	err := trustedVals.VerifyCommitLightTrustingWithCache(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel, verifiedSignatureCache)
 	if err != nil {
@@ -0,0 +1,287 @@
+package mempool
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protomem "github.com/cometbft/cometbft/api/cometbft/mempool/v2"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+// txBundle is a group of txs added to the mempool together, that must be
+// included in a block together and in order, or not at all.
+type txBundle struct {
+	txs       types.Txs // in the order in which they must be included
+	size      int64     // size of the txs in a block
+	gasWanted int64     // total gas wanted by the txs
+}
+
+// maxBundleCheckAttempts is the maximum number of times the txs of a bundle
+// are checked when blocks are committed while they are being checked.
+const maxBundleCheckAttempts = 3
+
+// CheckBundle checks the given txs with the application, one after the other,
+// and adds them to the mempool as a bundle if all of them are valid.
+// Otherwise, none of them is added. It returns the CheckTx responses of the
+// txs checked, that is, up to the first invalid tx, if any.
+//
+// The txs of a bundle are added to the lane that the application assigns to
+// the first of them. Bundles are reaped as a whole or not at all, and are
+// never evicted by txs with a higher priority. When a tx of a bundle is removed
+// from the mempool, the other txs of the bundle are removed too.
+//
+// Bundles are local to the node: they are not gossiped to peers, so they are
+// only included in the blocks proposed by the node. They are written to the
+// journal, if enabled, and restored as bundles.
+//
+// As for single txs, the application may update its state when checking the
+// txs of a bundle, even if the bundle is rejected afterwards.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) CheckBundle(txs types.Txs) ([]*abci.CheckTxResponse, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyBundle
+	}
+	height, postCheck, err := mem.prepareBundle(txs)
+	if err != nil {
+		return nil, err
+	}
+	removeFromCache := func() {
+		for _, tx := range txs {
+			mem.forceRemoveFromCache(tx)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		// The txs are checked without holding updateMtx, so that a block can
+		// be committed while the application checks them one after the other.
+		responses, err := mem.checkBundleTxs(txs, postCheck)
+		if err != nil {
+			removeFromCache()
+			return responses, err
+		}
+
+		mem.updateMtx.RLock()
+		if mem.height.Load() == height {
+			err := mem.addCheckedBundle(txs, responses)
+			mem.updateMtx.RUnlock()
+			if err != nil {
+				removeFromCache() // the bundle may fit later
+				return responses, err
+			}
+			return responses, nil
+		}
+		// A block was committed while the txs were checked, so they are
+		// checked again against the new state of the application.
+		height, postCheck = mem.height.Load(), mem.postCheck
+		mem.updateMtx.RUnlock()
+		if attempt == maxBundleCheckAttempts {
+			removeFromCache()
+			mem.metrics.RejectedTxs.Add(float64(len(txs)))
+			return nil, ErrBundleCheckInterrupted
+		}
+	}
+}
+
+// prepareBundle checks the txs of a bundle before they are checked with the
+// application, and adds them to the cache. It returns the height of the
+// mempool and the post-check function to check them with.
+func (mem *CListMempool) prepareBundle(txs types.Txs) (int64, PostCheckFunc, error) {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	if mem.recheck.consideredFull() {
+		mem.metrics.RejectedTxs.Add(float64(len(txs)))
+		return 0, nil, ErrRecheckFull
+	}
+
+	keys := make(map[types.TxKey]struct{}, len(txs))
+	for i, tx := range txs {
+		if len(tx) > mem.config.MaxTxBytes {
+			return 0, nil, ErrInvalidBundleTx{Index: i, Err: ErrTxTooLarge{
+				Max:    mem.config.MaxTxBytes,
+				Actual: len(tx),
+			}}
+		}
+		if mem.preCheck != nil {
+			if err := mem.preCheck(tx); err != nil {
+				return 0, nil, ErrInvalidBundleTx{Index: i, Err: ErrPreCheck{Err: err}}
+			}
+		}
+		if _, ok := keys[tx.Key()]; ok {
+			return 0, nil, ErrInvalidBundleTx{Index: i, Err: ErrTxInBundleTwice}
+		}
+		keys[tx.Key()] = struct{}{}
+	}
+
+	// NOTE: proxyAppConn may error if tx buffer is full
+	if err := mem.proxyAppConn.Error(); err != nil {
+		return 0, nil, ErrAppConnMempool{Err: err}
+	}
+
+	// Like single txs, the txs of the bundle are kept in the cache while they
+	// are checked, so that they are not checked twice concurrently.
+	for i, tx := range txs {
+		if added := mem.addToCache(tx); !added {
+			mem.metrics.AlreadyReceivedTxs.Add(1)
+			for _, tx := range txs[:i] {
+				mem.forceRemoveFromCache(tx)
+			}
+			return 0, nil, ErrInvalidBundleTx{Index: i, Err: ErrTxInCache}
+		}
+	}
+	return mem.height.Load(), mem.postCheck, nil
+}
+
+// addCheckedBundle adds the txs of a bundle, checked with the application, to
+// the lane of its first tx. The caller must hold updateMtx.
+func (mem *CListMempool) addCheckedBundle(txs types.Txs, responses []*abci.CheckTxResponse) error {
+	// All the txs of the bundle go to the lane of its first tx.
+	lane := mem.defaultLane
+	if responses[0].LaneId != "" {
+		lane = LaneID(responses[0].LaneId)
+		if _, ok := mem.lanes[lane]; !ok {
+			mem.metrics.RejectedTxs.Add(float64(len(txs)))
+			return ErrLaneNotFound{laneID: lane}
+		}
+	}
+
+	if err := mem.addBundle(txs, responses, lane); err != nil {
+		mem.logger.Debug("Rejected bundle", "txs", len(txs), "lane", lane, "err", err)
+		mem.metrics.RejectedTxs.Add(float64(len(txs)))
+		return err
+	}
+
+	mem.notifyTxsAvailable()
+	if mem.onNewTx != nil {
+		for _, tx := range txs {
+			mem.onNewTx(tx)
+		}
+	}
+	mem.updateSizeMetrics(lane)
+	return nil
+}
+
+// checkBundleTxs calls CheckTx on each tx of a bundle, in order, until one of
+// them is invalid.
+func (mem *CListMempool) checkBundleTxs(txs types.Txs, postCheck PostCheckFunc) ([]*abci.CheckTxResponse, error) {
+	responses := make([]*abci.CheckTxResponse, 0, len(txs))
+	for i, tx := range txs {
+		res, err := mem.proxyAppConn.CheckTx(context.TODO(), &abci.CheckTxRequest{
+			Tx:   tx,
+			Type: abci.CHECK_TX_TYPE_CHECK,
+		})
+		if err != nil {
+			return responses, ErrAppConnMempool{Err: err}
+		}
+		responses = append(responses, res)
+
+		var postCheckErr error
+		if postCheck != nil {
+			postCheckErr = postCheck(tx, res)
+		}
+		if res.Code != abci.CodeTypeOK || postCheckErr != nil {
+			mem.logger.Debug(
+				"Rejected bundle with invalid transaction",
+				"tx", log.NewLazyHash(tx),
+				"index", i,
+				"res", res,
+				"err", postCheckErr,
+			)
+			mem.metrics.FailedTxs.Add(1)
+			if postCheckErr != nil {
+				return responses, ErrInvalidBundleTx{Index: i, Err: postCheckErr}
+			}
+			return responses, ErrInvalidBundleTx{Index: i, Err: ErrInvalidTx{
+				Code:      res.Code,
+				Data:      res.Data,
+				Log:       res.Log,
+				Codespace: res.Codespace,
+				Hash:      tx.Hash(),
+			}}
+		}
+	}
+	return responses, nil
+}
+
+// addBundle adds the txs of a bundle to the given lane, if they all fit in the
+// mempool and none of them is in the mempool already. The replacements
+// requested by the application for the txs are ignored.
+func (mem *CListMempool) addBundle(txs types.Txs, responses []*abci.CheckTxResponse, lane LaneID) error {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	bundle := &txBundle{
+		txs:  txs,
+		size: types.ComputeProtoSizeForTxs(txs),
+	}
+	var txsSize int
+	for i, tx := range txs {
+		// This can happen when the cache overflows.
+		if _, ok := mem.txsMap[tx.Key()]; ok {
+			return ErrInvalidBundleTx{Index: i, Err: ErrTxInMempool}
+		}
+		txsSize += len(tx)
+		bundle.gasWanted += responses[i].GasWanted
+	}
+	err := mem.checkCapacity(
+		txsSize,
+		lane,
+		int(mem.numTxs)+len(txs)-1,
+		mem.txsBytes,
+		mem.lanes[lane].Len()+len(txs)-1,
+		mem.laneBytes[lane],
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, tx := range txs {
+		res := *responses[i]
+		res.ReplacedTxKey = nil
+		res.ReplacementKey = ""
+		mem.addTxLocked(tx, &res, noSender, lane, bundle)
+	}
+	first := mem.txsMap[txs[0].Key()].Value.(*mempoolTx)
+	mem.writeJournal(func() *protomem.JournalRecord { return addBundleRecord(first) })
+	mem.logger.Debug("Added bundle", "txs", len(txs), "lane", lane, "total", mem.numTxs)
+	return nil
+}
+
+// txBundle returns the bundle of the tx with the given key, or nil if the tx
+// is not in the mempool or not part of a bundle.
+func (mem *CListMempool) txBundle(txKey types.TxKey) *txBundle {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	if elem, ok := mem.txsMap[txKey]; ok {
+		return elem.Value.(*mempoolTx).bundle
+	}
+	return nil
+}
+
+// removeBundle removes the txs of the bundle still in the mempool.
+func (mem *CListMempool) removeBundle(bundle *txBundle) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	mem.removeBundleLocked(bundle)
+}
+
+// removeBundleLocked removes the txs of the bundle still in the mempool, and
+// from the cache, so that they can be submitted again. The caller must hold
+// txsMtx.
+func (mem *CListMempool) removeBundleLocked(bundle *txBundle) {
+	for _, tx := range bundle.txs {
+		if _, ok := mem.txsMap[tx.Key()]; !ok {
+			continue
+		}
+		if err := mem.removeTxLocked(tx.Key(), TxRemovalReasonBundle); err != nil {
+			mem.logger.Debug("Bundle transaction could not be removed from mempool", "tx", log.NewLazyHash(tx), "err", err)
+			continue
+		}
+		mem.forceRemoveFromCache(tx)
+		mem.notifyTxRemoved(tx, TxRemovalReasonBundle)
+	}
+}
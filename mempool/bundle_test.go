@@ -0,0 +1,174 @@
+package mempool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestMempoolBundle(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	removed := make(map[string]TxRemovalReason)
+	mp.onRemovedTx = func(tx types.Tx, reason TxRemovalReason) {
+		removed[string(tx)] = reason
+	}
+
+	tx1 := types.Tx(kvstore.NewTxFromID(1))
+	_, err := mp.CheckTx(tx1, noSender)
+	require.NoError(t, err)
+
+	// All the txs of a bundle go to the lane of its first tx.
+	bundle := types.Txs{kvstore.NewTxFromID(3), kvstore.NewTxFromID(4), kvstore.NewTxFromID(5)}
+	responses, err := mp.CheckBundle(bundle)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+	require.Equal(t, 4, mp.Size())
+	for _, tx := range bundle {
+		assert.Equal(t, LaneID("bar"), mp.txsMap[tx.Key()].Value.(*mempoolTx).lane)
+	}
+
+	// The bundle is reaped as a whole, or skipped if it does not fit.
+	assert.Equal(t, types.Txs{tx1, bundle[0], bundle[1], bundle[2]}, mp.ReapMaxBytesMaxGas(-1, -1))
+	assert.Equal(t, types.Txs{tx1}, mp.ReapMaxBytesMaxGas(-1, 3))
+	assert.Equal(t, types.Txs{tx1}, mp.ReapMaxBytesMaxGas(types.ComputeProtoSizeForTxs(bundle), -1))
+	assert.Equal(t, types.Txs{tx1}, mp.ReapMaxTxs(3))
+
+	// Bundles with an invalid tx are rejected, and their txs can be submitted
+	// again.
+	invalid := types.Txs{kvstore.NewTxFromID(10), types.Tx("invalid")}
+	responses, err = mp.CheckBundle(invalid)
+	var bundleErr ErrInvalidBundleTx
+	require.ErrorAs(t, err, &bundleErr)
+	assert.Equal(t, 1, bundleErr.Index)
+	require.ErrorAs(t, err, &ErrInvalidTx{})
+	require.Len(t, responses, 2)
+	assert.False(t, mp.Contains(invalid[0].Key()))
+	_, err = mp.CheckTx(invalid[0], noSender)
+	require.NoError(t, err)
+
+	_, err = mp.CheckBundle(nil)
+	require.ErrorIs(t, err, ErrEmptyBundle)
+	_, err = mp.CheckBundle(types.Txs{kvstore.NewTxFromID(12), kvstore.NewTxFromID(12)})
+	require.ErrorIs(t, err, ErrTxInBundleTwice)
+	_, err = mp.CheckBundle(types.Txs{kvstore.NewTxFromID(13), tx1})
+	require.ErrorIs(t, err, ErrTxInCache)
+	assert.False(t, mp.Contains(types.Tx(kvstore.NewTxFromID(13)).Key()))
+
+	// The txs of a bundle partially committed are removed.
+	doUpdate(t, mp, 1, types.Txs{bundle[0]})
+	require.Equal(t, 2, mp.Size())
+	assert.Equal(t, map[string]TxRemovalReason{
+		string(bundle[1]): TxRemovalReasonBundle,
+		string(bundle[2]): TxRemovalReasonBundle,
+	}, removed)
+	_, err = mp.CheckTx(bundle[1], noSender)
+	require.NoError(t, err)
+
+	// Removing a tx of a bundle removes the whole bundle.
+	clear(removed)
+	bundle = types.Txs{kvstore.NewTxFromID(20), kvstore.NewTxFromID(21)}
+	_, err = mp.CheckBundle(bundle)
+	require.NoError(t, err)
+	require.NoError(t, mp.RemoveTxByKey(bundle[1].Key()))
+	assert.False(t, mp.Contains(bundle[0].Key()))
+	assert.Equal(t, map[string]TxRemovalReason{string(bundle[0]): TxRemovalReasonBundle}, removed)
+
+	// A bundle fully committed is removed as committed.
+	clear(removed)
+	bundle = types.Txs{kvstore.NewTxFromID(22), kvstore.NewTxFromID(23)}
+	_, err = mp.CheckBundle(bundle)
+	require.NoError(t, err)
+	doUpdate(t, mp, 2, bundle)
+	assert.False(t, mp.Contains(bundle[0].Key()))
+	assert.Empty(t, removed)
+	require.Equal(t, 3, mp.Size())
+}
+
+func TestMempoolBundleCapacity(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 8 // 2 txs per lane
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	// A bundle must fit in its lane.
+	_, err := mp.CheckBundle(types.Txs{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2), kvstore.NewTxFromID(4)})
+	require.ErrorAs(t, err, &ErrLaneIsFull{})
+	require.Zero(t, mp.Size())
+
+	bundle := types.Txs{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)}
+	_, err = mp.CheckBundle(bundle)
+	require.NoError(t, err)
+
+	// The txs of a bundle are not evicted by txs with a higher priority.
+	evicted, err := mp.txsToEvict(len(kvstore.NewTxFromID(4)), 10, defaultLane)
+	require.ErrorAs(t, err, &ErrLaneIsFull{})
+	assert.Empty(t, evicted)
+}
+
+// checkTxHookApp is a kvstore application calling a hook before checking each
+// tx.
+type checkTxHookApp struct {
+	*kvstore.Application
+	onCheckTx func(tx types.Tx)
+}
+
+func (app *checkTxHookApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	app.onCheckTx(req.Tx)
+	return app.Application.CheckTx(ctx, req)
+}
+
+func TestMempoolBundleCheckedWhileCommitting(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	// The blocks are committed while the app is checking a tx, so it cannot
+	// recheck the txs.
+	cfg.Mempool.Recheck = false
+	app := &checkTxHookApp{Application: kvstore.NewInMemoryApplication()}
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(app), cfg)
+	defer cleanup()
+
+	// A block committed while the txs of a bundle are checked does not wait
+	// for the check to end, and the txs are checked again.
+	bundle := types.Txs{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)}
+	height := int64(0)
+	checks := make(map[string]int)
+	app.onCheckTx = func(tx types.Tx) {
+		checks[string(tx)]++
+		if string(tx) == string(bundle[1]) && checks[string(tx)] == 1 {
+			height++
+			doUpdate(t, mp, height, nil)
+		}
+	}
+	_, err := mp.CheckBundle(bundle)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{string(bundle[0]): 2, string(bundle[1]): 2}, checks)
+	require.Equal(t, 2, mp.Size())
+
+	// The bundle is rejected if blocks keep being committed, and can be
+	// submitted again.
+	bundle = types.Txs{kvstore.NewTxFromID(3), kvstore.NewTxFromID(4)}
+	app.onCheckTx = func(types.Tx) {
+		height++
+		doUpdate(t, mp, height, nil)
+	}
+	_, err = mp.CheckBundle(bundle)
+	require.ErrorIs(t, err, ErrBundleCheckInterrupted)
+	require.Equal(t, 2, mp.Size())
+
+	app.onCheckTx = func(types.Tx) {}
+	_, err = mp.CheckBundle(bundle)
+	require.NoError(t, err)
+	require.Equal(t, 4, mp.Size())
+}
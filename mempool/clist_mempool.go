@@ -2,7 +2,9 @@ package mempool
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sync/atomic"
@@ -10,6 +12,7 @@ import (
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
+	protomem "github.com/cometbft/cometbft/api/cometbft/mempool/v2"
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/internal/clist"
 	"github.com/cometbft/cometbft/libs/log"
@@ -38,6 +41,8 @@ type CListMempool struct {
 	notifiedTxsAvailable atomic.Bool
 	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
 	onNewTx              func(types.Tx)
+	onRemovedTx          func(types.Tx, TxRemovalReason)
+	onTxChecked          func(p2p.ID, bool) // set by the reactor to track invalid txs of peers
 
 	config *config.MempoolConfig
 
@@ -53,21 +58,52 @@ type CListMempool struct {
 	recheck *recheck
 
 	// Data in the following variables must to be kept in sync and updated atomically.
-	txsMtx    cmtsync.RWMutex
-	lanes     map[LaneID]*clist.CList         // each lane is a linked-list of (valid) txs
-	txsMap    map[types.TxKey]*clist.CElement // for quick access to the mempool entry of a given tx
-	laneBytes map[LaneID]int64                // number of bytes per lane (for metrics)
-	txsBytes  int64                           // total size of mempool, in bytes
-	numTxs    int64                           // total number of txs in the mempool
+	txsMtx     cmtsync.RWMutex
+	lanes      map[LaneID]*clist.CList         // each lane is a linked-list of (valid) txs; replaced, never modified
+	txsMap     map[types.TxKey]*clist.CElement // for quick access to the mempool entry of a given tx
+	laneBytes  map[LaneID]int64                // number of bytes per lane (for metrics)
+	laneOrders map[LaneID]*laneOrder           // entries of each lane by decreasing priority
+	txsBytes   int64                           // total size of mempool, in bytes
+	numTxs     int64                           // total number of txs in the mempool
+
+	// Number of txs and bytes of bundles per lane, which cannot be evicted.
+	laneBundleTxs   map[LaneID]int
+	laneBundleBytes map[LaneID]int64
+
+	// Key of the tx in the mempool with each replacement key set by the app.
+	replacementKeys map[string]types.TxKey
+
+	// The journal, if enabled, is written by journalRoutine, so that writing
+	// and compacting it, which syncs it to disk, is not done while holding
+	// txsMtx. The changes to record are queued while holding txsMtx, in the
+	// order in which they are made.
+	journal        *journal    // only used by journalRoutine once started
+	journaling     atomic.Bool // whether the changes are recorded in the journal
+	journalMtx     cmtsync.Mutex
+	journalRecs    []*protomem.JournalRecord // records to write, protected by journalMtx
+	journalCompact bool                      // whether to compact the journal, protected by journalMtx
+	journalCh      chan struct{}             // signals journalRoutine that there are records to write
+	journalQuit    chan struct{}
+	journalDone    chan struct{}
+
+	// Delivers the events about the txs added and removed to subscribers.
+	txFeed *txFeed
 
 	addTxChMtx    cmtsync.RWMutex  // Protects the fields below
 	addTxCh       chan struct{}    // Blocks until the next TX is added
 	addTxSeq      int64            // Helps detect is new TXs have been added to a given lane
 	addTxLaneSeqs map[LaneID]int64 // Sequence of the last TX added to a given lane
 
-	// Immutable fields, only set during initialization.
-	defaultLane LaneID
-	sortedLanes []lane // lanes sorted by priority, in descending order
+	// Lanes, set during initialization and changed by UpdateLanes, while
+	// holding the update lock, txsMtx and addTxChMtx.
+	defaultLane  LaneID
+	sortedLanes  []lane // lanes sorted by priority, in descending order
+	lanesVersion int64  // incremented each time the lanes change
+
+	// Maximum number of txs and bytes of the lanes given in the config.
+	// Immutable.
+	laneMaxTxs   map[LaneID]int
+	laneMaxBytes map[LaneID]int64
 
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
@@ -107,39 +143,46 @@ func NewCListMempool(
 	options ...CListMempoolOption,
 ) *CListMempool {
 	mp := &CListMempool{
-		config:        cfg,
-		proxyAppConn:  proxyAppConn,
-		txsMap:        make(map[types.TxKey]*clist.CElement),
-		laneBytes:     make(map[LaneID]int64),
-		logger:        log.NewNopLogger(),
-		metrics:       NopMetrics(),
-		addTxCh:       make(chan struct{}),
-		addTxLaneSeqs: make(map[LaneID]int64),
+		config:          cfg,
+		proxyAppConn:    proxyAppConn,
+		txsMap:          make(map[types.TxKey]*clist.CElement),
+		replacementKeys: make(map[string]types.TxKey),
+		txFeed:          newTxFeed(),
+		laneBytes:       make(map[LaneID]int64),
+		laneBundleTxs:   make(map[LaneID]int),
+		laneBundleBytes: make(map[LaneID]int64),
+		logger:          log.NewNopLogger(),
+		metrics:         NopMetrics(),
+		addTxCh:         make(chan struct{}),
+		addTxLaneSeqs:   make(map[LaneID]int64),
 	}
 	mp.height.Store(height)
 
 	// Initialize lanes
 	if lanesInfo == nil || len(lanesInfo.lanes) == 0 {
-		// The only lane will be "default" with priority 1.
-		lanesInfo = &LanesInfo{lanes: map[LaneID]LanePriority{defaultLane: 1}, defaultLane: defaultLane}
+		lanesInfo = defaultLanesInfo()
 	}
-	numLanes := len(lanesInfo.lanes)
-	mp.lanes = make(map[LaneID]*clist.CList, numLanes)
-	mp.defaultLane = lanesInfo.defaultLane
-	mp.sortedLanes = make([]lane, 0, numLanes)
-	for id, priority := range lanesInfo.lanes {
+	mp.lanes = make(map[LaneID]*clist.CList, len(lanesInfo.lanes))
+	mp.laneOrders = make(map[LaneID]*laneOrder, len(lanesInfo.lanes))
+	for id := range lanesInfo.lanes {
 		mp.lanes[id] = clist.New()
-		mp.sortedLanes = append(mp.sortedLanes, lane{id: id, priority: priority})
+		mp.laneOrders[id] = &laneOrder{}
+	}
+	mp.defaultLane = lanesInfo.defaultLane
+	mp.sortedLanes = lanesInfo.sortedLanes()
+
+	maxTxs, maxBytes, err := cfg.LaneLimits()
+	if err != nil {
+		panic(fmt.Sprintf("invalid mempool config: %v", err))
+	}
+	mp.laneMaxTxs = make(map[LaneID]int, len(maxTxs))
+	for id, limit := range maxTxs {
+		mp.laneMaxTxs[LaneID(id)] = int(limit)
+	}
+	mp.laneMaxBytes = make(map[LaneID]int64, len(maxBytes))
+	for id, limit := range maxBytes {
+		mp.laneMaxBytes[LaneID(id)] = limit
 	}
-	slices.SortStableFunc(mp.sortedLanes, func(i, j lane) int {
-		if i.priority > j.priority {
-			return -1
-		}
-		if i.priority < j.priority {
-			return 1
-		}
-		return 0
-	})
 
 	mp.recheck = newRecheck(mp)
 
@@ -194,7 +237,11 @@ func (mem *CListMempool) removeAllTxs(lane LaneID) {
 		e.DetachPrev()
 	}
 	mem.txsMap = make(map[types.TxKey]*clist.CElement)
+	mem.replacementKeys = make(map[string]types.TxKey)
+	mem.laneOrders[lane] = &laneOrder{}
 	delete(mem.laneBytes, lane)
+	delete(mem.laneBundleTxs, lane)
+	delete(mem.laneBundleBytes, lane)
 	mem.txsBytes = 0
 }
 
@@ -218,6 +265,10 @@ func (mem *CListMempool) addSender(txKey types.TxKey, sender p2p.ID) error {
 		// It should not be possible to receive twice a tx from the same sender.
 		return ErrTxAlreadyReceivedFromSender
 	}
+	if memTx.bundle == nil {
+		// Recording the tx again updates its senders in the journal.
+		mem.writeJournal(func() *protomem.JournalRecord { return addTxRecord(memTx) })
+	}
 	return nil
 }
 
@@ -256,6 +307,14 @@ func WithNewTxCallback(cb func(types.Tx)) CListMempoolOption {
 	return func(mem *CListMempool) { mem.onNewTx = cb }
 }
 
+// WithRemovedTxCallback sets a callback function to be executed when a
+// transaction is removed from the mempool without being committed. The
+// callback function will receive the removed transaction and the reason for
+// its removal.
+func WithRemovedTxCallback(cb func(types.Tx, TxRemovalReason)) CListMempoolOption {
+	return func(mem *CListMempool) { mem.onRemovedTx = cb }
+}
+
 // Lock acquires the exclusive lock for mempool updates.
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
@@ -310,6 +369,186 @@ func (mem *CListMempool) LaneSizes(lane LaneID) (numTxs int, bytes int64) {
 	return txs.Len(), bytes
 }
 
+// LaneStats holds the state of a lane of the mempool.
+type LaneStats struct {
+	ID       LaneID
+	Priority LanePriority
+	Default  bool
+	NumTxs   int
+	Bytes    int64
+	MaxTxs   int
+	MaxBytes int64
+}
+
+// Lanes returns the state of each lane of the mempool, by decreasing priority.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) Lanes() []LaneStats {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	stats := make([]LaneStats, len(mem.sortedLanes))
+	for i, lane := range mem.sortedLanes {
+		maxTxs, maxBytes := mem.laneCapacity(lane.id)
+		stats[i] = LaneStats{
+			ID:       lane.id,
+			Priority: lane.priority,
+			Default:  lane.id == mem.defaultLane,
+			NumTxs:   mem.lanes[lane.id].Len(),
+			Bytes:    mem.laneBytes[lane.id],
+			MaxTxs:   maxTxs,
+			MaxBytes: maxBytes,
+		}
+	}
+	return stats
+}
+
+// UpdateLanes replaces the lanes of the mempool with the given ones, or with a
+// single default lane if there are none. The txs of the lanes that are kept
+// stay where they are, even if the new capacity of their lane is exceeded. The
+// txs of the lanes that are removed are moved to the back of the new default
+// lane, in the order in which they were added to the mempool.
+//
+// Lock() must be help by the caller during execution.
+func (mem *CListMempool) UpdateLanes(lanesInfo *LanesInfo) error {
+	if lanesInfo == nil || len(lanesInfo.lanes) == 0 {
+		lanesInfo = defaultLanesInfo()
+	}
+	if err := validate(*lanesInfo); err != nil {
+		return err
+	}
+
+	removedLanes, numMoved := mem.updateLanes(lanesInfo)
+
+	mem.logger.Info("Updated mempool lanes",
+		"lanes", len(lanesInfo.lanes),
+		"default", lanesInfo.defaultLane,
+		"removed", len(removedLanes),
+		"moved_txs", numMoved,
+	)
+
+	// Update metrics.
+	for _, laneID := range removedLanes {
+		label := string(laneID)
+		mem.metrics.LaneSize.With("lane", label).Set(0)
+		mem.metrics.LaneBytes.With("lane", label).Set(0)
+		mem.metrics.LaneMaxTxs.With("lane", label).Set(0)
+		mem.metrics.LaneMaxBytes.With("lane", label).Set(0)
+		mem.metrics.LanePriority.With("lane", label).Set(0)
+	}
+	mem.updateLaneMetrics()
+	return nil
+}
+
+// updateLanes installs the given lanes and moves the txs of the lanes removed
+// to the default lane. It returns the lanes removed and the number of txs
+// moved.
+func (mem *CListMempool) updateLanes(lanesInfo *LanesInfo) (removedLanes []LaneID, numMoved int) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	// Iterators may still be reading the current map of lanes, so a new one is
+	// built, keeping the lists of the lanes that stay.
+	lanes := make(map[LaneID]*clist.CList, len(lanesInfo.lanes))
+	laneOrders := make(map[LaneID]*laneOrder, len(lanesInfo.lanes))
+	for id := range lanesInfo.lanes {
+		if txs, ok := mem.lanes[id]; ok {
+			lanes[id] = txs
+			laneOrders[id] = mem.laneOrders[id]
+		} else {
+			lanes[id] = clist.New()
+			laneOrders[id] = &laneOrder{}
+		}
+	}
+	var moved []*mempoolTx
+	for id, txs := range mem.lanes {
+		if _, ok := lanes[id]; ok {
+			continue
+		}
+		removedLanes = append(removedLanes, id)
+		for e := txs.Front(); e != nil; e = e.Next() {
+			moved = append(moved, e.Value.(*mempoolTx))
+		}
+	}
+	slices.SortFunc(moved, func(a, b *mempoolTx) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	mem.addTxChMtx.Lock()
+	defer mem.addTxChMtx.Unlock()
+
+	// Entries may be held by iterators, so each tx moved gets a new entry in
+	// the default lane, keeping the height and time at which it was added.
+	defaultLane := lanesInfo.defaultLane
+	for _, memTx := range moved {
+		mem.addTxSeq++
+		newMemTx := &mempoolTx{
+			tx:             memTx.tx,
+			height:         memTx.Height(),
+			timestamp:      memTx.timestamp,
+			gasWanted:      memTx.gasWanted,
+			priority:       memTx.priority,
+			replacementKey: memTx.replacementKey,
+			lane:           defaultLane,
+			seq:            mem.addTxSeq,
+			bundle:         memTx.bundle,
+		}
+		for _, sender := range memTx.Senders() {
+			_ = newMemTx.addSender(sender)
+		}
+
+		txKey := memTx.tx.Key()
+		elem := mem.txsMap[txKey]
+		mem.lanes[memTx.lane].Remove(elem)
+		elem.DetachPrev()
+		mem.txsMap[txKey] = lanes[defaultLane].PushBack(newMemTx)
+		laneOrders[defaultLane].add(mem.txsMap[txKey])
+		mem.laneBytes[defaultLane] += int64(len(memTx.tx))
+		if memTx.bundle != nil {
+			mem.laneBundleTxs[defaultLane]++
+			mem.laneBundleBytes[defaultLane] += int64(len(memTx.tx))
+		}
+
+		mem.txFeed.publish(TxEvent{
+			Type:   TxEventRemoved,
+			TxKey:  txKey,
+			Lane:   memTx.lane,
+			Size:   len(memTx.tx),
+			Reason: TxRemovalReasonLaneRemoved,
+		})
+		mem.txFeed.publish(TxEvent{
+			Type:  TxEventAdded,
+			TxKey: txKey,
+			Lane:  defaultLane,
+			Size:  len(memTx.tx),
+		})
+		mem.metrics.MovedTxs.With("lane", string(memTx.lane)).Add(1)
+	}
+	for _, id := range removedLanes {
+		delete(mem.laneBytes, id)
+		delete(mem.laneBundleTxs, id)
+		delete(mem.laneBundleBytes, id)
+		delete(mem.addTxLaneSeqs, id)
+	}
+
+	mem.lanes = lanes
+	mem.laneOrders = laneOrders
+	mem.defaultLane = defaultLane
+	mem.sortedLanes = lanesInfo.sortedLanes()
+	mem.lanesVersion++
+
+	if len(moved) > 0 {
+		mem.addTxLaneSeqs[defaultLane] = mem.addTxSeq
+		// The journal records the lane of each tx.
+		mem.compactJournal()
+	}
+	// Notify iterators that the lanes changed.
+	close(mem.addTxCh)
+	mem.addTxCh = make(chan struct{})
+
+	return removedLanes, len(moved)
+}
+
 // Lock() must be help by the caller during execution.
 func (mem *CListMempool) FlushAppConn() error {
 	err := mem.proxyAppConn.Flush(context.TODO())
@@ -332,6 +571,10 @@ func (mem *CListMempool) Flush() {
 	for lane := range mem.lanes {
 		mem.removeAllTxs(lane)
 	}
+
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+	mem.compactJournal()
 }
 
 func (mem *CListMempool) Contains(txKey types.TxKey) bool {
@@ -431,44 +674,85 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 				"err", postCheckErr,
 			)
 			mem.metrics.FailedTxs.Add(1)
+			mem.notifyTxChecked(sender, false)
 
 			if postCheckErr != nil {
 				return postCheckErr
 			}
 			return ErrInvalidTx{Code: res.Code, Data: res.Data, Log: res.Log, Codespace: res.Codespace, Hash: tx.Hash()}
 		}
+		mem.notifyTxChecked(sender, true)
 
 		// If the app returned a non-empty lane, use it; otherwise use the default lane.
 		lane := mem.defaultLane
 		if res.LaneId != "" {
+			lane = LaneID(res.LaneId)
+			// The app may assign txs to new lanes before the mempool is
+			// updated with them at the end of the block.
 			if _, ok := mem.lanes[lane]; !ok {
-				panic(ErrLaneNotFound{laneID: lane})
+				mem.forceRemoveFromCache(tx) // the lane may exist later
+				mem.logger.Debug("Rejected transaction of unknown lane", "tx", log.NewLazyHash(tx), "lane", lane)
+				mem.metrics.RejectedTxs.Add(1)
+				return ErrLaneNotFound{laneID: lane}
 			}
-			lane = LaneID(res.LaneId)
 		}
 
-		if err := mem.isLaneFull(len(tx), lane); err != nil {
-			mem.forceRemoveFromCache(tx) // lane might have space later
-			// use debug level to avoid spamming logs when traffic is high
-			mem.logger.Debug(err.Error())
+		// If the tx replaces a tx in the mempool, swap them.
+		replaced, err := mem.replaceTx(tx, res, sender, lane)
+		if err != nil {
+			mem.forceRemoveFromCache(tx) // the tx may be accepted later
+			mem.logger.Debug("Could not replace transaction", "tx", log.NewLazyHash(tx), "err", err)
 			mem.metrics.RejectedTxs.Add(1)
 			return err
 		}
+		if replaced != nil {
+			// Keep the replaced tx in the cache, so that it is not added back
+			// when received again from peers.
+			mem.cache.Push(replaced.tx)
+			mem.metrics.ReplacedTxs.With("lane", string(lane)).Add(1)
+			mem.notifyTxRemoved(replaced.tx, TxRemovalReasonReplaced)
+			mem.notifyTxsAvailable()
+			if mem.onNewTx != nil {
+				mem.onNewTx(tx)
+			}
+			mem.updateSizeMetrics(lane)
+			return nil
+		}
 
-		// Check that tx is not already in the mempool. This can happen when the
-		// cache overflows. See https://github.com/cometbft/cometbft/pull/890.
-		txKey := tx.Key()
-		if mem.Contains(txKey) {
+		// Add tx to mempool, evicting txs with a lower priority if needed.
+		evicted, err := mem.addNewTx(tx, res, sender, lane)
+		if errors.Is(err, ErrTxInMempool) {
+			// This can happen when the cache overflows.
+			// See https://github.com/cometbft/cometbft/pull/890.
 			mem.metrics.RejectedTxs.Add(1)
-			if err := mem.addSender(txKey, sender); err != nil {
+			if err := mem.addSender(tx.Key(), sender); err != nil {
 				mem.logger.Error("Could not add sender to tx", "tx", tx.Hash(), "sender", sender, "err", err)
 			}
-			mem.logger.Debug("Reject tx", "tx", log.NewLazyHash(tx), "height", mem.height.Load(), "err", ErrTxInMempool)
-			return ErrTxInMempool
+			mem.logger.Debug("Reject tx", "tx", log.NewLazyHash(tx), "height", mem.height.Load(), "err", err)
+			return err
+		}
+		if err != nil {
+			mem.forceRemoveFromCache(tx) // lane might have space later
+			// use debug level to avoid spamming logs when traffic is high
+			mem.logger.Debug(err.Error())
+			mem.metrics.RejectedTxs.Add(1)
+			return err
+		}
+		for _, memTx := range evicted {
+			// The tx is still valid, so it may be added again later.
+			mem.forceRemoveFromCache(memTx.tx)
+			mem.metrics.PriorityEvictedTxs.With("lane", string(lane)).Add(1)
+			mem.logger.Debug(
+				"Evicted transaction with lower priority",
+				"tx", log.NewLazyHash(memTx.tx),
+				"lane", lane,
+				"priority", memTx.priority,
+				"new-priority", res.TxPriority,
+			)
+			mem.notifyTxRemoved(memTx.tx, TxRemovalReasonEvicted)
 		}
 
-		// Add tx to mempool and notify that new txs are available.
-		mem.addTx(tx, res.GasWanted, sender, lane)
+		// Notify that new txs are available.
 		mem.notifyTxsAvailable()
 
 		if mem.onNewTx != nil {
@@ -481,12 +765,44 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 	}
 }
 
+// addNewTx adds a valid tx that does not replace another tx to its lane. If
+// the mempool or the lane is full, it first evicts the txs of the lane with
+// the lowest priority, as long as their priority is lower than the new tx's
+// and evicting them makes enough room; otherwise, nothing is evicted and an
+// error is returned. Checking the capacity, evicting and adding the tx are
+// done atomically, so that concurrent CheckTx responses cannot overfill the
+// mempool. It returns the txs evicted.
+//
 // Called from:
 //   - handleCheckTxResponse (lock not held) if tx is valid
-func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane LaneID) {
+func (mem *CListMempool) addNewTx(tx types.Tx, res *abci.CheckTxResponse, sender p2p.ID, lane LaneID) ([]*mempoolTx, error) {
 	mem.txsMtx.Lock()
 	defer mem.txsMtx.Unlock()
 
+	if _, ok := mem.txsMap[tx.Key()]; ok {
+		return nil, ErrTxInMempool
+	}
+	if mem.recheck.consideredFull() {
+		return nil, ErrRecheckFull
+	}
+
+	evicted, err := mem.txsToEvict(len(tx), res.TxPriority, lane)
+	if err != nil {
+		return nil, err
+	}
+	for _, memTx := range evicted {
+		// The txs to evict are in the mempool and not part of a bundle.
+		if err := mem.removeTxLocked(memTx.tx.Key(), TxRemovalReasonEvicted); err != nil {
+			panic(fmt.Sprintf("could not evict tx %X: %v", memTx.tx.Hash(), err))
+		}
+	}
+	mem.addTxLocked(tx, res, sender, lane, nil)
+	return evicted, nil
+}
+
+// addTxLocked adds a valid tx to its lane, as part of the given bundle, if
+// not nil. The caller must hold txsMtx.
+func (mem *CListMempool) addTxLocked(tx types.Tx, res *abci.CheckTxResponse, sender p2p.ID, lane LaneID, bundle *txBundle) {
 	// Get lane's clist.
 	txs, ok := mem.lanes[lane]
 	if !ok {
@@ -501,24 +817,46 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane
 
 	// Add new transaction.
 	memTx := &mempoolTx{
-		tx:        tx,
-		height:    mem.height.Load(),
-		gasWanted: gasWanted,
-		lane:      lane,
-		seq:       mem.addTxSeq,
+		tx:             tx,
+		height:         mem.height.Load(),
+		timestamp:      cmttime.Now(),
+		gasWanted:      res.GasWanted,
+		priority:       res.TxPriority,
+		replacementKey: res.ReplacementKey,
+		lane:           lane,
+		seq:            mem.addTxSeq,
+		bundle:         bundle,
 	}
 	_ = memTx.addSender(sender)
 	e := txs.PushBack(memTx)
+	mem.laneOrders[lane].add(e)
 
 	// Update auxiliary variables.
 	mem.txsMap[tx.Key()] = e
+	if memTx.replacementKey != "" {
+		mem.replacementKeys[memTx.replacementKey] = tx.Key()
+	}
 	mem.txsBytes += int64(len(tx))
 	mem.numTxs++
 	mem.laneBytes[lane] += int64(len(tx))
+	if bundle != nil {
+		mem.laneBundleTxs[lane]++
+		mem.laneBundleBytes[lane] += int64(len(tx))
+	} else {
+		// Bundles are journaled as a whole once all their txs are added.
+		mem.writeJournal(func() *protomem.JournalRecord { return addTxRecord(memTx) })
+	}
 
-	// Notify iterators there's a new transaction.
+	// Notify iterators and subscribers there's a new transaction.
 	close(mem.addTxCh)
 	mem.addTxCh = make(chan struct{})
+	mem.txFeed.publish(TxEvent{
+		Type:   TxEventAdded,
+		TxKey:  tx.Key(),
+		Lane:   lane,
+		Size:   len(tx),
+		Sender: sender,
+	})
 
 	// Update metrics.
 	mem.metrics.TxSizeBytes.Observe(float64(len(tx)))
@@ -527,19 +865,104 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane
 		"Added transaction",
 		"tx", log.NewLazyHash(tx),
 		"lane", lane,
+		"priority", memTx.priority,
 		"height", mem.height.Load(),
 		"total", mem.numTxs,
 	)
 }
 
+// replaceTx swaps tx with the tx in the mempool that it replaces, as given by
+// the replaced tx key or the replacement key set by the application in res.
+// It returns the replaced tx, or nil if tx does not replace any tx in the
+// mempool, in which case the caller adds it as a new tx. The replaced tx must
+// be in the same lane as tx, and tx must fit in the room it leaves.
+//
+// Called from:
+//   - handleCheckTxResponse (lock not held) if tx is valid
+func (mem *CListMempool) replaceTx(tx types.Tx, res *abci.CheckTxResponse, sender p2p.ID, lane LaneID) (*mempoolTx, error) {
+	if len(res.ReplacedTxKey) == 0 && res.ReplacementKey == "" {
+		return nil, nil
+	}
+
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	var (
+		replacedKey types.TxKey
+		found       bool
+	)
+	switch {
+	case len(res.ReplacedTxKey) == types.TxKeySize:
+		replacedKey = types.TxKey(res.ReplacedTxKey)
+		_, found = mem.txsMap[replacedKey]
+	case len(res.ReplacedTxKey) != 0:
+		return nil, ErrInvalidReplacedTxKey{Key: res.ReplacedTxKey}
+	default:
+		replacedKey, found = mem.replacementKeys[res.ReplacementKey]
+	}
+	txKey := tx.Key()
+	if !found || replacedKey == txKey {
+		return nil, nil
+	}
+	if _, ok := mem.txsMap[txKey]; ok {
+		// The tx is already in the mempool, which is handled by the caller.
+		return nil, nil
+	}
+
+	replaced := mem.txsMap[replacedKey].Value.(*mempoolTx)
+	if replaced.lane != lane {
+		return nil, ErrReplacementLaneMismatch{Lane: lane, ReplacedLane: replaced.lane}
+	}
+	if mem.recheck.consideredFull() {
+		return nil, ErrRecheckFull
+	}
+	replacedSize := int64(len(replaced.tx))
+	err := mem.checkCapacity(
+		len(tx),
+		lane,
+		int(mem.numTxs)-1,
+		mem.txsBytes-replacedSize,
+		mem.lanes[lane].Len()-1,
+		mem.laneBytes[lane]-replacedSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mem.removeTxLocked(replacedKey, TxRemovalReasonReplaced); err != nil {
+		return nil, err
+	}
+	mem.addTxLocked(tx, res, sender, lane, nil)
+	mem.logger.Debug(
+		"Replaced transaction",
+		"tx", log.NewLazyHash(replaced.tx),
+		"new-tx", log.NewLazyHash(tx),
+		"lane", lane,
+	)
+	return replaced, nil
+}
+
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+	return mem.removeTx(txKey, TxRemovalReasonRemoved)
+}
+
+// removeTx removes the tx with the given key from its lane, for the given
+// reason.
 // Called from:
 //   - Update (updateMtx held) if tx was committed
 //   - handleRecheckTxResponse (updateMtx not held) if tx was invalidated
-func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+//   - purgeExpiredTxs if tx expired
+func (mem *CListMempool) removeTx(txKey types.TxKey, reason TxRemovalReason) error {
 	mem.txsMtx.Lock()
 	defer mem.txsMtx.Unlock()
 
+	return mem.removeTxLocked(txKey, reason)
+}
+
+// removeTxLocked removes the tx with the given key from its lane, for the
+// given reason. The caller must hold txsMtx.
+func (mem *CListMempool) removeTxLocked(txKey types.TxKey, reason TxRemovalReason) error {
 	elem, ok := mem.txsMap[txKey]
 	if !ok {
 		return ErrTxNotFound
@@ -553,12 +976,28 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 	// Remove tx from lane.
 	mem.lanes[memTx.lane].Remove(elem)
 	elem.DetachPrev()
+	mem.laneOrders[memTx.lane].remove()
 
 	// Update auxiliary variables.
 	delete(mem.txsMap, txKey)
+	if memTx.replacementKey != "" && mem.replacementKeys[memTx.replacementKey] == txKey {
+		delete(mem.replacementKeys, memTx.replacementKey)
+	}
 	mem.txsBytes -= int64(len(memTx.tx))
 	mem.numTxs--
 	mem.laneBytes[memTx.lane] -= int64(len(memTx.tx))
+	if memTx.bundle != nil {
+		mem.laneBundleTxs[memTx.lane]--
+		mem.laneBundleBytes[memTx.lane] -= int64(len(memTx.tx))
+	}
+	mem.writeJournal(func() *protomem.JournalRecord { return removeTxRecord(txKey) })
+	mem.txFeed.publish(TxEvent{
+		Type:   TxEventRemoved,
+		TxKey:  txKey,
+		Lane:   memTx.lane,
+		Size:   len(memTx.tx),
+		Reason: reason,
+	})
 
 	mem.logger.Debug(
 		"Removed transaction",
@@ -567,36 +1006,113 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 		"height", mem.height.Load(),
 		"total", mem.numTxs,
 	)
+
+	// The other txs of the bundle cannot be included in a block anymore. The
+	// txs of a committed bundle are removed by Update.
+	if memTx.bundle != nil && reason != TxRemovalReasonCommitted && reason != TxRemovalReasonBundle {
+		mem.removeBundleLocked(memTx.bundle)
+	}
 	return nil
 }
 
+// isFull returns an error if a tx of the given size cannot be added to the
+// mempool, whatever the lane and priority the application gives it, so that
+// the application is not asked to check it. Since a tx may evict the txs of
+// its lane with a lower priority, that is the case when evicting all the txs
+// of any lane that are not part of a bundle would not make enough room.
 func (mem *CListMempool) isFull(txSize int) error {
-	memSize := mem.Size()
-	txsBytes := mem.SizeBytes()
-	if memSize >= mem.config.Size || uint64(txSize)+uint64(txsBytes) > uint64(mem.config.MaxTxsBytes) {
-		return ErrMempoolIsFull{
-			NumTxs:      memSize,
-			MaxTxs:      mem.config.Size,
-			TxsBytes:    txsBytes,
-			MaxTxsBytes: mem.config.MaxTxsBytes,
-		}
-	}
-
 	if mem.recheck.consideredFull() {
 		return ErrRecheckFull
 	}
 
-	return nil
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	var err error
+	for _, lane := range mem.sortedLanes {
+		evictableTxs := mem.lanes[lane.id].Len() - mem.laneBundleTxs[lane.id]
+		evictableBytes := mem.laneBytes[lane.id] - mem.laneBundleBytes[lane.id]
+		err = mem.checkCapacity(
+			txSize,
+			lane.id,
+			int(mem.numTxs)-evictableTxs,
+			mem.txsBytes-evictableBytes,
+			mem.laneBundleTxs[lane.id],
+			mem.laneBundleBytes[lane.id],
+		)
+		if err == nil {
+			return nil
+		}
+	}
+	return err
 }
 
-func (mem *CListMempool) isLaneFull(txSize int, lane LaneID) error {
-	laneTxs, laneBytes := mem.LaneSizes(lane)
+// txsToEvict returns the txs of the lane to evict for a new tx of the given
+// size and priority to fit in the mempool, by increasing priority and, among
+// txs with the same priority, from the most to the least recently added. The
+// txs of bundles are never evicted. It returns an error if evicting all the
+// txs of the lane with a lower priority does not make enough room. The caller
+// must hold txsMtx.
+func (mem *CListMempool) txsToEvict(txSize int, priority int64, laneID LaneID) ([]*mempoolTx, error) {
+	txs, ok := mem.lanes[laneID]
+	if !ok {
+		panic(ErrLaneNotFound{laneID: laneID})
+	}
+	numTxs, txsBytes := int(mem.numTxs), mem.txsBytes
+	laneTxs, laneBytes := txs.Len(), mem.laneBytes[laneID]
+
+	err := mem.checkCapacity(txSize, laneID, numTxs, txsBytes, laneTxs, laneBytes)
+	if err == nil {
+		return nil, nil
+	}
 
-	// The mempool is partitioned evenly across all lanes.
-	laneTxsCapacity := mem.config.Size / len(mem.sortedLanes)
-	laneBytesCapacity := mem.config.MaxTxsBytes / int64(len(mem.sortedLanes))
+	candidates := make([]*mempoolTx, 0)
+	for e := txs.Front(); e != nil; e = e.Next() {
+		if memTx := e.Value.(*mempoolTx); memTx.bundle == nil && memTx.priority < priority {
+			candidates = append(candidates, memTx)
+		}
+	}
+	slices.SortFunc(candidates, func(a, b *mempoolTx) int {
+		if c := cmp.Compare(a.priority, b.priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.seq, a.seq)
+	})
+
+	for i, memTx := range candidates {
+		size := int64(len(memTx.tx))
+		numTxs--
+		txsBytes -= size
+		laneTxs--
+		laneBytes -= size
+		if mem.checkCapacity(txSize, laneID, numTxs, txsBytes, laneTxs, laneBytes) == nil {
+			return candidates[:i+1], nil
+		}
+	}
+	return nil, err
+}
+
+// checkCapacity returns an error if a tx of the given size does not fit in the
+// mempool or in its lane, given the number of txs and bytes they hold.
+func (mem *CListMempool) checkCapacity(
+	txSize int,
+	lane LaneID,
+	numTxs int,
+	txsBytes int64,
+	laneTxs int,
+	laneBytes int64,
+) error {
+	if numTxs >= mem.config.Size || uint64(txSize)+uint64(txsBytes) > uint64(mem.config.MaxTxsBytes) {
+		return ErrMempoolIsFull{
+			NumTxs:      numTxs,
+			MaxTxs:      mem.config.Size,
+			TxsBytes:    txsBytes,
+			MaxTxsBytes: mem.config.MaxTxsBytes,
+		}
+	}
 
-	if laneTxs > laneTxsCapacity || int64(txSize)+laneBytes > laneBytesCapacity {
+	laneTxsCapacity, laneBytesCapacity := mem.laneCapacity(lane)
+	if laneTxs >= laneTxsCapacity || int64(txSize)+laneBytes > laneBytesCapacity {
 		return ErrLaneIsFull{
 			Lane:     lane,
 			NumTxs:   laneTxs,
@@ -606,13 +1122,24 @@ func (mem *CListMempool) isLaneFull(txSize int, lane LaneID) error {
 		}
 	}
 
-	if mem.recheck.consideredFull() {
-		return ErrRecheckFull
-	}
-
 	return nil
 }
 
+// laneCapacity returns the maximum number of txs and bytes of the given lane.
+// Unless set in the config, the mempool is partitioned evenly across all lanes,
+// each lane holding at least one tx. The caller must hold txsMtx.
+func (mem *CListMempool) laneCapacity(laneID LaneID) (maxTxs int, maxBytes int64) {
+	maxTxs, ok := mem.laneMaxTxs[laneID]
+	if !ok {
+		maxTxs = max(mem.config.Size/len(mem.sortedLanes), 1)
+	}
+	maxBytes, ok = mem.laneMaxBytes[laneID]
+	if !ok {
+		maxBytes = mem.config.MaxTxsBytes / int64(len(mem.sortedLanes))
+	}
+	return maxTxs, maxBytes
+}
+
 // handleRecheckTxResponse handles CheckTx responses for transactions in the mempool that need to be
 // revalidated after a mempool update.
 func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Response) error {
@@ -644,7 +1171,7 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 		if (res.Code != abci.CodeTypeOK) || postCheckErr != nil {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Debug("Tx is no longer valid", "tx", log.NewLazyHash(tx), "res", res, "postCheckErr", postCheckErr)
-			if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+			if err := mem.removeTx(tx.Key(), TxRemovalReasonInvalid); err != nil {
 				mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
 				return err
 			}
@@ -658,6 +1185,7 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 			}
 
 			mem.tryRemoveFromCache(tx)
+			mem.notifyTxRemoved(tx, TxRemovalReasonInvalid)
 			if postCheckErr != nil {
 				return postCheckErr
 			}
@@ -700,12 +1228,31 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, cmtmath.MinInt(mem.Size(), max/mem.avgTxSize))
 	txs := make([]types.Tx, 0, mem.Size())
+	bundles := make(map[*txBundle]struct{}) // bundles reaped or skipped
 	iter := NewNonBlockingIterator(mem)
 	for {
 		memTx := iter.Next()
 		if memTx == nil {
 			break
 		}
+
+		// A bundle is reaped as a whole, in its own order, when the first of
+		// its txs is reached, or skipped if it does not fit.
+		if bundle := memTx.(*mempoolTx).bundle; bundle != nil {
+			if _, ok := bundles[bundle]; ok {
+				continue
+			}
+			bundles[bundle] = struct{}{}
+			if (maxBytes > -1 && runningSize+bundle.size > maxBytes) ||
+				(maxGas > -1 && totalGas+bundle.gasWanted > maxGas) {
+				continue
+			}
+			txs = append(txs, bundle.txs...)
+			runningSize += bundle.size
+			totalGas += bundle.gasWanted
+			continue
+		}
+
 		txs = append(txs, memTx.Tx())
 
 		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.Tx()})
@@ -740,12 +1287,23 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	}
 
 	txs := make([]types.Tx, 0, cmtmath.MinInt(mem.Size(), max))
+	bundles := make(map[*txBundle]struct{}) // bundles reaped or skipped
 	iter := NewNonBlockingIterator(mem)
 	for len(txs) <= max {
 		memTx := iter.Next()
 		if memTx == nil {
 			break
 		}
+		if bundle := memTx.(*mempoolTx).bundle; bundle != nil {
+			if _, ok := bundles[bundle]; ok {
+				continue
+			}
+			bundles[bundle] = struct{}{}
+			if len(txs)+len(bundle.txs) <= max {
+				txs = append(txs, bundle.txs...)
+			}
+			continue
+		}
 		txs = append(txs, memTx.Tx())
 	}
 	return txs
@@ -784,6 +1342,7 @@ func (mem *CListMempool) Update(
 		mem.postCheck = postCheck
 	}
 
+	var bundles []*txBundle // bundles with committed txs
 	for i, tx := range txs {
 		if txResults[i].Code == abci.CodeTypeOK {
 			// Add valid committed tx to the cache (if missing).
@@ -792,6 +1351,10 @@ func (mem *CListMempool) Update(
 			mem.tryRemoveFromCache(tx)
 		}
 
+		if bundle := mem.txBundle(tx.Key()); bundle != nil {
+			bundles = append(bundles, bundle)
+		}
+
 		// Remove committed tx from the mempool.
 		//
 		// Note an evil proposer can drop valid txs!
@@ -802,13 +1365,21 @@ func (mem *CListMempool) Update(
 		// Mempool after:
 		//   100
 		// https://github.com/tendermint/tendermint/issues/3322.
-		if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+		if err := mem.removeTx(tx.Key(), TxRemovalReasonCommitted); err != nil {
 			mem.logger.Debug("Committed transaction not in local mempool (not an error)",
 				"tx", log.NewLazyHash(tx),
 				"error", err.Error())
 		}
 	}
 
+	// Remove the txs left of the bundles only partially included in the block.
+	for _, bundle := range bundles {
+		mem.removeBundle(bundle)
+	}
+
+	// Remove the txs that have been in the mempool for too long.
+	mem.purgeExpiredTxs(height)
+
 	// Recheck txs left in the mempool to remove them if they became invalid in the new state.
 	if mem.config.Recheck {
 		mem.recheckTxs()
@@ -820,13 +1391,348 @@ func (mem *CListMempool) Update(
 	}
 
 	// Update metrics
-	for lane := range mem.lanes {
-		mem.updateSizeMetrics(lane)
+	mem.updateLaneMetrics()
+
+	return nil
+}
+
+// purgeExpiredTxs removes from the mempool and the cache the txs that have
+// been in the mempool for more than TTLNumBlocks blocks or for longer than
+// TTLDuration, if set. The txs are removed from the cache so that they can be
+// resubmitted.
+//
+// Lock() must be help by the caller during execution.
+func (mem *CListMempool) purgeExpiredTxs(height int64) {
+	if mem.config.TTLNumBlocks <= 0 && mem.config.TTLDuration <= 0 {
+		return
+	}
+
+	type expiredTx struct {
+		memTx  *mempoolTx
+		reason TxRemovalReason
+	}
+	var expired []expiredTx
+	now := cmttime.Now()
+	mem.txsMtx.RLock()
+	for _, txs := range mem.lanes {
+		for e := txs.Front(); e != nil; e = e.Next() {
+			memTx := e.Value.(*mempoolTx)
+			switch {
+			case mem.config.TTLNumBlocks > 0 && height-memTx.Height() > mem.config.TTLNumBlocks:
+				expired = append(expired, expiredTx{memTx, TxRemovalReasonExpiredNumBlocks})
+			case mem.config.TTLDuration > 0 && now.Sub(memTx.timestamp) > mem.config.TTLDuration:
+				expired = append(expired, expiredTx{memTx, TxRemovalReasonExpiredDuration})
+			}
+		}
+	}
+	mem.txsMtx.RUnlock()
+
+	for _, e := range expired {
+		if err := mem.removeTx(e.memTx.tx.Key(), e.reason); err != nil {
+			mem.logger.Debug("Expired transaction could not be removed from mempool", "tx", log.NewLazyHash(e.memTx.tx), "err", err)
+			continue
+		}
+		mem.forceRemoveFromCache(e.memTx.tx)
+		mem.metrics.ExpiredTxs.With("lane", string(e.memTx.lane), "reason", string(e.reason)).Add(1)
+		mem.logger.Debug(
+			"Removed expired transaction",
+			"tx", log.NewLazyHash(e.memTx.tx),
+			"lane", e.memTx.lane,
+			"reason", e.reason,
+			"height", height,
+		)
+		mem.notifyTxRemoved(e.memTx.tx, e.reason)
+	}
+}
+
+// notifyTxRemoved calls the callback set for transactions removed from the
+// mempool without being committed, if any.
+func (mem *CListMempool) notifyTxRemoved(tx types.Tx, reason TxRemovalReason) {
+	if mem.onRemovedTx != nil {
+		mem.onRemovedTx(tx, reason)
+	}
+}
+
+// SubscribeTxEvents subscribes to the events about the txs added to and removed
+// from the given lanes of the mempool, or from all lanes if none is given. Up
+// to capacity events are buffered for the subscriber, after which events are
+// dropped until it catches up.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) SubscribeTxEvents(lanes []LaneID, capacity int) (*TxEventSubscription, error) {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	for _, lane := range lanes {
+		if _, ok := mem.lanes[lane]; !ok {
+			return nil, ErrLaneNotFound{laneID: lane}
+		}
+	}
+	return mem.txFeed.subscribe(lanes, capacity), nil
+}
+
+// notifyTxChecked calls the callback set for the result of checking txs
+// received from peers, if any.
+func (mem *CListMempool) notifyTxChecked(sender p2p.ID, valid bool) {
+	if mem.onTxChecked != nil && sender != noSender {
+		mem.onTxChecked(sender, valid)
 	}
+}
 
+// RestoreFromJournal re-submits through CheckTx the txs recorded in the
+// mempool journal, if enabled in the config, and starts recording the txs
+// added to and removed from the mempool. Txs of higher-priority lanes are
+// re-submitted first. It returns the number of txs restored.
+//
+// It must be called once, before the mempool receives any tx.
+func (mem *CListMempool) RestoreFromJournal() (int, error) {
+	if !mem.config.JournalEnabled {
+		return 0, nil
+	}
+	path := mem.config.JournalFile()
+	txs, bundles, err := readJournal(path)
+	if err != nil {
+		return 0, err
+	}
+
+	lanePriorities := make(map[LaneID]LanePriority, len(mem.sortedLanes))
+	for _, lane := range mem.sortedLanes {
+		lanePriorities[lane.id] = lane.priority
+	}
+	// Bundles are restored first, as they are never evicted.
+	slices.SortStableFunc(bundles, func(a, b *protomem.JournalAddBundle) int {
+		return cmp.Compare(lanePriorities[LaneID(b.Lane)], lanePriorities[LaneID(a.Lane)])
+	})
+	for _, bundle := range bundles {
+		bundleTxs := types.ToTxs(bundle.Txs)
+		if _, err := mem.CheckBundle(bundleTxs); err != nil {
+			mem.logger.Debug("Could not restore bundle from journal", "txs", len(bundleTxs), "err", err)
+		}
+	}
+	slices.SortStableFunc(txs, func(a, b *protomem.JournalAddTx) int {
+		return cmp.Compare(lanePriorities[LaneID(b.Lane)], lanePriorities[LaneID(a.Lane)])
+	})
+	for _, tx := range txs {
+		sender := noSender
+		if len(tx.Senders) > 0 {
+			sender = p2p.ID(tx.Senders[0])
+		}
+		if _, err := mem.CheckTx(tx.Tx, sender); err != nil {
+			mem.logger.Debug("Could not restore tx from journal", "tx", log.NewLazyHash(types.Tx(tx.Tx)), "err", err)
+		}
+	}
+	// Wait until the app has responded to all CheckTx requests.
+	if err := mem.FlushAppConn(); err != nil {
+		return 0, err
+	}
+	for _, tx := range txs {
+		for _, sender := range tx.Senders[min(1, len(tx.Senders)):] {
+			_ = mem.addSender(types.Tx(tx.Tx).Key(), p2p.ID(sender))
+		}
+	}
+
+	// The TTL of a restored tx is counted from when it was first added.
+	mem.txsMtx.Lock()
+	restoreAge := func(tx types.Tx, timestamp time.Time, height int64) {
+		elem, ok := mem.txsMap[tx.Key()]
+		if !ok {
+			return
+		}
+		memTx := elem.Value.(*mempoolTx)
+		if !timestamp.IsZero() {
+			memTx.timestamp = timestamp
+		}
+		if height > 0 {
+			atomic.StoreInt64(&memTx.height, height)
+		}
+	}
+	for _, tx := range txs {
+		restoreAge(tx.Tx, tx.Timestamp, tx.Height)
+	}
+	for _, bundle := range bundles {
+		for _, tx := range bundle.Txs {
+			restoreAge(tx, bundle.Timestamp, bundle.Height)
+		}
+	}
+	journalTxs := mem.journalTxs()
+	numTxs := int(mem.numTxs)
+	mem.txsMtx.Unlock()
+
+	j, err := createJournal(path, mem.config.JournalMaxBytes, journalTxs)
+	if err != nil {
+		return 0, err
+	}
+	mem.journal = j
+	mem.journalCh = make(chan struct{}, 1)
+	mem.journalQuit = make(chan struct{})
+	mem.journalDone = make(chan struct{})
+	mem.journaling.Store(true)
+	go mem.journalRoutine()
+	return numTxs, nil
+}
+
+// CloseJournal stops recording txs in the mempool journal, if enabled, and
+// closes it, after writing the changes still queued.
+func (mem *CListMempool) CloseJournal() error {
+	if mem.journalQuit == nil {
+		return nil
+	}
+	mem.journaling.Store(false)
+	close(mem.journalQuit)
+	<-mem.journalDone
+	mem.journalQuit = nil
+
+	if mem.journal == nil {
+		return nil
+	}
+	err := mem.journal.close()
+	mem.journal = nil
+	return err
+}
+
+// writeJournal queues the record returned by rec to be written to the
+// journal, if enabled.
+//
+// txsMtx must be held by the caller.
+func (mem *CListMempool) writeJournal(rec func() *protomem.JournalRecord) {
+	if !mem.journaling.Load() {
+		return
+	}
+	mem.journalMtx.Lock()
+	mem.journalRecs = append(mem.journalRecs, rec())
+	mem.journalMtx.Unlock()
+	mem.notifyJournal()
+}
+
+// compactJournal makes journalRoutine replace the journal, if enabled, with a
+// new one holding only the txs in the mempool.
+//
+// txsMtx must be held by the caller.
+func (mem *CListMempool) compactJournal() {
+	if !mem.journaling.Load() {
+		return
+	}
+	mem.journalMtx.Lock()
+	mem.journalCompact = true
+	mem.journalMtx.Unlock()
+	mem.notifyJournal()
+}
+
+func (mem *CListMempool) notifyJournal() {
+	select {
+	case mem.journalCh <- struct{}{}:
+	default:
+	}
+}
+
+// journalRoutine writes the records queued to the journal, until the journal
+// is closed.
+func (mem *CListMempool) journalRoutine() {
+	defer close(mem.journalDone)
+	for {
+		select {
+		case <-mem.journalCh:
+			mem.flushJournal()
+		case <-mem.journalQuit:
+			mem.flushJournal()
+			return
+		}
+	}
+}
+
+// flushJournal writes the records queued to the journal, and compacts the
+// journal if requested or if it is full. If writing fails, the journal is
+// disabled.
+func (mem *CListMempool) flushJournal() {
+	if mem.journal == nil {
+		return
+	}
+	mem.journalMtx.Lock()
+	recs, compact := mem.journalRecs, mem.journalCompact
+	mem.journalRecs, mem.journalCompact = nil, false
+	mem.journalMtx.Unlock()
+
+	var err error
+	if !compact {
+		// A compacted journal already reflects the changes queued.
+		for _, rec := range recs {
+			if err = mem.journal.append(rec); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil && (compact || mem.journal.full()) {
+		err = mem.rewriteJournal()
+	}
+	if err != nil {
+		mem.logger.Error("Failed to write mempool journal; disabling it", "err", err)
+		mem.journaling.Store(false)
+		mem.journalMtx.Lock()
+		mem.journalRecs = nil
+		mem.journalMtx.Unlock()
+		if mem.journal != nil {
+			_ = mem.journal.close()
+			mem.journal = nil
+		}
+	}
+}
+
+// rewriteJournal replaces the journal with a new one holding only the txs in
+// the mempool.
+func (mem *CListMempool) rewriteJournal() error {
+	// No change can be queued while the txs are taken, and the changes queued
+	// so far are reflected in them.
+	mem.txsMtx.RLock()
+	txs := mem.journalTxs()
+	mem.journalMtx.Lock()
+	mem.journalRecs, mem.journalCompact = nil, false
+	mem.journalMtx.Unlock()
+	mem.txsMtx.RUnlock()
+
+	old := mem.journal
+	mem.journal = nil
+	if err := old.close(); err != nil {
+		return err
+	}
+	j, err := createJournal(old.path, old.maxBytes, txs)
+	if err != nil {
+		return err
+	}
+	mem.journal = j
 	return nil
 }
 
+// journalTxs returns the txs in the mempool in the order in which they are
+// written to a new journal: by decreasing lane priority and, inside each lane,
+// by decreasing tx priority.
+//
+// txsMtx must be held by the caller.
+func (mem *CListMempool) journalTxs() []*mempoolTx {
+	txs := make([]*mempoolTx, 0, mem.numTxs)
+	for _, lane := range mem.sortedLanes {
+		start := len(txs)
+		for e := mem.lanes[lane.id].Front(); e != nil; e = e.Next() {
+			txs = append(txs, e.Value.(*mempoolTx))
+		}
+		slices.SortStableFunc(txs[start:], comparePriority)
+	}
+	return txs
+}
+
+// updateLaneMetrics updates the metrics of all lanes.
+func (mem *CListMempool) updateLaneMetrics() {
+	for _, stats := range mem.Lanes() {
+		label := string(stats.ID)
+		mem.metrics.LaneSize.With("lane", label).Set(float64(stats.NumTxs))
+		mem.metrics.LaneBytes.With("lane", label).Set(float64(stats.Bytes))
+		mem.metrics.LaneMaxTxs.With("lane", label).Set(float64(stats.MaxTxs))
+		mem.metrics.LaneMaxBytes.With("lane", label).Set(float64(stats.MaxBytes))
+		mem.metrics.LanePriority.With("lane", label).Set(float64(stats.Priority))
+	}
+	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+}
+
 // updateSizeMetrics updates the size-related metrics of a given lane.
 func (mem *CListMempool) updateSizeMetrics(laneID LaneID) {
 	laneTxs, laneBytes := mem.LaneSizes(laneID)
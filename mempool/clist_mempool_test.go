@@ -370,6 +370,147 @@ func TestMempoolBuildLanesInfo(t *testing.T) {
 	require.ErrorAs(t, err, &ErrDefaultLaneNotInList{})
 }
 
+func TestMempoolLaneLimits(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 100
+	cfg.Mempool.LaneMaxTxs = []string{"foo=2"}
+	cfg.Mempool.LaneMaxTxsBytes = []string{"bar=5"}
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	lanes := mp.Lanes()
+	require.Len(t, lanes, 4)
+	for _, lane := range lanes {
+		switch lane.ID {
+		case "foo":
+			assert.Equal(t, 2, lane.MaxTxs)
+			assert.Equal(t, cfg.Mempool.MaxTxsBytes/4, lane.MaxBytes)
+		case "bar":
+			assert.Equal(t, 25, lane.MaxTxs)
+			assert.Equal(t, int64(5), lane.MaxBytes)
+		default:
+			assert.Equal(t, 25, lane.MaxTxs)
+			assert.Equal(t, cfg.Mempool.MaxTxsBytes/4, lane.MaxBytes)
+		}
+		assert.Equal(t, lane.ID == defaultLane, lane.Default)
+	}
+
+	// Txs 11, 22 and 33 go to lane foo.
+	for _, id := range []int{11, 22} {
+		rr, err := mp.CheckTx(kvstore.NewTxFromID(id), noSender)
+		require.NoError(t, err)
+		require.NoError(t, rr.Error())
+	}
+	rr, err := mp.CheckTx(kvstore.NewTxFromID(33), noSender)
+	require.NoError(t, err)
+	require.ErrorAs(t, rr.Error(), &ErrLaneIsFull{})
+
+	// Txs 3 and 6 go to lane bar, and take 3 bytes each.
+	rr, err = mp.CheckTx(kvstore.NewTxFromID(3), noSender)
+	require.NoError(t, err)
+	require.NoError(t, rr.Error())
+	rr, err = mp.CheckTx(kvstore.NewTxFromID(6), noSender)
+	require.NoError(t, err)
+	require.ErrorAs(t, rr.Error(), &ErrLaneIsFull{})
+}
+
+func TestMempoolUpdateLanes(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	// Txs 1 and 2 go to the default lane, tx 3 to lane bar and tx 11 to lane
+	// foo.
+	txs := types.Txs{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2), kvstore.NewTxFromID(3), kvstore.NewTxFromID(11)}
+	for _, tx := range txs {
+		rr, err := mp.CheckTx(tx, "peer1")
+		require.NoError(t, err)
+		require.NoError(t, rr.Error())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	iter := NewBlockingIterator(ctx, mp, t.Name())
+	for range txs {
+		require.NotNil(t, waitForEntry(t, iter))
+	}
+	sub, err := mp.SubscribeTxEvents(nil, 10)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// Replace the default lane and lane bar by lane baz, and lower the
+	// priority of lane foo.
+	lanesInfo, err := BuildLanesInfo(map[string]uint32{"baz": 8, "foo": 2}, "baz")
+	require.NoError(t, err)
+	mp.Lock()
+	require.NoError(t, mp.UpdateLanes(lanesInfo))
+	mp.Unlock()
+
+	lanes := mp.Lanes()
+	require.Len(t, lanes, 2)
+	assert.Equal(t, LaneStats{ID: "baz", Priority: 8, Default: true, NumTxs: 3, Bytes: 9, MaxTxs: 2500, MaxBytes: mp.config.MaxTxsBytes / 2}, lanes[0])
+	assert.Equal(t, LaneStats{ID: "foo", Priority: 2, NumTxs: 1, Bytes: 5, MaxTxs: 2500, MaxBytes: mp.config.MaxTxsBytes / 2}, lanes[1])
+	for _, tx := range txs[:3] {
+		memTx := mp.txsMap[tx.Key()].Value.(*mempoolTx)
+		assert.Equal(t, LaneID("baz"), memTx.lane)
+		assert.True(t, memTx.IsSender("peer1"))
+	}
+	assert.Equal(t, types.Txs{txs[0], txs[3], txs[1], txs[2]}, mp.ReapMaxTxs(-1))
+
+	// Subscribers see the txs moved from their lane to the default lane.
+	require.Len(t, sub.Out(), 6)
+	for i := 0; i < 3; i++ {
+		ev := <-sub.Out()
+		assert.Equal(t, TxEventRemoved, ev.Type)
+		assert.Equal(t, TxRemovalReasonLaneRemoved, ev.Reason)
+		assert.Equal(t, txs[i].Key(), ev.TxKey)
+		ev = <-sub.Out()
+		assert.Equal(t, TxEventAdded, ev.Type)
+		assert.Equal(t, LaneID("baz"), ev.Lane)
+		assert.Equal(t, txs[i].Key(), ev.TxKey)
+	}
+
+	// Iterators go through the txs moved to a new lane again.
+	moved := make([]types.Tx, 0, 3)
+	for range txs[:3] {
+		moved = append(moved, waitForEntry(t, iter).Tx())
+	}
+	assert.ElementsMatch(t, txs[:3], moved)
+
+	// Txs of lanes unknown to the mempool are rejected.
+	rr, err := mp.CheckTx(kvstore.NewTxFromID(4), noSender)
+	require.NoError(t, err)
+	require.ErrorAs(t, rr.Error(), &ErrLaneNotFound{})
+
+	// Without lanes, all txs are moved to a single default lane.
+	mp.Lock()
+	require.NoError(t, mp.UpdateLanes(nil))
+	mp.Unlock()
+	lanes = mp.Lanes()
+	require.Len(t, lanes, 1)
+	assert.Equal(t, LaneID(defaultLane), lanes[0].ID)
+	assert.Equal(t, 4, lanes[0].NumTxs)
+	rr, err = mp.CheckTx(kvstore.NewTxFromID(4), noSender)
+	require.NoError(t, err)
+	require.NoError(t, rr.Error())
+}
+
+// waitForEntry returns the next entry of the iterator, failing the test if
+// there is none after a while.
+func waitForEntry(t *testing.T, iter Iterator) Entry {
+	t.Helper()
+	select {
+	case entry := <-iter.WaitNextCh():
+		require.NotNil(t, entry)
+		return entry
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for the next entry")
+		return nil
+	}
+}
+
 // Test dropping CheckTx requests when rechecking transactions. It mocks an asynchronous connection
 // to the app.
 func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
@@ -771,6 +912,207 @@ func TestMempoolTxsBytes(t *testing.T) {
 	assert.EqualValues(t, 10, mp.SizeBytes())
 }
 
+func TestMempoolEvictLowerPriorityTxs(t *testing.T) {
+	mockClient := new(abciclimocks.Client)
+	mockClient.On("Start").Return(nil)
+	mockClient.On("SetLogger", mock.Anything)
+	mockClient.On("Error").Return(nil)
+	mockClient.On("Info", mock.Anything, mock.Anything).Return(&abci.InfoResponse{
+		LanePriorities: map[string]uint32{"low": 1, "high": 2},
+		DefaultLane:    "low",
+	}, nil)
+
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 8
+	mp, cleanup := newMempoolWithAppAndConfigMock(cfg, mockClient)
+	defer cleanup()
+	mp.config.Recheck = false
+
+	// Fill the mempool: each lane holds at most 4 txs.
+	for i := 0; i < 4; i++ {
+		require.NoError(t, checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(i), "low", int64(i%2)))
+		require.NoError(t, checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(10+i), "high", 5))
+	}
+	require.Equal(t, 8, mp.Size())
+
+	// A tx with no higher priority than any tx in the lane is rejected.
+	err := checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(4), "low", 0)
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+	err = checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(20), "high", 5)
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+
+	// Txs with a higher priority evict the txs of the lane with the lowest
+	// priority, the most recent first. Txs of other lanes are not evicted.
+	require.NoError(t, checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(5), "low", 1))
+	require.False(t, mp.Contains(types.Tx(kvstore.NewTxFromID(2)).Key()))
+	require.True(t, mp.Contains(types.Tx(kvstore.NewTxFromID(0)).Key()))
+	require.NoError(t, checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(6), "low", 7))
+	require.False(t, mp.Contains(types.Tx(kvstore.NewTxFromID(0)).Key()))
+	require.Equal(t, 8, mp.Size())
+	numTxs, _ := mp.LaneSizes("high")
+	require.Equal(t, 4, numTxs)
+
+	// Evicted txs are removed from the cache, so they are checked again when
+	// resubmitted.
+	err = checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(0), "low", 0)
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+
+	// Reaping honors the priorities inside each lane.
+	require.Equal(t, []types.Tx{
+		kvstore.NewTxFromID(10),
+		kvstore.NewTxFromID(6),
+		kvstore.NewTxFromID(11),
+		kvstore.NewTxFromID(12),
+		kvstore.NewTxFromID(1),
+		kvstore.NewTxFromID(13),
+		kvstore.NewTxFromID(3),
+		kvstore.NewTxFromID(5),
+	}, []types.Tx(mp.ReapMaxBytesMaxGas(-1, -1)))
+}
+
+func TestMempoolFullRejectsTxsBeforeCheckTx(t *testing.T) {
+	mockClient := new(abciclimocks.Client)
+	mockClient.On("Start").Return(nil)
+	mockClient.On("SetLogger", mock.Anything)
+	mockClient.On("Error").Return(nil)
+	mockClient.On("Info", mock.Anything, mock.Anything).Return(&abci.InfoResponse{
+		LanePriorities: map[string]uint32{"1": 1},
+		DefaultLane:    "1",
+	}, nil)
+
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 2
+	cfg.Mempool.MaxTxsBytes = 100
+	mp, cleanup := newMempoolWithAppAndConfigMock(cfg, mockClient)
+	defer cleanup()
+	mp.config.Recheck = false
+
+	// A tx that would not fit in the mempool even if it was empty.
+	_, err := mp.CheckTx(make(types.Tx, 101), "")
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+
+	// The txs of a bundle cannot be evicted, so nothing can make room for a
+	// new tx.
+	mockClient.On("CheckTx", mock.Anything, mock.Anything).Return(&abci.CheckTxResponse{Code: abci.CodeTypeOK}, nil)
+	_, err = mp.CheckBundle(types.Txs{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)})
+	require.NoError(t, err)
+	_, err = mp.CheckTx(kvstore.NewTxFromID(3), "")
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+
+	mockClient.AssertNotCalled(t, "CheckTxAsync", mock.Anything, mock.Anything)
+}
+
+func TestMempoolReplaceTxs(t *testing.T) {
+	mockClient := new(abciclimocks.Client)
+	mockClient.On("Start").Return(nil)
+	mockClient.On("SetLogger", mock.Anything)
+	mockClient.On("Error").Return(nil)
+	mockClient.On("Info", mock.Anything, mock.Anything).Return(&abci.InfoResponse{
+		LanePriorities: map[string]uint32{"low": 1, "high": 2},
+		DefaultLane:    "low",
+	}, nil)
+
+	cfg := test.ResetTestRoot("mempool_test")
+	mp, cleanup := newMempoolWithAppAndConfigMock(cfg, mockClient)
+	defer cleanup()
+	mp.config.Recheck = false
+	var removed []types.Tx
+	mp.onRemovedTx = func(tx types.Tx, reason TxRemovalReason) {
+		require.Equal(t, TxRemovalReasonReplaced, reason)
+		removed = append(removed, tx)
+	}
+
+	txs := newUniqueTxs(5)
+	require.NoError(t, checkTxWithResponse(t, mp, mockClient, txs[0], &abci.CheckTxResponse{LaneId: "low", ReplacementKey: "acc"}))
+	iter := NewBlockingIterator(context.Background(), mp, t.Name())
+	nextTx := func() types.Tx {
+		// The iterator returns nil when its entry was removed.
+		for {
+			if entry := <-iter.WaitNextCh(); entry != nil {
+				return entry.Tx()
+			}
+		}
+	}
+	require.Equal(t, txs[0], nextTx())
+
+	// A tx with the same replacement key replaces the tx in the mempool, and
+	// is picked up by iterators.
+	require.NoError(t, checkTxWithResponse(t, mp, mockClient, txs[1], &abci.CheckTxResponse{LaneId: "low", ReplacementKey: "acc"}))
+	require.Equal(t, 1, mp.Size())
+	require.False(t, mp.Contains(txs[0].Key()))
+	require.Equal(t, txs[1], nextTx())
+	require.Equal(t, []types.Tx{txs[0]}, removed)
+
+	// The replaced tx stays in the cache.
+	_, err := mp.CheckTx(txs[0], "")
+	require.ErrorIs(t, err, ErrTxInCache)
+
+	// A tx replaces the tx with the given key.
+	require.NoError(t, checkTxWithResponse(t, mp, mockClient, txs[2], &abci.CheckTxResponse{LaneId: "low", ReplacedTxKey: txs[1].Hash(), ReplacementKey: "acc"}))
+	require.Equal(t, 1, mp.Size())
+	require.True(t, mp.Contains(txs[2].Key()))
+	require.Equal(t, []types.Tx{txs[0], txs[1]}, removed)
+	require.Equal(t, int64(len(txs[2])), mp.SizeBytes())
+
+	// A tx cannot replace a tx of another lane, nor name an invalid key.
+	err = checkTxWithResponse(t, mp, mockClient, txs[3], &abci.CheckTxResponse{LaneId: "high", ReplacementKey: "acc"})
+	require.ErrorAs(t, err, &ErrReplacementLaneMismatch{})
+	err = checkTxWithResponse(t, mp, mockClient, txs[3], &abci.CheckTxResponse{LaneId: "low", ReplacedTxKey: []byte("key")})
+	require.ErrorAs(t, err, &ErrInvalidReplacedTxKey{})
+	require.True(t, mp.Contains(txs[2].Key()))
+
+	// A tx that replaces a tx no longer in the mempool is added.
+	require.NoError(t, checkTxWithResponse(t, mp, mockClient, txs[4], &abci.CheckTxResponse{LaneId: "low", ReplacedTxKey: txs[1].Hash()}))
+	require.Equal(t, 2, mp.Size())
+	require.Len(t, removed, 2)
+}
+
+func TestMempoolExpiredTxs(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Recheck = false
+	cfg.Mempool.TTLNumBlocks = 2
+	cfg.Mempool.TTLDuration = time.Hour
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	removed := make(map[string]TxRemovalReason)
+	mp.onRemovedTx = func(tx types.Tx, reason TxRemovalReason) {
+		removed[string(tx)] = reason
+	}
+
+	tx1 := kvstore.NewTxFromID(1)
+	_, err := mp.CheckTx(tx1, "")
+	require.NoError(t, err)
+	doUpdate(t, mp, 1, nil)
+	tx2 := kvstore.NewTxFromID(2)
+	_, err = mp.CheckTx(tx2, "")
+	require.NoError(t, err)
+
+	// tx1 expires after staying in the mempool for more than 2 blocks.
+	doUpdate(t, mp, 2, nil)
+	require.Equal(t, 2, mp.Size())
+	doUpdate(t, mp, 3, nil)
+	require.Equal(t, 1, mp.Size())
+	require.Equal(t, map[string]TxRemovalReason{string(tx1): TxRemovalReasonExpiredNumBlocks}, removed)
+
+	// Expired txs are removed from the cache, so they can be resubmitted.
+	_, err = mp.CheckTx(tx1, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, mp.Size())
+
+	// Both txs expire once they have been in the mempool for too long.
+	mp.config.TTLNumBlocks = 0
+	mp.config.TTLDuration = time.Nanosecond
+	doUpdate(t, mp, 4, nil)
+	require.Zero(t, mp.Size())
+	require.Equal(t, map[string]TxRemovalReason{
+		string(tx1): TxRemovalReasonExpiredDuration,
+		string(tx2): TxRemovalReasonExpiredDuration,
+	}, removed)
+}
+
 func TestMempoolNoCacheOverflow(t *testing.T) {
 	mp, cleanup := newMempoolWithAsyncConnection(t)
 	defer cleanup()
@@ -1141,6 +1483,37 @@ func newReqResWithLanes(tx types.Tx, code uint32, requestType abci.CheckTxType,
 	return reqRes
 }
 
+func newReqResWithPriority(tx types.Tx, lane string, priority int64) *abciclient.ReqRes {
+	reqRes := abciclient.NewReqRes(abci.ToCheckTxRequest(&abci.CheckTxRequest{Tx: tx, Type: abci.CHECK_TX_TYPE_CHECK}))
+	reqRes.Response = abci.ToCheckTxResponse(&abci.CheckTxResponse{Code: abci.CodeTypeOK, LaneId: lane, TxPriority: priority})
+	return reqRes
+}
+
+// checkTxWithPriority calls CheckTx on the mempool, with the mocked application
+// returning the given priority for tx in the given lane.
+func checkTxWithPriority(t *testing.T, mp *CListMempool, client *abciclimocks.Client, tx types.Tx, lane string, priority int64) error {
+	t.Helper()
+	reqRes := newReqResWithPriority(tx, lane, priority)
+	client.On("CheckTxAsync", mock.Anything, mock.Anything).Return(reqRes, nil).Once()
+	_, err := mp.CheckTx(tx, "")
+	require.NoError(t, err)
+	reqRes.InvokeCallback()
+	return reqRes.Error()
+}
+
+// checkTxWithResponse calls CheckTx on the mempool, with the mocked
+// application returning res for tx.
+func checkTxWithResponse(t *testing.T, mp *CListMempool, client *abciclimocks.Client, tx types.Tx, res *abci.CheckTxResponse) error {
+	t.Helper()
+	reqRes := abciclient.NewReqRes(abci.ToCheckTxRequest(&abci.CheckTxRequest{Tx: tx, Type: abci.CHECK_TX_TYPE_CHECK}))
+	reqRes.Response = abci.ToCheckTxResponse(res)
+	client.On("CheckTxAsync", mock.Anything, mock.Anything).Return(reqRes, nil).Once()
+	_, err := mp.CheckTx(tx, "")
+	require.NoError(t, err)
+	reqRes.InvokeCallback()
+	return reqRes.Error()
+}
+
 func abciResponses(n int, code uint32) []*abci.ExecTxResult {
 	responses := make([]*abci.ExecTxResult, 0, n)
 	for i := 0; i < n; i++ {
@@ -3,6 +3,8 @@ package mempool
 import (
 	"errors"
 	"fmt"
+
+	"github.com/cometbft/cometbft/types"
 )
 
 // ErrTxNotFound is returned to the client if tx is not found in mempool.
@@ -27,6 +29,17 @@ var ErrLateRecheckResponse = errors.New("rechecking has finished; discard late r
 // rechecking is still in progress after a new block was committed.
 var ErrRecheckFull = errors.New("mempool is still rechecking after a new committed block, so it is considered as full")
 
+// ErrEmptyBundle is returned when checking a bundle with no transactions.
+var ErrEmptyBundle = errors.New("bundle has no transactions")
+
+// ErrBundleCheckInterrupted is returned when blocks kept being committed while
+// the transactions of a bundle were checked.
+var ErrBundleCheckInterrupted = errors.New("blocks were committed while the bundle was checked, try again")
+
+// ErrTxInBundleTwice is returned when a transaction appears more than once in
+// a bundle.
+var ErrTxInBundleTwice = errors.New("transaction appears more than once in bundle")
+
 // ErrInvalidTx is returned when a transaction that is trying to be added to the
 // mempool is invalid.
 type ErrInvalidTx struct {
@@ -99,6 +112,59 @@ func (e ErrLaneIsFull) Error() string {
 	)
 }
 
+// ErrInvalidReplacedTxKey is returned when the application sets a replaced tx
+// key that is not a valid tx key.
+type ErrInvalidReplacedTxKey struct {
+	Key []byte
+}
+
+func (e ErrInvalidReplacedTxKey) Error() string {
+	return fmt.Sprintf("invalid replaced tx key %X: expected %d bytes", e.Key, types.TxKeySize)
+}
+
+// ErrReplacementLaneMismatch is returned when a tx replaces a tx of a
+// different lane.
+type ErrReplacementLaneMismatch struct {
+	Lane         LaneID
+	ReplacedLane LaneID
+}
+
+func (e ErrReplacementLaneMismatch) Error() string {
+	return fmt.Sprintf("tx of lane %s cannot replace a tx of lane %s", e.Lane, e.ReplacedLane)
+}
+
+// ErrInvalidBundleTx is returned when a bundle cannot be added to the mempool
+// because of one of its transactions.
+type ErrInvalidBundleTx struct {
+	Index int
+	Err   error
+}
+
+func (e ErrInvalidBundleTx) Error() string {
+	return fmt.Sprintf("bundle tx %d: %v", e.Index, e.Err)
+}
+
+func (e ErrInvalidBundleTx) Unwrap() error {
+	return e.Err
+}
+
+// ErrTooManyInvalidTxs is returned when the ratio of invalid txs received from
+// a peer goes over the maximum allowed.
+type ErrTooManyInvalidTxs struct {
+	InvalidTxs int64
+	CheckedTxs int64
+	MaxRatio   float64
+}
+
+func (e ErrTooManyInvalidTxs) Error() string {
+	return fmt.Sprintf(
+		"too many invalid txs: %d out of %d (max ratio: %v)",
+		e.InvalidTxs,
+		e.CheckedTxs,
+		e.MaxRatio,
+	)
+}
+
 // ErrPreCheck defines an error where a transaction fails a pre-check.
 type ErrPreCheck struct {
 	Err error
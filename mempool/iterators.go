@@ -1,8 +1,11 @@
 package mempool
 
 import (
+	"cmp"
+	"container/heap"
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/cometbft/cometbft/internal/clist"
 )
@@ -10,11 +13,14 @@ import (
 // IWRRIterator is the base struct for implementing iterators that traverse lanes with
 // the Interleaved Weighted Round Robin (WRR) algorithm.
 // https://en.wikipedia.org/wiki/Weighted_round_robin
+//
+// Inside each lane, transactions are traversed by decreasing priority, as set
+// by the application in CheckTx. Transactions with the same priority are
+// traversed in the order in which they were added to the mempool.
 type IWRRIterator struct {
 	sortedLanes []lane
-	laneIndex   int                        // current lane being iterated; index on sortedLanes
-	cursors     map[LaneID]*clist.CElement // last accessed entries on each lane
-	round       int                        // counts the rounds for IWRR
+	laneIndex   int // current lane being iterated; index on sortedLanes
+	round       int // counts the rounds for IWRR
 }
 
 // This function picks the next lane to fetch an item from.
@@ -37,32 +43,96 @@ func (iter *IWRRIterator) advanceIndexes() lane {
 // or after a `Reset`. Therefore, the lock must be held on the mempool when iterating to
 // ensure consistency. The iterator will traverse the lanes using the Interleaved Weighted
 // Round Robin (WRR) algorithm, which allows for fair access to transactions based on their
-// priority.
+// priority. Transactions removed from the mempool while iterating are skipped.
 type NonBlockingIterator struct {
 	IWRRIterator
+	entries map[LaneID][]*clist.CElement // entries left on each lane, by decreasing priority
 }
 
 func NewNonBlockingIterator(mem *CListMempool) *NonBlockingIterator {
-	baseIter := IWRRIterator{
-		sortedLanes: mem.sortedLanes,
-		cursors:     make(map[LaneID]*clist.CElement, len(mem.lanes)),
-		round:       1,
-	}
 	iter := &NonBlockingIterator{
-		IWRRIterator: baseIter,
+		IWRRIterator: IWRRIterator{round: 1},
 	}
-	iter.reset(mem.lanes)
+	iter.reset(mem)
 	return iter
 }
 
 // Reset must be called before every use of the iterator.
-func (iter *NonBlockingIterator) reset(lanes map[LaneID]*clist.CList) {
+func (iter *NonBlockingIterator) reset(mem *CListMempool) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	iter.sortedLanes = mem.sortedLanes
 	iter.laneIndex = 0
 	iter.round = 1
-	// Set cursors at the beginning of each lane.
-	for lane := range lanes {
-		iter.cursors[lane] = lanes[lane].Front()
+	iter.entries = make(map[LaneID][]*clist.CElement, len(mem.laneOrders))
+	for lane, order := range mem.laneOrders {
+		iter.entries[lane] = order.sortedEntries()
+	}
+}
+
+// comparePriority orders entries by decreasing priority.
+func comparePriority(a, b *mempoolTx) int {
+	return cmp.Compare(b.priority, a.priority)
+}
+
+// compareEntries orders entries by decreasing priority and, among entries
+// with the same priority, in the order in which they were added.
+func compareEntries(a, b *clist.CElement) int {
+	txA, txB := a.Value.(*mempoolTx), b.Value.(*mempoolTx)
+	if c := comparePriority(txA, txB); c != 0 {
+		return c
 	}
+	return cmp.Compare(txA.seq, txB.seq)
+}
+
+// laneOrder keeps the entries of a lane sorted by decreasing priority, so that
+// NonBlockingIterator does not sort the lane each time it is reset. The
+// entries added are only sorted, and merged with the others, when the lane is
+// next iterated. The entries removed are skipped by the iterators until they
+// make up half of the sorted entries. It is protected by txsMtx.
+type laneOrder struct {
+	sorted  []*clist.CElement // never modified, since iterators may hold it
+	added   []*clist.CElement // entries added since sorted was built
+	removed int               // entries removed since sorted was built
+}
+
+func (o *laneOrder) add(e *clist.CElement) {
+	o.added = append(o.added, e)
+}
+
+func (o *laneOrder) remove() {
+	o.removed++
+}
+
+// sortedEntries returns the entries of the lane by decreasing priority. Some
+// of them may have been removed from the lane.
+func (o *laneOrder) sortedEntries() []*clist.CElement {
+	if len(o.added) == 0 && o.removed <= len(o.sorted)/2 {
+		return o.sorted
+	}
+
+	// Entries are added in order, so a stable sort keeps that order among
+	// entries with the same priority.
+	added := slices.DeleteFunc(o.added, (*clist.CElement).Removed)
+	slices.SortStableFunc(added, compareEntries)
+	sorted := make([]*clist.CElement, 0, len(o.sorted)+len(added))
+	i := 0
+	for _, e := range o.sorted {
+		if e.Removed() {
+			continue
+		}
+		for ; i < len(added) && compareEntries(added[i], e) < 0; i++ {
+			sorted = append(sorted, added[i])
+		}
+		sorted = append(sorted, e)
+	}
+	sorted = append(sorted, added[i:]...)
+
+	o.sorted = sorted
+	o.added = nil
+	o.removed = 0
+	return sorted
 }
 
 // Next returns the next element according to the WRR algorithm.
@@ -71,8 +141,13 @@ func (iter *NonBlockingIterator) Next() Entry {
 
 	lane := iter.sortedLanes[iter.laneIndex]
 	for {
-		// Skip empty lane or if cursor is at end of lane.
-		if iter.cursors[lane.id] == nil {
+		// Skip empty lane or if all its entries were accessed or removed.
+		entries := iter.entries[lane.id]
+		for len(entries) > 0 && entries[0].Removed() {
+			entries = entries[1:]
+		}
+		iter.entries[lane.id] = entries
+		if len(entries) == 0 {
 			numEmptyLanes++
 			if numEmptyLanes >= len(iter.sortedLanes) {
 				return nil
@@ -88,13 +163,13 @@ func (iter *NonBlockingIterator) Next() Entry {
 		}
 		break
 	}
-	elem := iter.cursors[lane.id]
-	if elem == nil {
+	entries := iter.entries[lane.id]
+	if len(entries) == 0 {
 		panic(fmt.Errorf("Iterator picked a nil entry on lane %s", lane.id))
 	}
-	iter.cursors[lane.id] = iter.cursors[lane.id].Next()
+	iter.entries[lane.id] = entries[1:]
 	_ = iter.advanceIndexes()
-	return elem.Value.(*mempoolTx)
+	return entries[0].Value.(*mempoolTx)
 }
 
 // BlockingIterator implements a blocking version of the WRR iterator,
@@ -106,12 +181,25 @@ type BlockingIterator struct {
 	ctx  context.Context
 	mp   *CListMempool
 	name string // for debugging
+
+	// Lanes of the mempool when they were last read, to detect changes.
+	lanes        map[LaneID]*clist.CList
+	lanesVersion int64
+
+	// Entries may be accessed out of the order in which they were added to
+	// their lane, because of their priority. The entries of each lane are
+	// read once, in the order in which they were added, into a queue of the
+	// entries not yet accessed, which yields them by decreasing priority.
+	lastSeqs map[LaneID]int64 // sequence number of the last entry read from each lane
+	pending  map[LaneID]*entryQueue
 }
 
 func NewBlockingIterator(ctx context.Context, mem *CListMempool, name string) Iterator {
+	mem.addTxChMtx.RLock()
+	defer mem.addTxChMtx.RUnlock()
+
 	iter := IWRRIterator{
 		sortedLanes: mem.sortedLanes,
-		cursors:     make(map[LaneID]*clist.CElement, len(mem.sortedLanes)),
 		round:       1,
 	}
 	return &BlockingIterator{
@@ -119,6 +207,10 @@ func NewBlockingIterator(ctx context.Context, mem *CListMempool, name string) It
 		ctx:          ctx,
 		mp:           mem,
 		name:         name,
+		lanes:        mem.lanes,
+		lanesVersion: mem.lanesVersion,
+		lastSeqs:     make(map[LaneID]int64, len(mem.sortedLanes)),
+		pending:      make(map[LaneID]*entryQueue, len(mem.sortedLanes)),
 	}
 }
 
@@ -165,6 +257,10 @@ func (iter *BlockingIterator) pickLane() (lane, chan struct{}) {
 	iter.mp.addTxChMtx.RLock()
 	defer iter.mp.addTxChMtx.RUnlock()
 
+	if iter.lanesVersion != iter.mp.lanesVersion {
+		iter.refreshLanes()
+	}
+
 	// Start from the last accessed lane.
 	currLane := iter.sortedLanes[iter.laneIndex]
 
@@ -173,10 +269,8 @@ func (iter *BlockingIterator) pickLane() (lane, chan struct{}) {
 	numEmptyLanes := 0
 	for {
 		laneID := currLane.id
-		// Skip empty lanes or lanes with their cursor pointing at their last entry.
-		if iter.mp.lanes[laneID].Len() == 0 ||
-			(iter.cursors[laneID] != nil &&
-				iter.cursors[laneID].Value.(*mempoolTx).seq == iter.mp.addTxLaneSeqs[laneID]) {
+		// Skip lanes without entries not yet accessed.
+		if !iter.hasNewEntries(laneID) && iter.pending[laneID].peek() == nil {
 			numEmptyLanes++
 			if numEmptyLanes >= len(iter.sortedLanes) {
 				// There are no lanes with non-accessed entries. Wait until a
@@ -199,35 +293,116 @@ func (iter *BlockingIterator) pickLane() (lane, chan struct{}) {
 	}
 }
 
+// hasNewEntries returns true if entries were added to the lane since it was
+// last read and are still in it.
+func (iter *BlockingIterator) hasNewEntries(laneID LaneID) bool {
+	back := iter.lanes[laneID].Back()
+	return back != nil && back.Value.(*mempoolTx).seq > iter.lastSeqs[laneID]
+}
+
+// refreshLanes takes the new lanes of the mempool, restarting the WRR
+// iteration and forgetting the entries accessed in the lanes removed. The
+// caller must hold addTxChMtx.
+func (iter *BlockingIterator) refreshLanes() {
+	iter.sortedLanes = iter.mp.sortedLanes
+	iter.lanes = iter.mp.lanes
+	iter.lanesVersion = iter.mp.lanesVersion
+	iter.laneIndex = 0
+	iter.round = 1
+	for laneID := range iter.lastSeqs {
+		if _, ok := iter.lanes[laneID]; !ok {
+			delete(iter.lastSeqs, laneID)
+			delete(iter.pending, laneID)
+		}
+	}
+}
+
 // In classical WRR, the iterator cycles over the lanes. When a lane is selected, Next returns an
 // entry from the selected lane. On subsequent calls, Next will return the next entries from the
 // same lane until `lane` entries are accessed or the lane is empty, where `lane` is the priority.
 // The next time, Next will select the successive lane with lower priority.
-// next returns the next entry from the given lane and updates WRR variables.
+// next returns the entry with the highest priority not yet accessed from the given lane, or nil if
+// all its entries were accessed or removed.
 func (iter *BlockingIterator) next(laneID LaneID) *clist.CElement {
-	// Load the last accessed entry in the lane and set the next one.
-	var next *clist.CElement
-
-	if cursor := iter.cursors[laneID]; cursor != nil {
-		// If the current entry is the last one or was removed, Next will return nil.
-		// Note we don't need to wait until the next entry is available (with <-cursor.NextWaitChan()).
-		next = cursor.Next()
-	} else {
-		// We are at the beginning of the iteration or the saved entry got removed. Pick the first
-		// entry in the lane if it's available (don't wait for it); if not, Front will return nil.
-		next = iter.mp.lanes[laneID].Front()
-	}
-
-	// Update auxiliary variables.
-	if next != nil {
-		// Save entry.
-		iter.cursors[laneID] = next
-	} else {
-		// The entry got removed or it was the last one in the lane.
-		// At the moment this should not happen - the loop in PickLane will loop forever until there
-		// is data in at least one lane
-		delete(iter.cursors, laneID)
-	}
-
-	return next
+	queue := iter.pending[laneID]
+	if queue == nil {
+		queue = &entryQueue{}
+		iter.pending[laneID] = queue
+	}
+	iter.readNewEntries(laneID, queue)
+	return queue.pop()
+}
+
+// readNewEntries pushes the entries added to the lane since it was last read
+// to the queue.
+func (iter *BlockingIterator) readNewEntries(laneID LaneID, queue *entryQueue) {
+	// Entries are removed while holding txsMtx, which would make walking the
+	// lane backwards stop at the entry removed.
+	iter.mp.txsMtx.RLock()
+	defer iter.mp.txsMtx.RUnlock()
+
+	txs := iter.lanes[laneID]
+	lastSeq := iter.lastSeqs[laneID]
+	// New entries are at the back of the lane.
+	e := txs.Back()
+	if e == nil || e.Value.(*mempoolTx).seq <= lastSeq {
+		return
+	}
+	iter.lastSeqs[laneID] = e.Value.(*mempoolTx).seq
+	for ; e != nil && e.Value.(*mempoolTx).seq > lastSeq; e = e.Prev() {
+		heap.Push(queue, e)
+	}
+
+	// Drop the entries removed from the lane before being accessed, once
+	// they make up half of the queue.
+	if queue.Len() > 2*txs.Len() {
+		*queue = slices.DeleteFunc(*queue, (*clist.CElement).Removed)
+		heap.Init(queue)
+	}
+}
+
+// entryQueue is a queue of entries by decreasing priority and, among entries
+// with the same priority, in the order in which they were added. It
+// implements heap.Interface.
+type entryQueue []*clist.CElement
+
+func (q entryQueue) Len() int           { return len(q) }
+func (q entryQueue) Less(i, j int) bool { return compareEntries(q[i], q[j]) < 0 }
+func (q entryQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *entryQueue) Push(x any) {
+	*q = append(*q, x.(*clist.CElement))
+}
+
+func (q *entryQueue) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return e
+}
+
+// peek returns the first entry of the queue not removed from its lane,
+// dropping the entries removed before it, or nil if there is none. It can be
+// called on a nil queue.
+func (q *entryQueue) peek() *clist.CElement {
+	if q == nil {
+		return nil
+	}
+	for q.Len() > 0 {
+		if e := (*q)[0]; !e.Removed() {
+			return e
+		}
+		heap.Pop(q)
+	}
+	return nil
+}
+
+// pop removes and returns the first entry of the queue not removed from its
+// lane, or nil if there is none.
+func (q *entryQueue) pop() *clist.CElement {
+	if q.peek() == nil {
+		return nil
+	}
+	return heap.Pop(q).(*clist.CElement)
 }
@@ -509,3 +509,76 @@ func TestReapMatchesGossipOrder(t *testing.T) {
 		require.Zero(t, mp.Size())
 	}
 }
+
+// Confirms that, inside a lane, transactions are iterated by decreasing
+// priority, including those added while iterating.
+func TestIteratorPriority(t *testing.T) {
+	mockClient := new(abciclimocks.Client)
+	mockClient.On("Start").Return(nil)
+	mockClient.On("SetLogger", mock.Anything)
+	mockClient.On("Error").Return(nil)
+	mockClient.On("Info", mock.Anything, mock.Anything).Return(&abci.InfoResponse{LanePriorities: map[string]uint32{"1": 1}, DefaultLane: "1"}, nil)
+	mp, cleanup := newMempoolWithAppMock(mockClient)
+	defer cleanup()
+	mp.config.Recheck = false
+
+	addTx := func(id int, priority int64) {
+		require.NoError(t, checkTxWithPriority(t, mp, mockClient, kvstore.NewTxFromID(id), "1", priority))
+	}
+	nextTx := func(iter Iterator) types.Tx {
+		entry := <-iter.WaitNextCh()
+		require.NotNil(t, entry)
+		return entry.Tx()
+	}
+
+	addTx(1, 0)
+	addTx(2, 5)
+	addTx(3, 1)
+	addTx(4, 5)
+
+	iter := NewBlockingIterator(context.Background(), mp, t.Name())
+	require.EqualValues(t, kvstore.NewTxFromID(2), nextTx(iter))
+	require.EqualValues(t, kvstore.NewTxFromID(4), nextTx(iter))
+	addTx(5, 3)
+	require.EqualValues(t, kvstore.NewTxFromID(5), nextTx(iter))
+	require.EqualValues(t, kvstore.NewTxFromID(3), nextTx(iter))
+	require.EqualValues(t, kvstore.NewTxFromID(1), nextTx(iter))
+	addTx(6, 0)
+	require.EqualValues(t, kvstore.NewTxFromID(6), nextTx(iter))
+
+	// A new iterator accesses every entry once, then waits for new ones.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	iter = NewBlockingIterator(ctx, mp, t.Name())
+	for i := 0; i < 6; i++ {
+		nextTx(iter)
+	}
+	require.Nil(t, <-iter.WaitNextCh())
+
+	expected := []types.Tx{
+		kvstore.NewTxFromID(2),
+		kvstore.NewTxFromID(4),
+		kvstore.NewTxFromID(5),
+		kvstore.NewTxFromID(3),
+		kvstore.NewTxFromID(1),
+		kvstore.NewTxFromID(6),
+	}
+	nonBlockingIter := NewNonBlockingIterator(mp)
+	for _, tx := range expected {
+		require.EqualValues(t, tx, nonBlockingIter.Next().Tx())
+	}
+	require.Nil(t, nonBlockingIter.Next())
+	require.Equal(t, expected, []types.Tx(mp.ReapMaxBytesMaxGas(-1, -1)))
+
+	// Txs added since the last iteration are merged with the others, and txs
+	// removed are skipped, even while iterating.
+	addTx(7, 4)
+	require.NoError(t, mp.RemoveTxByKey(types.Tx(kvstore.NewTxFromID(5)).Key()))
+	nonBlockingIter = NewNonBlockingIterator(mp)
+	require.EqualValues(t, kvstore.NewTxFromID(2), nonBlockingIter.Next().Tx())
+	require.NoError(t, mp.RemoveTxByKey(types.Tx(kvstore.NewTxFromID(7)).Key()))
+	for _, tx := range []types.Tx{kvstore.NewTxFromID(4), kvstore.NewTxFromID(3), kvstore.NewTxFromID(1), kvstore.NewTxFromID(6)} {
+		require.EqualValues(t, tx, nonBlockingIter.Next().Tx())
+	}
+	require.Nil(t, nonBlockingIter.Next())
+}
@@ -0,0 +1,277 @@
+package mempool
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	protomem "github.com/cometbft/cometbft/api/cometbft/mempool/v2"
+	"github.com/cometbft/cometbft/types"
+)
+
+// journalRecordHeaderSize is the size of the header of each journal record,
+// made of the CRC32C checksum and the length of the record's data.
+const journalRecordHeaderSize = 8
+
+var journalCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// journal is an append-only file recording the txs accepted in the mempool and
+// their removals, so that the contents of the mempool can be restored after a
+// restart.
+//
+// Each record is written as the CRC32C checksum and the length of its data,
+// followed by the data. Writes are not synced: a crash may leave the last
+// records torn or missing, in which case the journal is read up to the last
+// complete record.
+type journal struct {
+	path     string
+	maxBytes int64
+	file     *os.File
+	size     int64
+}
+
+// readJournal returns the txs and the bundles that are in the journal at path,
+// that is, those added and not removed afterwards, in the order they were
+// added. The txs have their latest recorded senders. A missing journal is
+// empty. Reading stops at the first torn or corrupted record.
+func readJournal(path string) ([]*protomem.JournalAddTx, []*protomem.JournalAddBundle, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening mempool journal: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		txs           []*protomem.JournalAddTx
+		indexes       = make(map[types.TxKey]int) // index in txs of each tx not removed
+		bundles       []*protomem.JournalAddBundle
+		bundleIndexes = make(map[types.TxKey]int) // index in bundles of the txs of each bundle not removed
+	)
+	r := bufio.NewReader(f)
+	for {
+		rec, err := readJournalRecord(r)
+		if err != nil {
+			// io.EOF ends a complete journal; any other error is caused by a
+			// torn or corrupted record, which ends the journal too.
+			break
+		}
+		switch sum := rec.Sum.(type) {
+		case *protomem.JournalRecord_AddTx:
+			key := types.Tx(sum.AddTx.Tx).Key()
+			if i, ok := indexes[key]; ok {
+				// The tx was recorded again because of a new sender.
+				txs[i].Senders = sum.AddTx.Senders
+				continue
+			}
+			indexes[key] = len(txs)
+			txs = append(txs, sum.AddTx)
+		case *protomem.JournalRecord_AddBundle:
+			for _, tx := range sum.AddBundle.Txs {
+				bundleIndexes[types.Tx(tx).Key()] = len(bundles)
+			}
+			bundles = append(bundles, sum.AddBundle)
+		case *protomem.JournalRecord_RemoveTx:
+			if len(sum.RemoveTx.TxKey) != types.TxKeySize {
+				continue
+			}
+			key := types.TxKey(sum.RemoveTx.TxKey)
+			if i, ok := indexes[key]; ok {
+				txs[i] = nil
+				delete(indexes, key)
+			}
+			// Removing a tx of a bundle removes the whole bundle.
+			if i, ok := bundleIndexes[key]; ok {
+				for _, tx := range bundles[i].Txs {
+					delete(bundleIndexes, types.Tx(tx).Key())
+				}
+				bundles[i] = nil
+			}
+		}
+	}
+
+	restoredTxs := make([]*protomem.JournalAddTx, 0, len(indexes))
+	for _, tx := range txs {
+		if tx != nil {
+			restoredTxs = append(restoredTxs, tx)
+		}
+	}
+	var restoredBundles []*protomem.JournalAddBundle
+	for _, bundle := range bundles {
+		if bundle != nil {
+			restoredBundles = append(restoredBundles, bundle)
+		}
+	}
+	return restoredTxs, restoredBundles, nil
+}
+
+func readJournalRecord(r io.Reader) (*protomem.JournalRecord, error) {
+	var header [journalRecordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	crc := binary.BigEndian.Uint32(header[0:4])
+	length := binary.BigEndian.Uint32(header[4:8])
+
+	// The buffer only grows as data is read, so a corrupted length cannot make
+	// us allocate more than the size of the journal.
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r, int64(length)); err != nil {
+		return nil, fmt.Errorf("reading record: %w", err)
+	}
+	data := buf.Bytes()
+	if actual := crc32.Checksum(data, journalCRCTable); actual != crc {
+		return nil, fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actual)
+	}
+
+	rec := new(protomem.JournalRecord)
+	if err := proto.Unmarshal(data, rec); err != nil {
+		return nil, fmt.Errorf("decoding record: %w", err)
+	}
+	return rec, nil
+}
+
+// createJournal writes a new journal at path holding the given txs, replacing
+// the existing one, if any. The txs are written in the given order as long as
+// they fit in half of maxBytes, leaving room for the records appended
+// afterwards. The txs of a bundle are written together, as a bundle, where the
+// first of them is.
+func createJournal(path string, maxBytes int64, txs []*mempoolTx) (*journal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating mempool journal directory: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("creating mempool journal: %w", err)
+	}
+	j := &journal{path: path, maxBytes: maxBytes, file: tmp}
+	w := bufio.NewWriter(tmp)
+	bundles := make(map[*txBundle]struct{})
+	for _, memTx := range txs {
+		rec := addTxRecord(memTx)
+		if bundle := memTx.bundle; bundle != nil {
+			if _, ok := bundles[bundle]; ok {
+				continue
+			}
+			bundles[bundle] = struct{}{}
+			rec = addBundleRecord(memTx)
+		}
+		bz, err := encodeJournalRecord(rec)
+		if err != nil {
+			tmp.Close()
+			return nil, err
+		}
+		if j.size+int64(len(bz)) > maxBytes/2 {
+			break
+		}
+		if _, err := w.Write(bz); err != nil {
+			tmp.Close()
+			return nil, fmt.Errorf("writing mempool journal: %w", err)
+		}
+		j.size += int64(len(bz))
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("writing mempool journal: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("syncing mempool journal: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("closing mempool journal: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, fmt.Errorf("replacing mempool journal: %w", err)
+	}
+
+	j.file, err = os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening mempool journal: %w", err)
+	}
+	return j, nil
+}
+
+func addTxRecord(memTx *mempoolTx) *protomem.JournalRecord {
+	senders := memTx.Senders()
+	rec := &protomem.JournalAddTx{
+		Tx:        memTx.tx,
+		Lane:      string(memTx.lane),
+		Senders:   make([]string, len(senders)),
+		Timestamp: memTx.timestamp,
+		Height:    memTx.Height(),
+	}
+	for i, sender := range senders {
+		rec.Senders[i] = string(sender)
+	}
+	return &protomem.JournalRecord{Sum: &protomem.JournalRecord_AddTx{AddTx: rec}}
+}
+
+// addBundleRecord returns the record of the bundle of memTx, added to the lane
+// of memTx, at the time and height memTx was added.
+func addBundleRecord(memTx *mempoolTx) *protomem.JournalRecord {
+	rec := &protomem.JournalAddBundle{
+		Txs:       memTx.bundle.txs.ToSliceOfBytes(),
+		Lane:      string(memTx.lane),
+		Timestamp: memTx.timestamp,
+		Height:    memTx.Height(),
+	}
+	return &protomem.JournalRecord{Sum: &protomem.JournalRecord_AddBundle{AddBundle: rec}}
+}
+
+func encodeJournalRecord(rec *protomem.JournalRecord) ([]byte, error) {
+	data, err := proto.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("encoding mempool journal record: %w", err)
+	}
+	bz := make([]byte, journalRecordHeaderSize+len(data))
+	binary.BigEndian.PutUint32(bz[0:4], crc32.Checksum(data, journalCRCTable))
+	binary.BigEndian.PutUint32(bz[4:8], uint32(len(data)))
+	copy(bz[journalRecordHeaderSize:], data)
+	return bz, nil
+}
+
+func removeTxRecord(key types.TxKey) *protomem.JournalRecord {
+	return &protomem.JournalRecord{
+		Sum: &protomem.JournalRecord_RemoveTx{RemoveTx: &protomem.JournalRemoveTx{TxKey: key[:]}},
+	}
+}
+
+func (j *journal) append(rec *protomem.JournalRecord) error {
+	bz, err := encodeJournalRecord(rec)
+	if err != nil {
+		return err
+	}
+	n, err := j.file.Write(bz)
+	j.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("writing mempool journal: %w", err)
+	}
+	return nil
+}
+
+// full returns true if the journal is over its maximum size, and must be
+// compacted.
+func (j *journal) full() bool {
+	return j.size > j.maxBytes
+}
+
+func (j *journal) close() error {
+	if err := j.file.Sync(); err != nil {
+		j.file.Close()
+		return err
+	}
+	return j.file.Close()
+}
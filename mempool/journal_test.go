@@ -0,0 +1,198 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestMempoolJournal(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.JournalEnabled = true
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	n, err := mp.RestoreFromJournal()
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	txs := make(types.Txs, 10)
+	for i := range txs {
+		txs[i] = kvstore.NewTxFromID(i)
+		_, err := mp.CheckTx(txs[i], p2p.ID("peer1"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, mp.addSender(txs[4].Key(), "peer2"))
+	doUpdate(t, mp, 1, txs[:3])
+	lateTx := types.Tx(kvstore.NewTxFromID(11))
+	_, err = mp.CheckTx(lateTx, noSender)
+	require.NoError(t, err)
+	firstAdded := mp.txsMap[txs[4].Key()].Value.(*mempoolTx).timestamp
+	require.NoError(t, mp.CloseJournal())
+
+	// A new mempool restores the txs that were not removed.
+	mp, cleanup = newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+	n, err = mp.RestoreFromJournal()
+	require.NoError(t, err)
+	require.Equal(t, 8, n)
+	for i, tx := range txs {
+		assert.Equal(t, i >= 3, mp.Contains(tx.Key()), "tx %d", i)
+	}
+	assert.ElementsMatch(t, []p2p.ID{"peer1", "peer2"}, mp.txsMap[txs[4].Key()].Value.(*mempoolTx).Senders())
+
+	// Restored txs keep the time and height at which they were first added.
+	assert.True(t, firstAdded.Equal(mp.txsMap[txs[4].Key()].Value.(*mempoolTx).timestamp))
+	assert.EqualValues(t, 0, mp.txsMap[txs[4].Key()].Value.(*mempoolTx).Height())
+	assert.EqualValues(t, 1, mp.txsMap[lateTx.Key()].Value.(*mempoolTx).Height())
+
+	// Txs added after the restore are journaled too.
+	tx := kvstore.NewTxFromID(10)
+	_, err = mp.CheckTx(tx, noSender)
+	require.NoError(t, err)
+	doUpdate(t, mp, 2, txs[3:5])
+	require.NoError(t, mp.CloseJournal())
+
+	restored, _, err := readJournal(cfg.Mempool.JournalFile())
+	require.NoError(t, err)
+	restoredTxs := make([]types.Tx, len(restored))
+	for i, rec := range restored {
+		restoredTxs[i] = rec.Tx
+	}
+	assert.ElementsMatch(t, append(txs[5:], tx, lateTx), restoredTxs)
+}
+
+func TestMempoolJournalTornWrite(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.JournalEnabled = true
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	_, err := mp.RestoreFromJournal()
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := mp.CheckTx(kvstore.NewTxFromID(i), noSender)
+		require.NoError(t, err)
+	}
+	require.NoError(t, mp.CloseJournal())
+
+	// Cut the last record in the middle.
+	path := cfg.Mempool.JournalFile()
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-3))
+
+	restored, _, err := readJournal(path)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+
+	// Corrupt the data of the first record.
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[journalRecordHeaderSize] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	restored, _, err = readJournal(path)
+	require.NoError(t, err)
+	require.Empty(t, restored)
+
+	// A missing journal is empty.
+	restored, _, err = readJournal(filepath.Join(cfg.RootDir, "missing"))
+	require.NoError(t, err)
+	require.Empty(t, restored)
+}
+
+func TestMempoolJournalCompaction(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.JournalEnabled = true
+	cfg.Mempool.JournalMaxBytes = 1000
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	_, err := mp.RestoreFromJournal()
+	require.NoError(t, err)
+	for h := int64(1); h <= 20; h++ {
+		tx := kvstore.NewTxFromID(int(h))
+		_, err := mp.CheckTx(tx, noSender)
+		require.NoError(t, err)
+		doUpdate(t, mp, h, types.Txs{tx})
+	}
+	for i := 100; i < 200; i++ {
+		_, err := mp.CheckTx(kvstore.NewTxFromID(i), noSender)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, mp.CloseJournal())
+
+	// The journal is compacted when it grows beyond its maximum size, so it
+	// holds only part of the mempool.
+	info, err := os.Stat(cfg.Mempool.JournalFile())
+	require.NoError(t, err)
+	assert.LessOrEqual(t, info.Size(), cfg.Mempool.JournalMaxBytes)
+
+	restored, _, err := readJournal(cfg.Mempool.JournalFile())
+	require.NoError(t, err)
+	assert.NotEmpty(t, restored)
+	assert.Less(t, len(restored), 100)
+	for _, rec := range restored {
+		assert.True(t, mp.Contains(types.Tx(rec.Tx).Key()))
+	}
+}
+
+func TestMempoolJournalBundles(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.JournalEnabled = true
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	_, err := mp.RestoreFromJournal()
+	require.NoError(t, err)
+	tx := types.Tx(kvstore.NewTxFromID(1))
+	_, err = mp.CheckTx(tx, noSender)
+	require.NoError(t, err)
+	bundle := types.Txs{kvstore.NewTxFromID(3), kvstore.NewTxFromID(4)}
+	_, err = mp.CheckBundle(bundle)
+	require.NoError(t, err)
+	removed := types.Txs{kvstore.NewTxFromID(6), kvstore.NewTxFromID(7)}
+	_, err = mp.CheckBundle(removed)
+	require.NoError(t, err)
+	require.NoError(t, mp.RemoveTxByKey(removed[1].Key()))
+	firstAdded := mp.txsMap[bundle[0].Key()].Value.(*mempoolTx).timestamp
+	require.NoError(t, mp.CloseJournal())
+
+	// The bundles not removed are restored as bundles, including after the
+	// journal is compacted when it is restored.
+	for i := 0; i < 2; i++ {
+		mp, cleanup = newMempoolWithAppAndConfig(cc, cfg)
+		defer cleanup()
+		n, err := mp.RestoreFromJournal()
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+		assert.True(t, mp.Contains(tx.Key()))
+		for _, tx := range bundle {
+			memTx := mp.txsMap[tx.Key()].Value.(*mempoolTx)
+			require.NotNil(t, memTx.bundle)
+			assert.Equal(t, bundle, memTx.bundle.txs)
+		}
+		assert.True(t, firstAdded.Equal(mp.txsMap[bundle[0].Key()].Value.(*mempoolTx).timestamp))
+		require.NoError(t, mp.CloseJournal())
+	}
+
+	_, bundles, err := readJournal(cfg.Mempool.JournalFile())
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	assert.Equal(t, bundle.ToSliceOfBytes(), bundles[0].Txs)
+}
@@ -1,10 +1,36 @@
 package mempool
 
+import "slices"
+
 type LanesInfo struct {
 	lanes       map[LaneID]LanePriority
 	defaultLane LaneID
 }
 
+// defaultLanesInfo returns the lanes of a mempool when the app does not
+// define any: the only lane will be "default" with priority 1.
+func defaultLanesInfo() *LanesInfo {
+	return &LanesInfo{lanes: map[LaneID]LanePriority{defaultLane: 1}, defaultLane: defaultLane}
+}
+
+// sortedLanes returns the lanes sorted by priority, in descending order.
+func (info *LanesInfo) sortedLanes() []lane {
+	sorted := make([]lane, 0, len(info.lanes))
+	for id, priority := range info.lanes {
+		sorted = append(sorted, lane{id: id, priority: priority})
+	}
+	slices.SortStableFunc(sorted, func(i, j lane) int {
+		if i.priority > j.priority {
+			return -1
+		}
+		if i.priority < j.priority {
+			return 1
+		}
+		return 0
+	})
+	return sorted
+}
+
 // BuildLanesInfo builds the information required to initialize
 // lanes given the data queried from the app.
 func BuildLanesInfo(laneMap map[string]uint32, defLane string) (*LanesInfo, error) {
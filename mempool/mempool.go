@@ -75,6 +75,18 @@ type Mempool interface {
 		newPostFn PostCheckFunc,
 	) error
 
+	// UpdateLanes replaces the lanes of the mempool with the given ones. The
+	// txs of the lanes that are removed are moved to the new default lane.
+	//
+	// NOTE:
+	// 1. This should be called *after* block is committed by consensus.
+	// 2. Lock/Unlock must be managed by the caller.
+	UpdateLanes(lanesInfo *LanesInfo) error
+
+	// Lanes returns the state of each lane of the mempool, by decreasing
+	// priority.
+	Lanes() []LaneStats
+
 	// FlushAppConn flushes the mempool connection to ensure async callback calls
 	// are done, e.g. from CheckTx.
 	//
@@ -157,6 +169,40 @@ func PostCheckMaxGas(maxGas int64) PostCheckFunc {
 // TxKey is the fixed length array key used as an index.
 type TxKey [sha256.Size]byte
 
+// TxRemovalReason tells why a transaction was removed from the mempool.
+type TxRemovalReason string
+
+const (
+	// TxRemovalReasonInvalid is used for transactions that became invalid, as
+	// found when rechecking them.
+	TxRemovalReasonInvalid TxRemovalReason = "invalid"
+	// TxRemovalReasonEvicted is used for transactions evicted from a full lane
+	// by transactions with a higher priority.
+	TxRemovalReasonEvicted TxRemovalReason = "evicted"
+	// TxRemovalReasonExpiredNumBlocks is used for transactions that stayed in
+	// the mempool for more blocks than allowed by ttl_num_blocks.
+	TxRemovalReasonExpiredNumBlocks TxRemovalReason = "expired_num_blocks"
+	// TxRemovalReasonExpiredDuration is used for transactions that stayed in
+	// the mempool for longer than allowed by ttl_duration.
+	TxRemovalReasonExpiredDuration TxRemovalReason = "expired_duration"
+	// TxRemovalReasonReplaced is used for transactions replaced by a new
+	// transaction, as requested by the application in CheckTx.
+	TxRemovalReasonReplaced TxRemovalReason = "replaced"
+	// TxRemovalReasonCommitted is used for transactions included in a
+	// committed block.
+	TxRemovalReasonCommitted TxRemovalReason = "committed"
+	// TxRemovalReasonRemoved is used for transactions removed with
+	// RemoveTxByKey.
+	TxRemovalReasonRemoved TxRemovalReason = "removed"
+	// TxRemovalReasonLaneRemoved is used for transactions moved out of a lane
+	// removed by the application. They stay in the mempool, in the default
+	// lane.
+	TxRemovalReasonLaneRemoved TxRemovalReason = "lane_removed"
+	// TxRemovalReasonBundle is used for the transactions of a bundle removed
+	// because another transaction of the bundle was removed.
+	TxRemovalReasonBundle TxRemovalReason = "bundle"
+)
+
 // An Entry represents a transaction stored in the mempool.
 type Entry interface {
 	// Tx returns the transaction stored in the entry.
@@ -168,6 +214,10 @@ type Entry interface {
 	// GasWanted returns the amount of gas required by the transaction.
 	GasWanted() int64
 
+	// Priority returns the priority assigned by the application to the
+	// transaction within its lane.
+	Priority() int64
+
 	// IsSender returns whether we received the transaction from the given peer ID.
 	IsSender(peerID p2p.ID) bool
 
@@ -13,11 +13,18 @@ import (
 type mempoolTx struct {
 	height    int64    // height that this tx had been validated in
 	gasWanted int64    // amount of gas this tx states it will require
+	priority  int64    // priority of this tx within its lane, as set by the application
 	tx        types.Tx // validated by the application
 	lane      LaneID
 	seq       int64
 	timestamp time.Time // time when entry was created
 
+	// key shared by this tx and its replacements, as set by the application
+	replacementKey string
+
+	// bundle of this tx, if it was added as part of a bundle
+	bundle *txBundle
+
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> struct{}
 	senders sync.Map
@@ -35,6 +42,10 @@ func (memTx *mempoolTx) GasWanted() int64 {
 	return memTx.gasWanted
 }
 
+func (memTx *mempoolTx) Priority() int64 {
+	return memTx.priority
+}
+
 func (memTx *mempoolTx) IsSender(peerID p2p.ID) bool {
 	_, ok := memTx.senders.Load(peerID)
 	return ok
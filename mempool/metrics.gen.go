@@ -38,6 +38,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "lane_bytes",
 			Help:      "Number of used bytes per lane.",
 		}, append(labels, "lane")).With(labelsAndValues...),
+		LaneMaxTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_max_txs",
+			Help:      "Maximum number of transactions per lane.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		LaneMaxBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_max_bytes",
+			Help:      "Maximum number of bytes per lane.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		LanePriority: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_priority",
+			Help:      "Priority of each lane.",
+		}, append(labels, "lane")).With(labelsAndValues...),
 		TxLifeSpan: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -72,6 +90,48 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "evicted_txs",
 			Help:      "Number of evicted transactions.",
 		}, labels).With(labelsAndValues...),
+		PriorityEvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "priority_evicted_txs",
+			Help:      "Number of transactions evicted by higher-priority transactions.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		ReplacedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "replaced_txs",
+			Help:      "Number of replaced transactions.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		MovedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "moved_txs",
+			Help:      "Number of transactions moved to another lane.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_txs",
+			Help:      "Number of expired transactions.",
+		}, append(labels, "lane", "reason")).With(labelsAndValues...),
+		PeerQuotaDroppedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_quota_dropped_txs",
+			Help:      "Number of transactions dropped because a peer went over its quota.",
+		}, append(labels, "quota")).With(labelsAndValues...),
+		PeerInvalidTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_invalid_txs",
+			Help:      "Number of invalid transactions received from peers.",
+		}, labels).With(labelsAndValues...),
+		PenalizedPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "penalized_peers",
+			Help:      "Number of peers disconnected for sending too many invalid transactions.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -117,11 +177,21 @@ func NopMetrics() *Metrics {
 		SizeBytes:                 discard.NewGauge(),
 		LaneSize:                  discard.NewGauge(),
 		LaneBytes:                 discard.NewGauge(),
+		LaneMaxTxs:                discard.NewGauge(),
+		LaneMaxBytes:              discard.NewGauge(),
+		LanePriority:              discard.NewGauge(),
 		TxLifeSpan:                discard.NewHistogram(),
 		TxSizeBytes:               discard.NewHistogram(),
 		FailedTxs:                 discard.NewCounter(),
 		RejectedTxs:               discard.NewCounter(),
 		EvictedTxs:                discard.NewCounter(),
+		PriorityEvictedTxs:        discard.NewCounter(),
+		ReplacedTxs:               discard.NewCounter(),
+		MovedTxs:                  discard.NewCounter(),
+		ExpiredTxs:                discard.NewCounter(),
+		PeerQuotaDroppedTxs:       discard.NewCounter(),
+		PeerInvalidTxs:            discard.NewCounter(),
+		PenalizedPeers:            discard.NewCounter(),
 		RecheckTimes:              discard.NewCounter(),
 		AlreadyReceivedTxs:        discard.NewCounter(),
 		ActiveOutboundConnections: discard.NewGauge(),
@@ -31,6 +31,15 @@ type Metrics struct {
 	// Number of used bytes per lane.
 	LaneBytes metrics.Gauge `metrics_labels:"lane"`
 
+	// Maximum number of transactions per lane.
+	LaneMaxTxs metrics.Gauge `metrics_labels:"lane"`
+
+	// Maximum number of bytes per lane.
+	LaneMaxBytes metrics.Gauge `metrics_labels:"lane"`
+
+	// Priority of each lane.
+	LanePriority metrics.Gauge `metrics_labels:"lane"`
+
 	// TxLifeSpan measures the time each transaction has in the mempool, since
 	// the time it enters until it is removed.
 	// metrics:Duration in ms of a transaction in the mempool.
@@ -57,6 +66,43 @@ type Metrics struct {
 	// metrics:Number of evicted transactions.
 	EvictedTxs metrics.Counter
 
+	// PriorityEvictedTxs defines the number of valid transactions evicted from
+	// a full lane to make room for a transaction with a higher priority.
+	// metrics:Number of transactions evicted by higher-priority transactions.
+	PriorityEvictedTxs metrics.Counter `metrics_labels:"lane"`
+
+	// ReplacedTxs defines the number of transactions replaced by a new
+	// transaction, as requested by the application in CheckTx.
+	// metrics:Number of replaced transactions.
+	ReplacedTxs metrics.Counter `metrics_labels:"lane"`
+
+	// MovedTxs defines the number of transactions moved to the default lane
+	// because the application removed their lane.
+	// metrics:Number of transactions moved to another lane.
+	MovedTxs metrics.Counter `metrics_labels:"lane"`
+
+	// ExpiredTxs defines the number of transactions removed from the mempool
+	// because they stayed in it for longer than allowed by ttl_num_blocks
+	// or ttl_duration, as given by the reason label.
+	// metrics:Number of expired transactions.
+	ExpiredTxs metrics.Counter `metrics_labels:"lane, reason"`
+
+	// PeerQuotaDroppedTxs defines the number of transactions received from
+	// peers and dropped without being checked, because the peer went over its
+	// quota of transactions or bytes per second, as given by the quota label.
+	// metrics:Number of transactions dropped because a peer went over its quota.
+	PeerQuotaDroppedTxs metrics.Counter `metrics_labels:"quota"`
+
+	// PeerInvalidTxs defines the number of transactions received from peers
+	// that failed CheckTx.
+	// metrics:Number of invalid transactions received from peers.
+	PeerInvalidTxs metrics.Counter
+
+	// PenalizedPeers defines the number of peers the node disconnected from
+	// because they sent too many invalid transactions.
+	// metrics:Number of peers disconnected for sending too many invalid transactions.
+	PenalizedPeers metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
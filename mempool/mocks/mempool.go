@@ -146,6 +146,26 @@ func (_m *Mempool) GetTxByHash(hash []byte) types.Tx {
 	return r0
 }
 
+// Lanes provides a mock function with no fields
+func (_m *Mempool) Lanes() []mempool.LaneStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lanes")
+	}
+
+	var r0 []mempool.LaneStats
+	if rf, ok := ret.Get(0).(func() []mempool.LaneStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mempool.LaneStats)
+		}
+	}
+
+	return r0
+}
+
 // Lock provides a mock function with no fields
 func (_m *Mempool) Lock() {
 	_m.Called()
@@ -293,6 +313,24 @@ func (_m *Mempool) Update(blockHeight int64, blockTxs types.Txs, deliverTxRespon
 	return r0
 }
 
+// UpdateLanes provides a mock function with given fields: lanesInfo
+func (_m *Mempool) UpdateLanes(lanesInfo *mempool.LanesInfo) error {
+	ret := _m.Called(lanesInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLanes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*mempool.LanesInfo) error); ok {
+		r0 = rf(lanesInfo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMempool creates a new instance of Mempool. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMempool(t interface {
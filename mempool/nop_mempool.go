@@ -57,6 +57,12 @@ func (*NopMempool) Update(
 	return nil
 }
 
+// UpdateLanes does nothing.
+func (*NopMempool) UpdateLanes(*LanesInfo) error { return nil }
+
+// Lanes always returns nil.
+func (*NopMempool) Lanes() []LaneStats { return nil }
+
 // FlushAppConn does nothing.
 func (*NopMempool) FlushAppConn() error { return nil }
 
@@ -120,5 +126,10 @@ func (*NopMempoolReactor) TryAddTx(_ types.Tx, _ p2p.Peer) (*abcicli.ReqRes, err
 	return nil, nil
 }
 
+// TryAddBundle always returns an error.
+func (*NopMempoolReactor) TryAddBundle(types.Txs) ([]*abci.CheckTxResponse, error) {
+	return nil, errNotAllowed
+}
+
 // SetSwitch does nothing.
 func (*NopMempoolReactor) SetSwitch(*p2p.Switch) {}
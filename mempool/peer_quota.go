@@ -0,0 +1,175 @@
+package mempool
+
+import (
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// Labels of the PeerQuotaDroppedTxs metric.
+const (
+	quotaTxs   = "txs"
+	quotaBytes = "bytes"
+)
+
+// tokenBucket is a token bucket refilled at rate tokens per second, up to its
+// capacity.
+type tokenBucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// newTokenBucket returns a full token bucket.
+func newTokenBucket(rate, capacity float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, capacity: capacity, tokens: capacity, last: now}
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.capacity, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// has returns true if the bucket holds at least n tokens.
+func (b *tokenBucket) has(n float64) bool {
+	return b.tokens >= n
+}
+
+// take removes n tokens from the bucket.
+func (b *tokenBucket) take(n float64) {
+	b.tokens -= n
+}
+
+// peerQuota is the state of the ingress quotas of a peer.
+type peerQuota struct {
+	txs   *tokenBucket // nil if the number of txs per second is unlimited
+	bytes *tokenBucket // nil if the number of bytes per second is unlimited
+
+	checkedTxs int64 // txs received from the peer and checked by the app
+	invalidTxs int64 // checked txs that were invalid
+	penalized  bool  // whether the peer went over the ratio of invalid txs
+}
+
+// peerQuotas keeps track of the ingress quotas of each peer, that is, the rate
+// at which the reactor accepts txs from the peer and the ratio of invalid txs
+// the peer may send.
+type peerQuotas struct {
+	config *cfg.MempoolConfig
+
+	mtx   cmtsync.Mutex
+	peers map[p2p.ID]*peerQuota
+}
+
+func newPeerQuotas(config *cfg.MempoolConfig) *peerQuotas {
+	return &peerQuotas{
+		config: config,
+		peers:  make(map[p2p.ID]*peerQuota),
+	}
+}
+
+// addPeer starts keeping track of the quotas of the given peer.
+func (q *peerQuotas) addPeer(id p2p.ID, now time.Time) {
+	pq := &peerQuota{}
+	if rate := float64(q.config.PeerMaxTxsPerSecond); rate > 0 {
+		pq.txs = newTokenBucket(rate, rate, now)
+	}
+	if rate := float64(q.config.PeerMaxBytesPerSecond); rate > 0 {
+		// Allow at least one tx of the maximum size, which could otherwise
+		// never be accepted.
+		pq.bytes = newTokenBucket(rate, max(rate, float64(q.config.MaxTxBytes)), now)
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	q.peers[id] = pq
+}
+
+// removePeer stops keeping track of the quotas of the given peer.
+func (q *peerQuotas) removePeer(id p2p.ID) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	delete(q.peers, id)
+}
+
+// allowTx consumes the quotas of the peer for a tx of the given size. It
+// returns an empty string if the tx is accepted, or the label of the quota the
+// peer went over.
+func (q *peerQuotas) allowTx(id p2p.ID, size int, now time.Time) string {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	pq, ok := q.peers[id]
+	if !ok {
+		return ""
+	}
+	// Check both quotas before consuming any of them, so that a dropped tx
+	// does not use up the quota of the other.
+	if pq.txs != nil {
+		pq.txs.refill(now)
+		if !pq.txs.has(1) {
+			return quotaTxs
+		}
+	}
+	if pq.bytes != nil {
+		pq.bytes.refill(now)
+		if !pq.bytes.has(float64(size)) {
+			return quotaBytes
+		}
+		pq.bytes.take(float64(size))
+	}
+	if pq.txs != nil {
+		pq.txs.take(1)
+	}
+	return ""
+}
+
+// recordCheckedTx records the result of checking a tx received from the given
+// peer. Both counts are halved when the number of checked txs reaches twice
+// the minimum needed to evaluate the ratio of invalid txs, so that recent txs
+// weigh more than older ones.
+func (q *peerQuotas) recordCheckedTx(id p2p.ID, valid bool) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	pq, ok := q.peers[id]
+	if !ok {
+		return
+	}
+	pq.checkedTxs++
+	if !valid {
+		pq.invalidTxs++
+	}
+	if minCount := int64(q.config.PeerInvalidTxsMinCount); minCount > 0 && pq.checkedTxs >= 2*minCount {
+		pq.checkedTxs /= 2
+		pq.invalidTxs /= 2
+	}
+}
+
+// checkInvalidTxs returns an error the first time the ratio of invalid txs
+// received from the given peer goes over the maximum allowed, and nil
+// otherwise.
+func (q *peerQuotas) checkInvalidTxs(id p2p.ID) error {
+	maxRatio := q.config.PeerMaxInvalidTxsRatio
+	if maxRatio <= 0 {
+		return nil
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	pq, ok := q.peers[id]
+	if !ok || pq.penalized || pq.checkedTxs == 0 || pq.checkedTxs < int64(q.config.PeerInvalidTxsMinCount) {
+		return nil
+	}
+	if float64(pq.invalidTxs)/float64(pq.checkedTxs) <= maxRatio {
+		return nil
+	}
+	pq.penalized = true
+	return ErrTooManyInvalidTxs{InvalidTxs: pq.invalidTxs, CheckedTxs: pq.checkedTxs, MaxRatio: maxRatio}
+}
@@ -0,0 +1,91 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+func TestPeerQuotasAllowTx(t *testing.T) {
+	config := cfg.TestMempoolConfig()
+	config.MaxTxBytes = 100
+	config.PeerMaxTxsPerSecond = 10
+	config.PeerMaxBytesPerSecond = 50
+	q := newPeerQuotas(config)
+
+	const peerID = p2p.ID("peer")
+	now := time.Now()
+	q.addPeer(peerID, now)
+
+	// The bytes bucket holds at least one tx of the maximum size.
+	assert.Empty(t, q.allowTx(peerID, 100, now))
+	assert.Equal(t, quotaBytes, q.allowTx(peerID, 1, now))
+
+	// After one second, the bytes bucket is refilled up to its rate.
+	now = now.Add(time.Second)
+	for i := 0; i < 5; i++ {
+		assert.Empty(t, q.allowTx(peerID, 10, now))
+	}
+	assert.Equal(t, quotaBytes, q.allowTx(peerID, 10, now))
+
+	// Txs dropped by the bytes quota do not use up the txs quota.
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 10; i++ {
+		assert.Empty(t, q.allowTx(peerID, 1, now))
+	}
+	assert.Equal(t, quotaTxs, q.allowTx(peerID, 1, now))
+	now = now.Add(100 * time.Millisecond)
+	assert.Empty(t, q.allowTx(peerID, 1, now))
+	assert.Equal(t, quotaTxs, q.allowTx(peerID, 1, now))
+
+	// Unknown peers are not limited.
+	q.removePeer(peerID)
+	assert.Empty(t, q.allowTx(peerID, 1000, now))
+}
+
+func TestPeerQuotasInvalidTxs(t *testing.T) {
+	config := cfg.TestMempoolConfig()
+	config.PeerMaxInvalidTxsRatio = 0.5
+	config.PeerInvalidTxsMinCount = 10
+	q := newPeerQuotas(config)
+
+	const peerID = p2p.ID("peer")
+	q.addPeer(peerID, time.Now())
+
+	// The ratio is not evaluated until enough txs are checked.
+	for i := 0; i < 9; i++ {
+		q.recordCheckedTx(peerID, false)
+	}
+	require.NoError(t, q.checkInvalidTxs(peerID))
+
+	// 9 invalid txs out of 19.
+	for i := 0; i < 10; i++ {
+		q.recordCheckedTx(peerID, true)
+	}
+	require.NoError(t, q.checkInvalidTxs(peerID))
+
+	// The counts are halved to 5 out of 10, then go up to 7 out of 12.
+	for i := 0; i < 3; i++ {
+		q.recordCheckedTx(peerID, false)
+	}
+	err := q.checkInvalidTxs(peerID)
+	require.Equal(t, ErrTooManyInvalidTxs{InvalidTxs: 7, CheckedTxs: 12, MaxRatio: 0.5}, err)
+
+	// The peer is penalized only once.
+	q.recordCheckedTx(peerID, false)
+	require.NoError(t, q.checkInvalidTxs(peerID))
+
+	// The check is disabled with a zero ratio.
+	config.PeerMaxInvalidTxsRatio = 0
+	q = newPeerQuotas(config)
+	q.addPeer(peerID, time.Now())
+	for i := 0; i < 20; i++ {
+		q.recordCheckedTx(peerID, false)
+	}
+	require.NoError(t, q.checkInvalidTxs(peerID))
+}
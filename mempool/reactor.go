@@ -10,6 +10,7 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
 	protomem "github.com/cometbft/cometbft/api/cometbft/mempool/v2"
 	cfg "github.com/cometbft/cometbft/config"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
@@ -45,6 +46,9 @@ type Reactor struct {
 	// connections for different groups of peers.
 	activePersistentPeersSemaphore    *semaphore.Weighted
 	activeNonPersistentPeersSemaphore *semaphore.Weighted
+
+	// Ingress quotas and ratio of invalid txs of each peer.
+	quotas *peerQuotas
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
@@ -53,6 +57,7 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool, waitSync bool)
 		config:   config,
 		mempool:  mempool,
 		waitSync: atomic.Bool{},
+		quotas:   newPeerQuotas(config),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
 	if waitSync {
@@ -61,6 +66,9 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool, waitSync bool)
 	}
 	memR.activePersistentPeersSemaphore = semaphore.NewWeighted(int64(memR.config.ExperimentalMaxGossipConnectionsToPersistentPeers))
 	memR.activeNonPersistentPeersSemaphore = semaphore.NewWeighted(int64(memR.config.ExperimentalMaxGossipConnectionsToNonPersistentPeers))
+	if config.PeerMaxInvalidTxsRatio > 0 {
+		mempool.onTxChecked = memR.recordCheckedTx
+	}
 
 	return memR
 }
@@ -129,6 +137,12 @@ func (memR *Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 	}
 }
 
+// InitPeer implements Reactor by setting up the ingress quotas of the peer.
+func (memR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
+	memR.quotas.addPeer(peer.ID(), time.Now())
+	return peer
+}
+
 // AddPeer implements Reactor.
 // It starts a broadcast routine ensuring all txs are forwarded to the given peer.
 func (memR *Reactor) AddPeer(peer p2p.Peer) {
@@ -173,6 +187,7 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 }
 
 func (memR *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	memR.quotas.removePeer(peer.ID())
 	if memR.router != nil {
 		// Remove all routes with peer as source or target and immediately
 		// adjust redundancy.
@@ -240,10 +255,22 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 			}
 
 			memR.Logger.Debug("Received Txs", "from", senderID, "msg", e.Message)
+			now := time.Now()
 			for _, txBytes := range protoTxs {
+				if quota := memR.quotas.allowTx(senderID, len(txBytes), now); quota != "" {
+					// using debug level to avoid flooding when traffic is high
+					memR.Logger.Debug("Dropping tx, peer went over its quota", "tx", types.Tx(txBytes).Hash(), "peer", senderID, "quota", quota)
+					memR.mempool.metrics.PeerQuotaDroppedTxs.With("quota", quota).Add(1)
+					continue
+				}
 				_, _ = memR.TryAddTx(types.Tx(txBytes), e.Src)
 			}
 
+			if err := memR.quotas.checkInvalidTxs(senderID); err != nil {
+				memR.penalizePeer(e.Src, err)
+				return
+			}
+
 		default:
 			memR.Logger.Error("Unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
 			memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
@@ -306,6 +333,37 @@ func (memR *Reactor) TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, er
 	return reqRes, nil
 }
 
+// TryAddBundle attempts to add a bundle of transactions, coming from an RPC
+// endpoint, to the mempool. See CListMempool.CheckBundle.
+func (memR *Reactor) TryAddBundle(txs types.Txs) ([]*abci.CheckTxResponse, error) {
+	responses, err := memR.mempool.CheckBundle(txs)
+	if err != nil {
+		memR.Logger.Info("Could not check bundle", "txs", len(txs), "err", err)
+		return responses, err
+	}
+	return responses, nil
+}
+
+// recordCheckedTx is called by the mempool with the result of checking a tx
+// received from a peer.
+func (memR *Reactor) recordCheckedTx(sender p2p.ID, valid bool) {
+	if !valid {
+		memR.mempool.metrics.PeerInvalidTxs.Add(1)
+	}
+	memR.quotas.recordCheckedTx(sender, valid)
+}
+
+// penalizePeer disconnects from a peer that sent too many invalid txs and, if
+// configured, bans it from the address book.
+func (memR *Reactor) penalizePeer(peer p2p.Peer, err error) {
+	memR.Logger.Info("Disconnecting from peer that sent too many invalid txs", "peer", peer.ID(), "err", err)
+	memR.mempool.metrics.PenalizedPeers.Add(1)
+	if memR.config.PeerBanDuration > 0 {
+		memR.Switch.MarkPeerAsBad(peer, memR.config.PeerBanDuration)
+	}
+	memR.Switch.StopPeerForError(peer, err)
+}
+
 func (memR *Reactor) EnableInOutTxs() {
 	memR.Logger.Info("Enabling inbound and outbound transactions")
 	if !memR.waitSync.CompareAndSwap(true, false) {
@@ -362,6 +420,12 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 			continue
 		}
 
+		// Bundles are not gossiped, so that peers cannot propose only some of
+		// their txs.
+		if entry.(*mempoolTx).bundle != nil {
+			continue
+		}
+
 		// If we suspect that the peer is lagging behind, at least by more than
 		// one block, we don't send the transaction immediately. This code
 		// reduces the mempool size and the recheck-tx rate of the receiving
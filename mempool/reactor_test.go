@@ -3,6 +3,7 @@ package mempool
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -287,6 +288,93 @@ func TestMempoolReactorMaxTxBytes(t *testing.T) {
 	require.Nil(t, reqRes)
 }
 
+func TestMempoolReactorPeerQuotas(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.PeerMaxTxsPerSecond = 5
+
+	const n = 2
+	reactors, _ := makeAndConnectReactors(config, n, nil)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+
+	// The first reactor receives more txs from its peer than allowed in a
+	// second, and drops those above its quota.
+	peer := reactors[0].Switch.Peers().Get(reactors[1].Switch.NodeInfo().ID())
+	txs := newUniqueTxs(20)
+	reactors[0].Receive(p2p.Envelope{
+		Src:       peer,
+		ChannelID: MempoolChannel,
+		Message:   &memproto.Txs{Txs: txs.ToSliceOfBytes()},
+	})
+	require.Equal(t, 5, reactors[0].mempool.Size())
+	for _, tx := range txs[:5] {
+		require.True(t, reactors[0].mempool.Contains(tx.Key()))
+	}
+
+	// Txs received via RPC are not limited.
+	for _, tx := range txs[5:] {
+		_, err := reactors[0].TryAddTx(tx, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(txs), reactors[0].mempool.Size())
+}
+
+func TestMempoolReactorPenalizesInvalidTxs(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.PeerMaxInvalidTxsRatio = 0.5
+	config.Mempool.PeerInvalidTxsMinCount = 10
+	config.Mempool.PeerBanDuration = time.Minute
+
+	const n = 2
+	reactors, _ := makeAndConnectReactors(config, n, nil)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+
+	peer := reactors[0].Switch.Peers().Get(reactors[1].Switch.NodeInfo().ID())
+	addrBook := &p2p.AddrBookMock{
+		Addrs:        map[string]struct{}{peer.SocketAddr().String(): {}},
+		OurAddrs:     make(map[string]struct{}),
+		PrivateAddrs: make(map[string]struct{}),
+	}
+	reactors[0].Switch.SetAddrBook(addrBook)
+
+	receiveTxs := func(txs [][]byte) {
+		reactors[0].Receive(p2p.Envelope{
+			Src:       peer,
+			ChannelID: MempoolChannel,
+			Message:   &memproto.Txs{Txs: txs},
+		})
+	}
+	invalidTx := func(i int) []byte {
+		return []byte(fmt.Sprintf("invalid%d", i))
+	}
+
+	// As many valid as invalid txs.
+	txs := newUniqueTxs(5).ToSliceOfBytes()
+	for i := 0; i < 5; i++ {
+		txs = append(txs, invalidTx(i))
+	}
+	receiveTxs(txs)
+	require.Equal(t, 1, reactors[0].Switch.Peers().Size())
+
+	// One more invalid tx puts the peer over the maximum ratio.
+	receiveTxs([][]byte{invalidTx(5)})
+	require.Eventually(t, func() bool {
+		return reactors[0].Switch.Peers().Size() == 0
+	}, time.Second, 10*time.Millisecond)
+	require.False(t, addrBook.HasAddress(peer.SocketAddr()))
+}
+
 func TestBroadcastTxForPeerStopsWhenPeerStops(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test in short mode.")
@@ -0,0 +1,118 @@
+package mempool
+
+import (
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxEventType tells whether a TxEvent is about a tx added to or removed from
+// the mempool.
+type TxEventType int
+
+const (
+	TxEventAdded TxEventType = iota + 1
+	TxEventRemoved
+)
+
+// TxEvent describes a tx added to or removed from the mempool. A tx moved to
+// the default lane because its lane was removed is reported as removed from
+// its lane, with reason TxRemovalReasonLaneRemoved, and added to the default
+// lane.
+type TxEvent struct {
+	Type  TxEventType
+	TxKey types.TxKey
+	Lane  LaneID
+	Size  int
+	// Peer that first sent the added tx; empty if the tx was not received
+	// from a peer.
+	Sender p2p.ID
+	// Reason why the tx was removed.
+	Reason TxRemovalReason
+	// Number of events not delivered to the subscriber right before this one,
+	// because it did not keep up with the mempool.
+	Dropped uint64
+}
+
+// TxEventSubscription receives the events about the txs added to and removed
+// from the mempool, for the lanes it subscribed to.
+//
+// Events are never blocked on a slow subscriber: when its buffer is full,
+// events are dropped, and the number of events dropped is reported in the
+// next event delivered.
+type TxEventSubscription struct {
+	feed    *txFeed
+	lanes   map[LaneID]struct{} // nil for all lanes
+	out     chan TxEvent
+	dropped uint64 // protected by feed.mtx
+}
+
+// Out returns the channel on which the events are delivered. It is closed
+// when the subscription is canceled.
+func (sub *TxEventSubscription) Out() <-chan TxEvent {
+	return sub.out
+}
+
+// Unsubscribe cancels the subscription.
+func (sub *TxEventSubscription) Unsubscribe() {
+	sub.feed.unsubscribe(sub)
+}
+
+// txFeed delivers the events about the txs in the mempool to their subscribers.
+type txFeed struct {
+	mtx  cmtsync.Mutex
+	subs map[*TxEventSubscription]struct{}
+}
+
+func newTxFeed() *txFeed {
+	return &txFeed{subs: make(map[*TxEventSubscription]struct{})}
+}
+
+func (f *txFeed) subscribe(lanes []LaneID, capacity int) *TxEventSubscription {
+	sub := &TxEventSubscription{
+		feed: f,
+		out:  make(chan TxEvent, capacity),
+	}
+	if len(lanes) > 0 {
+		sub.lanes = make(map[LaneID]struct{}, len(lanes))
+		for _, lane := range lanes {
+			sub.lanes[lane] = struct{}{}
+		}
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.subs[sub] = struct{}{}
+	return sub
+}
+
+func (f *txFeed) unsubscribe(sub *TxEventSubscription) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if _, ok := f.subs[sub]; ok {
+		delete(f.subs, sub)
+		close(sub.out)
+	}
+}
+
+// publish delivers the event to the subscribers of its lane, without
+// blocking.
+func (f *txFeed) publish(ev TxEvent) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	for sub := range f.subs {
+		if sub.lanes != nil {
+			if _, ok := sub.lanes[ev.Lane]; !ok {
+				continue
+			}
+		}
+		ev.Dropped = sub.dropped
+		select {
+		case sub.out <- ev:
+			sub.dropped = 0
+		default:
+			sub.dropped++
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestMempoolTxEvents(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	defer cleanup()
+
+	_, err := mp.SubscribeTxEvents([]LaneID{"unknown"}, 10)
+	require.Error(t, err)
+
+	all, err := mp.SubscribeTxEvents(nil, 10)
+	require.NoError(t, err)
+	defer all.Unsubscribe()
+	bar, err := mp.SubscribeTxEvents([]LaneID{"bar"}, 10)
+	require.NoError(t, err)
+	defer bar.Unsubscribe()
+
+	// Txs 1 and 2 go to the default lane, tx 3 to lane bar.
+	txs := types.Txs{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2), kvstore.NewTxFromID(3)}
+	for _, tx := range txs {
+		_, err := mp.CheckTx(tx, p2p.ID("peer1"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, mp.RemoveTxByKey(txs[1].Key()))
+	doUpdate(t, mp, 1, txs[2:])
+
+	expected := []TxEvent{
+		{Type: TxEventAdded, TxKey: txs[0].Key(), Lane: "default", Size: len(txs[0]), Sender: "peer1"},
+		{Type: TxEventAdded, TxKey: txs[1].Key(), Lane: "default", Size: len(txs[1]), Sender: "peer1"},
+		{Type: TxEventAdded, TxKey: txs[2].Key(), Lane: "bar", Size: len(txs[2]), Sender: "peer1"},
+		{Type: TxEventRemoved, TxKey: txs[1].Key(), Lane: "default", Size: len(txs[1]), Reason: TxRemovalReasonRemoved},
+		{Type: TxEventRemoved, TxKey: txs[2].Key(), Lane: "bar", Size: len(txs[2]), Reason: TxRemovalReasonCommitted},
+	}
+	require.Len(t, all.Out(), len(expected))
+	for _, ev := range expected {
+		assert.Equal(t, ev, <-all.Out())
+	}
+	require.Len(t, bar.Out(), 2)
+	assert.Equal(t, expected[2], <-bar.Out())
+	assert.Equal(t, expected[4], <-bar.Out())
+
+	// The channel is closed once unsubscribed.
+	bar.Unsubscribe()
+	_, ok := <-bar.Out()
+	assert.False(t, ok)
+}
+
+func TestMempoolTxEventsDropped(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	defer cleanup()
+
+	sub, err := mp.SubscribeTxEvents(nil, 2)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// Only the first two events fit in the buffer.
+	for i := 1; i <= 5; i++ {
+		_, err := mp.CheckTx(kvstore.NewTxFromID(i), noSender)
+		require.NoError(t, err)
+	}
+	for i := 0; i < 2; i++ {
+		ev := <-sub.Out()
+		assert.Zero(t, ev.Dropped)
+	}
+
+	// The next event delivered reports the events dropped.
+	_, err = mp.CheckTx(kvstore.NewTxFromID(6), noSender)
+	require.NoError(t, err)
+	ev := <-sub.Out()
+	assert.Equal(t, types.Tx(kvstore.NewTxFromID(6)).Key(), ev.TxKey)
+	assert.Equal(t, uint64(3), ev.Dropped)
+}
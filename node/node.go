@@ -18,6 +18,7 @@ import (
 	_ "net/http/pprof" //nolint: gosec
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	bc "github.com/cometbft/cometbft/internal/blocksync"
 	cs "github.com/cometbft/cometbft/internal/consensus"
@@ -100,6 +101,7 @@ type waitSyncP2PReactor interface {
 type mempoolReactor interface {
 	waitSyncP2PReactor
 	TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, error)
+	TryAddBundle(txs types.Txs) ([]*abci.CheckTxResponse, error)
 }
 
 // Option sets a parameter for the node.
@@ -174,7 +176,7 @@ func BootstrapState(ctx context.Context, config *cfg.Config, dbProvider cfg.DBPr
 	}
 	blockStoreDB, stateDB, err := initDBs(config, dbProvider)
 
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(store.NopMetrics()), store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(store.NopMetrics()), store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout), store.WithColdTier(config.ColdTierDir(), 0, config.Storage.ColdTierSegmentSize))
 	logger.Info("Blockstore version", "version", blockStore.GetVersion())
 
 	defer func() {
@@ -343,7 +345,13 @@ func NewNodeWithCliParams(ctx context.Context,
 		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
 	})
 
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(bstMetrics), store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore := store.NewBlockStore(blockStoreDB,
+		store.WithMetrics(bstMetrics),
+		store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval),
+		store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout),
+		store.WithLogger(logger.With("module", "blockstore")),
+		store.WithColdTier(config.ColdTierDir(), config.Storage.ColdTierDepth, config.Storage.ColdTierSegmentSize),
+	)
 	logger.Info("Blockstore version", "version", blockStore.GetVersion())
 
 	// The key will be deleted if it existed.
@@ -358,7 +366,11 @@ func NewNodeWithCliParams(ctx context.Context,
 	}
 
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics)
+	var proxyAppOptions []proxy.MultiAppConnOption
+	if config.Consensus.OptimisticExecution {
+		proxyAppOptions = append(proxyAppOptions, proxy.WithSpeculativeConn())
+	}
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics, proxyAppOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -428,9 +440,14 @@ func NewNodeWithCliParams(ctx context.Context,
 	// Blocksync is always active, except if the local node blocks the chain
 	waitSync := !state.Validators.ValidatorBlocksTheChain(localAddr)
 
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, waitSync, memplMetrics, logger, appInfoResponse)
+	lanePriorities, defaultLane, err := mempoolLanes(stateStore, appInfoResponse)
+	if err != nil {
+		return nil, err
+	}
+	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, waitSync, memplMetrics, logger,
+		lanePriorities, defaultLane)
 
-	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
+	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, eventBus, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -458,6 +475,7 @@ func NewNodeWithCliParams(ctx context.Context,
 		blockStore,
 		sm.BlockExecutorWithPruner(pruner),
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithSpeculativeConn(proxyApp.Speculative()),
 	)
 
 	offlineStateSyncHeight := int64(0)
@@ -678,6 +696,12 @@ func (n *Node) OnStop() {
 		n.Logger.Error("Error closing transport", "err", err)
 	}
 
+	if mp, ok := n.mempool.(*mempl.CListMempool); ok {
+		if err := mp.CloseJournal(); err != nil {
+			n.Logger.Error("Error closing mempool journal", "err", err)
+		}
+	}
+
 	n.isListening = false
 
 	for _, l := range n.rpcListeners {
@@ -747,13 +771,14 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		ProxyAppQuery:   n.proxyApp.Query(),
 		ProxyAppMempool: n.proxyApp.Mempool(),
 
-		StateStore:     n.stateStore,
-		BlockStore:     n.blockStore,
-		EvidencePool:   n.evidencePool,
-		ConsensusState: n.consensusState,
-		P2PPeers:       n.sw,
-		P2PTransport:   n,
-		PubKey:         pubKey,
+		StateStore:        n.stateStore,
+		BlockStore:        n.blockStore,
+		EvidencePool:      n.evidencePool,
+		EvidenceInspector: n.evidencePool,
+		ConsensusState:    n.consensusState,
+		P2PPeers:          n.sw,
+		P2PTransport:      n,
+		PubKey:            pubKey,
 
 		TxIndexer:        n.txIndexer,
 		BlockIndexer:     n.blockIndexer,
@@ -889,6 +914,16 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.MempoolService.Enabled {
+			if mp, ok := n.mempool.(*mempl.CListMempool); ok {
+				opts = append(opts, grpcserver.WithMempoolService(mp, n.config.GRPC.MempoolService.BufferSize, n.Logger))
+			} else {
+				n.Logger.Info("gRPC mempool service is not available with this mempool type", "type", n.config.Mempool.Type)
+			}
+		}
+		if n.config.GRPC.EvidenceService.Enabled {
+			opts = append(opts, grpcserver.WithEvidenceService(n.evidencePool, n.blockStore, n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -1096,6 +1131,10 @@ func makeNodeInfo(
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
 
+	if config.Consensus.CompactBlockPropagation {
+		nodeInfo.Channels = append(nodeInfo.Channels, cs.CompactBlockChannel)
+	}
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
@@ -176,8 +176,13 @@ func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (bsDB cmtdb.DB, stat
 	return bsDB, stateDB, nil
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics) (proxy.AppConns, error) {
-	proxyApp := proxy.NewAppConns(clientCreator, metrics)
+func createAndStartProxyAppConns(
+	clientCreator proxy.ClientCreator,
+	logger log.Logger,
+	metrics *proxy.Metrics,
+	options ...proxy.MultiAppConnOption,
+) (proxy.AppConns, error) {
+	proxyApp := proxy.NewAppConns(clientCreator, metrics, options...)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -273,6 +278,20 @@ func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusL
 	}
 }
 
+// mempoolLanes returns the lanes of the mempool and their priorities, and the
+// default lane. The last lanes set by the app in a FinalizeBlock response, if
+// any, replace the ones in the app info.
+func mempoolLanes(stateStore sm.Store, appInfoResponse *abci.InfoResponse) (map[string]uint32, string, error) {
+	lanes, err := stateStore.LoadMempoolLanes()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not load mempool lanes: %w", err)
+	}
+	if lanes != nil {
+		return lanes.LanePriorities, lanes.DefaultLane, nil
+	}
+	return appInfoResponse.LanePriorities, appInfoResponse.DefaultLane, nil
+}
+
 // createMempoolAndMempoolReactor creates a mempool and a mempool reactor based on the config.
 func createMempoolAndMempoolReactor(
 	config *cfg.Config,
@@ -282,12 +301,13 @@ func createMempoolAndMempoolReactor(
 	waitSync bool,
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
-	appInfoResponse *abci.InfoResponse,
+	lanePriorities map[string]uint32,
+	defaultLane string,
 ) (mempl.Mempool, mempoolReactor) {
 	switch config.Mempool.Type {
 	// allow empty string for backward compatibility
 	case cfg.MempoolTypeFlood, "":
-		lanesInfo, err := mempl.BuildLanesInfo(appInfoResponse.LanePriorities, appInfoResponse.DefaultLane)
+		lanesInfo, err := mempl.BuildLanesInfo(lanePriorities, defaultLane)
 		if err != nil {
 			panic(fmt.Sprintf("could not get lanes info from app: %s", err))
 		}
@@ -297,6 +317,12 @@ func createMempoolAndMempoolReactor(
 			mempl.WithMetrics(memplMetrics),
 			mempl.WithPreCheck(sm.TxPreCheck(state)),
 			mempl.WithPostCheck(sm.TxPostCheck(state)),
+			mempl.WithRemovedTxCallback(func(tx types.Tx, reason mempl.TxRemovalReason) {
+				_ = eventBus.PublishEventRemovedTx(types.EventDataRemovedTx{
+					Tx:     tx,
+					Reason: string(reason),
+				})
+			}),
 		}
 		if config.Mempool.ExperimentalPublishEventPendingTx {
 			options = append(options, mempl.WithNewTxCallback(func(tx types.Tx) {
@@ -323,6 +349,13 @@ func createMempoolAndMempoolReactor(
 		}
 		reactor.SetLogger(logger)
 
+		// Restore the txs of the journal before the reactor starts gossiping.
+		if n, err := mp.RestoreFromJournal(); err != nil {
+			logger.Error("Failed to restore mempool from journal", "err", err)
+		} else if n > 0 {
+			logger.Info("Restored mempool from journal", "txs", n)
+		}
+
 		return mp, reactor
 	case cfg.MempoolTypeNop:
 		// Strictly speaking, there's no need to have a `mempl.NopMempoolReactor`, but
@@ -334,7 +367,7 @@ func createMempoolAndMempoolReactor(
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
-	stateStore sm.Store, blockStore *store.BlockStore, logger log.Logger,
+	stateStore sm.Store, blockStore *store.BlockStore, eventBus *types.EventBus, logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
 	evidenceDB, err := dbProvider(&cfg.DBContext{ID: "evidence", Config: config})
 	if err != nil {
@@ -347,6 +380,7 @@ func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
 	}
 	evidenceReactor := evidence.NewReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
+	evidenceReactor.SetEventBus(eventBus)
 	return evidenceReactor, evidencePool, nil
 }
 
@@ -400,7 +434,7 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics), cs.ReactorMempool(mempool))
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -45,6 +45,7 @@ type AddrBook interface {
 	AddOurAddress(addr *na.NetAddr)
 	OurAddress(addr *na.NetAddr) bool
 	MarkGood(id nodekey.ID)
+	MarkBad(addr *na.NetAddr, banTime time.Duration)
 	RemoveAddress(addr *na.NetAddr)
 	HasAddress(addr *na.NetAddr) bool
 	Save()
@@ -430,6 +431,14 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	}
 }
 
+// MarkPeerAsBad bans the given peer from the address book for banTime, after
+// it misbehaved.
+func (sw *Switch) MarkPeerAsBad(peer Peer, banTime time.Duration) {
+	if sw.addrBook != nil {
+		sw.addrBook.MarkBad(peer.SocketAddr(), banTime)
+	}
+}
+
 // ---------------------------------------------------------------------
 // Dialing
 
@@ -308,6 +308,9 @@ func (book *AddrBookMock) OurAddress(addr *na.NetAddr) bool {
 	return ok
 }
 func (*AddrBookMock) MarkGood(nodekey.ID) {}
+func (book *AddrBookMock) MarkBad(addr *na.NetAddr, _ time.Duration) {
+	delete(book.Addrs, addr.String())
+}
 func (book *AddrBookMock) HasAddress(addr *na.NetAddr) bool {
 	_, ok := book.Addrs[addr.String()]
 	return ok
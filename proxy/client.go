@@ -26,6 +26,9 @@ type ClientCreator interface {
 	// NewABCISnapshotClient creates an ABCI client for handling
 	// snapshot-related queries.
 	NewABCISnapshotClient() (abcicli.Client, error)
+	// NewABCISpeculativeClient creates an ABCI client for executing blocks
+	// before they are decided.
+	NewABCISpeculativeClient() (abcicli.Client, error)
 }
 
 // ----------------------------------------------------
@@ -67,6 +70,11 @@ func (l *localClientCreator) NewABCISnapshotClient() (abcicli.Client, error) {
 	return l.newABCIClient()
 }
 
+// NewABCISpeculativeClient implements ClientCreator.
+func (l *localClientCreator) NewABCISpeculativeClient() (abcicli.Client, error) {
+	return l.newABCIClient()
+}
+
 func (l *localClientCreator) newABCIClient() (abcicli.Client, error) {
 	return abcicli.NewLocalClient(l.mtx, l.app), nil
 }
@@ -112,6 +120,11 @@ func (c *connSyncLocalClientCreator) NewABCISnapshotClient() (abcicli.Client, er
 	return c.newABCIClient()
 }
 
+// NewABCISpeculativeClient implements ClientCreator.
+func (c *connSyncLocalClientCreator) NewABCISpeculativeClient() (abcicli.Client, error) {
+	return c.newABCIClient()
+}
+
 func (c *connSyncLocalClientCreator) newABCIClient() (abcicli.Client, error) {
 	return abcicli.NewLocalClient(nil, c.app), nil
 }
@@ -166,6 +179,13 @@ func (c *consensusSyncLocalClientCreator) NewABCISnapshotClient() (abcicli.Clien
 	return abcicli.NewUnsyncLocalClient(c.app), nil
 }
 
+// NewABCISpeculativeClient implements ClientCreator.
+func (c *consensusSyncLocalClientCreator) NewABCISpeculativeClient() (abcicli.Client, error) {
+	// A mutex is created by the local client and applied across all
+	// speculative calls, which are not serialized with the consensus ones.
+	return abcicli.NewLocalClient(nil, c.app), nil
+}
+
 // -----------------------------------------------------------------------------
 // most advanced local client creator with a more complex concurrency model
 // than the other local client creators - all concurrency is assumed to be
@@ -206,6 +226,11 @@ func (c *unsyncLocalClientCreator) NewABCISnapshotClient() (abcicli.Client, erro
 	return abcicli.NewUnsyncLocalClient(c.app), nil
 }
 
+// NewABCISpeculativeClient implements ClientCreator.
+func (c *unsyncLocalClientCreator) NewABCISpeculativeClient() (abcicli.Client, error) {
+	return abcicli.NewUnsyncLocalClient(c.app), nil
+}
+
 // ---------------------------------------------------------------
 // remote proxy opens new connections to an external app process
 
@@ -246,6 +271,11 @@ func (r *remoteClientCreator) NewABCISnapshotClient() (abcicli.Client, error) {
 	return r.newABCIClient()
 }
 
+// NewABCISpeculativeClient implements ClientCreator.
+func (r *remoteClientCreator) NewABCISpeculativeClient() (abcicli.Client, error) {
+	return r.newABCIClient()
+}
+
 func (r *remoteClientCreator) newABCIClient() (abcicli.Client, error) {
 	remoteApp, err := abcicli.NewClient(r.addr, r.transport, r.mustConnect)
 	if err != nil {
@@ -132,6 +132,36 @@ func (_m *ClientCreator) NewABCISnapshotClient() (abcicli.Client, error) {
 	return r0, r1
 }
 
+// NewABCISpeculativeClient provides a mock function with no fields
+func (_m *ClientCreator) NewABCISpeculativeClient() (abcicli.Client, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewABCISpeculativeClient")
+	}
+
+	var r0 abcicli.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (abcicli.Client, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() abcicli.Client); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(abcicli.Client)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewClientCreator creates a new instance of ClientCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientCreator(t interface {
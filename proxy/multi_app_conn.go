@@ -8,10 +8,11 @@ import (
 )
 
 const (
-	connConsensus = "consensus"
-	connMempool   = "mempool"
-	connQuery     = "query"
-	connSnapshot  = "snapshot"
+	connConsensus   = "consensus"
+	connMempool     = "mempool"
+	connQuery       = "query"
+	connSnapshot    = "snapshot"
+	connSpeculative = "speculative"
 )
 
 // AppConns is the CometBFT's interface to the application that consists of
@@ -27,11 +28,24 @@ type AppConns interface {
 	Query() AppConnQuery
 	// Snapshot connection
 	Snapshot() AppConnSnapshot
+	// Speculative connection, nil unless opened with WithSpeculativeConn
+	Speculative() AppConnConsensus
 }
 
 // NewAppConns calls NewMultiAppConn.
-func NewAppConns(clientCreator ClientCreator, metrics *Metrics) AppConns {
-	return NewMultiAppConn(clientCreator, metrics)
+func NewAppConns(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
+	return NewMultiAppConn(clientCreator, metrics, options...)
+}
+
+// MultiAppConnOption sets an optional parameter on the multiAppConn.
+type MultiAppConnOption func(*multiAppConn)
+
+// WithSpeculativeConn opens a speculative connection to the application, on
+// which blocks are executed before they are decided.
+func WithSpeculativeConn() MultiAppConnOption {
+	return func(app *multiAppConn) {
+		app.speculative = true
+	}
 }
 
 // multiAppConn implements AppConns.
@@ -48,20 +62,27 @@ type multiAppConn struct {
 	queryConn     AppConnQuery
 	snapshotConn  AppConnSnapshot
 
-	consensusConnClient abcicli.Client
-	mempoolConnClient   abcicli.Client
-	queryConnClient     abcicli.Client
-	snapshotConnClient  abcicli.Client
+	speculative     bool
+	speculativeConn AppConnConsensus
+
+	consensusConnClient   abcicli.Client
+	mempoolConnClient     abcicli.Client
+	queryConnClient       abcicli.Client
+	snapshotConnClient    abcicli.Client
+	speculativeConnClient abcicli.Client
 
 	clientCreator ClientCreator
 }
 
 // NewMultiAppConn makes all necessary abci connections to the application.
-func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics) AppConns {
+func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
 	multiAppConn := &multiAppConn{
 		metrics:       metrics,
 		clientCreator: clientCreator,
 	}
+	for _, option := range options {
+		option(multiAppConn)
+	}
 	multiAppConn.BaseService = *service.NewBaseService(nil, "multiAppConn", multiAppConn)
 	return multiAppConn
 }
@@ -82,6 +103,10 @@ func (app *multiAppConn) Snapshot() AppConnSnapshot {
 	return app.snapshotConn
 }
 
+func (app *multiAppConn) Speculative() AppConnConsensus {
+	return app.speculativeConn
+}
+
 func (app *multiAppConn) OnStart() error {
 	if err := app.startQueryClient(); err != nil {
 		return err
@@ -98,6 +123,12 @@ func (app *multiAppConn) OnStart() error {
 		app.stopAllClients()
 		return err
 	}
+	if app.speculative {
+		if err := app.startSpeculativeClient(); err != nil {
+			app.stopAllClients()
+			return err
+		}
+	}
 
 	// Kill CometBFT if the ABCI application crashes.
 	go app.killTMOnClientError()
@@ -146,6 +177,16 @@ func (app *multiAppConn) startConsensusClient() error {
 	return app.startClient(c, "consensus")
 }
 
+func (app *multiAppConn) startSpeculativeClient() error {
+	c, err := app.clientCreator.NewABCISpeculativeClient()
+	if err != nil {
+		return ErrABCIClientCreate{ClientName: "speculative", Err: err}
+	}
+	app.speculativeConnClient = c
+	app.speculativeConn = NewAppConnConsensus(c, app.metrics)
+	return app.startClient(c, "speculative")
+}
+
 func (app *multiAppConn) startClient(c abcicli.Client, conn string) error {
 	c.SetLogger(app.Logger.With("module", "abci-client", "connection", conn))
 	if err := c.Start(); err != nil {
@@ -169,6 +210,13 @@ func (app *multiAppConn) killTMOnClientError() {
 		}
 	}
 
+	// The speculative connection is optional: receiving from a nil channel
+	// blocks forever.
+	var speculativeQuit <-chan struct{}
+	if app.speculativeConnClient != nil {
+		speculativeQuit = app.speculativeConnClient.Quit()
+	}
+
 	select {
 	case <-app.consensusConnClient.Quit():
 		if err := app.consensusConnClient.Error(); err != nil {
@@ -186,6 +234,10 @@ func (app *multiAppConn) killTMOnClientError() {
 		if err := app.snapshotConnClient.Error(); err != nil {
 			killFn(connSnapshot, err, app.Logger)
 		}
+	case <-speculativeQuit:
+		if err := app.speculativeConnClient.Error(); err != nil {
+			killFn(connSpeculative, err, app.Logger)
+		}
 	}
 }
 
@@ -210,4 +262,9 @@ func (app *multiAppConn) stopAllClients() {
 			app.Logger.Error("error while stopping snapshot client", "error", err)
 		}
 	}
+	if app.speculativeConnClient != nil {
+		if err := app.speculativeConnClient.Stop(); err != nil {
+			app.Logger.Error("error while stopping speculative client", "error", err)
+		}
+	}
 }
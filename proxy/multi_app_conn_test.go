@@ -44,6 +44,41 @@ func TestAppConns_Start_Stop(t *testing.T) {
 	clientMock.AssertExpectations(t)
 }
 
+func TestAppConns_Speculative(t *testing.T) {
+	quitCh := make(<-chan struct{})
+
+	clientCreatorMock := &mocks.ClientCreator{}
+
+	clientMock := &abcimocks.Client{}
+	clientMock.On("SetLogger", mock.Anything).Return().Times(5)
+	clientMock.On("Start").Return(nil).Times(5)
+	clientMock.On("Stop").Return(nil).Times(5)
+	clientMock.On("Quit").Return(quitCh).Times(5)
+
+	clientCreatorMock.On("NewABCIQueryClient").Return(clientMock, nil).Once()
+	clientCreatorMock.On("NewABCIMempoolClient").Return(clientMock, nil).Once()
+	clientCreatorMock.On("NewABCISnapshotClient").Return(clientMock, nil).Once()
+	clientCreatorMock.On("NewABCIConsensusClient").Return(clientMock, nil).Once()
+	clientCreatorMock.On("NewABCISpeculativeClient").Return(clientMock, nil).Once()
+
+	// The speculative connection is only opened if requested.
+	appConns := NewAppConns(clientCreatorMock, NopMetrics())
+	require.Nil(t, appConns.Speculative())
+
+	appConns = NewAppConns(clientCreatorMock, NopMetrics(), WithSpeculativeConn())
+	err := appConns.Start()
+	require.NoError(t, err)
+	require.NotNil(t, appConns.Speculative())
+
+	time.Sleep(100 * time.Millisecond)
+
+	err = appConns.Stop()
+	require.NoError(t, err)
+
+	clientMock.AssertExpectations(t)
+	clientCreatorMock.AssertExpectations(t)
+}
+
 // Upon failure, we call cmtos.Kill.
 func TestAppConns_Failure(t *testing.T) {
 	ok := make(chan struct{})
@@ -16,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctest "github.com/cometbft/cometbft/rpc/test"
 	"github.com/cometbft/cometbft/types"
 )
@@ -127,6 +128,9 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		correct, fakes := makeEvidences(t, pv, chainID, ts)
 		t.Logf("client %d", i)
 
+		before, err := c.Status(context.Background())
+		require.NoError(t, err)
+
 		result, err := c.BroadcastEvidence(context.Background(), correct)
 		require.NoError(t, err, "BroadcastEvidence(%s) failed", correct)
 		assert.Equal(t, correct.Hash(), result.Hash, "expected result hash to match evidence hash")
@@ -136,6 +140,28 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
 
+		// The evidence is not pending anymore, but committed in a block.
+		pending, err := c.PendingEvidence(context.Background())
+		require.NoError(t, err)
+		for _, info := range pending.Evidence {
+			assert.NotEqual(t, correct.Hash(), info.Evidence.Hash())
+		}
+		var committed *ctypes.EvidenceInfo
+		for h := before.SyncInfo.LatestBlockHeight; h <= status.SyncInfo.LatestBlockHeight+2 && committed == nil; h++ {
+			res, err := c.CommittedEvidence(context.Background(), &h)
+			require.NoError(t, err)
+			require.Equal(t, h, res.Height)
+			for _, info := range res.Evidence {
+				if bytes.Equal(correct.Hash(), info.Hash) {
+					committed = &info
+				}
+			}
+		}
+		require.NotNil(t, committed, "evidence not committed")
+		assert.Equal(t, "committed", committed.Status)
+		assert.True(t, committed.Verified)
+		assert.Equal(t, correct.Height()+types.DefaultEvidenceParams().MaxAgeNumBlocks, committed.ExpiryHeight)
+
 		ed25519pub := pv.Key.PubKey.(ed25519.PubKey)
 		rawpub := ed25519pub.Bytes()
 		result2, err := c.ABCIQuery(context.Background(), "/val", rawpub)
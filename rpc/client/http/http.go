@@ -273,6 +273,18 @@ func (c *baseRPCClient) BroadcastTxSync(
 	return c.broadcastTX(ctx, "broadcast_tx_sync", tx)
 }
 
+func (c *baseRPCClient) BroadcastBundle(
+	ctx context.Context,
+	txs types.Txs,
+) (*ctypes.ResultBroadcastBundle, error) {
+	result := new(ctypes.ResultBroadcastBundle)
+	_, err := c.caller.Call(ctx, "broadcast_bundle", map[string]any{"txs": txs}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) broadcastTX(
 	ctx context.Context,
 	route string,
@@ -324,6 +336,15 @@ func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUn
 	return result, nil
 }
 
+func (c *baseRPCClient) MempoolLanes(ctx context.Context) (*ctypes.ResultMempoolLanes, error) {
+	result := new(ctypes.ResultMempoolLanes)
+	_, err := c.caller.Call(ctx, "mempool_lanes", map[string]any{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	result := new(ctypes.ResultCheckTx)
 	_, err := c.caller.Call(ctx, "check_tx", map[string]any{"tx": tx}, result)
@@ -360,6 +381,19 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConse
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	result := new(ctypes.ResultConsensusTrace)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_trace", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -603,6 +637,28 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	_, err := c.caller.Call(ctx, "pending_evidence", map[string]any{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) CommittedEvidence(ctx context.Context, height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	result := new(ctypes.ResultCommittedEvidence)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "committed_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // -----------------------------------------------------------------------------
 // WSEvents
 
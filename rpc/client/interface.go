@@ -59,6 +59,7 @@ type ABCIClient interface {
 	BroadcastTxCommit(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
 	BroadcastTxAsync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error)
 	BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error)
+	BroadcastBundle(ctx context.Context, txs types.Txs) (*ctypes.ResultBroadcastBundle, error)
 }
 
 // SignClient groups together the functionality needed to get valid signatures
@@ -111,6 +112,7 @@ type NetworkClient interface {
 	NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error)
+	ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(ctx context.Context) (*ctypes.ResultHealth, error)
 }
@@ -137,13 +139,16 @@ type MempoolClient interface {
 	UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error)
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error)
+	MempoolLanes(ctx context.Context) (*ctypes.ResultMempoolLanes, error)
 	CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior, and for inspecting the evidence held by the evidence pool.
 type EvidenceClient interface {
 	BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error)
+	CommittedEvidence(ctx context.Context, height *int64) (*ctypes.ResultCommittedEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
@@ -110,6 +110,10 @@ func (c *Local) BroadcastTxSync(_ context.Context, tx types.Tx) (*ctypes.ResultB
 	return c.env.BroadcastTxSync(c.ctx, tx)
 }
 
+func (c *Local) BroadcastBundle(_ context.Context, txs types.Txs) (*ctypes.ResultBroadcastBundle, error) {
+	return c.env.BroadcastBundle(c.ctx, txs)
+}
+
 func (c *Local) UnconfirmedTx(_ context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
 	return c.env.UnconfirmedTx(c.ctx, hash)
 }
@@ -122,6 +126,10 @@ func (c *Local) NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs
 	return c.env.NumUnconfirmedTxs(c.ctx)
 }
 
+func (c *Local) MempoolLanes(context.Context) (*ctypes.ResultMempoolLanes, error) {
+	return c.env.MempoolLanes(c.ctx)
+}
+
 func (c *Local) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.env.CheckTx(c.ctx, tx)
 }
@@ -138,6 +146,10 @@ func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, e
 	return c.env.GetConsensusState(c.ctx)
 }
 
+func (c *Local) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(c.ctx, height)
+}
+
 func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(c.ctx, height)
 }
@@ -228,6 +240,14 @@ func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(c.ctx)
+}
+
+func (c *Local) CommittedEvidence(_ context.Context, height *int64) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(c.ctx, height)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
@@ -116,6 +116,10 @@ func (c Client) BroadcastTxSync(_ context.Context, tx types.Tx) (*ctypes.ResultB
 	return c.env.BroadcastTxSync(&rpctypes.Context{}, tx)
 }
 
+func (c Client) BroadcastBundle(_ context.Context, txs types.Txs) (*ctypes.ResultBroadcastBundle, error) {
+	return c.env.BroadcastBundle(&rpctypes.Context{}, txs)
+}
+
 func (c Client) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.env.CheckTx(&rpctypes.Context{}, tx)
 }
@@ -132,6 +136,10 @@ func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsens
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(&rpctypes.Context{}, height)
+}
+
 func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
@@ -206,6 +206,29 @@ func (_m *Client) BlockchainInfo(ctx context.Context, minHeight int64, maxHeight
 	return r0, r1
 }
 
+// BroadcastBundle provides a mock function with given fields: ctx, txs
+func (_m *Client) BroadcastBundle(ctx context.Context, txs types.Txs) (*coretypes.ResultBroadcastBundle, error) {
+	ret := _m.Called(ctx, txs)
+
+	var r0 *coretypes.ResultBroadcastBundle
+	if rf, ok := ret.Get(0).(func(context.Context, types.Txs) *coretypes.ResultBroadcastBundle); ok {
+		r0 = rf(ctx, txs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBroadcastBundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Txs) error); ok {
+		r1 = rf(ctx, txs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BroadcastEvidence provides a mock function with given fields: _a0, _a1
 func (_m *Client) BroadcastEvidence(_a0 context.Context, _a1 types.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
 	ret := _m.Called(_a0, _a1)
@@ -344,6 +367,29 @@ func (_m *Client) Commit(ctx context.Context, height *int64) (*coretypes.ResultC
 	return r0, r1
 }
 
+// CommittedEvidence provides a mock function with given fields: ctx, height
+func (_m *Client) CommittedEvidence(ctx context.Context, height *int64) (*coretypes.ResultCommittedEvidence, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultCommittedEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultCommittedEvidence); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultCommittedEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusParams provides a mock function with given fields: ctx, height
 func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	ret := _m.Called(ctx, height)
@@ -390,6 +436,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTrace provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTrace(ctx context.Context, height *int64) (*coretypes.ResultConsensusTrace, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTrace
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTrace); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTrace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -542,6 +611,29 @@ func (_m *Client) IsRunning() bool {
 	return r0
 }
 
+// MempoolLanes provides a mock function with given fields: _a0
+func (_m *Client) MempoolLanes(_a0 context.Context) (*coretypes.ResultMempoolLanes, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultMempoolLanes
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultMempoolLanes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultMempoolLanes)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NetInfo provides a mock function with given fields: _a0
 func (_m *Client) NetInfo(_a0 context.Context) (*coretypes.ResultNetInfo, error) {
 	ret := _m.Called(_a0)
@@ -621,6 +713,29 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// PendingEvidence provides a mock function with given fields: _a0
+func (_m *Client) PendingEvidence(_a0 context.Context) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
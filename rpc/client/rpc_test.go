@@ -151,6 +151,26 @@ func TestConsensusState(t *testing.T) {
 	}
 }
 
+func TestConsensusTrace(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+
+		trace, err := nc.ConsensusTrace(context.Background(), nil)
+		require.NoError(t, err, "%d: %+v", i, err)
+		var timelines []struct {
+			Height int64 `json:"height"`
+		}
+		require.NoError(t, cmtjson.Unmarshal(trace.Timelines, &timelines))
+		assert.NotEmpty(t, timelines)
+
+		for _, height := range []int64{0, 1 << 40} {
+			_, err = nc.ConsensusTrace(context.Background(), &height)
+			require.Error(t, err, "%d: height %d", i, height)
+		}
+	}
+}
+
 func TestHealth(t *testing.T) {
 	for i, c := range GetClients() {
 		nc, ok := c.(client.NetworkClient)
@@ -353,6 +373,36 @@ func TestBroadcastTxSync(t *testing.T) {
 	}
 }
 
+func TestBroadcastBundle(t *testing.T) {
+	mempool := node.Mempool()
+	initMempoolSize := mempool.Size()
+
+	for i, c := range GetClients() {
+		_, _, tx1 := MakeTxKV()
+		_, _, tx2 := MakeTxKV()
+		bres, err := c.BroadcastBundle(context.Background(), types.Txs{tx1, tx2})
+		require.NoError(t, err, "%d: %+v", i, err)
+		require.Len(t, bres.Txs, 2)
+		for _, res := range bres.Txs {
+			require.Equal(t, abci.CodeTypeOK, res.Code)
+		}
+		require.Equal(t, initMempoolSize+2, mempool.Size())
+		require.True(t, mempool.Contains(types.Tx(tx1).Key()))
+		require.True(t, mempool.Contains(types.Tx(tx2).Key()))
+
+		// A bundle with an invalid tx is not added.
+		_, _, tx3 := MakeTxKV()
+		bres, err = c.BroadcastBundle(context.Background(), types.Txs{tx3, types.Tx("invalid")})
+		require.NoError(t, err, "%d: %+v", i, err)
+		require.Len(t, bres.Txs, 2)
+		require.Equal(t, abci.CodeTypeOK, bres.Txs[0].Code)
+		require.NotEqual(t, abci.CodeTypeOK, bres.Txs[1].Code)
+		require.Equal(t, initMempoolSize+2, mempool.Size())
+
+		mempool.Flush()
+	}
+}
+
 func TestBroadcastTxCommit(t *testing.T) {
 	require := require.New(t)
 
@@ -456,6 +506,41 @@ func TestNumUnconfirmedTxs(t *testing.T) {
 	mempool.Flush()
 }
 
+func TestMempoolLanes(t *testing.T) {
+	_, _, tx := MakeTxKV()
+
+	mempool := node.Mempool()
+	reqRes, err := mempool.CheckTx(tx, "")
+	require.NoError(t, err)
+	reqRes.Wait()
+
+	lanes := mempool.Lanes()
+	require.NotEmpty(t, lanes)
+	for i, c := range GetClients() {
+		mc, ok := c.(client.MempoolClient)
+		require.True(t, ok, "%d", i)
+		res, err := mc.MempoolLanes(context.Background())
+		require.NoError(t, err, "%d: %+v", i, err)
+
+		require.Len(t, res.Lanes, len(lanes))
+		numTxs, numDefault := 0, 0
+		for j, lane := range res.Lanes {
+			assert.Equal(t, string(lanes[j].ID), lane.ID)
+			assert.Equal(t, uint32(lanes[j].Priority), lane.Priority)
+			assert.Equal(t, lanes[j].MaxTxs, lane.MaxTxs)
+			assert.Positive(t, lane.MaxBytes)
+			numTxs += lane.Count
+			if lane.Default {
+				numDefault++
+			}
+		}
+		assert.Equal(t, mempool.Size(), numTxs)
+		assert.Equal(t, 1, numDefault)
+	}
+
+	mempool.Flush()
+}
+
 func TestCheckTx(t *testing.T) {
 	mempool := node.Mempool()
 
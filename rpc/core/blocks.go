@@ -27,10 +27,18 @@ func (env *Environment) BlockchainInfo(
 	minHeight, maxHeight int64,
 ) (*ctypes.ResultBlockchainInfo, error) {
 	const limit int64 = 20
-	var err error
+
+	// Read all the metas from the same snapshot, so that pruning or new blocks
+	// cannot leave holes in the result or make LastHeight disagree with it.
+	blockStore, err := env.BlockStore.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer blockStore.Close()
+
 	minHeight, maxHeight, err = filterMinMax(
-		env.BlockStore.Base(),
-		env.BlockStore.Height(),
+		blockStore.Base(),
+		blockStore.Height(),
 		minHeight,
 		maxHeight,
 		limit)
@@ -41,12 +49,12 @@ func (env *Environment) BlockchainInfo(
 
 	blockMetas := []*types.BlockMeta{}
 	for height := maxHeight; height >= minHeight; height-- {
-		blockMeta := env.BlockStore.LoadBlockMeta(height)
+		blockMeta := blockStore.LoadBlockMeta(height)
 		blockMetas = append(blockMetas, blockMeta)
 	}
 
 	return &ctypes.ResultBlockchainInfo{
-		LastHeight: env.BlockStore.Height(),
+		LastHeight: blockStore.Height(),
 		BlockMetas: blockMetas,
 	}, nil
 }
@@ -120,12 +128,18 @@ func (env *Environment) HeaderByHash(_ *rpctypes.Context, hash bytes.HexBytes) (
 // If no height is provided, it will fetch the latest block.
 // More: https://docs.cometbft.com/main/rpc/#/Info/block
 func (env *Environment) Block(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error) {
-	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	blockStore, err := env.BlockStore.NewSnapshot()
 	if err != nil {
 		return nil, err
 	}
+	defer blockStore.Close()
 
-	block, blockMeta := env.BlockStore.LoadBlock(height)
+	height, err := getHeightInRange(blockStore.Base(), blockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	block, blockMeta := blockStore.LoadBlock(height)
 	if blockMeta == nil {
 		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block}, nil
 	}
@@ -135,7 +149,13 @@ func (env *Environment) Block(_ *rpctypes.Context, heightPtr *int64) (*ctypes.Re
 // BlockByHash gets block by hash.
 // More: https://docs.cometbft.com/main/rpc/#/Info/block_by_hash
 func (env *Environment) BlockByHash(_ *rpctypes.Context, hash []byte) (*ctypes.ResultBlock, error) {
-	block, blockMeta := env.BlockStore.LoadBlockByHash(hash)
+	blockStore, err := env.BlockStore.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer blockStore.Close()
+
+	block, blockMeta := blockStore.LoadBlockByHash(hash)
 	if blockMeta == nil {
 		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: nil}, nil
 	}
@@ -146,12 +166,21 @@ func (env *Environment) BlockByHash(_ *rpctypes.Context, hash []byte) (*ctypes.R
 // If no height is provided, it will fetch the commit for the latest block.
 // More: https://docs.cometbft.com/main/rpc/#/Info/commit
 func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
-	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	// The header, the height check and the commit must all come from the same
+	// snapshot: if the next block were saved in between, we could otherwise
+	// look for a seen commit that has just been superseded.
+	blockStore, err := env.BlockStore.NewSnapshot()
 	if err != nil {
 		return nil, err
 	}
+	defer blockStore.Close()
 
-	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	height, err := getHeightInRange(blockStore.Base(), blockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := blockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
 		return nil, nil
 	}
@@ -159,13 +188,13 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 
 	// If the next block has not been committed yet,
 	// use a non-canonical commit
-	if height == env.BlockStore.Height() {
-		commit := env.BlockStore.LoadSeenCommit(height)
+	if height == blockStore.Height() {
+		commit := blockStore.LoadSeenCommit(height)
 		return ctypes.NewResultCommit(&header, commit, false), nil
 	}
 
 	// Return the canonical commit (comes from the block at height+1)
-	commit := env.BlockStore.LoadBlockCommit(height)
+	commit := blockStore.LoadBlockCommit(height)
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
@@ -177,12 +206,22 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 // getBlock(h).Txs[5]
 // More: https://docs.cometbft.com/main/rpc/#/Info/block_results
 func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockResults, error) {
-	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	// The height checks and the results must agree on the latest height:
+	// otherwise, the results of a block that has been saved but not executed
+	// yet could be requested.
+	blockStore, stateStore, latestHeight, err := env.storeSnapshots()
+	if err != nil {
+		return nil, err
+	}
+	defer blockStore.Close()
+	defer stateStore.Close()
+
+	height, err := getHeightInRange(blockStore.Base(), latestHeight, heightPtr)
 	if err != nil {
 		return nil, err
 	}
 
-	results, err := env.StateStore.LoadFinalizeBlockResponse(height)
+	results, err := stateStore.LoadFinalizeBlockResponse(height)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestBlockchainInfo(t *testing.T) {
@@ -85,6 +86,8 @@ func TestBlockResults(t *testing.T) {
 	err = env.StateStore.SaveFinalizeBlockResponse(100, results)
 	require.NoError(t, err)
 	mockstore := &mocks.BlockStore{}
+	mockstore.On("NewSnapshot").Return(mockstore, nil)
+	mockstore.On("Close").Return(nil)
 	mockstore.On("Height").Return(int64(100))
 	mockstore.On("Base").Return(int64(1))
 	env.BlockStore = mockstore
@@ -117,3 +120,35 @@ func TestBlockResults(t *testing.T) {
 		}
 	}
 }
+
+func TestBlockResultsBoundedByState(t *testing.T) {
+	results := &abci.FinalizeBlockResponse{AppHash: make([]byte, 1)}
+
+	// Block 101 is saved, but it has not been executed yet.
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("NewSnapshot").Return(blockStore, nil)
+	blockStore.On("Close").Return(nil)
+	blockStore.On("Height").Return(int64(101))
+	blockStore.On("Base").Return(int64(1))
+
+	stateStore := &mocks.Store{}
+	stateStore.On("NewSnapshot").Return(stateStore, nil)
+	stateStore.On("Close").Return(nil)
+	stateStore.On("Load").Return(sm.State{
+		LastBlockHeight: 100,
+		Validators:      &types.ValidatorSet{},
+	}, nil)
+	stateStore.On("LoadFinalizeBlockResponse", int64(100)).Return(results, nil)
+
+	env := &Environment{BlockStore: blockStore, StateStore: stateStore}
+
+	res, err := env.BlockResults(&rpctypes.Context{}, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, res.Height)
+
+	height := int64(101)
+	_, err = env.BlockResults(&rpctypes.Context{}, &height)
+	require.Error(t, err)
+
+	stateStore.AssertExpectations(t)
+}
@@ -101,6 +101,27 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTrace returns the timeline of the consensus at the given height:
+// the step transitions, the arrival of the proposal, of the block parts and of
+// the votes, and the timeouts fired. If no height is provided, it returns the
+// timelines of all the heights kept in memory.
+// UNSTABLE
+// More: https://docs.cometbft.com/main/rpc/#/Info/consensus_trace
+func (env *Environment) ConsensusTrace(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultConsensusTrace, error) {
+	var height int64
+	if heightPtr != nil {
+		height = *heightPtr
+		if height <= 0 {
+			return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+		}
+	}
+	bz, err := env.ConsensusState.GetTraceJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusTrace{Timelines: bz}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/main/rpc/#/Info/consensus_params
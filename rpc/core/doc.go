@@ -22,6 +22,8 @@ Available endpoints:
 /health
 /net_info
 /num_unconfirmed_txs
+/mempool_lanes
+/pending_evidence
 /status
 /unsafe_flush_mempool
 /unsubscribe_all?
@@ -33,14 +35,17 @@ Endpoints that require arguments:
 /block_results?height=_
 /block_search?query=_&page=_&per_page=_&order_by=_
 /blockchain?minHeight=_&maxHeight=_
+/broadcast_bundle?txs=_
 /broadcast_evidence?evidence=_
 /broadcast_tx_async?tx=_
 /broadcast_tx_commit?tx=_
 /broadcast_tx_sync?tx=_
 /check_tx?tx=_
 /commit?height=_
+/committed_evidence?height=_
 /consensus_params?height=_
 /consensus_state?
+/consensus_trace?height=_
 /genesis_chunked?chunk=_
 /header?height=_
 /header_by_hash?hash=_
@@ -11,8 +11,10 @@ import (
 	"time"
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/internal/evidence"
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
@@ -47,6 +49,12 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetTraceJSON(height int64) ([]byte, error)
+}
+
+type evidenceInspector interface {
+	PendingEvidenceInfo() ([]evidence.Info, error)
+	CommittedEvidenceInfo(height int64) ([]evidence.Info, error)
 }
 
 type transport interface {
@@ -71,6 +79,7 @@ type syncReactor interface {
 type mempoolReactor interface {
 	syncReactor
 	TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, error)
+	TryAddBundle(txs types.Txs) ([]*abci.CheckTxResponse, error)
 }
 
 // Environment contains the objects and interfaces used to serve the RPC APIs.
@@ -88,14 +97,15 @@ type Environment struct {
 	ProxyAppMempool proxy.AppConnMempool
 
 	// interfaces defined in types and above
-	StateStore       sm.Store
-	BlockStore       sm.BlockStore
-	EvidencePool     sm.EvidencePool
-	ConsensusState   Consensus
-	ConsensusReactor syncReactor
-	MempoolReactor   mempoolReactor
-	P2PPeers         peers
-	P2PTransport     transport
+	StateStore        sm.Store
+	BlockStore        sm.BlockStore
+	EvidencePool      sm.EvidencePool
+	EvidenceInspector evidenceInspector
+	ConsensusState    Consensus
+	ConsensusReactor  syncReactor
+	MempoolReactor    mempoolReactor
+	P2PPeers          peers
+	P2PTransport      transport
 
 	// objects
 	PubKey       crypto.PubKey
@@ -234,6 +244,12 @@ func validateSkipCount(page, perPage int) int {
 
 // latestHeight can be either latest committed or uncommitted (+1) height.
 func (env *Environment) getHeight(latestHeight int64, heightPtr *int64) (int64, error) {
+	return getHeightInRange(env.BlockStore.Base(), latestHeight, heightPtr)
+}
+
+// getHeightInRange is like getHeight, but takes the lowest available height
+// too, so that callers reading from a snapshot can check against its base.
+func getHeightInRange(base, latestHeight int64, heightPtr *int64) (int64, error) {
 	if heightPtr != nil {
 		height := *heightPtr
 		if height <= 0 {
@@ -243,7 +259,6 @@ func (env *Environment) getHeight(latestHeight int64, heightPtr *int64) (int64,
 			return 0, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d",
 				height, latestHeight)
 		}
-		base := env.BlockStore.Base()
 		if height < base {
 			return 0, fmt.Errorf("height %d is not available, lowest height is %d",
 				height, base)
@@ -253,6 +268,38 @@ func (env *Environment) getHeight(latestHeight int64, heightPtr *int64) (int64,
 	return latestHeight, nil
 }
 
+// storeSnapshots returns a snapshot of the block store and one of the state
+// store, along with the highest height for which both of them hold data.
+//
+// The state store is snapshotted first: a block is always saved before the
+// state and the results of executing it, so the block store snapshot taken
+// afterwards holds every block the state store snapshot knows of. A state
+// store without a state (e.g. when inspecting a bare block store) does not
+// bound the height. The caller must close both snapshots.
+func (env *Environment) storeSnapshots() (sm.BlockStoreSnapshot, sm.StoreSnapshot, int64, error) {
+	stateStore, err := env.StateStore.NewSnapshot()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	state, err := stateStore.Load()
+	if err != nil {
+		stateStore.Close()
+		return nil, nil, 0, err
+	}
+
+	blockStore, err := env.BlockStore.NewSnapshot()
+	if err != nil {
+		stateStore.Close()
+		return nil, nil, 0, err
+	}
+
+	height := blockStore.Height()
+	if !state.IsEmpty() && state.LastBlockHeight < height {
+		height = state.LastBlockHeight
+	}
+	return blockStore, stateStore, height, nil
+}
+
 func (env *Environment) latestUncommittedHeight() int64 {
 	nodeIsSyncing := env.ConsensusReactor.WaitSync()
 	if nodeIsSyncing {
@@ -10,6 +10,7 @@ var (
 	ErrBlockIndexing           = errors.New("block indexing is disabled")
 	ErrTxIndexingDisabled      = errors.New("transaction indexing is disabled")
 	ErrNoEvidence              = errors.New("no evidence was provided")
+	ErrEvidenceInspection      = errors.New("evidence pool inspection is not available")
 	ErrSlowClient              = errors.New("slow client")
 	ErrCometBFTExited          = errors.New("cometBFT exited")
 	ErrConfirmationNotReceived = errors.New("broadcast confirmation not received")
@@ -3,6 +3,7 @@ package core
 import (
 	"reflect"
 
+	"github.com/cometbft/cometbft/internal/evidence"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
@@ -31,3 +32,56 @@ func (env *Environment) BroadcastEvidence(
 
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence gets the evidence pending in the evidence pool, with the
+// result of its verification when it was added to the pool, its expiry and the
+// peer it was received from.
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/pending_evidence
+func (env *Environment) PendingEvidence(*rpctypes.Context) (*ctypes.ResultPendingEvidence, error) {
+	if env.EvidenceInspector == nil {
+		return nil, ErrEvidenceInspection
+	}
+	infos, err := env.EvidenceInspector.PendingEvidenceInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultPendingEvidence{Evidence: evidenceInfos(infos)}, nil
+}
+
+// CommittedEvidence gets the evidence committed in the block at the given
+// height, with its expiry and the peer it was received from. If no height is
+// provided, it returns the evidence committed in the latest block.
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/committed_evidence
+func (env *Environment) CommittedEvidence(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommittedEvidence, error) {
+	if env.EvidenceInspector == nil {
+		return nil, ErrEvidenceInspection
+	}
+	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := env.EvidenceInspector.CommittedEvidenceInfo(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultCommittedEvidence{Height: height, Evidence: evidenceInfos(infos)}, nil
+}
+
+func evidenceInfos(infos []evidence.Info) []ctypes.EvidenceInfo {
+	res := make([]ctypes.EvidenceInfo, len(infos))
+	for i, info := range infos {
+		res[i] = ctypes.EvidenceInfo{
+			Evidence:     info.Evidence,
+			Hash:         info.Evidence.Hash(),
+			Status:       string(info.Status),
+			Verified:     info.VerifyErr == nil,
+			ExpiryHeight: info.ExpiryHeight,
+			ExpiryTime:   info.ExpiryTime,
+			Peer:         string(info.Peer),
+		}
+		if info.VerifyErr != nil {
+			res[i].VerifyError = info.VerifyErr.Error()
+		}
+	}
+	return res
+}
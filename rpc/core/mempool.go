@@ -6,6 +6,7 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	mempl "github.com/cometbft/cometbft/mempool"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
@@ -81,6 +82,37 @@ func (env *Environment) BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ct
 	}
 }
 
+// BroadcastBundle checks the given txs with CheckTx, in order, and adds them to
+// the mempool as a bundle if all of them are valid: the txs of a bundle are
+// included in a block together and in the given order, or not at all. Returns
+// with the responses from CheckTx, up to the first invalid tx, if any, in which
+// case no tx is added. Does not wait for the transaction results.
+//
+// Bundles are not gossiped to peers: they are only proposed by this node.
+// More: https://docs.cometbft.com/main/rpc/#/Tx/broadcast_bundle
+func (env *Environment) BroadcastBundle(_ *rpctypes.Context, txs []types.Tx) (*ctypes.ResultBroadcastBundle, error) {
+	if env.MempoolReactor.WaitSync() {
+		return nil, ErrEndpointClosedCatchingUp
+	}
+
+	responses, err := env.MempoolReactor.TryAddBundle(txs)
+	// As for single txs, an invalid tx is reported by its CheckTx response.
+	if err != nil && !errors.As(err, &mempl.ErrInvalidTx{}) {
+		return nil, err
+	}
+	result := &ctypes.ResultBroadcastBundle{Txs: make([]ctypes.ResultBroadcastTx, len(responses))}
+	for i, res := range responses {
+		result.Txs[i] = ctypes.ResultBroadcastTx{
+			Code:      res.Code,
+			Data:      res.Data,
+			Log:       res.Log,
+			Codespace: res.Codespace,
+			Hash:      txs[i].Hash(),
+		}
+	}
+	return result, nil
+}
+
 // BroadcastTxCommit returns with the responses from CheckTx and ExecTxResult.
 // More: https://docs.cometbft.com/main/rpc/#/Tx/broadcast_tx_commit
 func (env *Environment) BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
@@ -222,6 +254,26 @@ func (env *Environment) NumUnconfirmedTxs(*rpctypes.Context) (*ctypes.ResultUnco
 	}, nil
 }
 
+// MempoolLanes gets the lanes of the mempool, by decreasing priority, with the
+// number of transactions and bytes in each lane and their maximums.
+// More: https://docs.cometbft.com/main/rpc/#/Info/mempool_lanes
+func (env *Environment) MempoolLanes(*rpctypes.Context) (*ctypes.ResultMempoolLanes, error) {
+	stats := env.Mempool.Lanes()
+	lanes := make([]ctypes.MempoolLane, len(stats))
+	for i, lane := range stats {
+		lanes[i] = ctypes.MempoolLane{
+			ID:       string(lane.ID),
+			Priority: uint32(lane.Priority),
+			Default:  lane.Default,
+			Count:    lane.NumTxs,
+			Bytes:    lane.Bytes,
+			MaxTxs:   lane.MaxTxs,
+			MaxBytes: lane.MaxBytes,
+		}
+	}
+	return &ctypes.ResultMempoolLanes{Lanes: lanes}, nil
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.cometbft.com/main/rpc/#/Tx/check_tx
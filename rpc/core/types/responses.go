@@ -171,6 +171,11 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// UNSTABLE.
+type ResultConsensusTrace struct {
+	Timelines json.RawMessage `json:"timelines"`
+}
+
 // CheckTx result.
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -181,6 +186,11 @@ type ResultBroadcastTx struct {
 	Hash bytes.HexBytes `json:"hash"`
 }
 
+// CheckTx results of the txs of a bundle, up to the first invalid tx, if any.
+type ResultBroadcastBundle struct {
+	Txs []ResultBroadcastTx `json:"txs"`
+}
+
 // CheckTx and ExecTx results.
 type ResultBroadcastTxCommit struct {
 	CheckTx  abcitypes.CheckTxResponse `json:"check_tx"`
@@ -229,6 +239,22 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Lanes of the mempool.
+type ResultMempoolLanes struct {
+	Lanes []MempoolLane `json:"lanes"`
+}
+
+// State of a lane of the mempool.
+type MempoolLane struct {
+	ID       string `json:"id"`
+	Priority uint32 `json:"priority"`
+	Default  bool   `json:"default"`
+	Count    int    `json:"n_txs"`
+	Bytes    int64  `json:"bytes"`
+	MaxTxs   int    `json:"max_txs"`
+	MaxBytes int64  `json:"max_bytes"`
+}
+
 // Info abci msg.
 type ResultABCIInfo struct {
 	Response abcitypes.InfoResponse `json:"response"`
@@ -244,6 +270,32 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// Evidence pending in the evidence pool.
+type ResultPendingEvidence struct {
+	Evidence []EvidenceInfo `json:"evidence"`
+}
+
+// Evidence committed in the block at the given height.
+type ResultCommittedEvidence struct {
+	Height   int64          `json:"height"`
+	Evidence []EvidenceInfo `json:"evidence"`
+}
+
+// Evidence held by the evidence pool, with its status ("pending" or
+// "committed"). The evidence expires once the last block height is greater
+// than ExpiryHeight and the last block time is after ExpiryTime. Peer is the
+// ID of the peer the evidence was received from, if known.
+type EvidenceInfo struct {
+	Evidence     types.Evidence `json:"evidence"`
+	Hash         bytes.HexBytes `json:"hash"`
+	Status       string         `json:"status"`
+	Verified     bool           `json:"verified"`
+	VerifyError  string         `json:"verify_error"`
+	ExpiryHeight int64          `json:"expiry_height"`
+	ExpiryTime   time.Time      `json:"expiry_time"`
+	Peer         string         `json:"peer"`
+}
+
 // empty results.
 type (
 	ResultUnsafeFlushMempool struct{}
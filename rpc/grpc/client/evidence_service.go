@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	evidencesvc "github.com/cometbft/cometbft/api/cometbft/services/evidence/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// EvidenceStatus tells whether evidence is pending or committed.
+type EvidenceStatus int
+
+const (
+	EvidenceStatusUnknown EvidenceStatus = iota
+	EvidenceStatusPending
+	EvidenceStatusCommitted
+)
+
+// EvidenceInfo describes evidence held by the evidence pool, as returned by the
+// CometBFT EvidenceService gRPC API.
+type EvidenceInfo struct {
+	Evidence types.Evidence `json:"evidence"`
+	Status   EvidenceStatus `json:"status"`
+	// Whether pending evidence is still valid against the current state, and
+	// why not.
+	Verified    bool   `json:"verified"`
+	VerifyError string `json:"verify_error"`
+	// The evidence expires once the last block height is greater than
+	// ExpiryHeight and the last block time is after ExpiryTime.
+	ExpiryHeight int64     `json:"expiry_height"`
+	ExpiryTime   time.Time `json:"expiry_time"`
+	// ID of the peer the evidence was received from, if known.
+	Peer string `json:"peer"`
+}
+
+// CommittedEvidence is the evidence committed in the block at a given height.
+type CommittedEvidence struct {
+	Height   int64          `json:"height"`
+	Evidence []EvidenceInfo `json:"evidence"`
+}
+
+func evidenceInfosFromProto(pinfos []*evidencesvc.EvidenceInfo) ([]EvidenceInfo, error) {
+	infos := make([]EvidenceInfo, len(pinfos))
+	for i, pinfo := range pinfos {
+		ev, err := types.EvidenceFromProto(pinfo.Evidence)
+		if err != nil {
+			return nil, err
+		}
+		infos[i] = EvidenceInfo{
+			Evidence:     ev,
+			Verified:     pinfo.Verified,
+			VerifyError:  pinfo.VerifyError,
+			ExpiryHeight: pinfo.ExpiryHeight,
+			ExpiryTime:   pinfo.ExpiryTime,
+			Peer:         pinfo.Peer,
+		}
+		switch pinfo.Status {
+		case evidencesvc.EvidenceStatus_EVIDENCE_STATUS_PENDING:
+			infos[i].Status = EvidenceStatusPending
+		case evidencesvc.EvidenceStatus_EVIDENCE_STATUS_COMMITTED:
+			infos[i].Status = EvidenceStatusCommitted
+		}
+	}
+	return infos, nil
+}
+
+// EvidenceServiceClient provides information about the evidence held by the
+// evidence pool.
+type EvidenceServiceClient interface {
+	// GetPendingEvidence returns the evidence pending in the evidence pool,
+	// from oldest to newest.
+	GetPendingEvidence(ctx context.Context) ([]EvidenceInfo, error)
+
+	// GetCommittedEvidence returns the evidence committed in the block at the
+	// given height. If the height is 0, the latest block is used.
+	GetCommittedEvidence(ctx context.Context, height int64) (*CommittedEvidence, error)
+}
+
+type evidenceServiceClient struct {
+	client evidencesvc.EvidenceServiceClient
+}
+
+func newEvidenceServiceClient(conn grpc.ClientConn) EvidenceServiceClient {
+	return &evidenceServiceClient{
+		client: evidencesvc.NewEvidenceServiceClient(conn),
+	}
+}
+
+// GetPendingEvidence implements EvidenceServiceClient GetPendingEvidence.
+func (c *evidenceServiceClient) GetPendingEvidence(ctx context.Context) ([]EvidenceInfo, error) {
+	res, err := c.client.GetPendingEvidence(ctx, &evidencesvc.GetPendingEvidenceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return evidenceInfosFromProto(res.Evidence)
+}
+
+// GetCommittedEvidence implements EvidenceServiceClient GetCommittedEvidence.
+func (c *evidenceServiceClient) GetCommittedEvidence(ctx context.Context, height int64) (*CommittedEvidence, error) {
+	res, err := c.client.GetCommittedEvidence(ctx, &evidencesvc.GetCommittedEvidenceRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	infos, err := evidenceInfosFromProto(res.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	return &CommittedEvidence{
+		Height:   res.Height,
+		Evidence: infos,
+	}, nil
+}
+
+type disabledEvidenceServiceClient struct{}
+
+func newDisabledEvidenceServiceClient() EvidenceServiceClient {
+	return &disabledEvidenceServiceClient{}
+}
+
+// GetPendingEvidence implements EvidenceServiceClient GetPendingEvidence - disabled client.
+func (*disabledEvidenceServiceClient) GetPendingEvidence(context.Context) ([]EvidenceInfo, error) {
+	panic("evidence service client is disabled")
+}
+
+// GetCommittedEvidence implements EvidenceServiceClient GetCommittedEvidence - disabled client.
+func (*disabledEvidenceServiceClient) GetCommittedEvidence(context.Context, int64) (*CommittedEvidence, error) {
+	panic("evidence service client is disabled")
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+)
+
+// TxEventType tells whether a transaction was added to or removed from the
+// mempool.
+type TxEventType int
+
+const (
+	TxEventUnknown TxEventType = iota
+	TxEventAdded
+	TxEventRemoved
+)
+
+// TxEvent describes a transaction added to or removed from the mempool, as
+// returned by the CometBFT MempoolService gRPC API.
+type TxEvent struct {
+	Type   TxEventType `json:"type"`
+	TxHash []byte      `json:"tx_hash"`
+	Lane   string      `json:"lane"`
+	Size   int64       `json:"size"`
+	// ID of the peer that first sent the added transaction, if any.
+	Sender string `json:"sender"`
+	// Reason why the transaction was removed.
+	RemovalReason string `json:"removal_reason"`
+	// Number of events dropped right before this one, by the server or the
+	// client, because the consumer did not keep up.
+	Dropped uint64 `json:"dropped"`
+}
+
+func txEventFromProto(res *mempoolsvc.GetTxEventsResponse) *TxEvent {
+	ev := &TxEvent{
+		TxHash:        res.TxHash,
+		Lane:          res.Lane,
+		Size:          res.Size_,
+		Sender:        res.Sender,
+		RemovalReason: res.RemovalReason,
+		Dropped:       res.Dropped,
+	}
+	switch res.Type {
+	case mempoolsvc.TxEventType_TX_EVENT_TYPE_ADDED:
+		ev.Type = TxEventAdded
+	case mempoolsvc.TxEventType_TX_EVENT_TYPE_REMOVED:
+		ev.Type = TxEventRemoved
+	}
+	return ev
+}
+
+// TxEventResult type used in GetTxEvents and sent to the client via a
+// channel.
+type TxEventResult struct {
+	Event *TxEvent
+	Error error
+}
+
+type getTxEventsConfig struct {
+	chSize uint
+	lanes  []string
+}
+
+type GetTxEventsOption func(*getTxEventsConfig)
+
+// GetTxEventsChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func GetTxEventsChannelSize(sz uint) GetTxEventsOption {
+	return func(opts *getTxEventsConfig) {
+		opts.chSize = sz
+	}
+}
+
+// GetTxEventsLanes restricts the events to the transactions of the given
+// lanes. If not used, the events of all lanes are returned.
+func GetTxEventsLanes(lanes ...string) GetTxEventsOption {
+	return func(opts *getTxEventsConfig) {
+		opts.lanes = append(opts.lanes, lanes...)
+	}
+}
+
+// BundleTxResult is the result of checking a transaction of a bundle, as
+// returned by the CometBFT MempoolService gRPC API.
+type BundleTxResult struct {
+	TxHash    []byte `json:"tx_hash"`
+	Code      uint32 `json:"code"`
+	Data      []byte `json:"data"`
+	Log       string `json:"log"`
+	Codespace string `json:"codespace"`
+}
+
+// MempoolServiceClient provides information about the transactions in the
+// mempool.
+type MempoolServiceClient interface {
+	// GetTxEvents sends the events about the transactions added to and
+	// removed from the mempool to the resulting output channel.
+	GetTxEvents(ctx context.Context, opts ...GetTxEventsOption) (<-chan TxEventResult, error)
+
+	// BroadcastBundle adds the given transactions to the mempool as a bundle,
+	// included in a block together and in order, or not at all. It returns
+	// the results of checking the transactions, up to the first invalid one,
+	// if any, in which case the bundle is not added.
+	BroadcastBundle(ctx context.Context, txs [][]byte) ([]BundleTxResult, error)
+}
+
+type mempoolServiceClient struct {
+	client mempoolsvc.MempoolServiceClient
+}
+
+func newMempoolServiceClient(conn grpc.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{
+		client: mempoolsvc.NewMempoolServiceClient(conn),
+	}
+}
+
+// GetTxEvents implements MempoolServiceClient GetTxEvents.
+func (c *mempoolServiceClient) GetTxEvents(ctx context.Context, opts ...GetTxEventsOption) (<-chan TxEventResult, error) {
+	cfg := &getTxEventsConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	txEventsClient, err := c.client.GetTxEvents(ctx, &mempoolsvc.GetTxEventsRequest{Lanes: cfg.lanes})
+	if err != nil {
+		return nil, ErrStreamSetup{Source: err}
+	}
+
+	resultCh := make(chan TxEventResult, cfg.chSize)
+
+	go func(client mempoolsvc.MempoolService_GetTxEventsClient) {
+		defer close(resultCh)
+		var dropped uint64
+		for {
+			response, err := client.Recv()
+			if err != nil {
+				res := TxEventResult{Error: ErrStreamReceive{Source: err}}
+				select {
+				case <-ctx.Done():
+				case resultCh <- res:
+				}
+				return
+			}
+			ev := txEventFromProto(response)
+			ev.Dropped += dropped
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- TxEventResult{Event: ev}:
+				dropped = 0
+			default:
+				// Skip sending this event because the channel is full, and
+				// report it in the next event sent.
+				dropped = ev.Dropped + 1
+			}
+		}
+	}(txEventsClient)
+
+	return resultCh, nil
+}
+
+// BroadcastBundle implements MempoolServiceClient BroadcastBundle.
+func (c *mempoolServiceClient) BroadcastBundle(ctx context.Context, txs [][]byte) ([]BundleTxResult, error) {
+	res, err := c.client.BroadcastBundle(ctx, &mempoolsvc.BroadcastBundleRequest{Txs: txs})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]BundleTxResult, len(res.TxResults))
+	for i, r := range res.TxResults {
+		results[i] = BundleTxResult{
+			TxHash:    r.TxHash,
+			Code:      r.Code,
+			Data:      r.Data,
+			Log:       r.Log,
+			Codespace: r.Codespace,
+		}
+	}
+	return results, nil
+}
+
+type disabledMempoolServiceClient struct{}
+
+func newDisabledMempoolServiceClient() MempoolServiceClient {
+	return &disabledMempoolServiceClient{}
+}
+
+// GetTxEvents implements MempoolServiceClient GetTxEvents - disabled client.
+func (*disabledMempoolServiceClient) GetTxEvents(context.Context, ...GetTxEventsOption) (<-chan TxEventResult, error) {
+	panic("mempool service client is disabled")
+}
+
+// BroadcastBundle implements MempoolServiceClient BroadcastBundle - disabled client.
+func (*disabledMempoolServiceClient) BroadcastBundle(context.Context, [][]byte) ([]BundleTxResult, error) {
+	panic("mempool service client is disabled")
+}
@@ -0,0 +1,137 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/rpc/grpc/client"
+	"github.com/cometbft/cometbft/rpc/grpc/server"
+	"github.com/cometbft/cometbft/types"
+)
+
+// startMempoolService serves the mempool service of a new mempool, and returns
+// the mempool and a client connected to the server.
+func startMempoolService(t *testing.T) (*mempl.CListMempool, client.Client) {
+	t.Helper()
+	app := kvstore.NewInMemoryApplication()
+	conn, err := proxy.NewLocalClientCreator(app).NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, conn.Start())
+	t.Cleanup(func() { _ = conn.Stop() })
+	info, err := app.Info(context.Background(), proxy.InfoRequest)
+	require.NoError(t, err)
+	lanesInfo, err := mempl.BuildLanesInfo(info.LanePriorities, info.DefaultLane)
+	require.NoError(t, err)
+	mp := mempl.NewCListMempool(config.TestMempoolConfig(), conn, lanesInfo, 0)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	logger := log.TestingLogger()
+	go func() {
+		_ = server.Serve(listener, server.WithMempoolService(mp, 10, logger), server.WithLogger(logger))
+	}()
+	t.Cleanup(func() { _ = listener.Close() })
+
+	c, err := client.New(context.Background(), listener.Addr().String(),
+		client.WithInsecure(),
+		client.WithVersionServiceEnabled(false),
+		client.WithBlockServiceEnabled(false),
+		client.WithBlockResultsServiceEnabled(false),
+		client.WithMempoolServiceEnabled(true),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+	return mp, c
+}
+
+func TestGetTxEvents(t *testing.T) {
+	mp, c := startMempoolService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := c.GetTxEvents(ctx, client.GetTxEventsLanes("bar"), client.GetTxEventsChannelSize(10))
+	require.NoError(t, err)
+
+	// The server subscribes to the events once the stream is set up, so txs
+	// of lane bar are added until the event of one is received. Txs with IDs
+	// 3+33i go to lane bar, and txs with IDs 1+33i to the default lane.
+	deadline := time.Now().Add(5 * time.Second)
+	for i, received := 0, false; !received; i++ {
+		require.True(t, time.Now().Before(deadline), "no event received")
+		_, err := mp.CheckTx(kvstore.NewTxFromID(3+33*i), "")
+		require.NoError(t, err)
+		select {
+		case res := <-events:
+			require.NoError(t, res.Error)
+			received = true
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// The events of the txs of other lanes are filtered out.
+	other := types.Tx(kvstore.NewTxFromID(1))
+	tx := types.Tx(kvstore.NewTxFromID(3 + 33*1000))
+	for _, tx := range []types.Tx{other, tx} {
+		_, err := mp.CheckTx(tx, "")
+		require.NoError(t, err)
+	}
+	require.NoError(t, mp.RemoveTxByKey(other.Key()))
+	require.NoError(t, mp.RemoveTxByKey(tx.Key()))
+	expected := []*client.TxEvent{
+		{Type: client.TxEventAdded, TxHash: tx.Hash(), Lane: "bar", Size: int64(len(tx))},
+		{
+			Type: client.TxEventRemoved, TxHash: tx.Hash(), Lane: "bar", Size: int64(len(tx)),
+			RemovalReason: string(mempl.TxRemovalReasonRemoved),
+		},
+	}
+	for _, ev := range expected {
+		select {
+		case res := <-events:
+			require.NoError(t, res.Error)
+			assert.Equal(t, ev, res.Event)
+		case <-time.After(5 * time.Second):
+			t.Fatal("event not received")
+		}
+	}
+
+	// The stream of an unknown lane fails.
+	events, err = c.GetTxEvents(ctx, client.GetTxEventsLanes("unknown"))
+	require.NoError(t, err)
+	res := <-events
+	var recvErr client.ErrStreamReceive
+	require.ErrorAs(t, res.Error, &recvErr)
+	assert.Equal(t, codes.InvalidArgument, status.Code(recvErr.Source))
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestBroadcastBundle(t *testing.T) {
+	mp, c := startMempoolService(t)
+	ctx := context.Background()
+
+	txs := [][]byte{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)}
+	results, err := c.BroadcastBundle(ctx, txs)
+	require.NoError(t, err)
+	require.Len(t, results, len(txs))
+	for i, r := range results {
+		assert.Equal(t, []byte(types.Tx(txs[i]).Hash()), r.TxHash)
+		assert.Equal(t, kvstore.CodeTypeOK, r.Code)
+		assert.True(t, mp.Contains(types.Tx(txs[i]).Key()))
+	}
+
+	_, err = c.BroadcastBundle(ctx, nil)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
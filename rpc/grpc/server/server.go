@@ -9,11 +9,17 @@ import (
 
 	pbblocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v2"
 	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v2"
+	pbevidencesvc "github.com/cometbft/cometbft/api/cometbft/services/evidence/v1"
+	pbmempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
 	pbversionsvc "github.com/cometbft/cometbft/api/cometbft/services/version/v1"
+	"github.com/cometbft/cometbft/internal/evidence"
 	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
 	grpcerr "github.com/cometbft/cometbft/rpc/grpc/errors"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/evidenceservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/mempoolservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/versionservice"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
@@ -29,6 +35,8 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	mempoolService      pbmempoolsvc.MempoolServiceServer
+	evidenceService     pbevidencesvc.EvidenceServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -74,6 +82,20 @@ func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logge
 	}
 }
 
+// WithMempoolService enables the mempool service on the CometBFT server.
+func WithMempoolService(mempool *mempl.CListMempool, bufferSize int, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.mempoolService = mempoolservice.New(mempool, bufferSize, logger)
+	}
+}
+
+// WithEvidenceService enables the evidence service on the CometBFT server.
+func WithEvidenceService(evpool *evidence.Pool, bs *store.BlockStore, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.evidenceService = evidenceservice.New(evpool, bs, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -114,6 +136,14 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.mempoolService != nil {
+		pbmempoolsvc.RegisterMempoolServiceServer(server, b.mempoolService)
+		b.logger.Debug("Registered mempool service")
+	}
+	if b.evidenceService != nil {
+		pbevidencesvc.RegisterEvidenceServiceServer(server, b.evidenceService)
+		b.logger.Debug("Registered evidence service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
@@ -0,0 +1,114 @@
+package evidenceservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evidencesvc "github.com/cometbft/cometbft/api/cometbft/services/evidence/v1"
+	"github.com/cometbft/cometbft/internal/evidence"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+type evidenceServiceServer struct {
+	evpool *evidence.Pool
+	store  *store.BlockStore
+	logger log.Logger
+}
+
+// New creates a new CometBFT evidence service server.
+func New(evpool *evidence.Pool, store *store.BlockStore, logger log.Logger) evidencesvc.EvidenceServiceServer {
+	return &evidenceServiceServer{
+		evpool: evpool,
+		store:  store,
+		logger: logger.With("service", "EvidenceService"),
+	}
+}
+
+// GetPendingEvidence implements v1.EvidenceServiceServer GetPendingEvidence method.
+func (s *evidenceServiceServer) GetPendingEvidence(_ context.Context, _ *evidencesvc.GetPendingEvidenceRequest) (*evidencesvc.GetPendingEvidenceResponse, error) {
+	logger := s.logger.With("endpoint", "GetPendingEvidence")
+
+	infos, err := s.evpool.PendingEvidenceInfo()
+	if err != nil {
+		return nil, internalError(logger, "Failed to load pending evidence", err)
+	}
+	res := &evidencesvc.GetPendingEvidenceResponse{}
+	if res.Evidence, err = evidenceInfosToProto(infos); err != nil {
+		return nil, internalError(logger, "Failed to convert evidence to its Protobuf representation", err)
+	}
+	return res, nil
+}
+
+// GetCommittedEvidence implements v1.EvidenceServiceServer GetCommittedEvidence method.
+func (s *evidenceServiceServer) GetCommittedEvidence(_ context.Context, req *evidencesvc.GetCommittedEvidenceRequest) (*evidencesvc.GetCommittedEvidenceResponse, error) {
+	logger := s.logger.With("endpoint", "GetCommittedEvidence")
+
+	height := req.Height
+	latestHeight := s.store.Height()
+	switch {
+	case height == 0:
+		height = latestHeight
+	case height < 0:
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	case height < s.store.Base():
+		return nil, status.Errorf(codes.InvalidArgument, "Requested height %d is below base height %d", height, s.store.Base())
+	case height > latestHeight:
+		return nil, status.Errorf(codes.InvalidArgument, "Requested height %d is higher than latest height %d", height, latestHeight)
+	}
+
+	infos, err := s.evpool.CommittedEvidenceInfo(height)
+	if err != nil {
+		if errors.As(err, &evidence.ErrNoBlockAtHeight{}) {
+			return nil, status.Errorf(codes.NotFound, "Block not found for height %d", height)
+		}
+		return nil, internalError(logger, "Failed to load committed evidence", err)
+	}
+	res := &evidencesvc.GetCommittedEvidenceResponse{Height: height}
+	if res.Evidence, err = evidenceInfosToProto(infos); err != nil {
+		return nil, internalError(logger, "Failed to convert evidence to its Protobuf representation", err)
+	}
+	return res, nil
+}
+
+func internalError(logger log.Logger, msg string, err error) error {
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
+
+func evidenceInfosToProto(infos []evidence.Info) ([]*evidencesvc.EvidenceInfo, error) {
+	res := make([]*evidencesvc.EvidenceInfo, len(infos))
+	for i, info := range infos {
+		evpb, err := types.EvidenceToProto(info.Evidence)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = &evidencesvc.EvidenceInfo{
+			Evidence:     evpb,
+			Verified:     info.VerifyErr == nil,
+			ExpiryHeight: info.ExpiryHeight,
+			ExpiryTime:   info.ExpiryTime,
+			Peer:         string(info.Peer),
+		}
+		if info.VerifyErr != nil {
+			res[i].VerifyError = info.VerifyErr.Error()
+		}
+		switch info.Status {
+		case evidence.StatusPending:
+			res[i].Status = evidencesvc.EvidenceStatus_EVIDENCE_STATUS_PENDING
+		case evidence.StatusCommitted:
+			res[i].Status = evidencesvc.EvidenceStatus_EVIDENCE_STATUS_COMMITTED
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package mempoolservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/types"
+)
+
+type mempoolServiceServer struct {
+	mempool    *mempl.CListMempool
+	bufferSize int
+	logger     log.Logger
+}
+
+// New creates a new CometBFT mempool service server. Up to bufferSize events
+// are buffered for each client of the stream of transactions.
+func New(mempool *mempl.CListMempool, bufferSize int, logger log.Logger) mempoolsvc.MempoolServiceServer {
+	return &mempoolServiceServer{
+		mempool:    mempool,
+		bufferSize: bufferSize,
+		logger:     logger.With("service", "MempoolService"),
+	}
+}
+
+// GetTxEvents implements v1.MempoolServiceServer GetTxEvents method.
+func (s *mempoolServiceServer) GetTxEvents(req *mempoolsvc.GetTxEventsRequest, stream mempoolsvc.MempoolService_GetTxEventsServer) error {
+	logger := s.logger.With("endpoint", "GetTxEvents")
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	lanes := make([]mempl.LaneID, len(req.Lanes))
+	for i, lane := range req.Lanes {
+		lanes[i] = mempl.LaneID(lane)
+	}
+	sub, err := s.mempool.SubscribeTxEvents(lanes, s.bufferSize)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Cannot subscribe to tx events: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev, ok := <-sub.Out():
+			if !ok {
+				return status.Error(codes.Canceled, "Subscription terminated")
+			}
+			if err := stream.Send(txEventToProto(ev)); err != nil {
+				logger.Error("Failed to stream tx event", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream canceled by the client")
+		}
+	}
+}
+
+// BroadcastBundle implements v1.MempoolServiceServer BroadcastBundle method.
+func (s *mempoolServiceServer) BroadcastBundle(_ context.Context, req *mempoolsvc.BroadcastBundleRequest) (*mempoolsvc.BroadcastBundleResponse, error) {
+	logger := s.logger.With("endpoint", "BroadcastBundle")
+
+	txs := make(types.Txs, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = tx
+	}
+	responses, err := s.mempool.CheckBundle(txs)
+	switch {
+	case err == nil, errors.As(err, &mempl.ErrInvalidTx{}):
+		// An invalid tx is reported by its result.
+	case errors.Is(err, mempl.ErrTxInCache), errors.Is(err, mempl.ErrTxInMempool):
+		return nil, status.Errorf(codes.AlreadyExists, "Invalid bundle: %v", err)
+	case errors.Is(err, mempl.ErrEmptyBundle),
+		errors.As(err, &mempl.ErrInvalidBundleTx{}),
+		errors.As(err, &mempl.ErrLaneNotFound{}):
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid bundle: %v", err)
+	case errors.Is(err, mempl.ErrRecheckFull),
+		errors.As(err, &mempl.ErrMempoolIsFull{}),
+		errors.As(err, &mempl.ErrLaneIsFull{}):
+		return nil, status.Errorf(codes.ResourceExhausted, "Cannot add bundle: %v", err)
+	case errors.Is(err, mempl.ErrBundleCheckInterrupted):
+		return nil, status.Errorf(codes.Aborted, "Cannot add bundle: %v", err)
+	default:
+		traceID, traceErr := rpctrace.New()
+		if traceErr != nil {
+			logger.Error("Error generating RPC trace ID", "err", traceErr)
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		logger.Error("Failed to check bundle", "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to check bundle (see logs for trace ID: %s)", traceID)
+	}
+
+	res := &mempoolsvc.BroadcastBundleResponse{
+		TxResults: make([]*mempoolsvc.BundleTxResult, len(responses)),
+	}
+	for i, r := range responses {
+		res.TxResults[i] = &mempoolsvc.BundleTxResult{
+			TxHash:    txs[i].Hash(),
+			Code:      r.Code,
+			Data:      r.Data,
+			Log:       r.Log,
+			Codespace: r.Codespace,
+		}
+	}
+	return res, nil
+}
+
+func txEventToProto(ev mempl.TxEvent) *mempoolsvc.GetTxEventsResponse {
+	res := &mempoolsvc.GetTxEventsResponse{
+		TxHash:        ev.TxKey.Hash(),
+		Lane:          string(ev.Lane),
+		Size_:         int64(ev.Size),
+		Sender:        string(ev.Sender),
+		RemovalReason: string(ev.Reason),
+		Dropped:       ev.Dropped,
+	}
+	switch ev.Type {
+	case mempl.TxEventAdded:
+		res.Type = mempoolsvc.TxEventType_TX_EVENT_TYPE_ADDED
+	case mempl.TxEventRemoved:
+		res.Type = mempoolsvc.TxEventType_TX_EVENT_TYPE_REMOVED
+	}
+	return res
+}
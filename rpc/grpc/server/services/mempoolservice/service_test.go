@@ -0,0 +1,78 @@
+package mempoolservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/mempoolservice"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newMempool(t *testing.T) *mempl.CListMempool {
+	t.Helper()
+	app := kvstore.NewInMemoryApplication()
+	conn, err := proxy.NewLocalClientCreator(app).NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, conn.Start())
+	t.Cleanup(func() { _ = conn.Stop() })
+
+	info, err := app.Info(context.Background(), proxy.InfoRequest)
+	require.NoError(t, err)
+	lanesInfo, err := mempl.BuildLanesInfo(info.LanePriorities, info.DefaultLane)
+	require.NoError(t, err)
+	return mempl.NewCListMempool(config.TestMempoolConfig(), conn, lanesInfo, 0)
+}
+
+func TestBroadcastBundle(t *testing.T) {
+	mp := newMempool(t)
+	s := mempoolservice.New(mp, 10, log.TestingLogger())
+	ctx := context.Background()
+
+	txs := [][]byte{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)}
+	res, err := s.BroadcastBundle(ctx, &mempoolsvc.BroadcastBundleRequest{Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, len(txs))
+	for i, r := range res.TxResults {
+		assert.Equal(t, []byte(types.Tx(txs[i]).Hash()), r.TxHash)
+		assert.Equal(t, kvstore.CodeTypeOK, r.Code)
+		assert.True(t, mp.Contains(types.Tx(txs[i]).Key()))
+	}
+
+	// An invalid tx is reported by its result, and the bundle is not added.
+	invalid := [][]byte{kvstore.NewTxFromID(3), []byte("invalid")}
+	res, err = s.BroadcastBundle(ctx, &mempoolsvc.BroadcastBundleRequest{Txs: invalid})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+	assert.Equal(t, kvstore.CodeTypeOK, res.TxResults[0].Code)
+	assert.Equal(t, kvstore.CodeTypeInvalidTxFormat, res.TxResults[1].Code)
+	assert.False(t, mp.Contains(types.Tx(invalid[0]).Key()))
+	assert.Equal(t, len(txs), mp.Size())
+
+	testCases := []struct {
+		name string
+		txs  [][]byte
+		code codes.Code
+	}{
+		{"empty", nil, codes.InvalidArgument},
+		{"duplicate tx", [][]byte{kvstore.NewTxFromID(4), kvstore.NewTxFromID(4)}, codes.InvalidArgument},
+		{"tx in mempool", [][]byte{kvstore.NewTxFromID(5), txs[0]}, codes.AlreadyExists},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.BroadcastBundle(ctx, &mempoolsvc.BroadcastBundleRequest{Txs: tc.txs})
+			require.Error(t, err)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
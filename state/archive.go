@@ -0,0 +1,360 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ArchiveVersion is the version of the block archive format written by
+// ExportBlocks. ImportBlocks rejects archives of any other version.
+const ArchiveVersion = 1
+
+// maxArchiveEntrySize is the maximum size of a single archive entry, which
+// holds a block, up to two commits, the results of the block and a validator
+// set.
+const maxArchiveEntrySize = 4 * types.MaxBlockSizeBytes
+
+// ExportBlocks writes the blocks at heights from to to (inclusive) as a block
+// archive: a stream of length-delimited protobuf messages made of an
+// [cmtstore.ArchiveHeader] followed by one [cmtstore.ArchiveEntry] per height.
+// Each entry holds the block, its seen and extended commits, its results (if
+// still available) and the validator set which signed it.
+//
+// onHeight, if not nil, is called after each height has been written.
+func ExportBlocks(
+	w io.Writer,
+	chainID string,
+	blockStore BlockStore,
+	stateStore Store,
+	from, to int64,
+	onHeight func(height int64),
+) error {
+	// Read everything from the same snapshot, so that blocks pruned or results
+	// discarded while exporting cannot leave holes in the archive.
+	bs, err := blockStore.NewSnapshot()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	ss, err := stateStore.NewSnapshot()
+	if err != nil {
+		return err
+	}
+	defer ss.Close()
+
+	if from > to {
+		return fmt.Errorf("invalid height range: from %d is greater than to %d", from, to)
+	}
+	if from < bs.Base() || to > bs.Height() {
+		return fmt.Errorf("height range [%d, %d] is not available, block store has [%d, %d]",
+			from, to, bs.Base(), bs.Height())
+	}
+
+	pw := protoio.NewDelimitedWriter(w)
+	header := &cmtstore.ArchiveHeader{
+		Version:    ArchiveVersion,
+		ChainId:    chainID,
+		FromHeight: from,
+		ToHeight:   to,
+	}
+	if _, err := pw.WriteMsg(header); err != nil {
+		return fmt.Errorf("writing archive header: %w", err)
+	}
+
+	for height := from; height <= to; height++ {
+		entry, err := newArchiveEntry(bs, ss, height)
+		if err != nil {
+			return fmt.Errorf("exporting height %d: %w", height, err)
+		}
+		if _, err := pw.WriteMsg(entry); err != nil {
+			return fmt.Errorf("writing height %d: %w", height, err)
+		}
+		if onHeight != nil {
+			onHeight(height)
+		}
+	}
+
+	return nil
+}
+
+func newArchiveEntry(bs BlockStoreSnapshot, ss StoreSnapshot, height int64) (*cmtstore.ArchiveEntry, error) {
+	block, _ := bs.LoadBlock(height)
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	pbb, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	seenCommit := bs.LoadSeenCommit(height)
+	if seenCommit == nil {
+		// The seen commit may have been lost, e.g., after a state sync. The
+		// canonical commit is just as good to prove the block.
+		seenCommit = bs.LoadBlockCommit(height)
+	}
+	if seenCommit == nil {
+		return nil, errors.New("commit not found")
+	}
+
+	vals, err := ss.LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("loading validators: %w", err)
+	}
+	pbvs, err := vals.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ss.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		var errNoResponses ErrNoABCIResponsesForHeight
+		if !errors.As(err, &errNoResponses) && !errors.Is(err, ErrFinalizeBlockResponsesNotPersisted) {
+			return nil, fmt.Errorf("loading results: %w", err)
+		}
+		resp = nil
+	}
+
+	return &cmtstore.ArchiveEntry{
+		Block:                 pbb,
+		SeenCommit:            seenCommit.ToProto(),
+		ExtendedCommit:        bs.LoadBlockExtendedCommit(height).ToProto(),
+		FinalizeBlockResponse: resp,
+		Validators:            pbvs,
+	}, nil
+}
+
+// ImportBlocks reads a block archive written by ExportBlocks and saves its
+// blocks, commits and results, advancing the state in stateStore past every
+// imported block. It returns the range of heights it imported.
+//
+// The archive must continue the chain in stateStore: it must start right above
+// the last block of the state, or at the initial height of the chain if
+// stateStore holds no state yet, in which case the state is built from genDoc.
+// The block store must either be empty or end at the last block of the state.
+//
+// Every block is validated against the state before being saved, as if it was
+// received from a peer: it must extend the previous block, be signed by +2/3 of
+// the validator set of its height and match the results of the previous block.
+// The results of every block are required, as they are needed to update the
+// state. The results of the last block of the archive are saved unverified: the
+// application checks them when it executes the block.
+//
+// The application itself does not execute the imported blocks: the node
+// replays them on the application when it starts, during the ABCI handshake.
+//
+// onHeight, if not nil, is called after each height has been saved.
+func ImportBlocks(
+	r io.Reader,
+	genDoc *types.GenesisDoc,
+	blockStore BlockStore,
+	stateStore Store,
+	onHeight func(height int64),
+) (from, to int64, err error) {
+	pr := protoio.NewDelimitedReader(r, maxArchiveEntrySize)
+
+	header := new(cmtstore.ArchiveHeader)
+	if _, err := pr.ReadMsg(header); err != nil {
+		return 0, 0, fmt.Errorf("reading archive header: %w", err)
+	}
+	if header.Version != ArchiveVersion {
+		return 0, 0, fmt.Errorf("unsupported archive version %d, expected %d", header.Version, ArchiveVersion)
+	}
+	if header.ChainId != genDoc.ChainID {
+		return 0, 0, fmt.Errorf("archive is for chain %q, expected %q", header.ChainId, genDoc.ChainID)
+	}
+	if header.FromHeight > header.ToHeight {
+		return 0, 0, fmt.Errorf("invalid archive height range [%d, %d]", header.FromHeight, header.ToHeight)
+	}
+
+	imp := &archiveImporter{
+		blockStore: blockStore,
+		stateStore: stateStore,
+	}
+	if err := imp.init(header.FromHeight, genDoc); err != nil {
+		return 0, 0, err
+	}
+
+	for height := header.FromHeight; height <= header.ToHeight; height++ {
+		entry := new(cmtstore.ArchiveEntry)
+		if _, err := pr.ReadMsg(entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return header.FromHeight, height - 1, fmt.Errorf("reading height %d: %w", height, err)
+		}
+		if err := imp.importEntry(height, entry); err != nil {
+			return header.FromHeight, height - 1, fmt.Errorf("importing height %d: %w", height, err)
+		}
+		if onHeight != nil {
+			onHeight(height)
+		}
+	}
+
+	return header.FromHeight, header.ToHeight, nil
+}
+
+// archiveImporter verifies and saves the entries of a block archive, one
+// height at a time.
+type archiveImporter struct {
+	blockStore BlockStore
+	stateStore Store
+
+	// state is the state after the last block of the chain, either already in
+	// the stores or just imported.
+	state State
+}
+
+func (imp *archiveImporter) init(from int64, genDoc *types.GenesisDoc) error {
+	state, err := imp.stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("loading state: %w", err)
+	}
+	if state.IsEmpty() {
+		if state, err = MakeGenesisState(genDoc); err != nil {
+			return err
+		}
+		// Save the validators of the first heights, as the node does before
+		// executing the first block.
+		if err := imp.stateStore.Save(state); err != nil {
+			return fmt.Errorf("saving genesis state: %w", err)
+		}
+	}
+
+	nextHeight := state.LastBlockHeight + 1
+	if state.LastBlockHeight == 0 {
+		nextHeight = state.InitialHeight
+	}
+	if from != nextHeight {
+		return fmt.Errorf("archive starts at height %d, but the next height of the state is %d", from, nextHeight)
+	}
+	if height := imp.blockStore.Height(); height != 0 && height != state.LastBlockHeight {
+		return fmt.Errorf("block store is at height %d, but the state is at height %d; "+
+			"start the node once to bring them in sync", height, state.LastBlockHeight)
+	}
+
+	imp.state = state
+	return nil
+}
+
+func (imp *archiveImporter) importEntry(height int64, entry *cmtstore.ArchiveEntry) error {
+	block, err := types.BlockFromProto(entry.Block)
+	if err != nil {
+		return fmt.Errorf("invalid block: %w", err)
+	}
+	seenCommit, err := types.CommitFromProto(entry.SeenCommit)
+	if err != nil {
+		return fmt.Errorf("invalid seen commit: %w", err)
+	}
+	var extCommit *types.ExtendedCommit
+	if entry.ExtendedCommit != nil {
+		if extCommit, err = types.ExtendedCommitFromProto(entry.ExtendedCommit); err != nil {
+			return fmt.Errorf("invalid extended commit: %w", err)
+		}
+		if err := extCommit.EnsureExtensions(true); err != nil {
+			return fmt.Errorf("invalid extended commit: %w", err)
+		}
+	}
+	vals, err := types.ValidatorSetFromProto(entry.Validators)
+	if err != nil {
+		return fmt.Errorf("invalid validator set: %w", err)
+	}
+	results := entry.FinalizeBlockResponse
+	if results == nil {
+		return errors.New("the results of the block are missing from the archive, but they are needed to update the state")
+	}
+
+	// The blocks are split as they were when committed.
+	blockParts, err := block.MakePartSetAs(seenCommit.BlockID.PartSetHeader, types.BlockPartSizeBytes)
+	if err != nil {
+		return err
+	}
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
+
+	if err := imp.verify(height, block, blockID, vals, seenCommit, extCommit); err != nil {
+		return err
+	}
+	if len(block.Data.Txs) != len(results.TxResults) {
+		return fmt.Errorf("the block has %d transactions, but its results have %d", len(block.Data.Txs), len(results.TxResults))
+	}
+
+	// Save the block, its results and the new state in the same order as
+	// when executing the block, so that the handshake can recover from a
+	// crash in between.
+	if extCommit != nil {
+		imp.blockStore.SaveBlockWithExtendedCommit(block, blockParts, extCommit)
+	} else {
+		imp.blockStore.SaveBlock(block, blockParts, seenCommit)
+	}
+	if err := imp.stateStore.SaveFinalizeBlockResponse(height, results); err != nil {
+		return fmt.Errorf("saving results: %w", err)
+	}
+
+	if err := validateValidatorUpdates(results.ValidatorUpdates, imp.state.ConsensusParams.Validator); err != nil {
+		return fmt.Errorf("invalid validator updates: %w", err)
+	}
+	validatorUpdates, err := types.PB2TM.ValidatorUpdates(results.ValidatorUpdates)
+	if err != nil {
+		return err
+	}
+	state, err := updateState(imp.state, blockID, &block.Header, results, validatorUpdates)
+	if err != nil {
+		return fmt.Errorf("updating state: %w", err)
+	}
+	state.AppHash = results.AppHash
+	if err := imp.stateStore.Save(state); err != nil {
+		return fmt.Errorf("saving state: %w", err)
+	}
+	imp.state = state
+
+	return nil
+}
+
+// verify checks that block is the valid next block of the chain.
+func (imp *archiveImporter) verify(
+	height int64,
+	block *types.Block,
+	blockID types.BlockID,
+	vals *types.ValidatorSet,
+	seenCommit *types.Commit,
+	extCommit *types.ExtendedCommit,
+) error {
+	state := imp.state
+	if block.Height != height {
+		return fmt.Errorf("expected block at height %d, got %d", height, block.Height)
+	}
+	if height == state.InitialHeight {
+		// The state does not know the app hash returned by InitChain, but the
+		// block does, and the commit verified below authenticates it.
+		state.AppHash = block.AppHash
+	}
+
+	// The results of the previous block are checked against the
+	// LastResultsHash and AppHash of this one, whether the previous block has
+	// just been imported or was already in the store.
+	if err := validateBlock(state, block); err != nil {
+		return fmt.Errorf("invalid block: %w", err)
+	}
+	if !bytes.Equal(vals.Hash(), state.Validators.Hash()) {
+		return fmt.Errorf("validator set hash %X does not match the expected %X", vals.Hash(), state.Validators.Hash())
+	}
+
+	commit := seenCommit
+	if extCommit != nil {
+		commit = extCommit.ToCommit()
+	}
+	if !commit.BlockID.Equals(blockID) {
+		return fmt.Errorf("commit is for block %v, expected %v", commit.BlockID, blockID)
+	}
+	if err := state.Validators.VerifyCommit(state.ChainID, blockID, height, commit); err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+
+	return nil
+}
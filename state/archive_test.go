@@ -0,0 +1,271 @@
+package state_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	cmtdb "github.com/cometbft/cometbft/db"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/protoio"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// makeArchiveTestChain executes numBlocks blocks, saving them and their
+// commits, and returns the stores holding them and the matching genesis.
+func makeArchiveTestChain(t *testing.T, numBlocks int64, opts ...store.BlockStoreOption) (*types.GenesisDoc, *store.BlockStore, sm.Store) {
+	t.Helper()
+
+	// The results of the blocks are only loaded back with an app hash.
+	app := &testApp{AppHash: []byte("app_hash")}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+
+	state, stateDB, privVals := makeState(3, 1, chainID)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	blockStoreDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	blockStore := store.NewBlockStore(blockStoreDB, opts...)
+
+	genDoc := &types.GenesisDoc{
+		ChainID:         chainID,
+		GenesisTime:     state.LastBlockTime,
+		InitialHeight:   1,
+		ConsensusParams: test.ConsensusParams(),
+	}
+	for _, val := range state.Validators.Validators {
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower})
+	}
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		&mempool.NopMempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+	lastCommit := new(types.Commit)
+	for height := int64(1); height <= numBlocks; height++ {
+		block := state.MakeBlock(height, test.MakeNTxs(height, 10), lastCommit, nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		extCommit, err := makeValidCommit(height, blockID, state.Validators, privVals)
+		require.NoError(t, err)
+		blockStore.SaveBlockWithExtendedCommit(block, partSet, extCommit)
+
+		state, err = blockExec.ApplyBlock(state, blockID, block, height)
+		require.NoError(t, err)
+		lastCommit = extCommit.ToCommit()
+	}
+
+	return genDoc, blockStore, stateStore
+}
+
+func newEmptyArchiveTestStores(t *testing.T) (*store.BlockStore, sm.Store) {
+	t.Helper()
+
+	blockStoreDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	stateDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	return store.NewBlockStore(blockStoreDB), sm.NewStore(stateDB, sm.StoreOptions{})
+}
+
+func TestExportImportBlocks(t *testing.T) {
+	genDoc, srcBlockStore, srcStateStore := makeArchiveTestChain(t, 5)
+
+	var archive bytes.Buffer
+	var exported []int64
+	err := sm.ExportBlocks(&archive, chainID, srcBlockStore, srcStateStore, 1, 5,
+		func(h int64) { exported = append(exported, h) })
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, exported)
+
+	blockStore, stateStore := newEmptyArchiveTestStores(t)
+	from, to, err := sm.ImportBlocks(bytes.NewReader(archive.Bytes()), genDoc, blockStore, stateStore, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, from)
+	assert.EqualValues(t, 5, to)
+
+	assert.EqualValues(t, 1, blockStore.Base())
+	assert.EqualValues(t, 5, blockStore.Height())
+	for h := int64(1); h <= 5; h++ {
+		expected := srcBlockStore.LoadBlockMeta(h)
+		meta := blockStore.LoadBlockMeta(h)
+		require.NotNil(t, meta)
+		assert.Equal(t, expected.BlockID, meta.BlockID)
+		assert.NotNil(t, blockStore.LoadBlockExtendedCommit(h))
+		assert.NotNil(t, blockStore.LoadSeenCommit(h))
+
+		expectedVals, err := srcStateStore.LoadValidators(h)
+		require.NoError(t, err)
+		vals, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+		assert.Equal(t, expectedVals.Hash(), vals.Hash())
+
+		expectedResp, err := srcStateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		resp, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		assert.Equal(t, sm.TxResultsHash(expectedResp.TxResults), sm.TxResultsHash(resp.TxResults))
+	}
+
+	// The state follows the imported blocks, so the node can replay them on
+	// the application when it starts.
+	expectedState, err := srcStateStore.Load()
+	require.NoError(t, err)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, state.LastBlockHeight)
+	assert.Equal(t, expectedState.LastBlockID, state.LastBlockID)
+	assert.Equal(t, expectedState.AppHash, state.AppHash)
+	assert.Equal(t, expectedState.LastResultsHash, state.LastResultsHash)
+	assert.Equal(t, expectedState.NextValidators.Hash(), state.NextValidators.Hash())
+
+	// A second archive continues the chain.
+	genDoc, srcBlockStore, srcStateStore = makeArchiveTestChain(t, 8)
+	blockStore, stateStore = newEmptyArchiveTestStores(t)
+	for _, r := range [][2]int64{{1, 4}, {5, 8}} {
+		archive.Reset()
+		require.NoError(t, sm.ExportBlocks(&archive, chainID, srcBlockStore, srcStateStore, r[0], r[1], nil))
+		_, _, err := sm.ImportBlocks(&archive, genDoc, blockStore, stateStore, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 8, blockStore.Height())
+
+	// But an archive cannot leave a gap.
+	archive.Reset()
+	require.NoError(t, sm.ExportBlocks(&archive, chainID, srcBlockStore, srcStateStore, 3, 8, nil))
+	blockStore, stateStore = newEmptyArchiveTestStores(t)
+	_, _, err = sm.ImportBlocks(&archive, genDoc, blockStore, stateStore, nil)
+	require.ErrorContains(t, err, "archive starts at height 3, but the next height of the state is 1")
+}
+
+func TestImportBlocksVerifiesStoredResults(t *testing.T) {
+	genDoc, srcBlockStore, srcStateStore := makeArchiveTestChain(t, 8)
+
+	// The results of the last block of an archive are saved unverified...
+	var archive bytes.Buffer
+	require.NoError(t, sm.ExportBlocks(&archive, chainID, srcBlockStore, srcStateStore, 1, 4, nil))
+	header, entries := readArchive(t, &archive, 4)
+	entries[3].FinalizeBlockResponse.TxResults[0].Code = 1
+	blockStore, stateStore := newEmptyArchiveTestStores(t)
+	_, _, err := sm.ImportBlocks(writeArchive(t, header, entries), genDoc, blockStore, stateStore, nil)
+	require.NoError(t, err)
+
+	// ...but they are checked against the first block of the next archive.
+	archive.Reset()
+	require.NoError(t, sm.ExportBlocks(&archive, chainID, srcBlockStore, srcStateStore, 5, 8, nil))
+	_, _, err = sm.ImportBlocks(&archive, genDoc, blockStore, stateStore, nil)
+	require.ErrorContains(t, err, "LastResultsHash")
+	assert.EqualValues(t, 4, blockStore.Height())
+}
+
+func TestImportBlocksRejectsInvalidArchive(t *testing.T) {
+	genDoc, srcBlockStore, srcStateStore := makeArchiveTestChain(t, 3)
+
+	var archive bytes.Buffer
+	require.NoError(t, sm.ExportBlocks(&archive, chainID, srcBlockStore, srcStateStore, 1, 3, nil))
+
+	testCases := []struct {
+		name   string
+		tamper func(header *cmtstore.ArchiveHeader, entries []*cmtstore.ArchiveEntry)
+		errMsg string
+	}{
+		{
+			"unsupported version",
+			func(header *cmtstore.ArchiveHeader, _ []*cmtstore.ArchiveEntry) { header.Version = 2 },
+			"unsupported archive version",
+		},
+		{
+			"other chain",
+			func(header *cmtstore.ArchiveHeader, _ []*cmtstore.ArchiveEntry) { header.ChainId = "other" },
+			"archive is for chain",
+		},
+		{
+			"tampered transactions",
+			func(_ *cmtstore.ArchiveHeader, entries []*cmtstore.ArchiveEntry) {
+				entries[1].Block.Data.Txs[0] = []byte("tampered")
+			},
+			"importing height 2",
+		},
+		{
+			"other validator set",
+			func(_ *cmtstore.ArchiveHeader, entries []*cmtstore.ArchiveEntry) {
+				vals, err := genValSet(3).ToProto()
+				require.NoError(t, err)
+				entries[2].Validators = vals
+			},
+			"validator set hash",
+		},
+		{
+			"tampered results",
+			func(_ *cmtstore.ArchiveHeader, entries []*cmtstore.ArchiveEntry) {
+				entries[0].FinalizeBlockResponse.TxResults[0].Code = 1
+			},
+			"LastResultsHash",
+		},
+		{
+			"missing results",
+			func(_ *cmtstore.ArchiveHeader, entries []*cmtstore.ArchiveEntry) {
+				entries[1].FinalizeBlockResponse = nil
+			},
+			"results of the block are missing",
+		},
+		{
+			"truncated",
+			func(header *cmtstore.ArchiveHeader, _ []*cmtstore.ArchiveEntry) { header.ToHeight = 4 },
+			"unexpected EOF",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header, entries := readArchive(t, bytes.NewReader(archive.Bytes()), 3)
+			tc.tamper(header, entries)
+
+			blockStore, stateStore := newEmptyArchiveTestStores(t)
+			_, _, err := sm.ImportBlocks(writeArchive(t, header, entries), genDoc, blockStore, stateStore, nil)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+// readArchive reads the header and the first n entries of a block archive.
+func readArchive(t *testing.T, r io.Reader, n int) (*cmtstore.ArchiveHeader, []*cmtstore.ArchiveEntry) {
+	t.Helper()
+
+	pr := protoio.NewDelimitedReader(r, types.MaxBlockSizeBytes)
+	header := new(cmtstore.ArchiveHeader)
+	_, err := pr.ReadMsg(header)
+	require.NoError(t, err)
+	entries := make([]*cmtstore.ArchiveEntry, n)
+	for i := range entries {
+		entries[i] = new(cmtstore.ArchiveEntry)
+		_, err := pr.ReadMsg(entries[i])
+		require.NoError(t, err)
+	}
+	return header, entries
+}
+
+// writeArchive writes a block archive made of the given header and entries.
+func writeArchive(t *testing.T, header *cmtstore.ArchiveHeader, entries []*cmtstore.ArchiveEntry) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	pw := protoio.NewDelimitedWriter(&buf)
+	_, err := pw.WriteMsg(header)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		_, err := pw.WriteMsg(entry)
+		require.NoError(t, err)
+	}
+	return &buf
+}
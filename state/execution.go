@@ -9,8 +9,10 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/internal/fail"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
@@ -46,6 +48,15 @@ type BlockExecutor struct {
 	// 1-element cache of validated blocks
 	lastValidatedBlock *types.Block
 
+	// the time of the proposed blocks, with PBTS
+	clock cmttime.Source
+
+	// connection on which blocks are executed before they are decided, and
+	// the execution in progress, if any
+	speculativeApp proxy.AppConnConsensus
+	optimisticMtx  cmtsync.Mutex
+	optimisticExec *optimisticExecution
+
 	logger log.Logger
 
 	metrics *Metrics
@@ -65,6 +76,22 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithClock sets the source of the time of the proposed blocks
+// when PBTS is enabled. It defaults to the system clock.
+func BlockExecutorWithClock(clock cmttime.Source) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.clock = clock
+	}
+}
+
+// BlockExecutorWithSpeculativeConn sets the connection to the app on which
+// ExecuteOptimistically executes blocks.
+func BlockExecutorWithSpeculativeConn(conn proxy.AppConnConsensus) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.speculativeApp = conn
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -85,6 +112,7 @@ func NewBlockExecutor(
 		logger:     logger,
 		metrics:    NopMetrics(),
 		blockStore: blockStore,
+		clock:      cmttime.DefaultSource{},
 	}
 
 	for _, option := range options {
@@ -104,7 +132,7 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 	blockExec.eventBus = eventBus
 }
 
-// CreateProposalBlock calls state.MakeBlock with evidence from the evpool
+// CreateProposalBlock calls state.MakeBlockWithClock with evidence from the evpool
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // The block space is first allocated to outstanding evidence.
 // The rest is given to txs, up to the max gas.
@@ -136,7 +164,16 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxReapBytes, maxGas)
 	commit := lastExtCommit.ToCommit()
-	block := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	// The signatures of validator sets with only BLS12-381 keys are aggregated
+	// to make the last commit of the block smaller and faster to verify.
+	if commit.Size() > 0 && state.LastValidators.AllKeysHaveType(bls12381.KeyType) {
+		aggregated, err := commit.Aggregate(state.LastValidators)
+		if err != nil {
+			return nil, err
+		}
+		commit = aggregated
+	}
+	block := state.MakeBlockWithClock(height, txs, commit, evidence, proposerAddr, blockExec.clock)
 	rpp, err := blockExec.proxyApp.PrepareProposal(
 		ctx,
 		&abci.PrepareProposalRequest{
@@ -167,7 +204,7 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 		return nil, err
 	}
 
-	return state.MakeBlock(height, txl, commit, evidence, proposerAddr), nil
+	return state.MakeBlockWithClock(height, txl, commit, evidence, proposerAddr, blockExec.clock), nil
 }
 
 func (blockExec *BlockExecutor) ProcessProposal(
@@ -234,17 +271,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 }
 
 func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block, syncingToHeight int64) (State, error) {
-	abciResponse, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), &abci.FinalizeBlockRequest{
-		Hash:               block.Hash(),
-		NextValidatorsHash: block.NextValidatorsHash,
-		ProposerAddress:    block.ProposerAddress,
-		Height:             block.Height,
-		Time:               block.Time,
-		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
-		Misbehavior:        block.Evidence.Evidence.ToABCI(),
-		Txs:                block.Txs.ToSliceOfBytes(),
-		SyncingToHeight:    syncingToHeight,
-	})
+	abciResponse, err := blockExec.finalizeBlock(state, block, syncingToHeight)
 	if err != nil {
 		blockExec.logger.Error("Error in proxyAppConn.FinalizeBlock", "err", err)
 		return state, err
@@ -336,6 +363,111 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	return state, nil
 }
 
+func (blockExec *BlockExecutor) finalizeBlockRequest(
+	state State, block *types.Block, syncingToHeight int64,
+) *abci.FinalizeBlockRequest {
+	return &abci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		SyncingToHeight:    syncingToHeight,
+	}
+}
+
+// finalizeBlock executes block against the app. If block was executed
+// optimistically, it first waits for that execution to return, so that the app
+// can reuse its result. Any other optimistic execution is aborted.
+func (blockExec *BlockExecutor) finalizeBlock(
+	state State, block *types.Block, syncingToHeight int64,
+) (*abci.FinalizeBlockResponse, error) {
+	blockExec.optimisticMtx.Lock()
+	oe := blockExec.optimisticExec
+	blockExec.optimisticExec = nil
+	blockExec.optimisticMtx.Unlock()
+
+	if oe != nil {
+		if oe.matches(block, syncingToHeight) {
+			<-oe.done
+			if oe.err != nil {
+				blockExec.logger.Error("Optimistic execution of block failed", "height", block.Height, "err", oe.err)
+				blockExec.metrics.OptimisticExecutions.With("status", "failed").Add(1)
+			} else {
+				blockExec.metrics.OptimisticExecutions.With("status", "committed").Add(1)
+			}
+		} else {
+			oe.cancel()
+			blockExec.metrics.OptimisticExecutions.With("status", "aborted").Add(1)
+		}
+	}
+
+	return blockExec.proxyApp.FinalizeBlock(context.TODO(), blockExec.finalizeBlockRequest(state, block, syncingToHeight))
+}
+
+// ExecuteOptimistically starts executing block, which is valid, with a
+// FinalizeBlock call on the speculative connection to the app, before the
+// block is decided, so that its execution overlaps with the voting on it. The
+// block is still executed on the consensus connection once decided, and the
+// app may then return the result of this execution. The execution is aborted
+// by the next call to ExecuteOptimistically, or to ApplyBlock with another
+// block. It does nothing if there is no speculative connection (see
+// BlockExecutorWithSpeculativeConn).
+//
+// Aborting an execution cancels its context without waiting for it to return:
+// the socket client cannot interrupt a request already sent, so the app may
+// still be executing the block. The next execution is then queued behind it
+// on the speculative connection, and the consensus connection is not delayed.
+func (blockExec *BlockExecutor) ExecuteOptimistically(state State, block *types.Block) {
+	if blockExec.speculativeApp == nil {
+		return
+	}
+
+	blockExec.optimisticMtx.Lock()
+	defer blockExec.optimisticMtx.Unlock()
+
+	if oe := blockExec.optimisticExec; oe != nil {
+		if oe.matches(block, block.Height) {
+			return
+		}
+		oe.cancel()
+		blockExec.metrics.OptimisticExecutions.With("status", "aborted").Add(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	oe := &optimisticExecution{
+		req:    blockExec.finalizeBlockRequest(state, block, block.Height),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	blockExec.optimisticExec = oe
+	blockExec.logger.Debug("Executing block optimistically", "height", block.Height, "hash", log.NewLazyHash(block))
+
+	go func() {
+		defer close(oe.done)
+		_, oe.err = blockExec.speculativeApp.FinalizeBlock(ctx, oe.req)
+	}()
+}
+
+// optimisticExecution is the execution of a block started before the block is
+// decided.
+type optimisticExecution struct {
+	req    *abci.FinalizeBlockRequest
+	cancel context.CancelFunc
+
+	done chan struct{} // closed when err is set
+	err  error
+}
+
+// matches returns true if the optimistic execution is the execution of block
+// that FinalizeBlock would perform.
+func (oe *optimisticExecution) matches(block *types.Block, syncingToHeight int64) bool {
+	return bytes.Equal(oe.req.Hash, block.Hash()) && oe.req.SyncingToHeight == syncingToHeight
+}
+
 func (blockExec *BlockExecutor) ExtendVote(
 	ctx context.Context,
 	vote *types.Vote,
@@ -448,6 +580,18 @@ func (blockExec *BlockExecutor) asyncUpdateMempool(
 ) {
 	defer unlockMempool()
 
+	// Update the mempool lanes first, if the app changed them, so that the
+	// txs left in the mempool are rechecked in their new lanes.
+	if len(abciResponse.LanePriorities) > 0 {
+		lanesInfo, err := mempool.BuildLanesInfo(abciResponse.LanePriorities, abciResponse.DefaultLane)
+		if err == nil {
+			err = blockExec.mempool.UpdateLanes(lanesInfo)
+		}
+		if err != nil {
+			blockExec.logger.Error("Failed to update mempool lanes", "height", block.Height, "err", err)
+		}
+	}
+
 	err := blockExec.mempool.Update(
 		block.Height,
 		block.Txs,
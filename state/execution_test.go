@@ -3,6 +3,7 @@ package state_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	cmtdb "github.com/cometbft/cometbft/db"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
 	"github.com/cometbft/cometbft/proxy"
 	pmocks "github.com/cometbft/cometbft/proxy/mocks"
@@ -82,6 +84,96 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// finalizeRecorderApp records the hashes of the blocks it finalizes.
+type finalizeRecorderApp struct {
+	*testApp
+	mtx       cmtsync.Mutex
+	finalized [][]byte
+}
+
+func (app *finalizeRecorderApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	app.mtx.Lock()
+	app.finalized = append(app.finalized, req.Hash)
+	app.mtx.Unlock()
+	return app.testApp.FinalizeBlock(ctx, req)
+}
+
+func (app *finalizeRecorderApp) finalizedBlocks() [][]byte {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return slices.Clone(app.finalized)
+}
+
+func TestApplyBlockOptimisticExecution(t *testing.T) {
+	testCases := []struct {
+		name            string
+		commitOptimized bool
+	}{
+		{"block executed optimistically is committed", true},
+		{"another block is committed", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := &finalizeRecorderApp{testApp: &testApp{}}
+			cc := proxy.NewLocalClientCreator(app)
+			proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics(), proxy.WithSpeculativeConn())
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			state, stateDB, _ := makeState(1, 1, chainID)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: false,
+			})
+			blkStoreDB, err := cmtdb.NewInMem()
+			require.NoError(t, err)
+			blockStore := store.NewBlockStore(blkStoreDB)
+
+			mp := &mpmocks.Mempool{}
+			mp.On("Lock").Return()
+			mp.On("Unlock").Return()
+			mp.On("PreUpdate").Return()
+			mp.On("FlushAppConn", mock.Anything).Return(nil)
+			mp.On("Update",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).Return(nil)
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+				mp, sm.EmptyEvidencePool{}, blockStore, sm.BlockExecutorWithSpeculativeConn(proxyApp.Speculative()))
+
+			proposal := makeBlock(state, 1, new(types.Commit))
+			committed := proposal
+			if !tc.commitOptimized {
+				committed = state.MakeBlock(1, test.MakeNTxs(state.LastBlockHeight, 5), new(types.Commit), nil,
+					state.Validators.GetProposer().Address)
+			}
+			bps, err := committed.MakePartSet(testPartSize)
+			require.NoError(t, err)
+			blockID := types.BlockID{Hash: committed.Hash(), PartSetHeader: bps.Header()}
+
+			blockExec.ExecuteOptimistically(state, proposal)
+			// Executing the same block again does not start a new execution.
+			blockExec.ExecuteOptimistically(state, proposal)
+			state, err = blockExec.ApplyBlock(state, blockID, committed, committed.Height)
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, state.Version.Consensus.App)
+
+			// The committed block is always executed on the consensus
+			// connection, after its optimistic execution if there was one.
+			if tc.commitOptimized {
+				assert.Equal(t, [][]byte{proposal.Hash(), proposal.Hash()}, app.finalizedBlocks())
+			} else {
+				// The aborted execution may complete after ApplyBlock.
+				require.Eventually(t, func() bool { return len(app.finalizedBlocks()) == 2 },
+					time.Second, 10*time.Millisecond)
+				assert.ElementsMatch(t, [][]byte{proposal.Hash(), committed.Hash()}, app.finalizedBlocks())
+			}
+		})
+	}
+}
+
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
 // DecidedLastCommit to the application. The test ensures that the
 // DecidedLastCommit properly reflects which validators signed the preceding
@@ -289,6 +381,9 @@ func TestFinalizeBlockMisbehavior(t *testing.T) {
 	dve, err := types.NewMockDuplicateVoteEvidenceWithValidator(3, defaultEvidenceTime, privVal, state.ChainID)
 	require.NoError(t, err)
 	dve.ValidatorPower = 1000
+	dpe, err := types.NewMockDuplicateProposalEvidenceWithValidator(4, defaultEvidenceTime, privVal, state.ChainID)
+	require.NoError(t, err)
+	dpe.ValidatorPower = 1000
 	lcae := &types.LightClientAttackEvidence{
 		ConflictingBlock: &types.LightBlock{
 			SignedHeader: &types.SignedHeader{
@@ -312,7 +407,7 @@ func TestFinalizeBlockMisbehavior(t *testing.T) {
 		Timestamp:           defaultEvidenceTime,
 	}
 
-	ev := []types.Evidence{dve, lcae}
+	ev := []types.Evidence{dve, lcae, dpe}
 
 	abciMb := []abci.Misbehavior{
 		{
@@ -329,6 +424,13 @@ func TestFinalizeBlockMisbehavior(t *testing.T) {
 			Validator:        types.TM2PB.Validator(state.Validators.Validators[0]),
 			TotalVotingPower: 12,
 		},
+		{
+			Type:             abci.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL,
+			Height:           4,
+			Time:             defaultEvidenceTime,
+			Validator:        types.TM2PB.Validator(state.Validators.Validators[0]),
+			TotalVotingPower: 10,
+		},
 	}
 
 	evpool := &mocks.EvidencePool{}
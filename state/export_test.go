@@ -47,7 +47,12 @@ func SaveValidatorsInfo(db cmtdb.DB, height, lastHeightChanged int64, valSet *ty
 	case "v2":
 		keyLayout = v2Layout{}
 	}
-	stateStore := dbStore{db, keyLayout, StoreOptions{DiscardABCIResponses: false, Metrics: NopMetrics()}}
+	stateStore := dbStore{
+		db:           db,
+		reader:       db,
+		DBKeyLayout:  keyLayout,
+		StoreOptions: StoreOptions{DiscardABCIResponses: false, Metrics: NopMetrics()},
+	}
 	batch := stateStore.db.NewBatch()
 	err := stateStore.saveValidatorsInfo(height, lastHeightChanged, valSet, batch)
 	if err != nil {
@@ -60,6 +65,18 @@ func SaveValidatorsInfo(db cmtdb.DB, height, lastHeightChanged int64, valSet *ty
 	return nil
 }
 
+// SaveStoreValidators saves the validator set at the given height in a store
+// created with NewStore, exclusively and explicitly for testing.
+func SaveStoreValidators(store Store, height, lastHeightChanged int64, valSet *types.ValidatorSet) error {
+	stateStore := store.(dbStore)
+	batch := stateStore.db.NewBatch()
+	defer batch.Close()
+	if err := stateStore.saveValidatorsInfo(height, lastHeightChanged, valSet, batch); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
 // FindMinRetainHeight is an alias for the private
 // findMinBlockRetainHeight method in pruner.go, exported exclusively and
 // explicitly for testing.
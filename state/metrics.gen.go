@@ -94,6 +94,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "fire_block_events_delay_seconds",
 			Help:      "The duration of event firing related to a new block",
 		}, labels).With(labelsAndValues...),
+		OptimisticExecutions: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "optimistic_executions",
+			Help:      "Number of optimistic executions of blocks, labeled by whether the block was committed, they were aborted because another block was committed, or they failed.",
+		}, append(labels, "status")).With(labelsAndValues...),
 	}
 }
 
@@ -112,5 +118,6 @@ func NopMetrics() *Metrics {
 		BlockIndexerBaseHeight:                 discard.NewGauge(),
 		StoreAccessDurationSeconds:             discard.NewHistogram(),
 		FireBlockEventsDelaySeconds:            discard.NewGauge(),
+		OptimisticExecutions:                   discard.NewCounter(),
 	}
 }
@@ -66,4 +66,9 @@ type Metrics struct {
 
 	// The duration of event firing related to a new block
 	FireBlockEventsDelaySeconds metrics.Gauge
+
+	// Number of optimistic executions of blocks, labeled by whether the block
+	// was committed, they were aborted because another block was committed,
+	// or they failed.
+	OptimisticExecutions metrics.Counter `metrics_labels:"status"`
 }
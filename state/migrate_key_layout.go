@@ -0,0 +1,313 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
+	cmtdb "github.com/cometbft/cometbft/db"
+)
+
+var (
+	versionKey            = []byte("version")
+	keyLayoutMigrationKey = []byte("keyLayoutMigration")
+)
+
+// KeyLayoutMigration rewrites the validator sets, consensus parameters and
+// FinalizeBlock responses of a state store database from the key layout they
+// were saved with to another one.
+//
+// Like its block store counterpart, the migration runs in four steps, which
+// must be called in order: Copy, Verify, Switch and Cleanup. Until Switch
+// returns, the store can still be used with the old layout. Progress is
+// recorded in the database after every height, so that an interrupted migration
+// is resumed by creating a new one towards the same layout.
+//
+// The migration must not run while the database is used by a node.
+type KeyLayoutMigration struct {
+	db cmtdb.DB
+
+	fromVersion, toVersion string
+	from, to               KeyLayout
+
+	// first and last bound the heights which may have records in the store.
+	first, last int64
+
+	// next is the first height which has not been copied yet (before the switch)
+	// or cleaned up yet (after the switch).
+	next     int64
+	switched bool
+}
+
+// NewKeyLayoutMigration prepares the migration of the state store in db to the
+// key layout with the given version ("v1" or "v2"). If db holds an interrupted
+// migration towards the same layout, the returned migration resumes it.
+func NewKeyLayoutMigration(db cmtdb.DB, toVersion string) (*KeyLayoutMigration, error) {
+	to, err := keyLayoutFromVersion(toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := db.Get(versionKey)
+	if err != nil {
+		return nil, fmt.Errorf("reading key layout version: %w", err)
+	}
+	currentVersion := string(version)
+	if currentVersion == "" {
+		currentVersion = "v1"
+	}
+
+	m := &KeyLayoutMigration{
+		db:          db,
+		fromVersion: currentVersion,
+		toVersion:   toVersion,
+		to:          to,
+		first:       1,
+	}
+
+	// The store does not keep track of the lowest height it has records for, so
+	// we go through all the heights of the chain. The validators are saved up to
+	// two heights ahead of the last block.
+	buf, err := db.Get(stateKey)
+	if err != nil {
+		return nil, fmt.Errorf("loading state: %w", err)
+	}
+	if len(buf) != 0 {
+		sp := new(cmtstate.State)
+		if err := proto.Unmarshal(buf, sp); err != nil {
+			return nil, fmt.Errorf("unmarshal to cmtstate.State: %w", err)
+		}
+		if sp.InitialHeight > 1 {
+			m.first = sp.InitialHeight
+		}
+		m.last = sp.LastBlockHeight + 2
+	}
+	m.next = m.first
+
+	marker, err := db.Get(keyLayoutMigrationKey)
+	if err != nil {
+		return nil, fmt.Errorf("reading key layout migration progress: %w", err)
+	}
+	if len(marker) != 0 {
+		fromVersion, targetVersion, next, err := decodeMigrationMarker(marker)
+		if err != nil {
+			return nil, err
+		}
+		if targetVersion != toVersion {
+			return nil, fmt.Errorf("a migration to key layout %s is in progress, resume it first", targetVersion)
+		}
+		switch currentVersion {
+		case fromVersion:
+		case targetVersion:
+			m.switched = true
+		default:
+			return nil, fmt.Errorf("inconsistent key layout migration: store uses %s, migration is from %s to %s",
+				currentVersion, fromVersion, targetVersion)
+		}
+		m.fromVersion = fromVersion
+		m.next = next
+	}
+
+	if m.from, err = keyLayoutFromVersion(m.fromVersion); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Done returns true if the store already uses the target layout and there is
+// nothing left to migrate.
+func (m *KeyLayoutMigration) Done() bool {
+	return m.fromVersion == m.toVersion
+}
+
+// Heights returns the range of heights each step of the migration goes
+// through. A resumed step starts in the middle of it.
+func (m *KeyLayoutMigration) Heights() (first, last int64) {
+	return m.first, m.last
+}
+
+// Copy writes every record of the state store under the new layout. The old
+// records are left untouched. onHeight, if not nil, is called after each height
+// has been copied.
+func (m *KeyLayoutMigration) Copy(onHeight func(height int64)) error {
+	if m.Done() || m.switched {
+		return nil
+	}
+
+	for ; m.next <= m.last; m.next++ {
+		batch := m.db.NewBatch()
+		err := m.copyHeight(batch, m.next)
+		if err == nil {
+			err = batch.Set(keyLayoutMigrationKey, m.marker(m.next+1))
+		}
+		if err == nil {
+			err = batch.Write()
+		}
+		batch.Close()
+		if err != nil {
+			return fmt.Errorf("copying height %d: %w", m.next, err)
+		}
+		if onHeight != nil {
+			onHeight(m.next)
+		}
+	}
+
+	return nil
+}
+
+func (m *KeyLayoutMigration) copyHeight(batch cmtdb.Batch, height int64) error {
+	for _, keys := range m.keys(height) {
+		bz, err := m.db.Get(keys[0])
+		if err != nil {
+			return err
+		}
+		if len(bz) == 0 {
+			continue
+		}
+		if err := batch.Set(keys[1], bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify checks that every record of the store is identical under both
+// layouts, and returns an error at the first height where they differ.
+// onHeight, if not nil, is called after each height has been verified.
+// Verify is a no-op once the migration has been switched, since the old records
+// may have been deleted already.
+func (m *KeyLayoutMigration) Verify(onHeight func(height int64)) error {
+	if m.Done() || m.switched {
+		return nil
+	}
+
+	for h := m.first; h <= m.last; h++ {
+		for _, keys := range m.keys(h) {
+			before, err := m.db.Get(keys[0])
+			if err != nil {
+				return err
+			}
+			after, err := m.db.Get(keys[1])
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(before, after) {
+				return fmt.Errorf("verifying height %d: records at %q and %q differ", h, keys[0], keys[1])
+			}
+		}
+		if onHeight != nil {
+			onHeight(h)
+		}
+	}
+
+	return nil
+}
+
+// Switch makes the new layout the one the state store is opened with. After
+// Switch, the migration can no longer be abandoned, only resumed.
+func (m *KeyLayoutMigration) Switch() error {
+	if m.Done() || m.switched {
+		return nil
+	}
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(versionKey, []byte(m.toVersion)); err != nil {
+		return err
+	}
+	if err := batch.Set(keyLayoutMigrationKey, m.marker(m.first)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("switching to key layout %s: %w", m.toVersion, err)
+	}
+
+	m.switched = true
+	m.next = m.first
+	return nil
+}
+
+// Cleanup deletes the records stored under the old layout and completes the
+// migration. onHeight, if not nil, is called after each height has been
+// cleaned up.
+func (m *KeyLayoutMigration) Cleanup(onHeight func(height int64)) error {
+	if m.Done() {
+		return nil
+	}
+	if !m.switched {
+		return errors.New("cannot clean up the old key layout before switching to the new one")
+	}
+
+	for ; m.next <= m.last; m.next++ {
+		batch := m.db.NewBatch()
+		var err error
+		for _, keys := range m.keys(m.next) {
+			if err = batch.Delete(keys[0]); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = batch.Set(keyLayoutMigrationKey, m.marker(m.next+1))
+		}
+		if err == nil {
+			err = batch.Write()
+		}
+		batch.Close()
+		if err != nil {
+			return fmt.Errorf("cleaning up height %d: %w", m.next, err)
+		}
+		if onHeight != nil {
+			onHeight(m.next)
+		}
+	}
+
+	if err := m.db.DeleteSync(keyLayoutMigrationKey); err != nil {
+		return fmt.Errorf("completing key layout migration: %w", err)
+	}
+	m.fromVersion, m.from = m.toVersion, m.to
+
+	return nil
+}
+
+// keys returns the pairs of old and new keys of the records stored at the
+// given height.
+func (m *KeyLayoutMigration) keys(height int64) [3][2][]byte {
+	return [3][2][]byte{
+		{m.from.CalcValidatorsKey(height), m.to.CalcValidatorsKey(height)},
+		{m.from.CalcConsensusParamsKey(height), m.to.CalcConsensusParamsKey(height)},
+		{m.from.CalcABCIResponsesKey(height), m.to.CalcABCIResponsesKey(height)},
+	}
+}
+
+func (m *KeyLayoutMigration) marker(next int64) []byte {
+	return []byte(m.fromVersion + ":" + m.toVersion + ":" + strconv.FormatInt(next, 10))
+}
+
+func decodeMigrationMarker(bz []byte) (fromVersion, toVersion string, next int64, err error) {
+	fields := strings.Split(string(bz), ":")
+	if len(fields) != 3 {
+		return "", "", 0, fmt.Errorf("invalid key layout migration progress %q", bz)
+	}
+	next, err = strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid key layout migration progress %q: %w", bz, err)
+	}
+	return fields[0], fields[1], next, nil
+}
+
+func keyLayoutFromVersion(version string) (KeyLayout, error) {
+	switch version {
+	case "v1":
+		return &v1LegacyLayout{}, nil
+	case "v2":
+		return &v2Layout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown key layout version %q, expected v1 or v2", version)
+	}
+}
@@ -0,0 +1,57 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+func TestKeyLayoutMigration(t *testing.T) {
+	state, stateDB, _ := makeState(2, 10, chainID)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	for h := int64(1); h <= 10; h++ {
+		resp := &abci.FinalizeBlockResponse{AppHash: []byte{byte(h)}}
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, resp))
+	}
+
+	m, err := sm.NewKeyLayoutMigration(stateDB, "v2")
+	require.NoError(t, err)
+	require.False(t, m.Done())
+
+	first, last := m.Heights()
+	assert.EqualValues(t, 1, first)
+	assert.EqualValues(t, state.LastBlockHeight+2, last)
+
+	require.NoError(t, m.Copy(nil))
+	require.NoError(t, m.Verify(nil))
+	require.NoError(t, m.Switch())
+	require.NoError(t, m.Cleanup(nil))
+	assert.True(t, m.Done())
+
+	// The store is now opened with the new layout, whatever the options say.
+	stateStore = sm.NewStore(stateDB, sm.StoreOptions{DBKeyLayout: "v1"})
+	for h := int64(1); h <= 10; h++ {
+		vals, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+		assert.Equal(t, state.Validators.Hash(), vals.Hash())
+
+		params, err := stateStore.LoadConsensusParams(h)
+		require.NoError(t, err)
+		assert.Equal(t, state.ConsensusParams.Hash(), params.Hash())
+
+		resp, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(h)}, resp.AppHash)
+	}
+
+	// The records under the old layout are gone.
+	for _, key := range []string{"validatorsKey:5", "consensusParamsKey:5", "abciResponsesKey:5"} {
+		has, err := stateDB.Has([]byte(key))
+		require.NoError(t, err)
+		assert.False(t, has, key)
+	}
+}
@@ -290,6 +290,36 @@ func (_m *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return r0
 }
 
+// NewSnapshot provides a mock function with no fields
+func (_m *BlockStore) NewSnapshot() (state.BlockStoreSnapshot, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewSnapshot")
+	}
+
+	var r0 state.BlockStoreSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (state.BlockStoreSnapshot, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() state.BlockStoreSnapshot); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(state.BlockStoreSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PruneBlocks provides a mock function with given fields: height, _a1
 func (_m *BlockStore) PruneBlocks(height int64, _a1 state.State) (uint64, int64, error) {
 	ret := _m.Called(height, _a1)
@@ -8,6 +8,8 @@ import (
 
 	types "github.com/cometbft/cometbft/types"
 
+	statev2 "github.com/cometbft/cometbft/api/cometbft/state/v2"
+
 	v2 "github.com/cometbft/cometbft/api/cometbft/abci/v2"
 )
 
@@ -336,6 +338,36 @@ func (_m *Store) LoadLastFinalizeBlockResponse(height int64) (*v2.FinalizeBlockR
 	return r0, r1
 }
 
+// LoadMempoolLanes provides a mock function with no fields
+func (_m *Store) LoadMempoolLanes() (*statev2.MempoolLanes, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadMempoolLanes")
+	}
+
+	var r0 *statev2.MempoolLanes
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*statev2.MempoolLanes, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *statev2.MempoolLanes); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statev2.MempoolLanes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LoadValidators provides a mock function with given fields: height
 func (_m *Store) LoadValidators(height int64) (*types.ValidatorSet, error) {
 	ret := _m.Called(height)
@@ -366,6 +398,36 @@ func (_m *Store) LoadValidators(height int64) (*types.ValidatorSet, error) {
 	return r0, r1
 }
 
+// NewSnapshot provides a mock function with no fields
+func (_m *Store) NewSnapshot() (state.StoreSnapshot, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewSnapshot")
+	}
+
+	var r0 state.StoreSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (state.StoreSnapshot, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() state.StoreSnapshot); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(state.StoreSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PruneABCIResponses provides a mock function with given fields: targetRetainHeight, forceCompact
 func (_m *Store) PruneABCIResponses(targetRetainHeight int64, forceCompact bool) (int64, int64, error) {
 	ret := _m.Called(targetRetainHeight, forceCompact)
@@ -39,6 +39,36 @@ type BlockStore interface {
 
 	DeleteLatestBlock() error
 
+	// NewSnapshot returns a read-only view of the store frozen at the time of
+	// the call. The caller must close it when done.
+	NewSnapshot() (BlockStoreSnapshot, error)
+
+	Close() error
+}
+
+// BlockStoreSnapshot is a read-only, point-in-time view of a BlockStore.
+// Base and Height are those of the store when the snapshot was taken, and all
+// the loads observe the same state of the underlying database, so the results
+// of several calls are mutually consistent even if blocks are saved or pruned
+// concurrently.
+type BlockStoreSnapshot interface {
+	Base() int64
+	Height() int64
+	Size() int64
+
+	LoadBaseMeta() *types.BlockMeta
+	LoadBlockMeta(height int64) *types.BlockMeta
+	LoadBlock(height int64) (*types.Block, *types.BlockMeta)
+
+	LoadBlockByHash(hash []byte) (*types.Block, *types.BlockMeta)
+	LoadBlockMetaByHash(hash []byte) *types.BlockMeta
+	LoadBlockPart(height int64, index int) *types.Part
+
+	LoadBlockCommit(height int64) *types.Commit
+	LoadSeenCommit(height int64) *types.Commit
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
+
+	// Close releases the snapshot.
 	Close() error
 }
 
@@ -66,3 +96,5 @@ func (EmptyEvidencePool) AddEvidence(types.Evidence) error                { retu
 func (EmptyEvidencePool) Update(State, types.EvidenceList)                {}
 func (EmptyEvidencePool) CheckEvidence(types.EvidenceList) error          { return nil }
 func (EmptyEvidencePool) ReportConflictingVotes(*types.Vote, *types.Vote) {}
+func (EmptyEvidencePool) ReportConflictingProposals(*types.Proposal, *types.Proposal, types.Address) {
+}
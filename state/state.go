@@ -244,6 +244,19 @@ func (state State) MakeBlock(
 	lastCommit *types.Commit,
 	evidence []types.Evidence,
 	proposerAddress []byte,
+) *types.Block {
+	return state.MakeBlockWithClock(height, txs, lastCommit, evidence, proposerAddress, cmttime.DefaultSource{})
+}
+
+// MakeBlockWithClock is like MakeBlock, but when PBTS is enabled the time of
+// the block is read from clock instead of the system clock.
+func (state State) MakeBlockWithClock(
+	height int64,
+	txs []types.Tx,
+	lastCommit *types.Commit,
+	evidence []types.Evidence,
+	proposerAddress []byte,
+	clock cmttime.Source,
 ) *types.Block {
 	// Build base block with block data.
 	block := types.MakeBlock(height, txs, lastCommit, evidence)
@@ -252,7 +265,7 @@ func (state State) MakeBlock(
 	var timestamp time.Time
 	switch {
 	case state.ConsensusParams.Feature.PbtsEnabled(height):
-		timestamp = cmttime.Now()
+		timestamp = clock.Now()
 	case height == state.InitialHeight:
 		timestamp = state.LastBlockTime // genesis time
 	default:
@@ -271,6 +284,15 @@ func (state State) MakeBlock(
 	return block
 }
 
+// MakePartSet returns the parts of a block in which it is gossiped, extended
+// with parity parts if erasure coding is enabled at its height.
+func (state State) MakePartSet(block *types.Block) (*types.PartSet, error) {
+	if state.ConsensusParams.Feature.ErasureCodingEnabled(block.Height) {
+		return block.MakeErasureCodedPartSet(types.BlockPartSizeBytes)
+	}
+	return block.MakePartSet(types.BlockPartSizeBytes)
+}
+
 // ------------------------------------------------------------------------
 // Genesis
 
@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,7 @@ import (
 	"github.com/cometbft/cometbft/internal/test"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
+	cmttimemocks "github.com/cometbft/cometbft/types/time/mocks"
 )
 
 // setupTestCase does setup common to all test cases.
@@ -1002,6 +1004,22 @@ func TestStateMakeBlock(t *testing.T) {
 	assert.Equal(t, proposerAddress, block.ProposerAddress)
 }
 
+func TestStateMakeBlockWithClock(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := new(cmttimemocks.Source)
+	clock.On("Now").Return(now)
+	proposerAddress := state.Validators.GetProposer().Address
+
+	// With PBTS, the time of the block is read from the clock.
+	state.ConsensusParams.Feature.PbtsEnableHeight = 1
+	block := state.MakeBlockWithClock(2, test.MakeNTxs(state.LastBlockHeight, 10), new(types.Commit), nil, proposerAddress, clock)
+	assert.Equal(t, now, block.Time)
+	clock.AssertExpectations(t)
+}
+
 // TestConsensusParamsChangesSaveLoad tests saving and loading consensus params
 // with changes.
 func TestConsensusParamsChangesSaveLoad(t *testing.T) {
@@ -112,6 +112,7 @@ var (
 	lastABCIResponseKey              = []byte("lastABCIResponseKey") // DEPRECATED
 	lastABCIResponsesRetainHeightKey = []byte("lastABCIResponsesRetainHeight")
 	offlineStateSyncHeight           = []byte("offlineStateSyncHeightKey")
+	mempoolLanesKey                  = []byte("mempoolLanesKey")
 )
 
 var (
@@ -169,6 +170,8 @@ type Store interface {
 	LoadFinalizeBlockResponse(height int64) (*abci.FinalizeBlockResponse, error)
 	// LoadLastFinalizeBlockResponse loads the last abciResponse for a given height
 	LoadLastFinalizeBlockResponse(height int64) (*abci.FinalizeBlockResponse, error)
+	// LoadMempoolLanes loads the last mempool lanes set in a FinalizeBlock response, if any
+	LoadMempoolLanes() (*cmtstate.MempoolLanes, error)
 	// LoadConsensusParams loads the consensus params for a given height
 	LoadConsensusParams(height int64) (types.ConsensusParams, error)
 	// Save overwrites the previous state with the updated one
@@ -197,14 +200,39 @@ type Store interface {
 	SetOfflineStateSyncHeight(height int64) error
 	// Gets the height at which the store is bootstrapped after out of band statesync
 	GetOfflineStateSyncHeight() (int64, error)
+	// NewSnapshot returns a read-only view of the store frozen at the time of the call
+	NewSnapshot() (StoreSnapshot, error)
 	// Close closes the connection with the database
 	Close() error
 }
 
+// StoreSnapshot is a read-only, point-in-time view of a Store.
+//
+// All reads through a snapshot observe the store as it was when the snapshot
+// was taken, so a caller that needs several values (e.g., the ABCI responses
+// and the validator set of a height) gets a consistent answer even if a block
+// is being committed concurrently. Callers must call Close when done.
+type StoreSnapshot interface {
+	// Load loads the state of the blockchain as of the snapshot
+	Load() (State, error)
+	// LoadValidators loads the validator set at a given height
+	LoadValidators(height int64) (*types.ValidatorSet, error)
+	// LoadFinalizeBlockResponse loads the abciResponse for a given height
+	LoadFinalizeBlockResponse(height int64) (*abci.FinalizeBlockResponse, error)
+	// LoadConsensusParams loads the consensus params for a given height
+	LoadConsensusParams(height int64) (types.ConsensusParams, error)
+	// Close releases the snapshot
+	Close() error
+}
+
 // dbStore wraps a [cmtdb.DB].
 type dbStore struct {
 	db cmtdb.DB
 
+	// reader serves all the loads of the store. It is db itself, or a snapshot
+	// of it when the store was handed out by NewSnapshot.
+	reader cmtdb.Reader
+
 	DBKeyLayout KeyLayout
 
 	StoreOptions
@@ -279,6 +307,7 @@ func NewStore(db cmtdb.DB, options StoreOptions) Store {
 
 	store := dbStore{
 		db:           db,
+		reader:       db,
 		StoreOptions: options,
 	}
 
@@ -345,7 +374,7 @@ func (store dbStore) Load() (State, error) {
 
 func (store dbStore) loadState(key []byte) (state State, err error) {
 	start := time.Now()
-	buf, err := store.db.Get(key)
+	buf, err := store.reader.Get(key)
 	if err != nil {
 		return state, err
 	}
@@ -693,7 +722,7 @@ func (store dbStore) LoadFinalizeBlockResponse(height int64) (*abci.FinalizeBloc
 	}
 
 	start := time.Now()
-	buf, err := store.db.Get(store.DBKeyLayout.CalcABCIResponsesKey(height))
+	buf, err := store.reader.Get(store.DBKeyLayout.CalcABCIResponsesKey(height))
 	if err != nil {
 		return nil, err
 	}
@@ -743,7 +772,7 @@ func (store dbStore) LoadFinalizeBlockResponse(height int64) (*abci.FinalizeBloc
 // method on the application but crashed before persisting the results.
 func (store dbStore) LoadLastFinalizeBlockResponse(height int64) (*abci.FinalizeBlockResponse, error) {
 	start := time.Now()
-	buf, err := store.db.Get(store.DBKeyLayout.CalcABCIResponsesKey(height))
+	buf, err := store.reader.Get(store.DBKeyLayout.CalcABCIResponsesKey(height))
 	if err != nil {
 		return nil, err
 	}
@@ -752,7 +781,7 @@ func (store dbStore) LoadLastFinalizeBlockResponse(height int64) (*abci.Finalize
 		// DEPRECATED lastABCIResponseKey
 		// It is possible if this is called directly after an upgrade that
 		// `lastABCIResponseKey` contains the last ABCI responses.
-		bz, err := store.db.Get(lastABCIResponseKey)
+		bz, err := store.reader.Get(lastABCIResponseKey)
 		if err == nil && len(bz) > 0 {
 			info := new(cmtstate.ABCIResponsesInfo)
 			err = info.Unmarshal(bz)
@@ -828,11 +857,45 @@ func (store dbStore) SaveFinalizeBlockResponse(height int64, resp *abci.Finalize
 		return err
 	}
 	addTimeSample(store.StoreOptions.Metrics.StoreAccessDurationSeconds.With("method", "save_abci_responses"), start)()
+
+	// The mempool lanes are kept apart, as the response setting them may be
+	// discarded or pruned.
+	if len(resp.LanePriorities) > 0 {
+		lanes := &cmtstate.MempoolLanes{
+			LanePriorities: resp.LanePriorities,
+			DefaultLane:    resp.DefaultLane,
+			Height:         height,
+		}
+		bz, err := lanes.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := store.db.SetSync(mempoolLanesKey, bz); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// LoadMempoolLanes loads the last mempool lanes set by the application in a
+// FinalizeBlock response. It returns nil if the application never set them.
+func (store dbStore) LoadMempoolLanes() (*cmtstate.MempoolLanes, error) {
+	buf, err := store.reader.Get(mempoolLanesKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, nil
+	}
+	lanes := new(cmtstate.MempoolLanes)
+	if err := lanes.Unmarshal(buf); err != nil {
+		return nil, fmt.Errorf("unmarshal to cmtstate.MempoolLanes: %w", err)
+	}
+	return lanes, nil
+}
+
 func (store dbStore) getValue(key []byte) ([]byte, error) {
-	bz, err := store.db.Get(key)
+	bz, err := store.reader.Get(key)
 	if err != nil {
 		return nil, err
 	}
@@ -921,14 +984,14 @@ func (store dbStore) setLastABCIResponsesRetainHeight(height int64) error {
 // LoadValidators loads the ValidatorSet for a given height.
 // Returns ErrNoValSetForHeight if the validator set can't be found for this height.
 func (store dbStore) LoadValidators(height int64) (*types.ValidatorSet, error) {
-	valInfo, elapsedTime, err := loadValidatorsInfo(store.db, store.DBKeyLayout.CalcValidatorsKey(height))
+	valInfo, elapsedTime, err := loadValidatorsInfo(store.reader, store.DBKeyLayout.CalcValidatorsKey(height))
 	if err != nil {
 		return nil, ErrNoValSetForHeight{height}
 	}
 	// (WARN) This includes time to unmarshal the validator info
 	if valInfo.ValidatorSet == nil {
 		lastStoredHeight := lastStoredHeightFor(height, valInfo.LastHeightChanged)
-		valInfo2, tmpTime, err := loadValidatorsInfo(store.db, store.DBKeyLayout.CalcValidatorsKey(lastStoredHeight))
+		valInfo2, tmpTime, err := loadValidatorsInfo(store.reader, store.DBKeyLayout.CalcValidatorsKey(lastStoredHeight))
 		elapsedTime += tmpTime
 		if err != nil || valInfo2.ValidatorSet == nil {
 			return nil,
@@ -968,7 +1031,7 @@ func lastStoredHeightFor(height, lastHeightChanged int64) int64 {
 }
 
 // CONTRACT: Returned ValidatorsInfo can be mutated.
-func loadValidatorsInfo(db cmtdb.DB, valInfoKey []byte) (*cmtstate.ValidatorsInfo, float64, error) {
+func loadValidatorsInfo(db cmtdb.Reader, valInfoKey []byte) (*cmtstate.ValidatorsInfo, float64, error) {
 	start := time.Now()
 	buf, err := db.Get(valInfoKey)
 	if err != nil {
@@ -1063,7 +1126,7 @@ func (store dbStore) LoadConsensusParams(height int64) (types.ConsensusParams, e
 
 func (store dbStore) loadConsensusParamsInfo(height int64) (*cmtstate.ConsensusParamsInfo, error) {
 	start := time.Now()
-	buf, err := store.db.Get(store.DBKeyLayout.CalcConsensusParamsKey(height))
+	buf, err := store.reader.Get(store.DBKeyLayout.CalcConsensusParamsKey(height))
 	if err != nil {
 		return nil, err
 	}
@@ -1120,7 +1183,7 @@ func (store dbStore) SetOfflineStateSyncHeight(height int64) error {
 
 // Gets the height at which the store is bootstrapped after out of band statesync.
 func (store dbStore) GetOfflineStateSyncHeight() (int64, error) {
-	buf, err := store.db.Get(offlineStateSyncHeight)
+	buf, err := store.reader.Get(offlineStateSyncHeight)
 	if err != nil {
 		return 0, err
 	}
@@ -1136,10 +1199,40 @@ func (store dbStore) GetOfflineStateSyncHeight() (int64, error) {
 	return height, nil
 }
 
+// NewSnapshot returns a read-only view of the store frozen at the time of the
+// call. The returned snapshot must be closed by the caller.
+func (store dbStore) NewSnapshot() (StoreSnapshot, error) {
+	snap, err := store.db.NewSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("taking state store snapshot: %w", err)
+	}
+
+	// Writes through a snapshot are a programming error, so make them panic
+	// rather than silently reaching the live database.
+	snapStore := store
+	snapStore.db = nil
+	snapStore.reader = snap
+
+	return dbStoreSnapshot{dbStore: snapStore, snap: snap}, nil
+}
+
 func (store dbStore) Close() error {
 	return store.db.Close()
 }
 
+// dbStoreSnapshot is a dbStore whose loads are served by a [cmtdb.Snapshot].
+type dbStoreSnapshot struct {
+	dbStore
+	snap cmtdb.Snapshot
+}
+
+var _ StoreSnapshot = dbStoreSnapshot{}
+
+// Close releases the underlying database snapshot.
+func (s dbStoreSnapshot) Close() error {
+	return s.snap.Close()
+}
+
 func min(a int64, b int64) int64 {
 	if a < b {
 		return a
@@ -62,6 +62,32 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestStoreSnapshot(t *testing.T) {
+	stateDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+
+	resp := &abci.FinalizeBlockResponse{AppHash: []byte("app_hash_1")}
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(1, resp))
+
+	snap, err := stateStore.NewSnapshot()
+	require.NoError(t, err)
+	defer snap.Close()
+
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(2, &abci.FinalizeBlockResponse{AppHash: []byte("app_hash_2")}))
+	_, err = stateStore.LoadFinalizeBlockResponse(2)
+	require.NoError(t, err)
+
+	loaded, err := snap.LoadFinalizeBlockResponse(1)
+	require.NoError(t, err)
+	assert.Equal(t, resp.AppHash, loaded.AppHash)
+
+	_, err = snap.LoadFinalizeBlockResponse(2)
+	require.Equal(t, sm.ErrNoABCIResponsesForHeight{Height: 2}, err)
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
@@ -609,6 +635,33 @@ func TestLastFinalizeBlockResponses(t *testing.T) {
 	})
 }
 
+func TestMempoolLanes(t *testing.T) {
+	stateDB, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: true})
+
+	lanes, err := stateStore.LoadMempoolLanes()
+	require.NoError(t, err)
+	require.Nil(t, lanes)
+
+	// The lanes are kept after the response setting them is discarded.
+	lanePriorities := map[string]uint32{"foo": 2, "bar": 1}
+	err = stateStore.SaveFinalizeBlockResponse(1, &abci.FinalizeBlockResponse{
+		LanePriorities: lanePriorities,
+		DefaultLane:    "bar",
+	})
+	require.NoError(t, err)
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(2, &abci.FinalizeBlockResponse{}))
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(3, &abci.FinalizeBlockResponse{}))
+
+	lanes, err = stateStore.LoadMempoolLanes()
+	require.NoError(t, err)
+	require.NotNil(t, lanes)
+	assert.Equal(t, lanePriorities, lanes.LanePriorities)
+	assert.Equal(t, "bar", lanes.DefaultLane)
+	assert.EqualValues(t, 1, lanes.Height)
+}
+
 func TestFinalizeBlockRecoveryUsingLegacyABCIResponses(t *testing.T) {
 	var (
 		height              int64 = 10
@@ -0,0 +1,301 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Names of the checks run by VerifyStores, which identify the inconsistencies
+// of a StoreReport.
+const (
+	CheckBlockMeta       = "block_meta"
+	CheckBlockParts      = "block_parts"
+	CheckBlock           = "block"
+	CheckLastBlockID     = "last_block_id"
+	CheckValidators      = "validators"
+	CheckCommit          = "commit"
+	CheckSeenCommit      = "seen_commit"
+	CheckLastResultsHash = "last_results_hash"
+	CheckAppHash         = "app_hash"
+)
+
+// StoreInconsistency is an inconsistency found by VerifyStores at a height.
+type StoreInconsistency struct {
+	Height int64  `json:"height"`
+	Check  string `json:"check"`
+	Error  string `json:"error"`
+}
+
+// StoreReport lists the inconsistencies found by VerifyStores.
+type StoreReport struct {
+	ChainID         string               `json:"chain_id"`
+	From            int64                `json:"from"`
+	To              int64                `json:"to"`
+	Inconsistencies []StoreInconsistency `json:"inconsistencies"`
+}
+
+// OK returns true if no inconsistency was found.
+func (r *StoreReport) OK() bool {
+	return len(r.Inconsistencies) == 0
+}
+
+// VerifyStores checks that the blocks at heights from to to (inclusive) are
+// intact and consistent with the state store. For every height, it checks
+// that:
+//   - the block parts hash to the PartSetHeader of the block ID, and the block
+//     they make up hashes to the block ID;
+//   - the LastBlockID of the block is the block ID of the previous block;
+//   - the validator set stored for the height matches the ValidatorsHash of
+//     the block, and the block commit and seen commit are signed by +2/3 of it;
+//   - the results stored for the height hash to the LastResultsHash of the next
+//     block, whose AppHash matches the results. Results which are not stored
+//     anymore are skipped.
+//
+// The loads panicking on corrupted data are reported as inconsistencies too.
+// An error is only returned if the height range is not in the block store.
+//
+// onHeight, if not nil, is called after each height has been checked.
+func VerifyStores(
+	chainID string,
+	blockStore BlockStore,
+	stateStore Store,
+	from, to int64,
+	onHeight func(height int64),
+) (*StoreReport, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid height range: from %d is greater than to %d", from, to)
+	}
+	if from < blockStore.Base() || to > blockStore.Height() {
+		return nil, fmt.Errorf("height range [%d, %d] is not available, block store has [%d, %d]",
+			from, to, blockStore.Base(), blockStore.Height())
+	}
+
+	v := &storeVerifier{
+		chainID:    chainID,
+		blockStore: blockStore,
+		stateStore: stateStore,
+		report: &StoreReport{
+			ChainID:         chainID,
+			From:            from,
+			To:              to,
+			Inconsistencies: []StoreInconsistency{},
+		},
+	}
+	for height := from; height <= to; height++ {
+		v.verifyHeight(height)
+		if onHeight != nil {
+			onHeight(height)
+		}
+	}
+
+	return v.report, nil
+}
+
+type storeVerifier struct {
+	chainID    string
+	blockStore BlockStore
+	stateStore Store
+	report     *StoreReport
+}
+
+// check runs fn, reporting its error as an inconsistency. The stores panic when
+// loading corrupted data, so panics are reported too. It returns false if an
+// inconsistency was reported.
+func (v *storeVerifier) check(height int64, name string, fn func() error) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			v.report.Inconsistencies = append(v.report.Inconsistencies, StoreInconsistency{
+				Height: height,
+				Check:  name,
+				Error:  fmt.Sprintf("panic: %v", r),
+			})
+			ok = false
+		}
+	}()
+	if err := fn(); err != nil {
+		v.report.Inconsistencies = append(v.report.Inconsistencies, StoreInconsistency{
+			Height: height,
+			Check:  name,
+			Error:  err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+func (v *storeVerifier) verifyHeight(height int64) {
+	var meta *types.BlockMeta
+	if !v.check(height, CheckBlockMeta, func() error {
+		meta = v.blockStore.LoadBlockMeta(height)
+		if meta == nil {
+			return errors.New("block meta not found")
+		}
+		if meta.Header.Height != height {
+			return fmt.Errorf("block meta is for height %d", meta.Header.Height)
+		}
+		return nil
+	}) {
+		return
+	}
+
+	var bz []byte
+	blockOK := v.check(height, CheckBlockParts, func() (err error) {
+		bz, err = v.loadParts(height, meta.BlockID.PartSetHeader)
+		return err
+	})
+	var block *types.Block
+	blockOK = blockOK && v.check(height, CheckBlock, func() (err error) {
+		block, err = decodeBlock(bz, meta)
+		return err
+	})
+
+	if blockOK && height > v.blockStore.Base() {
+		v.check(height, CheckLastBlockID, func() error {
+			prevMeta := v.blockStore.LoadBlockMeta(height - 1)
+			if prevMeta == nil {
+				return errors.New("previous block meta not found")
+			}
+			if !block.LastBlockID.Equals(prevMeta.BlockID) {
+				return fmt.Errorf("last block ID %v does not match the previous block ID %v", block.LastBlockID, prevMeta.BlockID)
+			}
+			return nil
+		})
+	}
+
+	var vals *types.ValidatorSet
+	valsOK := v.check(height, CheckValidators, func() (err error) {
+		vals, err = v.stateStore.LoadValidators(height)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(vals.Hash(), meta.Header.ValidatorsHash) {
+			return fmt.Errorf("validator set hash %X does not match the block's %X", vals.Hash(), meta.Header.ValidatorsHash)
+		}
+		return nil
+	})
+
+	if valsOK {
+		// The commit of the last block is only known from the seen commit.
+		if height < v.blockStore.Height() {
+			v.check(height, CheckCommit, func() error {
+				return v.verifyCommit(height, meta.BlockID, vals, v.blockStore.LoadBlockCommit(height))
+			})
+		}
+		v.check(height, CheckSeenCommit, func() error {
+			seenCommit := v.blockStore.LoadSeenCommit(height)
+			if seenCommit == nil {
+				if height == v.blockStore.Height() {
+					return errors.New("seen commit of the last block not found")
+				}
+				// Seen commits are not kept by every node for every height.
+				return nil
+			}
+			return v.verifyCommit(height, meta.BlockID, vals, seenCommit)
+		})
+	}
+
+	if height < v.blockStore.Height() {
+		v.verifyResults(height)
+	}
+}
+
+// loadParts returns the bytes of the block parts at height, checking that they
+// hash to psh, without the parity parts and padding if erasure-coded.
+func (v *storeVerifier) loadParts(height int64, psh types.PartSetHeader) ([]byte, error) {
+	var bz []byte
+	chunks := make([][]byte, 0, psh.Total)
+	for i := 0; i < int(psh.Total); i++ {
+		part := v.blockStore.LoadBlockPart(height, i)
+		if part == nil {
+			return nil, fmt.Errorf("part %d of %d not found", i, psh.Total)
+		}
+		if int(part.Index) != i {
+			return nil, fmt.Errorf("part %d has index %d", i, part.Index)
+		}
+		if err := part.Proof.Verify(psh.Hash, part.Bytes); err != nil {
+			return nil, fmt.Errorf("part %d does not match the part set header: %w", i, err)
+		}
+		chunks = append(chunks, part.Bytes)
+		if i < int(psh.DataTotal()) {
+			bz = append(bz, part.Bytes...)
+		}
+	}
+	if hash := merkle.HashFromByteSlices(chunks); !bytes.Equal(hash, psh.Hash) {
+		return nil, fmt.Errorf("parts hash to %X, expected %X", hash, psh.Hash)
+	}
+	if psh.IsErasureCoded() {
+		return types.ErasureCodedData(bz)
+	}
+	return bz, nil
+}
+
+func decodeBlock(bz []byte, meta *types.BlockMeta) (*types.Block, error) {
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(bz, pbb); err != nil {
+		return nil, fmt.Errorf("decoding block: %w", err)
+	}
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		return nil, fmt.Errorf("decoding block: %w", err)
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid block: %w", err)
+	}
+	if hash := block.Hash(); !bytes.Equal(hash, meta.BlockID.Hash) {
+		return nil, fmt.Errorf("block hashes to %X, expected %X", hash, meta.BlockID.Hash)
+	}
+	return block, nil
+}
+
+func (v *storeVerifier) verifyCommit(height int64, blockID types.BlockID, vals *types.ValidatorSet, commit *types.Commit) error {
+	if commit == nil {
+		return errors.New("commit not found")
+	}
+	if !commit.BlockID.Equals(blockID) {
+		return fmt.Errorf("commit is for block %v, expected %v", commit.BlockID, blockID)
+	}
+	return vals.VerifyCommit(v.chainID, blockID, height, commit)
+}
+
+// verifyResults checks the results of height against the header of the next
+// block.
+func (v *storeVerifier) verifyResults(height int64) {
+	var resp *abci.FinalizeBlockResponse
+	var next *types.BlockMeta
+	if !v.check(height, CheckLastResultsHash, func() (err error) {
+		resp, err = v.stateStore.LoadFinalizeBlockResponse(height)
+		if err != nil {
+			var errNoResponses ErrNoABCIResponsesForHeight
+			if errors.As(err, &errNoResponses) || errors.Is(err, ErrFinalizeBlockResponsesNotPersisted) {
+				resp = nil
+				return nil
+			}
+			return err
+		}
+		next = v.blockStore.LoadBlockMeta(height + 1)
+		if next == nil {
+			return errors.New("next block meta not found")
+		}
+		if hash := TxResultsHash(resp.TxResults); !bytes.Equal(hash, next.Header.LastResultsHash) {
+			return fmt.Errorf("results hash to %X, but the next block has %X", hash, next.Header.LastResultsHash)
+		}
+		return nil
+	}) || resp == nil {
+		return
+	}
+
+	v.check(height, CheckAppHash, func() error {
+		if !bytes.Equal(resp.AppHash, next.Header.AppHash) {
+			return fmt.Errorf("app hash %X of the results does not match the next block's %X", resp.AppHash, next.Header.AppHash)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,133 @@
+package state_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestVerifyStores(t *testing.T) {
+	_, blockStore, stateStore := makeArchiveTestChain(t, 5)
+
+	var verified []int64
+	report, err := sm.VerifyStores(chainID, blockStore, stateStore, 1, 5, func(h int64) { verified = append(verified, h) })
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "%+v", report.Inconsistencies)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, verified)
+
+	_, err = sm.VerifyStores(chainID, blockStore, stateStore, 1, 6, nil)
+	require.Error(t, err)
+
+	// Tamper with the results of height 2, the validators of height 3 and the
+	// seen commit of height 4 of another chain, whose stores have not cached
+	// anything yet.
+	_, blockStore, stateStore = makeArchiveTestChain(t, 5)
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(2, &abci.FinalizeBlockResponse{
+		TxResults: []*abci.ExecTxResult{{Code: 1}},
+	}))
+	require.NoError(t, sm.SaveStoreValidators(stateStore, 3, 3, genValSet(2)))
+	require.NoError(t, blockStore.SaveSeenCommit(4, blockStore.LoadBlockCommit(3)))
+
+	report, err = sm.VerifyStores(chainID, blockStore, stateStore, 1, 5, nil)
+	require.NoError(t, err)
+	require.False(t, report.OK())
+
+	found := make(map[int64][]string)
+	for _, inc := range report.Inconsistencies {
+		found[inc.Height] = append(found[inc.Height], inc.Check)
+	}
+	assert.Equal(t, map[int64][]string{
+		2: {sm.CheckLastResultsHash},
+		3: {sm.CheckValidators},
+		4: {sm.CheckSeenCommit},
+	}, found)
+}
+
+// corruptPartBlockStore serves a corrupted copy of a block part.
+type corruptPartBlockStore struct {
+	*store.BlockStore
+	height int64
+	index  int
+}
+
+func (bs corruptPartBlockStore) LoadBlockPart(height int64, index int) *types.Part {
+	part := bs.BlockStore.LoadBlockPart(height, index)
+	if part == nil || height != bs.height || index != bs.index {
+		return part
+	}
+	corrupted := *part
+	corrupted.Bytes = append([]byte{}, part.Bytes...)
+	corrupted.Bytes[0] ^= 0xff
+	return &corrupted
+}
+
+func TestVerifyStoresCorruptedBlockPart(t *testing.T) {
+	_, blockStore, stateStore := makeArchiveTestChain(t, 5)
+
+	report, err := sm.VerifyStores(chainID, corruptPartBlockStore{blockStore, 3, 0}, stateStore, 1, 5, nil)
+	require.NoError(t, err)
+	require.Len(t, report.Inconsistencies, 1)
+	assert.EqualValues(t, 3, report.Inconsistencies[0].Height)
+	assert.Equal(t, sm.CheckBlockParts, report.Inconsistencies[0].Check)
+}
+
+func TestVerifyStoresBrokenChain(t *testing.T) {
+	_, blockStore, stateStore := makeArchiveTestChain(t, 5)
+	// The other chain has the same validators, but blocks made at other times.
+	_, otherBlockStore, _ := makeArchiveTestChain(t, 5)
+
+	// Replace the last block with the one of the other chain, which does not
+	// build on the previous block of this chain.
+	block, _ := otherBlockStore.LoadBlock(5)
+	require.NotEqual(t, blockStore.LoadBlockMeta(4).BlockID, block.LastBlockID)
+	partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	require.NoError(t, blockStore.DeleteLatestBlock())
+	blockStore.SaveBlockWithExtendedCommit(block, partSet, otherBlockStore.LoadBlockExtendedCommit(5))
+
+	report, err := sm.VerifyStores(chainID, blockStore, stateStore, 1, 5, nil)
+	require.NoError(t, err)
+
+	found := make(map[int64][]string)
+	for _, inc := range report.Inconsistencies {
+		found[inc.Height] = append(found[inc.Height], inc.Check)
+	}
+	// The last commit of the replaced block is saved as the commit of the
+	// previous height, and does not match it either.
+	assert.Equal(t, map[int64][]string{
+		4: {sm.CheckCommit},
+		5: {sm.CheckLastBlockID},
+	}, found)
+}
+
+func TestVerifyStoresColdTier(t *testing.T) {
+	dir := t.TempDir()
+	_, blockStore, stateStore := makeArchiveTestChain(t, 6, store.WithColdTier(dir, 1, 2))
+	require.Eventually(t, func() bool { return blockStore.ColdHeight() == 4 }, 5*time.Second, 10*time.Millisecond)
+
+	// Corrupt the segment holding heights 3 and 4.
+	segFile := filepath.Join(dir, "00000000000000000003-00000000000000000004.seg")
+	bz, err := os.ReadFile(segFile)
+	require.NoError(t, err)
+	for i := range bz {
+		bz[i] ^= 0xff
+	}
+	require.NoError(t, os.WriteFile(segFile, bz, 0o600))
+
+	report, err := sm.VerifyStores(chainID, blockStore, stateStore, 1, 6, nil)
+	require.NoError(t, err)
+	found := make(map[int64]bool)
+	for _, inc := range report.Inconsistencies {
+		found[inc.Height] = true
+	}
+	assert.Equal(t, map[int64]bool{3: true, 4: true}, found)
+}
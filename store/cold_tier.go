@@ -0,0 +1,598 @@
+package store
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+/*
+The cold tier holds the blocks moved out of the block store database once they
+are old enough. It is made of immutable segment files, each holding the blocks
+of a contiguous range of heights. A segment is written in full before the block
+store switches the range of heights to it, and it is deleted as a whole when
+pruning.
+
+A segment is made of two files, named after the range of heights they hold:
+  - <start>-<end>.seg: the data file, the concatenation of the records of every
+    height, each being a flate-compressed [cmtstore.BlockSegmentEntry].
+  - <start>-<end>.idx: the index file, made of a magic string followed by one
+    entry per height, holding the offset, length and CRC32 of its record.
+*/
+
+const (
+	segmentDataExt  = ".seg"
+	segmentIndexExt = ".idx"
+	segmentTmpExt   = ".tmp"
+
+	// segmentIndexEntrySize is the size of the index entry of a height: the
+	// offset (8 bytes), the length (4 bytes) and the CRC32 (4 bytes) of its
+	// record.
+	segmentIndexEntrySize = 16
+)
+
+var segmentIndexMagic = []byte("CMTSEG01")
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// segment identifies a segment file of the cold tier.
+type segment struct {
+	start, end int64
+}
+
+func (s segment) name() string {
+	return fmt.Sprintf("%020d-%020d", s.start, s.end)
+}
+
+func (s segment) contains(height int64) bool {
+	return s.start <= height && height <= s.end
+}
+
+// coldTier is the set of segment files of a block store.
+type coldTier struct {
+	dir string
+	// depth is the number of most recent blocks kept in the database, and
+	// segmentSize the number of blocks in new segments. A depth of 0 means
+	// blocks are not moved to the cold tier anymore.
+	depth       int64
+	segmentSize int64
+
+	mtx      cmtsync.RWMutex
+	segments []segment // sorted by start height
+
+	// entryCache holds the last decoded entries, since loading a block loads
+	// each of its parts in turn.
+	entryCache *lru.Cache[int64, *cmtstore.BlockSegmentEntry]
+}
+
+// openColdTier loads the list of segments in dir. The directory is created if
+// blocks are to be moved to the cold tier.
+func openColdTier(dir string, depth, segmentSize int64) (*coldTier, error) {
+	if depth > 0 {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, err
+		}
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	ct := &coldTier{
+		dir:         dir,
+		depth:       depth,
+		segmentSize: segmentSize,
+	}
+	// err can only occur if the argument is non-positive, so is impossible in context.
+	ct.entryCache, err = lru.New[int64, *cmtstore.BlockSegmentEntry](16)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		switch {
+		case strings.HasSuffix(name, segmentTmpExt):
+			// Left over by a segment write which did not complete.
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return nil, err
+			}
+		case strings.HasSuffix(name, segmentIndexExt):
+			var seg segment
+			if _, err := fmt.Sscanf(strings.TrimSuffix(name, segmentIndexExt), "%d-%d", &seg.start, &seg.end); err != nil {
+				return nil, fmt.Errorf("invalid segment file name %q: %w", name, err)
+			}
+			ct.segments = append(ct.segments, seg)
+		}
+	}
+	sort.Slice(ct.segments, func(i, j int) bool { return ct.segments[i].start < ct.segments[j].start })
+
+	return ct, nil
+}
+
+// find returns the segment holding the given height.
+func (ct *coldTier) find(height int64) (segment, bool) {
+	ct.mtx.RLock()
+	defer ct.mtx.RUnlock()
+	i := sort.Search(len(ct.segments), func(i int) bool { return ct.segments[i].end >= height })
+	if i == len(ct.segments) || !ct.segments[i].contains(height) {
+		return segment{}, false
+	}
+	return ct.segments[i], true
+}
+
+// add registers a segment which has just been written, replacing any segment
+// starting at the same height.
+func (ct *coldTier) add(seg segment) {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	segments := make([]segment, 0, len(ct.segments)+1)
+	for _, s := range ct.segments {
+		if s.start != seg.start {
+			segments = append(segments, s)
+		}
+	}
+	segments = append(segments, seg)
+	sort.Slice(segments, func(i, j int) bool { return segments[i].start < segments[j].start })
+	ct.segments = segments
+}
+
+// removeIf deletes the segments matching the given predicate. It returns the
+// number of segments deleted.
+func (ct *coldTier) removeIf(match func(segment) bool) (int, error) {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+
+	removed := 0
+	kept := make([]segment, 0, len(ct.segments))
+	for i, seg := range ct.segments {
+		if !match(seg) {
+			kept = append(kept, seg)
+			continue
+		}
+		// Readers missing a segment consider its blocks to be missing, so the
+		// files can go before the segment is unregistered.
+		for _, ext := range []string{segmentIndexExt, segmentDataExt} {
+			if err := os.Remove(filepath.Join(ct.dir, seg.name()+ext)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				ct.segments = append(kept, ct.segments[i:]...)
+				return removed, err
+			}
+		}
+		removed++
+	}
+	ct.segments = kept
+	ct.entryCache.Purge()
+
+	return removed, nil
+}
+
+// loadEntry returns the entry of the given height, or nil if no segment holds
+// it.
+func (ct *coldTier) loadEntry(height int64) (*cmtstore.BlockSegmentEntry, error) {
+	if entry, ok := ct.entryCache.Get(height); ok {
+		return entry, nil
+	}
+	seg, ok := ct.find(height)
+	if !ok {
+		return nil, nil
+	}
+
+	record, err := ct.readRecord(seg, height)
+	if errors.Is(err, os.ErrNotExist) {
+		// The segment has been pruned since we found it.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading height %d from segment %s: %w", height, seg.name(), err)
+	}
+
+	bz, err := io.ReadAll(flate.NewReader(bytes.NewReader(record)))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing height %d from segment %s: %w", height, seg.name(), err)
+	}
+	entry := new(cmtstore.BlockSegmentEntry)
+	if err := proto.Unmarshal(bz, entry); err != nil {
+		return nil, fmt.Errorf("decoding height %d from segment %s: %w", height, seg.name(), err)
+	}
+	ct.entryCache.Add(height, entry)
+
+	return entry, nil
+}
+
+// readRecord returns the compressed record of the given height in seg, after
+// checking its CRC.
+func (ct *coldTier) readRecord(seg segment, height int64) ([]byte, error) {
+	idx, err := os.Open(filepath.Join(ct.dir, seg.name()+segmentIndexExt))
+	if err != nil {
+		return nil, err
+	}
+	defer idx.Close()
+	var ie [segmentIndexEntrySize]byte
+	if _, err := idx.ReadAt(ie[:], int64(len(segmentIndexMagic))+(height-seg.start)*segmentIndexEntrySize); err != nil {
+		return nil, fmt.Errorf("reading index: %w", err)
+	}
+	offset := int64(binary.BigEndian.Uint64(ie[0:8]))
+	length := binary.BigEndian.Uint32(ie[8:12])
+	checksum := binary.BigEndian.Uint32(ie[12:16])
+
+	data, err := os.Open(filepath.Join(ct.dir, seg.name()+segmentDataExt))
+	if err != nil {
+		return nil, err
+	}
+	defer data.Close()
+	record := make([]byte, length)
+	if _, err := data.ReadAt(record, offset); err != nil {
+		return nil, fmt.Errorf("reading data: %w", err)
+	}
+	if crc32.Checksum(record, crc32c) != checksum {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	return record, nil
+}
+
+// segmentWriter writes a new segment. The segment is written to temporary
+// files, which only take their final names once complete.
+type segmentWriter struct {
+	ct  *coldTier
+	seg segment
+
+	data   *os.File
+	offset int64
+	index  []byte
+	next   int64
+
+	buf bytes.Buffer
+	fw  *flate.Writer
+}
+
+func (ct *coldTier) newSegmentWriter(seg segment) (*segmentWriter, error) {
+	data, err := os.Create(filepath.Join(ct.dir, seg.name()+segmentDataExt+segmentTmpExt))
+	if err != nil {
+		return nil, err
+	}
+	fw, err := flate.NewWriter(nil, flate.DefaultCompression)
+	if err != nil {
+		panic(err) // only fails on an invalid compression level
+	}
+
+	index := make([]byte, len(segmentIndexMagic), len(segmentIndexMagic)+int(seg.end-seg.start+1)*segmentIndexEntrySize)
+	copy(index, segmentIndexMagic)
+
+	return &segmentWriter{
+		ct:    ct,
+		seg:   seg,
+		data:  data,
+		index: index,
+		next:  seg.start,
+		fw:    fw,
+	}, nil
+}
+
+// append writes the entry of the next height of the segment.
+func (w *segmentWriter) append(entry *cmtstore.BlockSegmentEntry) error {
+	if w.next > w.seg.end {
+		return fmt.Errorf("segment %s is full", w.seg.name())
+	}
+
+	w.buf.Reset()
+	w.fw.Reset(&w.buf)
+	if _, err := w.fw.Write(mustEncode(entry)); err != nil {
+		return err
+	}
+	if err := w.fw.Close(); err != nil {
+		return err
+	}
+	record := w.buf.Bytes()
+	if _, err := w.data.Write(record); err != nil {
+		return err
+	}
+
+	var ie [segmentIndexEntrySize]byte
+	binary.BigEndian.PutUint64(ie[0:8], uint64(w.offset))
+	binary.BigEndian.PutUint32(ie[8:12], uint32(len(record)))
+	binary.BigEndian.PutUint32(ie[12:16], crc32.Checksum(record, crc32c))
+	w.index = append(w.index, ie[:]...)
+
+	w.offset += int64(len(record))
+	w.next++
+	return nil
+}
+
+// commit makes the segment durable and registers it in the cold tier.
+func (w *segmentWriter) commit() error {
+	if w.next != w.seg.end+1 {
+		return fmt.Errorf("segment %s is incomplete, next height is %d", w.seg.name(), w.next)
+	}
+
+	if err := w.data.Sync(); err != nil {
+		return err
+	}
+	if err := w.data.Close(); err != nil {
+		return err
+	}
+	base := filepath.Join(w.ct.dir, w.seg.name())
+	if err := writeFileSync(base+segmentIndexExt+segmentTmpExt, w.index); err != nil {
+		return err
+	}
+
+	// The index goes last: a segment only exists once its index does.
+	if err := os.Rename(base+segmentDataExt+segmentTmpExt, base+segmentDataExt); err != nil {
+		return err
+	}
+	if err := os.Rename(base+segmentIndexExt+segmentTmpExt, base+segmentIndexExt); err != nil {
+		return err
+	}
+	if err := syncDir(w.ct.dir); err != nil {
+		return err
+	}
+
+	w.ct.add(w.seg)
+	return nil
+}
+
+// abort deletes the temporary files of the segment.
+func (w *segmentWriter) abort() {
+	_ = w.data.Close()
+	base := filepath.Join(w.ct.dir, w.seg.name())
+	_ = os.Remove(base + segmentDataExt + segmentTmpExt)
+	_ = os.Remove(base + segmentIndexExt + segmentTmpExt)
+}
+
+func writeFileSync(path string, bz []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// -----------------------------------------------------------------------------
+
+// startColdTier checks the cold tier against the store state and, if the store
+// moves blocks to the cold tier, starts the routine doing so.
+func (bs *BlockStore) startColdTier() {
+	if bs.cold == nil {
+		if bs.coldHeight > 0 {
+			panic(fmt.Sprintf("blocks up to height %d are in the cold tier, but no cold tier is configured", bs.coldHeight))
+		}
+		return
+	}
+
+	// Segments above the cold height were written by an attempt to move blocks
+	// which did not complete; their blocks are still in the database.
+	if _, err := bs.cold.removeIf(func(seg segment) bool { return seg.end > bs.coldHeight }); err != nil {
+		panic(fmt.Errorf("deleting incomplete cold tier segments: %w", err))
+	}
+
+	if bs.cold.depth <= 0 {
+		return
+	}
+	if bs.cold.segmentSize <= 0 {
+		panic(fmt.Sprintf("invalid cold tier segment size %d", bs.cold.segmentSize))
+	}
+	bs.coldCh = make(chan struct{}, 1)
+	bs.coldQuit = make(chan struct{})
+	bs.coldDone = make(chan struct{})
+	go bs.coldTierRoutine()
+	bs.notifyColdTier()
+}
+
+func (bs *BlockStore) stopColdTier() {
+	if bs.coldQuit == nil {
+		return
+	}
+	select {
+	case <-bs.coldQuit:
+	default:
+		close(bs.coldQuit)
+	}
+	<-bs.coldDone
+}
+
+// notifyColdTier wakes up the routine moving blocks to the cold tier, if any.
+func (bs *BlockStore) notifyColdTier() {
+	if bs.coldCh == nil {
+		return
+	}
+	select {
+	case bs.coldCh <- struct{}{}:
+	default:
+	}
+}
+
+func (bs *BlockStore) coldTierRoutine() {
+	defer close(bs.coldDone)
+	for {
+		select {
+		case <-bs.coldQuit:
+			return
+		case <-bs.coldCh:
+			moved, err := bs.moveToColdTier()
+			if moved > 0 {
+				bs.logger.Info("Moved blocks to the cold tier", "blocks", moved, "cold_height", bs.ColdHeight())
+			}
+			if err != nil {
+				bs.logger.Error("Failed to move blocks to the cold tier", "err", err)
+			}
+		}
+	}
+}
+
+// ColdHeight returns the last height moved to the cold tier, or 0 if none.
+func (bs *BlockStore) ColdHeight() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.coldHeight
+}
+
+// moveToColdTier moves every complete segment of blocks which are at least
+// depth blocks below the store height to the cold tier. It returns the number
+// of blocks moved.
+func (bs *BlockStore) moveToColdTier() (int64, error) {
+	bs.coldMtx.Lock()
+	defer bs.coldMtx.Unlock()
+
+	moved := int64(0)
+	for {
+		bs.mtx.RLock()
+		// Segments start right above the cold height even if the blocks there
+		// have been pruned, as the commits kept to prove evidence must move too.
+		seg := segment{start: bs.coldHeight + 1}
+		seg.end = seg.start + bs.cold.segmentSize - 1
+		full := bs.base > 0 && seg.end <= bs.height-bs.cold.depth
+		base := bs.base
+		bs.mtx.RUnlock()
+		if !full {
+			return moved, nil
+		}
+
+		if err := bs.moveSegment(seg, base); err != nil {
+			return moved, fmt.Errorf("moving blocks %d to %d: %w", seg.start, seg.end, err)
+		}
+		moved += seg.end - seg.start + 1
+
+		select {
+		case <-bs.coldQuit:
+			return moved, nil
+		default:
+		}
+	}
+}
+
+// moveSegment writes the blocks of seg to a new segment, then deletes them
+// from the database. Only what pruning left of the heights below base is
+// moved.
+//
+// Contract: the caller MUST hold coldMtx.
+func (bs *BlockStore) moveSegment(seg segment, base int64) error {
+	defer addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "move_to_cold_tier"), time.Now())()
+
+	w, err := bs.cold.newSegmentWriter(seg)
+	if err != nil {
+		return err
+	}
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+
+	for h := seg.start; h <= seg.end; h++ {
+		entry, keys, err := bs.loadHotEntry(h, h < base)
+		if err != nil {
+			w.abort()
+			return fmt.Errorf("height %d: %w", h, err)
+		}
+		if err := w.append(entry); err != nil {
+			w.abort()
+			return err
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				w.abort()
+				return err
+			}
+		}
+	}
+	if err := w.commit(); err != nil {
+		w.abort()
+		return err
+	}
+
+	// Switching the heights to the cold tier and deleting them from the
+	// database happen atomically.
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	prevColdHeight := bs.coldHeight
+	bs.coldHeight = seg.end
+	if err := bs.saveStateAndWriteDB(batch, "failed to move blocks to the cold tier"); err != nil {
+		bs.coldHeight = prevColdHeight
+		return err
+	}
+	return nil
+}
+
+// loadHotEntry returns the records of the block at height from the database,
+// along with the keys they are stored under. If the height has been pruned,
+// only the records which may be left are returned, and none is required.
+func (bs *BlockStore) loadHotEntry(height int64, pruned bool) (*cmtstore.BlockSegmentEntry, [][]byte, error) {
+	var meta *types.BlockMeta
+	if !pruned {
+		if meta = bs.LoadBlockMeta(height); meta == nil {
+			return nil, nil, errors.New("block meta not found")
+		}
+	}
+
+	entry := new(cmtstore.BlockSegmentEntry)
+	keys := make([][]byte, 0, 3)
+	get := func(key []byte, required bool) ([]byte, error) {
+		bz, err := bs.db.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) == 0 {
+			if required {
+				return nil, fmt.Errorf("record %q not found", key)
+			}
+			return nil, nil
+		}
+		keys = append(keys, key)
+		return bz, nil
+	}
+
+	if meta != nil {
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			bz, err := get(bs.dbKeyLayout.CalcBlockPartKey(height, p), true)
+			if err != nil {
+				return nil, nil, err
+			}
+			entry.Parts = append(entry.Parts, bz)
+		}
+	}
+	var err error
+	// Pruning keeps the commit only if it is needed to prove evidence.
+	if entry.BlockCommit, err = get(bs.dbKeyLayout.CalcBlockCommitKey(height), !pruned); err != nil {
+		return nil, nil, err
+	}
+	// The seen commit is lost after a state sync, and the extended commit only
+	// exists while vote extensions are enabled.
+	if entry.SeenCommit, err = get(bs.dbKeyLayout.CalcSeenCommitKey(height), false); err != nil {
+		return nil, nil, err
+	}
+	if entry.ExtendedCommit, err = get(bs.dbKeyLayout.CalcExtCommitKey(height), false); err != nil {
+		return nil, nil, err
+	}
+
+	return entry, keys, nil
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtdb "github.com/cometbft/cometbft/db"
+	"github.com/cometbft/cometbft/internal/test"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*"+segmentIndexExt))
+	require.NoError(t, err)
+	for i, file := range files {
+		files[i] = filepath.Base(file)
+	}
+	return files
+}
+
+func TestColdTier(t *testing.T) {
+	config := test.ResetTestRoot("cold_tier_test")
+	defer os.RemoveAll(config.RootDir)
+
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	db, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	dir := filepath.Join(config.DBDir(), "blockstore.segments")
+	bs := NewBlockStore(db, WithColdTier(dir, 5, 10))
+	defer bs.stopColdTier()
+
+	blocks := make(map[int64]*types.Block)
+	for h := int64(1); h <= 35; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), makeTestExtCommit(h-1, cmttime.Now()).ToCommit(), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+		blocks[h] = block
+	}
+
+	// Only the complete segments at least 5 blocks below the height are moved.
+	_, err = bs.moveToColdTier()
+	require.NoError(t, err)
+	assert.EqualValues(t, 30, bs.ColdHeight())
+	assert.Equal(t, []string{
+		"00000000000000000001-00000000000000000010.idx",
+		"00000000000000000011-00000000000000000020.idx",
+		"00000000000000000021-00000000000000000030.idx",
+	}, segmentFiles(t, dir))
+
+	for _, key := range [][]byte{
+		bs.dbKeyLayout.CalcBlockPartKey(5, 0),
+		bs.dbKeyLayout.CalcBlockCommitKey(5),
+		bs.dbKeyLayout.CalcSeenCommitKey(5),
+		bs.dbKeyLayout.CalcExtCommitKey(5),
+	} {
+		has, err := db.Has(key)
+		require.NoError(t, err)
+		assert.False(t, has, "key %q", key)
+	}
+
+	checkBlocks := func(bs *BlockStore, from, to int64) {
+		t.Helper()
+		for h := from; h <= to; h++ {
+			block, meta := bs.LoadBlock(h)
+			require.NotNil(t, block, "height %d", h)
+			assert.Equal(t, blocks[h].Hash(), block.Hash())
+			byHash, _ := bs.LoadBlockByHash(meta.BlockID.Hash)
+			require.NotNil(t, byHash)
+			assert.Equal(t, h, byHash.Height)
+			assert.NotNil(t, bs.LoadBlockPart(h, 0))
+			assert.NotNil(t, bs.LoadSeenCommit(h))
+			assert.NotNil(t, bs.LoadBlockExtendedCommit(h))
+			if h < 35 {
+				assert.Equal(t, blocks[h+1].LastCommit.Hash(), bs.LoadBlockCommit(h).Hash())
+			}
+		}
+	}
+	checkBlocks(bs, 1, 35)
+
+	// The blocks are read from the cold tier after a restart, and from
+	// snapshots.
+	reopened := NewBlockStore(db, WithColdTier(dir, 0, 10))
+	checkBlocks(reopened, 1, 35)
+	snap, err := reopened.NewSnapshot()
+	require.NoError(t, err)
+	block, _ := snap.LoadBlock(15)
+	require.NotNil(t, block)
+	require.NoError(t, snap.Close())
+
+	assert.Panics(t, func() { NewBlockStore(db) }, "the cold tier must be configured")
+
+	// The blocks in the cold tier cannot be rolled back.
+	for reopened.Height() > 30 {
+		require.NoError(t, reopened.DeleteLatestBlock())
+	}
+	require.Error(t, reopened.DeleteLatestBlock())
+}
+
+func TestColdTierPruneBlocks(t *testing.T) {
+	config := test.ResetTestRoot("cold_tier_test")
+	defer os.RemoveAll(config.RootDir)
+
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	db, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	dir := filepath.Join(config.DBDir(), "blockstore.segments")
+	bs := NewBlockStore(db, WithColdTier(dir, 5, 10))
+	defer bs.stopColdTier()
+
+	for h := int64(1); h <= 50; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), makeTestExtCommit(h-1, cmttime.Now()).ToCommit(), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+	}
+	_, err = bs.moveToColdTier()
+	require.NoError(t, err)
+	require.EqualValues(t, 40, bs.ColdHeight())
+
+	state.LastBlockTime = cmttime.Now().Add(24 * time.Hour)
+	state.LastBlockHeight = 50
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 20
+	state.ConsensusParams.Evidence.MaxAgeDuration = time.Minute
+
+	// Blocks from 30 on are needed to prove evidence, so only the segments
+	// entirely below 30 are deleted.
+	pruned, evidenceRetainHeight, err := bs.PruneBlocks(35, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 34, pruned)
+	assert.EqualValues(t, 30, evidenceRetainHeight)
+	assert.Equal(t, []string{
+		"00000000000000000021-00000000000000000030.idx",
+		"00000000000000000031-00000000000000000040.idx",
+	}, segmentFiles(t, dir))
+
+	// The blocks below the base are gone, even though the segment holding them
+	// is kept for their commits.
+	block, _ := bs.LoadBlock(34)
+	assert.Nil(t, block)
+	assert.Nil(t, bs.LoadSeenCommit(34))
+	assert.NotNil(t, bs.LoadBlockCommit(34))
+	block, _ = bs.LoadBlock(35)
+	assert.NotNil(t, block)
+	assert.Nil(t, bs.LoadBlockCommit(20))
+}
+
+func TestColdTierMovesPrunedHeights(t *testing.T) {
+	config := test.ResetTestRoot("cold_tier_test")
+	defer os.RemoveAll(config.RootDir)
+
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	db, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	// Blocks are only moved when the test calls moveToColdTier.
+	bs := NewBlockStore(db, WithColdTier(t.TempDir(), 0, 10))
+	bs.cold.depth = 5
+	for h := int64(1); h <= 50; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), makeTestExtCommit(h-1, cmttime.Now()).ToCommit(), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+	}
+
+	// Blocks from 30 on are needed to prove evidence, so the commits of
+	// heights 30 to 34 are kept in the database.
+	pruneState := state.Copy()
+	pruneState.LastBlockTime = cmttime.Now().Add(24 * time.Hour)
+	pruneState.LastBlockHeight = 50
+	pruneState.ConsensusParams.Evidence.MaxAgeNumBlocks = 20
+	pruneState.ConsensusParams.Evidence.MaxAgeDuration = time.Minute
+	_, evidenceRetainHeight, err := bs.PruneBlocks(35, pruneState)
+	require.NoError(t, err)
+	require.EqualValues(t, 30, evidenceRetainHeight)
+
+	// Moving blocks starts right above the cold height, below the base, so
+	// the commits kept for evidence move to the cold tier too.
+	_, err = bs.moveToColdTier()
+	require.NoError(t, err)
+	require.EqualValues(t, 40, bs.ColdHeight())
+	for h := int64(30); h < 35; h++ {
+		assert.NotNil(t, bs.LoadBlockCommit(h), "height %d", h)
+		has, err := db.Has(bs.dbKeyLayout.CalcBlockCommitKey(h))
+		require.NoError(t, err)
+		assert.False(t, has, "height %d", h)
+	}
+	block, _ := bs.LoadBlock(36)
+	assert.NotNil(t, block)
+}
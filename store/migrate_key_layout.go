@@ -0,0 +1,424 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	cmtdb "github.com/cometbft/cometbft/db"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	versionKey            = []byte("version")
+	keyLayoutMigrationKey = []byte("keyLayoutMigration")
+)
+
+// KeyLayoutMigration rewrites the blocks of a block store database from the key
+// layout they were saved with to another one.
+//
+// The migration runs in four steps, which must be called in order: Copy, Verify,
+// Switch and Cleanup. Copy writes every record of the store under the new layout
+// next to the old one, Verify checks that both layouts return the same data,
+// Switch atomically makes the new layout the one the block store is opened with,
+// and Cleanup deletes the records stored under the old layout.
+//
+// Until Switch returns, the block store can still be used with the old layout,
+// so the migration can be abandoned at any time. Progress is recorded in the
+// database after every height: if the process is interrupted, creating a new
+// migration towards the same layout resumes from where the previous one
+// stopped.
+//
+// Blocks moved to the cold tier need no migration: their segment files are
+// indexed by height, not by database key. Only the records left in the database
+// for them, such as their block metas, are rewritten, and Verify compares what
+// each layout returns for them without reading the segments.
+//
+// The migration must not run while the database is used by a node.
+type KeyLayoutMigration struct {
+	db cmtdb.DB
+
+	fromVersion, toVersion string
+	from, to               BlockKeyLayout
+
+	base, height int64
+
+	// next is the first height which has not been copied yet (before the switch)
+	// or cleaned up yet (after the switch).
+	next     int64
+	switched bool
+}
+
+// NewKeyLayoutMigration prepares the migration of the block store in db to the
+// key layout with the given version ("v1" or "v2"). If db holds an interrupted
+// migration towards the same layout, the returned migration resumes it.
+func NewKeyLayoutMigration(db cmtdb.DB, toVersion string) (*KeyLayoutMigration, error) {
+	to, err := blockKeyLayoutFromVersion(toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := db.Get(versionKey)
+	if err != nil {
+		return nil, fmt.Errorf("reading key layout version: %w", err)
+	}
+	currentVersion := string(version)
+	if currentVersion == "" {
+		currentVersion = "v1"
+	}
+
+	bsState := LoadBlockStoreState(db)
+	m := &KeyLayoutMigration{
+		db:          db,
+		fromVersion: currentVersion,
+		toVersion:   toVersion,
+		to:          to,
+		base:        bsState.Base,
+		height:      bsState.Height,
+	}
+	m.next = m.firstHeight()
+
+	marker, err := db.Get(keyLayoutMigrationKey)
+	if err != nil {
+		return nil, fmt.Errorf("reading key layout migration progress: %w", err)
+	}
+	if len(marker) != 0 {
+		fromVersion, targetVersion, next, err := decodeMigrationMarker(marker)
+		if err != nil {
+			return nil, err
+		}
+		if targetVersion != toVersion {
+			return nil, fmt.Errorf("a migration to key layout %s is in progress, resume it first", targetVersion)
+		}
+		switch currentVersion {
+		case fromVersion:
+		case targetVersion:
+			m.switched = true
+		default:
+			return nil, fmt.Errorf("inconsistent key layout migration: store uses %s, migration is from %s to %s",
+				currentVersion, fromVersion, targetVersion)
+		}
+		m.fromVersion = fromVersion
+		m.next = next
+	}
+
+	if m.from, err = blockKeyLayoutFromVersion(m.fromVersion); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Done returns true if the store already uses the target layout and there is
+// nothing left to migrate.
+func (m *KeyLayoutMigration) Done() bool {
+	return m.fromVersion == m.toVersion
+}
+
+// Heights returns the range of heights each step of the migration goes
+// through. A resumed step starts in the middle of it.
+func (m *KeyLayoutMigration) Heights() (first, last int64) {
+	return m.firstHeight(), m.height
+}
+
+// Copy writes every record of the block store under the new layout. The old
+// records are left untouched. onHeight, if not nil, is called after each height
+// has been copied.
+func (m *KeyLayoutMigration) Copy(onHeight func(height int64)) error {
+	if m.Done() || m.switched {
+		return nil
+	}
+
+	for ; m.next <= m.height; m.next++ {
+		batch := m.db.NewBatch()
+		err := m.copyHeight(batch, m.next)
+		if err == nil {
+			err = batch.Set(keyLayoutMigrationKey, m.marker(m.next+1))
+		}
+		if err == nil {
+			err = batch.Write()
+		}
+		batch.Close()
+		if err != nil {
+			return fmt.Errorf("copying height %d: %w", m.next, err)
+		}
+		if onHeight != nil {
+			onHeight(m.next)
+		}
+	}
+
+	return nil
+}
+
+func (m *KeyLayoutMigration) copyHeight(batch cmtdb.Batch, height int64) error {
+	copyKey := func(from, to []byte) error {
+		bz, err := m.db.Get(from)
+		if err != nil || len(bz) == 0 {
+			return err
+		}
+		return batch.Set(to, bz)
+	}
+
+	if err := copyKey(m.from.CalcBlockCommitKey(height), m.to.CalcBlockCommitKey(height)); err != nil {
+		return err
+	}
+	if err := copyKey(m.from.CalcSeenCommitKey(height), m.to.CalcSeenCommitKey(height)); err != nil {
+		return err
+	}
+	if err := copyKey(m.from.CalcExtCommitKey(height), m.to.CalcExtCommitKey(height)); err != nil {
+		return err
+	}
+
+	meta, err := m.loadBlockMeta(m.from, height)
+	if err != nil || meta == nil {
+		return err
+	}
+	if err := copyKey(m.from.CalcBlockMetaKey(height), m.to.CalcBlockMetaKey(height)); err != nil {
+		return err
+	}
+	hash := meta.BlockID.Hash
+	if err := copyKey(m.from.CalcBlockHashKey(hash), m.to.CalcBlockHashKey(hash)); err != nil {
+		return err
+	}
+	for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+		if err := copyKey(m.from.CalcBlockPartKey(height, p), m.to.CalcBlockPartKey(height, p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Verify loads every block, block meta and commit of the store through both
+// layouts, and returns an error at the first height where they differ.
+// onHeight, if not nil, is called after each height has been verified.
+// Verify is a no-op once the migration has been switched, since the old records
+// may have been deleted already.
+func (m *KeyLayoutMigration) Verify(onHeight func(height int64)) error {
+	if m.Done() || m.switched {
+		return nil
+	}
+
+	first := m.firstHeight()
+	before, after := m.blockStore(m.from), m.blockStore(m.to)
+
+	for h := first; h <= m.height; h++ {
+		if err := verifyHeight(before, after, h); err != nil {
+			return fmt.Errorf("verifying height %d: %w", h, err)
+		}
+		if onHeight != nil {
+			onHeight(h)
+		}
+	}
+
+	return nil
+}
+
+func verifyHeight(before, after *BlockStore, height int64) error {
+	metaBefore, metaAfter := before.LoadBlockMeta(height), after.LoadBlockMeta(height)
+	if !proto.Equal(metaBefore.ToProto(), metaAfter.ToProto()) {
+		return errors.New("block metas differ")
+	}
+	if metaBefore != nil {
+		hash := metaBefore.BlockID.Hash
+		if !proto.Equal(before.LoadBlockMetaByHash(hash).ToProto(), after.LoadBlockMetaByHash(hash).ToProto()) {
+			return errors.New("block metas loaded by hash differ")
+		}
+	}
+
+	blockBefore, _ := before.LoadBlock(height)
+	blockAfter, _ := after.LoadBlock(height)
+	pbBefore, err := blockToProto(blockBefore)
+	if err != nil {
+		return err
+	}
+	pbAfter, err := blockToProto(blockAfter)
+	if err != nil {
+		return err
+	}
+	if !proto.Equal(pbBefore, pbAfter) {
+		return errors.New("blocks differ")
+	}
+
+	if !proto.Equal(before.LoadBlockCommit(height).ToProto(), after.LoadBlockCommit(height).ToProto()) {
+		return errors.New("block commits differ")
+	}
+	if !proto.Equal(before.LoadSeenCommit(height).ToProto(), after.LoadSeenCommit(height).ToProto()) {
+		return errors.New("seen commits differ")
+	}
+	if !proto.Equal(before.LoadBlockExtendedCommit(height).ToProto(), after.LoadBlockExtendedCommit(height).ToProto()) {
+		return errors.New("extended commits differ")
+	}
+
+	return nil
+}
+
+// Switch makes the new layout the one the block store is opened with. After
+// Switch, the migration can no longer be abandoned, only resumed.
+func (m *KeyLayoutMigration) Switch() error {
+	if m.Done() || m.switched {
+		return nil
+	}
+
+	first := m.firstHeight()
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(versionKey, []byte(m.toVersion)); err != nil {
+		return err
+	}
+	if err := batch.Set(keyLayoutMigrationKey, m.marker(first)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("switching to key layout %s: %w", m.toVersion, err)
+	}
+
+	m.switched = true
+	m.next = first
+	return nil
+}
+
+// Cleanup deletes the records stored under the old layout and completes the
+// migration. onHeight, if not nil, is called after each height has been
+// cleaned up.
+func (m *KeyLayoutMigration) Cleanup(onHeight func(height int64)) error {
+	if m.Done() {
+		return nil
+	}
+	if !m.switched {
+		return errors.New("cannot clean up the old key layout before switching to the new one")
+	}
+
+	for ; m.next <= m.height; m.next++ {
+		batch := m.db.NewBatch()
+		err := m.deleteHeight(batch, m.next)
+		if err == nil {
+			err = batch.Set(keyLayoutMigrationKey, m.marker(m.next+1))
+		}
+		if err == nil {
+			err = batch.Write()
+		}
+		batch.Close()
+		if err != nil {
+			return fmt.Errorf("cleaning up height %d: %w", m.next, err)
+		}
+		if onHeight != nil {
+			onHeight(m.next)
+		}
+	}
+
+	if err := m.db.DeleteSync(keyLayoutMigrationKey); err != nil {
+		return fmt.Errorf("completing key layout migration: %w", err)
+	}
+	m.fromVersion, m.from = m.toVersion, m.to
+
+	return nil
+}
+
+func (m *KeyLayoutMigration) deleteHeight(batch cmtdb.Batch, height int64) error {
+	// The meta is read through the new layout: the old one may already have
+	// been deleted if a previous cleanup was interrupted.
+	meta, err := m.loadBlockMeta(m.to, height)
+	if err != nil {
+		return err
+	}
+	if meta != nil {
+		if err := batch.Delete(m.from.CalcBlockHashKey(meta.BlockID.Hash)); err != nil {
+			return err
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			if err := batch.Delete(m.from.CalcBlockPartKey(height, p)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range [][]byte{
+		m.from.CalcBlockMetaKey(height),
+		m.from.CalcBlockCommitKey(height),
+		m.from.CalcSeenCommitKey(height),
+		m.from.CalcExtCommitKey(height),
+	} {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// firstHeight returns the lowest height with records in the store. The commit
+// of the block below the base is saved together with the base block, and state
+// sync can leave a seen commit there as well.
+func (m *KeyLayoutMigration) firstHeight() int64 {
+	if m.base == 0 {
+		return 0
+	}
+	return m.base - 1
+}
+
+func (m *KeyLayoutMigration) loadBlockMeta(layout BlockKeyLayout, height int64) (*cmtproto.BlockMeta, error) {
+	bz, err := m.db.Get(layout.CalcBlockMetaKey(height))
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	pbbm := new(cmtproto.BlockMeta)
+	if err := proto.Unmarshal(bz, pbbm); err != nil {
+		return nil, fmt.Errorf("unmarshal to cmtproto.BlockMeta: %w", err)
+	}
+	return pbbm, nil
+}
+
+// blockStore returns a read-only block store over the migrated database, which
+// uses the given layout regardless of the version saved in the database.
+func (m *KeyLayoutMigration) blockStore(layout BlockKeyLayout) *BlockStore {
+	bs := &BlockStore{
+		db:          m.db,
+		reader:      m.db,
+		metrics:     NopMetrics(),
+		base:        m.base,
+		height:      m.height,
+		dbKeyLayout: layout,
+	}
+	bs.addCaches()
+	return bs
+}
+
+func (m *KeyLayoutMigration) marker(next int64) []byte {
+	return []byte(m.fromVersion + ":" + m.toVersion + ":" + strconv.FormatInt(next, 10))
+}
+
+func decodeMigrationMarker(bz []byte) (fromVersion, toVersion string, next int64, err error) {
+	fields := strings.Split(string(bz), ":")
+	if len(fields) != 3 {
+		return "", "", 0, fmt.Errorf("invalid key layout migration progress %q", bz)
+	}
+	next, err = strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid key layout migration progress %q: %w", bz, err)
+	}
+	return fields[0], fields[1], next, nil
+}
+
+func blockKeyLayoutFromVersion(version string) (BlockKeyLayout, error) {
+	switch version {
+	case "v1":
+		return &v1LegacyLayout{}, nil
+	case "v2":
+		return &v2Layout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown key layout version %q, expected v1 or v2", version)
+	}
+}
+
+func blockToProto(block *types.Block) (*cmtproto.Block, error) {
+	if block == nil {
+		return nil, nil
+	}
+	return block.ToProto()
+}
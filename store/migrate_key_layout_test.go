@@ -0,0 +1,190 @@
+package store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtdb "github.com/cometbft/cometbft/db"
+	"github.com/cometbft/cometbft/internal/test"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func newKeyLayoutMigrationTestStore(t *testing.T, numBlocks int64, opts ...BlockStoreOption) (cmtdb.DB, []*types.Block) {
+	t.Helper()
+
+	config := test.ResetTestRoot("key_layout_migration_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	db, err := cmtdb.NewInMem()
+	require.NoError(t, err)
+	bs := NewBlockStore(db, append([]BlockStoreOption{WithDBKeyLayout("v1")}, opts...)...)
+
+	blocks := make([]*types.Block, 0, numBlocks)
+	for h := int64(1); h <= numBlocks; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+		blocks = append(blocks, block)
+	}
+	if bs.cold != nil {
+		bs.cold.depth = 1
+		_, err := bs.moveToColdTier()
+		require.NoError(t, err)
+		require.Positive(t, bs.ColdHeight())
+	}
+
+	return db, blocks
+}
+
+func TestKeyLayoutMigration(t *testing.T) {
+	db, blocks := newKeyLayoutMigrationTestStore(t, 10)
+
+	m, err := NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	require.False(t, m.Done())
+
+	first, last := m.Heights()
+	assert.EqualValues(t, 0, first)
+	assert.EqualValues(t, 10, last)
+
+	var copied []int64
+	require.NoError(t, m.Copy(func(h int64) { copied = append(copied, h) }))
+	assert.Len(t, copied, 11)
+
+	// Before the switch, the store is still opened with the old layout.
+	bs := NewBlockStore(db, WithDBKeyLayout("v2"))
+	assert.Equal(t, "v1", bs.GetVersion())
+
+	require.NoError(t, m.Verify(nil))
+	require.NoError(t, m.Switch())
+	require.NoError(t, m.Cleanup(nil))
+	assert.True(t, m.Done())
+
+	bs = NewBlockStore(db)
+	assert.Equal(t, "v2", bs.GetVersion())
+	for _, block := range blocks {
+		loaded, meta := bs.LoadBlock(block.Height)
+		require.NotNil(t, loaded)
+		assert.Equal(t, block.Hash(), loaded.Hash())
+		assert.NotNil(t, bs.LoadBlockMetaByHash(meta.BlockID.Hash))
+		assert.NotNil(t, bs.LoadSeenCommit(block.Height))
+		assert.NotNil(t, bs.LoadBlockExtendedCommit(block.Height))
+		if block.Height < 10 {
+			assert.NotNil(t, bs.LoadBlockCommit(block.Height))
+		}
+	}
+
+	// Nothing is left under the old layout.
+	v1 := &v1LegacyLayout{}
+	for h := int64(1); h <= 10; h++ {
+		for _, key := range [][]byte{
+			v1.CalcBlockMetaKey(h),
+			v1.CalcBlockPartKey(h, 0),
+			v1.CalcSeenCommitKey(h),
+			v1.CalcExtCommitKey(h),
+		} {
+			has, err := db.Has(key)
+			require.NoError(t, err)
+			assert.False(t, has, "key %q", key)
+		}
+	}
+
+	m, err = NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	assert.True(t, m.Done())
+}
+
+func TestKeyLayoutMigrationResume(t *testing.T) {
+	db, blocks := newKeyLayoutMigrationTestStore(t, 10)
+
+	interrupt := func(step func(func(int64)) error, at int64) {
+		defer func() {
+			require.NotNil(t, recover())
+		}()
+		_ = step(func(h int64) {
+			if h == at {
+				panic("interrupted")
+			}
+		})
+	}
+
+	m, err := NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	interrupt(m.Copy, 5)
+
+	_, err = NewKeyLayoutMigration(db, "v1")
+	require.Error(t, err, "a migration to another layout is in progress")
+
+	// The copy resumes right after the last height it completed.
+	m, err = NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	var copied []int64
+	require.NoError(t, m.Copy(func(h int64) { copied = append(copied, h) }))
+	assert.Equal(t, []int64{6, 7, 8, 9, 10}, copied)
+	require.NoError(t, m.Verify(nil))
+	require.NoError(t, m.Switch())
+	interrupt(m.Cleanup, 3)
+
+	m, err = NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	require.False(t, m.Done())
+	require.NoError(t, m.Copy(nil))
+	require.NoError(t, m.Verify(nil))
+	require.NoError(t, m.Cleanup(nil))
+
+	bs := NewBlockStore(db)
+	assert.Equal(t, "v2", bs.GetVersion())
+	for _, block := range blocks {
+		loaded, _ := bs.LoadBlock(block.Height)
+		require.NotNil(t, loaded)
+		assert.Equal(t, block.Hash(), loaded.Hash())
+	}
+}
+
+func TestKeyLayoutMigrationVerifyDetectsMismatch(t *testing.T) {
+	db, _ := newKeyLayoutMigrationTestStore(t, 5)
+
+	m, err := NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	require.NoError(t, m.Copy(nil))
+
+	// Corrupt a record written under the new layout.
+	v2 := &v2Layout{}
+	require.NoError(t, db.Delete(v2.CalcSeenCommitKey(3)))
+
+	err = m.Verify(nil)
+	require.ErrorContains(t, err, "height 3")
+}
+
+func TestKeyLayoutMigrationColdTier(t *testing.T) {
+	dir := t.TempDir()
+	db, blocks := newKeyLayoutMigrationTestStore(t, 10, WithColdTier(dir, 0, 5))
+
+	m, err := NewKeyLayoutMigration(db, "v2")
+	require.NoError(t, err)
+	require.NoError(t, m.Copy(nil))
+	require.NoError(t, m.Verify(nil))
+	require.NoError(t, m.Switch())
+	require.NoError(t, m.Cleanup(nil))
+
+	// The blocks in the cold tier are still found through the new layout.
+	bs := NewBlockStore(db, WithColdTier(dir, 0, 5))
+	assert.Equal(t, "v2", bs.GetVersion())
+	assert.EqualValues(t, 5, bs.ColdHeight())
+	for _, block := range blocks {
+		loaded, meta := bs.LoadBlock(block.Height)
+		require.NotNil(t, loaded, "height %d", block.Height)
+		assert.Equal(t, block.Hash(), loaded.Hash())
+		assert.NotNil(t, bs.LoadBlockMetaByHash(meta.BlockID.Hash))
+		assert.NotNil(t, bs.LoadSeenCommit(block.Height))
+	}
+}
@@ -13,6 +13,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	cmtdb "github.com/cometbft/cometbft/db"
 	"github.com/cometbft/cometbft/internal/evidence"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/metrics"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	sm "github.com/cometbft/cometbft/state"
@@ -40,6 +41,11 @@ the Commit data outside the Block. (TODO)
 
 The store can be assumed to contain all contiguous blocks between base and height (inclusive).
 
+When a cold tier is configured (see WithColdTier), the block parts and commits
+of old blocks are moved out of the database into segment files. Block metas
+and the hash index stay in the database, and loads transparently read from
+either tier.
+
 // NOTE: BlockStore methods will panic if they encounter errors
 // deserializing loaded data, indicating probable corruption on disk.
 */
@@ -47,6 +53,10 @@ type BlockStore struct {
 	db      cmtdb.DB
 	metrics *Metrics
 
+	// reader serves all the loads of the store. It is db itself, or a snapshot
+	// of it when the store was handed out by NewSnapshot.
+	reader cmtdb.Reader
+
 	// mtx guards access to the struct fields listed below it. Although we rely on the database
 	// to enforce fine-grained concurrency control for its data, we need to make sure that
 	// no external observer can get data from the database that is not in sync with the fields below,
@@ -62,10 +72,24 @@ type BlockStore struct {
 
 	dbKeyLayout BlockKeyLayout
 
+	// coldHeight is the last height moved to the cold tier, or 0 if none.
+	coldHeight int64
+
 	blocksDeleted      int64
 	compact            bool
 	compactionInterval int64
 
+	logger log.Logger
+
+	// cold is the cold tier of the store, or nil if none is configured.
+	// coldMtx serializes moving blocks to the cold tier with pruning, and
+	// coldQuit and coldDone control the routine moving blocks.
+	cold     *coldTier
+	coldMtx  cmtsync.Mutex
+	coldCh   chan struct{}
+	coldQuit chan struct{}
+	coldDone chan struct{}
+
 	seenCommitCache          *lru.Cache[int64, *types.Commit]
 	blockCommitCache         *lru.Cache[int64, *types.Commit]
 	blockExtendedCommitCache *lru.Cache[int64, *types.ExtendedCommit]
@@ -92,6 +116,25 @@ func WithDBKeyLayout(dbKeyLayout string) BlockStoreOption {
 	return func(bs *BlockStore) { setDBLayout(bs, dbKeyLayout) }
 }
 
+// WithLogger sets the logger.
+func WithLogger(logger log.Logger) BlockStoreOption {
+	return func(bs *BlockStore) { bs.logger = logger }
+}
+
+// WithColdTier makes the store keep its old blocks in segment files in dir.
+// Once a block is depth blocks below the store height, its parts and commits
+// are moved to the cold tier, segmentSize blocks at a time. A depth of 0 does
+// not move any more blocks, but still reads the ones already in dir.
+func WithColdTier(dir string, depth, segmentSize int64) BlockStoreOption {
+	return func(bs *BlockStore) {
+		cold, err := openColdTier(dir, depth, segmentSize)
+		if err != nil {
+			panic(fmt.Errorf("opening cold tier: %w", err))
+		}
+		bs.cold = cold
+	}
+}
+
 func setDBLayout(bStore *BlockStore, dbKeyLayoutVersion string) {
 	if !bStore.IsEmpty() {
 		var version []byte
@@ -127,10 +170,13 @@ func NewBlockStore(db cmtdb.DB, options ...BlockStoreOption) *BlockStore {
 	bs := LoadBlockStoreState(db)
 
 	bStore := &BlockStore{
-		base:    bs.Base,
-		height:  bs.Height,
-		db:      db,
-		metrics: NopMetrics(),
+		base:       bs.Base,
+		height:     bs.Height,
+		coldHeight: bs.ColdHeight,
+		db:         db,
+		reader:     db,
+		metrics:    NopMetrics(),
+		logger:     log.NewNopLogger(),
 	}
 	bStore.addCaches()
 
@@ -142,6 +188,8 @@ func NewBlockStore(db cmtdb.DB, options ...BlockStoreOption) *BlockStore {
 		setDBLayout(bStore, "v1")
 	}
 
+	bStore.startColdTier()
+
 	addTimeSample(bStore.metrics.BlockStoreAccessDurationSeconds.With("method", "new_block_store"), start)()
 	return bStore
 }
@@ -227,7 +275,9 @@ func (bs *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
 	}
 	pbb := new(cmtproto.Block)
 	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+	// The parity parts of an erasure-coded part set are not needed.
+	psh := blockMeta.BlockID.PartSetHeader
+	for i := 0; i < int(psh.DataTotal()); i++ {
 		part := bs.LoadBlockPart(height, i)
 		// If the part is missing (e.g. since it has been deleted after we
 		// loaded the block meta) we consider the whole block to be missing.
@@ -238,7 +288,13 @@ func (bs *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
 	}
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block"), start)()
 
-	err := proto.Unmarshal(buf, pbb)
+	var err error
+	if psh.IsErasureCoded() {
+		if buf, err = types.ErasureCodedData(buf); err != nil {
+			panic(fmt.Sprintf("Error reading block: %v", err))
+		}
+	}
+	err = proto.Unmarshal(buf, pbb)
 	if err != nil {
 		// NOTE: The existence of meta should imply the existence of the
 		// block. So, make sure meta is only saved after blocks are saved.
@@ -259,7 +315,7 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) (*types.Block, *types.BlockMe
 	// WARN this function includes the time for LoadBlock and will count the time it takes to load the entire block, block parts
 	// AND unmarshall
 	defer addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block_by_hash"), time.Now())()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockHashKey(hash))
+	bz, err := bs.reader.Get(bs.dbKeyLayout.CalcBlockHashKey(hash))
 	if err != nil {
 		panic(err)
 	}
@@ -291,17 +347,19 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	}
 	pbpart := new(cmtproto.Part)
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockPartKey(height, index))
-	if err != nil {
-		panic(err)
-	}
+	bz := bs.get(height, bs.dbKeyLayout.CalcBlockPartKey(height, index), false, func(e *cmtstore.BlockSegmentEntry) []byte {
+		if index >= len(e.Parts) {
+			return nil
+		}
+		return e.Parts[index]
+	})
 
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block_part"), start)()
 
 	if len(bz) == 0 {
 		return nil
 	}
-	err = proto.Unmarshal(bz, pbpart)
+	err := proto.Unmarshal(bz, pbpart)
 	if err != nil {
 		panic(fmt.Errorf("unmarshal to cmtproto.Part failed: %w", err))
 	}
@@ -318,7 +376,7 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	pbbm := new(cmtproto.BlockMeta)
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockMetaKey(height))
+	bz, err := bs.reader.Get(bs.dbKeyLayout.CalcBlockMetaKey(height))
 	if err != nil {
 		panic(err)
 	}
@@ -346,7 +404,7 @@ func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 func (bs *BlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta {
 	// WARN Same as for block by hash, this includes the time to get the block metadata and unmarshall it
 	defer addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block_meta_by_hash"), time.Now())()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockHashKey(hash))
+	bz, err := bs.reader.Get(bs.dbKeyLayout.CalcBlockHashKey(hash))
 	if err != nil {
 		panic(err)
 	}
@@ -377,10 +435,9 @@ func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 	pbc := new(cmtproto.Commit)
 
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockCommitKey(height))
-	if err != nil {
-		panic(err)
-	}
+	bz := bs.get(height, bs.dbKeyLayout.CalcBlockCommitKey(height), true, func(e *cmtstore.BlockSegmentEntry) []byte {
+		return e.BlockCommit
+	})
 
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block_commit"), start)()
 
@@ -388,7 +445,7 @@ func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 		return nil
 	}
 
-	err = proto.Unmarshal(bz, pbc)
+	err := proto.Unmarshal(bz, pbc)
 	if err != nil {
 		panic(fmt.Errorf("error reading block commit: %w", err))
 	}
@@ -411,10 +468,9 @@ func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommi
 	pbec := new(cmtproto.ExtendedCommit)
 
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcExtCommitKey(height))
-	if err != nil {
-		panic(fmt.Errorf("fetching extended commit: %w", err))
-	}
+	bz := bs.get(height, bs.dbKeyLayout.CalcExtCommitKey(height), true, func(e *cmtstore.BlockSegmentEntry) []byte {
+		return e.ExtendedCommit
+	})
 
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block_ext_commit"), start)()
 
@@ -422,7 +478,7 @@ func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommi
 		return nil
 	}
 
-	err = proto.Unmarshal(bz, pbec)
+	err := proto.Unmarshal(bz, pbec)
 	if err != nil {
 		panic(fmt.Errorf("decoding extended commit: %w", err))
 	}
@@ -444,10 +500,9 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	}
 	pbc := new(cmtproto.Commit)
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcSeenCommitKey(height))
-	if err != nil {
-		panic(err)
-	}
+	bz := bs.get(height, bs.dbKeyLayout.CalcSeenCommitKey(height), false, func(e *cmtstore.BlockSegmentEntry) []byte {
+		return e.SeenCommit
+	})
 
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_seen_commit"), start)()
 
@@ -455,7 +510,7 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 		return nil
 	}
 
-	err = proto.Unmarshal(bz, pbc)
+	err := proto.Unmarshal(bz, pbc)
 	if err != nil {
 		panic(fmt.Sprintf("error reading block seen commit: %v", err))
 	}
@@ -468,19 +523,67 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return commit.Clone()
 }
 
+// get returns the record stored under key in the database or, if the given
+// height has been moved to the cold tier, the record selected by field in the
+// entry of the height. Since pruning keeps the segments holding commits needed
+// to prove evidence, records not kept by pruning (keptWhenPruned is false) are
+// not returned from the cold tier for heights below the base.
+func (bs *BlockStore) get(
+	height int64,
+	key []byte,
+	keptWhenPruned bool,
+	field func(*cmtstore.BlockSegmentEntry) []byte,
+) []byte {
+	if !bs.inColdTier(height) {
+		bz, err := bs.reader.Get(key)
+		if err != nil {
+			panic(err)
+		}
+		// The height may have been moved to the cold tier since we checked.
+		if len(bz) > 0 || !bs.inColdTier(height) {
+			return bz
+		}
+	}
+
+	if !keptWhenPruned && height < bs.Base() {
+		return nil
+	}
+	entry, err := bs.cold.loadEntry(height)
+	if err != nil {
+		panic(err)
+	}
+	if entry == nil {
+		return nil
+	}
+	return field(entry)
+}
+
+func (bs *BlockStore) inColdTier(height int64) bool {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.cold != nil && height <= bs.coldHeight
+}
+
 // PruneBlocks removes block up to (but not including) a height. It returns the
 // number of blocks pruned and the evidence retain height - the height at which
 // data needed to prove evidence must not be removed.
+//
+// The blocks in the cold tier are deleted a segment at a time: a segment is
+// deleted once all its blocks are below the evidence retain height.
 func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64, error) {
 	if height <= 0 {
 		return 0, -1, errors.New("height must be greater than 0")
 	}
+	bs.coldMtx.Lock()
+	defer bs.coldMtx.Unlock()
+
 	bs.mtx.RLock()
 	if height > bs.height {
 		bs.mtx.RUnlock()
 		return 0, -1, fmt.Errorf("cannot prune beyond the latest height %v", bs.height)
 	}
 	base := bs.base
+	coldHeight := bs.coldHeight
 	bs.mtx.RUnlock()
 	if height < base {
 		return 0, -1, fmt.Errorf("cannot prune to height %v, it is lower than base height %v",
@@ -525,23 +628,12 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 		if err := batch.Delete(bs.dbKeyLayout.CalcBlockHashKey(meta.BlockID.Hash)); err != nil {
 			return 0, -1, err
 		}
-		// if height is beyond the evidence point we dont delete the commit data
-		if h < evidencePoint {
-			if err := batch.Delete(bs.dbKeyLayout.CalcBlockCommitKey(h)); err != nil {
-				return 0, -1, err
-			}
-			bs.blockCommitCache.Remove(h)
-		}
-		if err := batch.Delete(bs.dbKeyLayout.CalcSeenCommitKey(h)); err != nil {
+		// the rest of the data of blocks in the cold tier goes with their segment
+		if h <= coldHeight {
+			bs.evictFromCaches(h, meta)
+		} else if err := bs.deleteBlockData(batch, h, meta, h < evidencePoint); err != nil {
 			return 0, -1, err
 		}
-		bs.seenCommitCache.Remove(h)
-		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
-			if err := batch.Delete(bs.dbKeyLayout.CalcBlockPartKey(h, p)); err != nil {
-				return 0, -1, err
-			}
-			bs.blockPartCache.Remove(blockPartIndex{h, p})
-		}
 		pruned++
 
 		// flush every 1000 blocks to avoid batches becoming too large
@@ -561,6 +653,12 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 	}
 	bs.blocksDeleted += int64(pruned)
 
+	if bs.cold != nil {
+		if _, err := bs.cold.removeIf(func(seg segment) bool { return seg.end < evidencePoint }); err != nil {
+			return 0, -1, fmt.Errorf("deleting cold tier segments: %w", err)
+		}
+	}
+
 	if bs.compact && bs.blocksDeleted >= bs.compactionInterval {
 		// When the range is nil,nil, the database will try to compact
 		// ALL levels. Another option is to set a predefined range of
@@ -576,6 +674,37 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 	return pruned, evidencePoint, err
 }
 
+// deleteBlockData adds to batch the deletion of the parts and seen commit of
+// the block at height, and of its commit if deleteCommit is true.
+func (bs *BlockStore) deleteBlockData(batch cmtdb.Batch, height int64, meta *types.BlockMeta, deleteCommit bool) error {
+	if deleteCommit {
+		if err := batch.Delete(bs.dbKeyLayout.CalcBlockCommitKey(height)); err != nil {
+			return err
+		}
+		bs.blockCommitCache.Remove(height)
+	}
+	if err := batch.Delete(bs.dbKeyLayout.CalcSeenCommitKey(height)); err != nil {
+		return err
+	}
+	bs.seenCommitCache.Remove(height)
+	for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+		if err := batch.Delete(bs.dbKeyLayout.CalcBlockPartKey(height, p)); err != nil {
+			return err
+		}
+		bs.blockPartCache.Remove(blockPartIndex{height, p})
+	}
+	return nil
+}
+
+func (bs *BlockStore) evictFromCaches(height int64, meta *types.BlockMeta) {
+	bs.blockCommitCache.Remove(height)
+	bs.seenCommitCache.Remove(height)
+	bs.blockExtendedCommitCache.Remove(height)
+	for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+		bs.blockPartCache.Remove(blockPartIndex{height, p})
+	}
+}
+
 // SaveBlock persists the given block, blockParts, and seenCommit to the underlying db.
 // blockParts: Must be parts of the block
 // seenCommit: The +2/3 precommits that were seen which committed at height.
@@ -608,6 +737,8 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	if err != nil {
 		panic(err)
 	}
+
+	bs.notifyColdTier()
 }
 
 // SaveBlockWithExtendedCommit persists the given block, blockParts, and
@@ -659,6 +790,8 @@ func (bs *BlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts
 		panic(err)
 	}
 
+	bs.notifyColdTier()
+
 	bs.metrics.BlockStoreAccessDurationSeconds.With("method", "save_block_ext_commit").Observe(time.Since(start).Seconds() - extCommitMarshallTDiff)
 }
 
@@ -767,8 +900,9 @@ func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part, b
 // Contract: the caller MUST have, at least, a read lock on `bs`.
 func (bs *BlockStore) saveStateAndWriteDB(batch cmtdb.Batch, errMsg string) error {
 	bss := cmtstore.BlockStoreState{
-		Base:   bs.base,
-		Height: bs.height,
+		Base:       bs.base,
+		Height:     bs.height,
+		ColdHeight: bs.coldHeight,
 	}
 	start := time.Now()
 
@@ -797,10 +931,57 @@ func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) err
 	return bs.db.Set(bs.dbKeyLayout.CalcSeenCommitKey(height), seenCommitBytes)
 }
 
+// NewSnapshot returns a read-only view of the block store frozen at the time
+// of the call. The caller must close the returned snapshot when done.
+func (bs *BlockStore) NewSnapshot() (sm.BlockStoreSnapshot, error) {
+	// Holding the read lock guarantees that no batch updating base and height
+	// is being written, so the snapshot agrees with the values we copy.
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+
+	snap, err := bs.db.NewSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("taking block store snapshot: %w", err)
+	}
+
+	// The snapshot gets its own caches: the ones of bs may hold data saved
+	// after the snapshot was taken.
+	// The cold tier is shared: its segments never change, although the ones
+	// pruned after the snapshot was taken are missing from it.
+	snapStore := &BlockStore{
+		reader:      snap,
+		metrics:     bs.metrics,
+		logger:      bs.logger,
+		base:        bs.base,
+		height:      bs.height,
+		coldHeight:  bs.coldHeight,
+		dbKeyLayout: bs.dbKeyLayout,
+		cold:        bs.cold,
+	}
+	snapStore.addCaches()
+
+	return &blockStoreSnapshot{BlockStore: snapStore, snap: snap}, nil
+}
+
 func (bs *BlockStore) Close() error {
+	bs.stopColdTier()
 	return bs.db.Close()
 }
 
+// blockStoreSnapshot is a BlockStore whose loads are served by a
+// [cmtdb.Snapshot]. Its db is nil, so any attempt to write through it panics.
+type blockStoreSnapshot struct {
+	*BlockStore
+	snap cmtdb.Snapshot
+}
+
+var _ sm.BlockStoreSnapshot = (*blockStoreSnapshot)(nil)
+
+// Close releases the underlying database snapshot.
+func (bss *blockStoreSnapshot) Close() error {
+	return bss.snap.Close()
+}
+
 // -----------------------------------------------------------------------------
 
 var blockStoreKey = []byte("blockStore")
@@ -818,7 +999,7 @@ func SaveBlockStoreState(bsj *cmtstore.BlockStoreState, batch cmtdb.Batch) {
 
 // LoadBlockStoreState returns the BlockStoreState as loaded from disk.
 // If no BlockStoreState was previously persisted, it returns the zero value.
-func LoadBlockStoreState(db cmtdb.DB) cmtstore.BlockStoreState {
+func LoadBlockStoreState(db cmtdb.Reader) cmtstore.BlockStoreState {
 	bytes, err := db.Get(blockStoreKey)
 	if err != nil {
 		panic(err)
@@ -861,8 +1042,13 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 
 	bs.mtx.RLock()
 	targetHeight := bs.height
+	coldHeight := bs.coldHeight
 	bs.mtx.RUnlock()
 
+	if targetHeight <= coldHeight {
+		return fmt.Errorf("cannot delete block %d, it has been moved to the cold tier", targetHeight)
+	}
+
 	batch := bs.db.NewBatch()
 	defer batch.Close()
 
@@ -883,6 +883,58 @@ func TestPruneBlocks(t *testing.T) {
 	assert.Nil(t, meta)
 }
 
+func TestBlockStoreSnapshot(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	bs, _ := newInMemoryBlockStore()
+	saveBlock := func(h int64) {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+	}
+	for h := int64(1); h <= 10; h++ {
+		saveBlock(h)
+	}
+
+	snap, err := bs.NewSnapshot()
+	require.NoError(t, err)
+	defer snap.Close()
+
+	// Save and prune blocks after the snapshot was taken.
+	saveBlock(11)
+	state.LastBlockHeight = 11
+	_, _, err = bs.PruneBlocks(5, state)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, bs.Base())
+	require.EqualValues(t, 11, bs.Height())
+
+	// The snapshot still sees the store as it was.
+	assert.EqualValues(t, 1, snap.Base())
+	assert.EqualValues(t, 10, snap.Height())
+	assert.EqualValues(t, 10, snap.Size())
+
+	block, meta := snap.LoadBlock(1)
+	require.NotNil(t, block)
+	require.NotNil(t, meta)
+	assert.EqualValues(t, 1, block.Height)
+	assert.NotNil(t, snap.LoadSeenCommit(1))
+	assert.NotNil(t, snap.LoadBlockMetaByHash(meta.BlockID.Hash))
+
+	block, meta = snap.LoadBlock(11)
+	assert.Nil(t, block)
+	assert.Nil(t, meta)
+
+	// While the live store does not.
+	block, meta = bs.LoadBlock(1)
+	assert.Nil(t, block)
+	assert.Nil(t, meta)
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := newInMemoryBlockStore()
 	height := int64(10)
@@ -981,6 +1033,28 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestLoadErasureCodedBlock(t *testing.T) {
+	state, bs, _, _, cleanup, _ := makeStateAndBlockStoreAndIndexers()
+	defer cleanup()
+	state.ConsensusParams.Feature.ErasureCodingEnableHeight = 1
+	txs := types.Txs{make(types.Tx, types.BlockPartSizeBytes), make(types.Tx, types.BlockPartSizeBytes)}
+	block := state.MakeBlock(bs.Height()+1, txs, new(types.Commit), nil, state.Validators.GetProposer().Address)
+
+	partSet, err := state.MakePartSet(block)
+	require.NoError(t, err)
+	require.True(t, partSet.Header().IsErasureCoded())
+	seenCommit := makeTestExtCommit(block.Header.Height, cmttime.Now())
+	bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+
+	// The parity parts are stored, but the block is read from the data parts.
+	require.NotNil(t, bs.LoadBlockPart(block.Height, int(partSet.Total())-1))
+	loaded, meta := bs.LoadBlock(block.Height)
+	require.NotNil(t, loaded)
+	assert.Equal(t, partSet.Header(), meta.BlockID.PartSetHeader)
+	assert.Equal(t, block.Hash(), loaded.Hash())
+	assert.Equal(t, txs, loaded.Txs)
+}
+
 func doFn(fn func() (any, error)) (res any, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -13,12 +13,14 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/bits"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	cmterrors "github.com/cometbft/cometbft/types/errors"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/cometbft/cometbft/version"
 )
@@ -145,6 +147,36 @@ func (b *Block) Hash() cmtbytes.HexBytes {
 // This is the form in which the block is gossipped to peers.
 // CONTRACT: partSize is greater than zero.
 func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
+	bz, err := b.serialize()
+	if err != nil {
+		return nil, err
+	}
+	return NewPartSetFromData(bz, partSize), nil
+}
+
+// MakeErasureCodedPartSet returns a PartSet containing parts of a serialized
+// block, extended with parity parts as by NewErasureCodedPartSetFromData.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakeErasureCodedPartSet(partSize uint32) (*PartSet, error) {
+	bz, err := b.serialize()
+	if err != nil {
+		return nil, err
+	}
+	return NewErasureCodedPartSetFromData(bz, partSize)
+}
+
+// MakePartSetAs returns a PartSet containing parts of a serialized block,
+// erasure-coded if the parts of the given header are, such as those of the
+// block ID of a commit.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakePartSetAs(header PartSetHeader, partSize uint32) (*PartSet, error) {
+	if header.IsErasureCoded() {
+		return b.MakeErasureCodedPartSet(partSize)
+	}
+	return b.MakePartSet(partSize)
+}
+
+func (b *Block) serialize() ([]byte, error) {
 	if b == nil {
 		return nil, errors.New("nil block")
 	}
@@ -155,11 +187,7 @@ func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	bz, err := proto.Marshal(pbb)
-	if err != nil {
-		return nil, err
-	}
-	return NewPartSetFromData(bz, partSize), nil
+	return proto.Marshal(pbb)
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -658,6 +686,12 @@ func (cs CommitSig) BlockID(commitBlockID BlockID) BlockID {
 
 // ValidateBasic performs basic validation.
 func (cs CommitSig) ValidateBasic() error {
+	return cs.validateBasic(false)
+}
+
+// validateBasic performs basic validation. If aggregated, the signature may
+// be missing, aggregated into the signature of the commit.
+func (cs CommitSig) validateBasic(aggregated bool) error {
 	switch cs.BlockIDFlag {
 	case BlockIDFlagAbsent:
 	case BlockIDFlagCommit:
@@ -685,7 +719,7 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
-		if len(cs.Signature) == 0 {
+		if len(cs.Signature) == 0 && !aggregated {
 			return errors.New("signature is missing")
 		}
 		if len(cs.Signature) > MaxSignatureSize {
@@ -713,12 +747,15 @@ func (cs *CommitSig) ToProto() *cmtproto.CommitSig {
 // FromProto sets a protobuf CommitSig to the given pointer.
 // It returns an error if the CommitSig is invalid.
 func (cs *CommitSig) FromProto(csp cmtproto.CommitSig) error {
+	cs.fromProto(csp)
+	return cs.ValidateBasic()
+}
+
+func (cs *CommitSig) fromProto(csp cmtproto.CommitSig) {
 	cs.BlockIDFlag = BlockIDFlag(csp.BlockIdFlag)
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
-
-	return cs.ValidateBasic()
 }
 
 // -------------------------------------
@@ -760,7 +797,14 @@ func (ecs ExtendedCommitSig) String() string {
 
 // ValidateBasic checks whether the structure is well-formed.
 func (ecs ExtendedCommitSig) ValidateBasic() error {
-	if err := ecs.CommitSig.ValidateBasic(); err != nil {
+	return ecs.validateBasic(false)
+}
+
+// validateBasic checks whether the structure is well-formed. If aggregated,
+// the signature may be missing, aggregated into the signature of the
+// extended commit.
+func (ecs ExtendedCommitSig) validateBasic(aggregated bool) error {
+	if err := ecs.CommitSig.validateBasic(aggregated); err != nil {
 		return err
 	}
 
@@ -849,6 +893,11 @@ func (ecs *ExtendedCommitSig) ToProto() *cmtproto.ExtendedCommitSig {
 // Protobuf representation. Returns an error if the ExtendedCommitSig is
 // invalid.
 func (ecs *ExtendedCommitSig) FromProto(ecsp cmtproto.ExtendedCommitSig) error {
+	ecs.fromProto(ecsp)
+	return ecs.ValidateBasic()
+}
+
+func (ecs *ExtendedCommitSig) fromProto(ecsp cmtproto.ExtendedCommitSig) {
 	ecs.BlockIDFlag = BlockIDFlag(ecsp.BlockIdFlag)
 	ecs.ValidatorAddress = ecsp.ValidatorAddress
 	ecs.Timestamp = ecsp.Timestamp
@@ -857,8 +906,6 @@ func (ecs *ExtendedCommitSig) FromProto(ecsp cmtproto.ExtendedCommitSig) error {
 	ecs.ExtensionSignature = ecsp.ExtensionSignature
 	ecs.NonRpExtension = ecsp.NonRpExtension
 	ecs.NonRpExtensionSignature = ecsp.NonRpExtensionSignature
-
-	return ecs.ValidateBasic()
 }
 
 // -------------------------------------
@@ -874,6 +921,10 @@ type Commit struct {
 	Round      int32       `json:"round"`
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
+	// If the validator set has only BLS12-381 keys, the signatures of the
+	// commit sigs can be aggregated into AggregatedSignature. The commit sigs
+	// that are not absent then have no signature of their own.
+	AggregatedSignature []byte `json:"aggregated_signature,omitempty"`
 
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
@@ -890,6 +941,48 @@ func (commit *Commit) Clone() *Commit {
 	return &commCopy
 }
 
+// IsAggregated returns true if the signatures of the commit are aggregated
+// into its AggregatedSignature.
+func (commit *Commit) IsAggregated() bool {
+	return len(commit.AggregatedSignature) > 0
+}
+
+// Aggregate returns a copy of the commit with the signatures of its commit
+// sigs aggregated into its AggregatedSignature, along with the signatures
+// already aggregated, if any. vals must be the validator set which signed the
+// commit, whose validators must all have BLS12-381 keys.
+//
+// The signatures are aggregated in the context of the hash of the committed
+// block, so the signatures of commit sigs with the same flag and timestamp,
+// which sign the same message, are aggregated like the other ones.
+func (commit *Commit) Aggregate(vals *ValidatorSet) (*Commit, error) {
+	if vals.Size() != len(commit.Signatures) {
+		return nil, cmterrors.NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
+	}
+
+	aggregated := commit.Clone()
+	aggregated.hash = nil
+	var (
+		sigs    [][]byte
+		pubKeys []crypto.PubKey
+	)
+	for i, cs := range commit.Signatures {
+		if cs.BlockIDFlag == BlockIDFlagAbsent || len(cs.Signature) == 0 {
+			continue
+		}
+		sigs = append(sigs, cs.Signature)
+		pubKeys = append(pubKeys, vals.Validators[i].PubKey)
+		aggregated.Signatures[i].Signature = nil
+	}
+
+	sig, err := bls12381.AggregateSignatures(commit.AggregatedSignature, sigs, pubKeys, commit.BlockID.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating the signatures of the commit: %w", err)
+	}
+	aggregated.AggregatedSignature = sig
+	return aggregated, nil
+}
+
 // GetVote converts the CommitSig for the given valIdx to a Vote. Commits do
 // not contain vote extensions, so the vote extension and vote extension
 // signature will not be present in the returned vote.
@@ -949,11 +1042,23 @@ func (commit *Commit) ValidateBasic() error {
 		if len(commit.Signatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
+		aggregated := commit.IsAggregated()
+		if aggregated && len(commit.AggregatedSignature) != bls12381.SignatureLength {
+			return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+				bls12381.SignatureLength,
+				len(commit.AggregatedSignature),
+			)
+		}
 		for i, commitSig := range commit.Signatures {
-			if err := commitSig.ValidateBasic(); err != nil {
+			if err := commitSig.validateBasic(aggregated); err != nil {
 				return fmt.Errorf("wrong CommitSig #%d: %w", i, err)
 			}
+			if aggregated && len(commitSig.Signature) != 0 {
+				return fmt.Errorf("wrong CommitSig #%d: signature is present in an aggregated commit", i)
+			}
 		}
+	} else if commit.IsAggregated() {
+		return errors.New("aggregated signature in an empty commit")
 	}
 	return nil
 }
@@ -1000,6 +1105,9 @@ func (commit *Commit) Hash() cmtbytes.HexBytes {
 
 			bs[i] = bz
 		}
+		if commit.IsAggregated() {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.HashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -1018,10 +1126,11 @@ func (commit *Commit) WrappedExtendedCommit() *ExtendedCommit {
 		}
 	}
 	return &ExtendedCommit{
-		Height:             commit.Height,
-		Round:              commit.Round,
-		BlockID:            commit.BlockID,
-		ExtendedSignatures: cs,
+		Height:              commit.Height,
+		Round:               commit.Round,
+		BlockID:             commit.BlockID,
+		ExtendedSignatures:  cs,
+		AggregatedSignature: commit.AggregatedSignature,
 	}
 }
 
@@ -1065,6 +1174,7 @@ func (commit *Commit) ToProto() *cmtproto.Commit {
 	c.Height = commit.Height
 	c.Round = commit.Round
 	c.BlockID = commit.BlockID.ToProto()
+	c.AggregatedSignature = commit.AggregatedSignature
 
 	return c
 }
@@ -1083,9 +1193,11 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 		return nil, err
 	}
 
+	aggregated := len(cp.AggregatedSignature) > 0
 	sigs := make([]CommitSig, len(cp.Signatures))
 	for i := range cp.Signatures {
-		if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
+		sigs[i].fromProto(cp.Signatures[i])
+		if err := sigs[i].validateBasic(aggregated); err != nil {
 			return nil, err
 		}
 	}
@@ -1094,6 +1206,7 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 	commit.Height = cp.Height
 	commit.Round = cp.Round
 	commit.BlockID = *bi
+	commit.AggregatedSignature = cp.AggregatedSignature
 
 	return commit, commit.ValidateBasic()
 }
@@ -1107,6 +1220,10 @@ type ExtendedCommit struct {
 	Round              int32
 	BlockID            BlockID
 	ExtendedSignatures []ExtendedCommitSig
+	// Aggregate of the signatures of the extended commit sigs that are not
+	// absent and have no signature of their own. It is only set if the
+	// extended commit was made from a Commit with an aggregated signature.
+	AggregatedSignature []byte
 
 	bitArray *bits.BitArray
 }
@@ -1148,24 +1265,34 @@ func (ec *ExtendedCommit) addSigsToVoteSet(voteSet *VoteSet) {
 }
 
 // ToVoteSet constructs a VoteSet from the Commit and validator set.
-// Panics if signatures from the commit can't be added to the voteset.
+// Returns an error if signatures from the commit can't be added to the
+// voteset, such as an aggregated signature which doesn't verify.
 // Inverse of VoteSet.MakeCommit().
-func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
+func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) (*VoteSet, error) {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
+	if commit.IsAggregated() {
+		if err := voteSet.addAggregatedCommit(commit); err != nil {
+			return nil, fmt.Errorf("failed to reconstruct vote set from aggregated commit: %w", err)
+		}
+		return voteSet, nil
+	}
 	for idx, cs := range commit.Signatures {
 		if cs.BlockIDFlag == BlockIDFlagAbsent {
 			continue // OK, some precommits can be missing.
 		}
 		vote := commit.GetVote(int32(idx))
 		if err := vote.ValidateBasic(); err != nil {
-			panic(fmt.Errorf("failed to validate vote reconstructed from commit: %w", err))
+			return nil, fmt.Errorf("failed to validate vote reconstructed from commit: %w", err)
 		}
 		added, err := voteSet.AddVote(vote)
-		if !added || err != nil {
-			panic(fmt.Errorf("failed to reconstruct vote set from commit: %w", err))
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconstruct vote set from commit: %w", err)
+		}
+		if !added {
+			return nil, fmt.Errorf("failed to reconstruct vote set from commit: vote %d not added", idx)
 		}
 	}
-	return voteSet
+	return voteSet, nil
 }
 
 // EnsureExtensions validates that a vote extensions signature is present for
@@ -1180,17 +1307,20 @@ func (ec *ExtendedCommit) EnsureExtensions(extEnabled bool) error {
 }
 
 // ToCommit converts an ExtendedCommit to a Commit by removing all vote
-// extension-related fields.
+// extension-related fields. If the extended commit has an aggregated
+// signature, some of its signatures may not be aggregated yet: the commit is
+// then only valid once aggregated again with Commit.Aggregate.
 func (ec *ExtendedCommit) ToCommit() *Commit {
 	cs := make([]CommitSig, len(ec.ExtendedSignatures))
 	for idx, ecs := range ec.ExtendedSignatures {
 		cs[idx] = ecs.CommitSig
 	}
 	return &Commit{
-		Height:     ec.Height,
-		Round:      ec.Round,
-		BlockID:    ec.BlockID,
-		Signatures: cs,
+		Height:              ec.Height,
+		Round:               ec.Round,
+		BlockID:             ec.BlockID,
+		Signatures:          cs,
+		AggregatedSignature: ec.AggregatedSignature,
 	}
 }
 
@@ -1283,8 +1413,15 @@ func (ec *ExtendedCommit) ValidateBasic() error {
 		if len(ec.ExtendedSignatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
+		aggregated := len(ec.AggregatedSignature) > 0
+		if aggregated && len(ec.AggregatedSignature) != bls12381.SignatureLength {
+			return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+				bls12381.SignatureLength,
+				len(ec.AggregatedSignature),
+			)
+		}
 		for i, extCommitSig := range ec.ExtendedSignatures {
-			if err := extCommitSig.ValidateBasic(); err != nil {
+			if err := extCommitSig.validateBasic(aggregated); err != nil {
 				return fmt.Errorf("wrong ExtendedCommitSig #%d: %w", i, err)
 			}
 		}
@@ -1308,6 +1445,7 @@ func (ec *ExtendedCommit) ToProto() *cmtproto.ExtendedCommit {
 	c.Height = ec.Height
 	c.Round = ec.Round
 	c.BlockID = ec.BlockID.ToProto()
+	c.AggregatedSignature = ec.AggregatedSignature
 
 	return c
 }
@@ -1326,9 +1464,11 @@ func ExtendedCommitFromProto(ecp *cmtproto.ExtendedCommit) (*ExtendedCommit, err
 		return nil, err
 	}
 
+	aggregated := len(ecp.AggregatedSignature) > 0
 	sigs := make([]ExtendedCommitSig, len(ecp.ExtendedSignatures))
 	for i := range ecp.ExtendedSignatures {
-		if err := sigs[i].FromProto(ecp.ExtendedSignatures[i]); err != nil {
+		sigs[i].fromProto(ecp.ExtendedSignatures[i])
+		if err := sigs[i].validateBasic(aggregated); err != nil {
 			return nil, err
 		}
 	}
@@ -1336,6 +1476,7 @@ func ExtendedCommitFromProto(ecp *cmtproto.ExtendedCommit) (*ExtendedCommit, err
 	extCommit.Height = ecp.Height
 	extCommit.Round = ecp.Round
 	extCommit.BlockID = *bi
+	extCommit.AggregatedSignature = ecp.AggregatedSignature
 
 	return extCommit, extCommit.ValidateBasic()
 }
@@ -0,0 +1,211 @@
+//go:build bls12381
+
+package types
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// randBLSVoteSet returns a vote set of validators with BLS12-381 keys.
+func randBLSVoteSet(t testing.TB, height int64, round int32, numValidators int) (*VoteSet, *ValidatorSet, []PrivValidator) {
+	t.Helper()
+	var (
+		valz           = make([]*Validator, numValidators)
+		privValidators = make([]PrivValidator, numValidators)
+	)
+	for i := 0; i < numValidators; i++ {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		privValidators[i] = NewMockPVWithParams(privKey, false, false)
+		valz[i] = NewValidator(privKey.PubKey(), 1)
+	}
+	sort.Sort(PrivValidatorsByAddress(privValidators))
+	valSet := NewValidatorSet(valz)
+	return NewVoteSet("test_chain_id", height, round, PrecommitType, valSet), valSet, privValidators
+}
+
+// signBLSVotes adds the precommits of the given validators to voteSet, for
+// blockID or for nil, each with a different timestamp.
+func signBLSVotes(t testing.TB, voteSet *VoteSet, vals []PrivValidator, blockID BlockID, indices ...int) {
+	t.Helper()
+	now := cmttime.Now()
+	for _, i := range indices {
+		pubKey, err := vals[i].GetPubKey()
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   int32(i),
+			Height:           voteSet.GetHeight(),
+			Round:            voteSet.GetRound(),
+			Type:             PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        now.Add(time.Duration(i) * time.Millisecond),
+		}
+		added, err := signAddVote(vals[i], vote, voteSet)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+}
+
+func TestCommitAggregate(t *testing.T) {
+	const height, round = int64(3), int32(1)
+	blockID := makeBlockIDRandom()
+	voteSet, valSet, vals := randBLSVoteSet(t, height, round, 7)
+	require.True(t, valSet.AllKeysHaveType(bls12381.KeyType))
+	signBLSVotes(t, voteSet, vals, blockID, 0, 1, 2, 3, 4)
+	signBLSVotes(t, voteSet, vals, BlockID{}, 5)
+
+	commit := voteSet.MakeExtendedCommit(DefaultFeatureParams()).ToCommit()
+	aggregated, err := commit.Aggregate(valSet)
+	require.NoError(t, err)
+
+	// The original commit is unchanged.
+	assert.False(t, commit.IsAggregated())
+	require.True(t, aggregated.IsAggregated())
+	require.NoError(t, aggregated.ValidateBasic())
+	for i, cs := range aggregated.Signatures {
+		assert.Equal(t, commit.Signatures[i].BlockIDFlag, cs.BlockIDFlag)
+		assert.Empty(t, cs.Signature)
+	}
+	assert.NotEqual(t, commit.Hash(), aggregated.Hash())
+
+	require.NoError(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, height, aggregated))
+	require.NoError(t, valSet.VerifyCommitLight(voteSet.ChainID(), blockID, height, aggregated))
+	require.NoError(t, valSet.VerifyCommitLightTrusting(voteSet.ChainID(), aggregated, cmtmath.Fraction{Numerator: 1, Denominator: 3}))
+
+	pb := aggregated.ToProto()
+	fromProto, err := CommitFromProto(pb)
+	require.NoError(t, err)
+	assert.Equal(t, aggregated.AggregatedSignature, fromProto.AggregatedSignature)
+	assert.Equal(t, aggregated.Hash(), fromProto.Hash())
+
+	// A nil vote signed by someone else is not verified.
+	tampered := aggregated.Clone()
+	tampered.Signatures[6] = tampered.Signatures[5]
+	tampered.Signatures[6].ValidatorAddress = valSet.Validators[6].Address
+	tampered.Signatures[5] = NewCommitSigAbsent()
+	require.Error(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, height, tampered))
+
+	// A signature present in an aggregated commit is invalid.
+	tampered = aggregated.Clone()
+	tampered.Signatures[0].Signature = commit.Signatures[0].Signature
+	require.Error(t, tampered.ValidateBasic())
+}
+
+func TestCommitAggregateSameMessage(t *testing.T) {
+	const height, round = int64(3), int32(1)
+	blockID := makeBlockIDRandom()
+	voteSet, valSet, vals := randBLSVoteSet(t, height, round, 4)
+
+	// Validators 2 and 3 sign the same message.
+	signBLSVotes(t, voteSet, vals, blockID, 0, 1, 2)
+	vote := voteSet.GetByIndex(2).Copy()
+	vote.ValidatorAddress = valSet.Validators[3].Address
+	vote.ValidatorIndex = 3
+	added, err := signAddVote(vals[3], vote, voteSet)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	// Both signatures are aggregated.
+	commit := voteSet.MakeExtendedCommit(DefaultFeatureParams()).ToCommit()
+	aggregated, err := commit.Aggregate(valSet)
+	require.NoError(t, err)
+	assert.Equal(t, BlockIDFlagCommit, aggregated.Signatures[2].BlockIDFlag)
+	assert.Equal(t, BlockIDFlagCommit, aggregated.Signatures[3].BlockIDFlag)
+	require.NoError(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, height, aggregated))
+
+	// Marking one of them as absent can't be verified.
+	tampered := aggregated.Clone()
+	tampered.Signatures[3] = NewCommitSigAbsent()
+	require.Error(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, height, tampered))
+}
+
+func TestAggregatedCommitToVoteSet(t *testing.T) {
+	const height, round = int64(3), int32(1)
+	blockID := makeBlockIDRandom()
+	voteSet, valSet, vals := randBLSVoteSet(t, height, round, 4)
+	signBLSVotes(t, voteSet, vals, blockID, 0, 1, 2)
+	commit, err := voteSet.MakeExtendedCommit(DefaultFeatureParams()).ToCommit().Aggregate(valSet)
+	require.NoError(t, err)
+
+	reconstructed, err := commit.ToVoteSet(voteSet.ChainID(), valSet)
+	require.NoError(t, err)
+	require.True(t, reconstructed.HasTwoThirdsMajority())
+	assert.Empty(t, reconstructed.GetByIndex(0).Signature)
+
+	// The votes already aggregated are duplicates.
+	added, err := reconstructed.AddVote(voteSet.GetByIndex(0))
+	require.NoError(t, err)
+	assert.False(t, added)
+	// A late vote is aggregated along with the previous ones.
+	signBLSVotes(t, reconstructed, vals, blockID, 3)
+	extCommit := reconstructed.MakeExtendedCommit(DefaultFeatureParams())
+	assert.Equal(t, commit.AggregatedSignature, extCommit.AggregatedSignature)
+	require.NoError(t, extCommit.ValidateBasic())
+
+	reaggregated, err := extCommit.ToCommit().Aggregate(valSet)
+	require.NoError(t, err)
+	assert.NotEqual(t, commit.AggregatedSignature, reaggregated.AggregatedSignature)
+	assert.Equal(t, BlockIDFlagCommit, reaggregated.Signatures[3].BlockIDFlag)
+	require.NoError(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, height, reaggregated))
+
+	// A vote set can't be reconstructed from an invalid aggregated commit.
+	commit.AggregatedSignature = reaggregated.AggregatedSignature
+	_, err = commit.ToVoteSet(voteSet.ChainID(), valSet)
+	require.Error(t, err)
+}
+
+func TestVerifyAggregatedCommitLightTrusting(t *testing.T) {
+	const height, round = int64(3), int32(1)
+	blockID := makeBlockIDRandom()
+	voteSet, valSet, vals := randBLSVoteSet(t, height, round, 4)
+	signBLSVotes(t, voteSet, vals, blockID, 0, 2, 3)
+	commit, err := voteSet.MakeExtendedCommit(DefaultFeatureParams()).ToCommit().Aggregate(valSet)
+	require.NoError(t, err)
+
+	// The trusted validators don't include all the signers.
+	trustedVals := NewValidatorSet(valSet.Copy().Validators[:2])
+	trustLevel := cmtmath.Fraction{Numerator: 1, Denominator: 3}
+	require.Error(t, trustedVals.VerifyCommitLightTrusting(voteSet.ChainID(), commit, trustLevel))
+
+	// Once verified with all the signers, the signature is only tallied.
+	cache := NewSignatureCache()
+	require.NoError(t, valSet.VerifyCommitLightWithCache(voteSet.ChainID(), blockID, height, commit, cache))
+	require.NoError(t, trustedVals.VerifyCommitLightTrustingWithCache(voteSet.ChainID(), commit, trustLevel, cache))
+	err = trustedVals.VerifyCommitLightTrustingWithCache(voteSet.ChainID(), commit, cmtmath.Fraction{Numerator: 2, Denominator: 3}, cache)
+	require.ErrorAs(t, err, &ErrNotEnoughVotingPowerSigned{})
+}
+
+func BenchmarkCommitVerify(b *testing.B) {
+	const height, round, numValidators = int64(3), int32(1), 100
+	blockID := makeBlockIDRandom()
+	voteSet, valSet, vals := randBLSVoteSet(b, height, round, numValidators)
+	indices := make([]int, numValidators)
+	for i := range indices {
+		indices[i] = i
+	}
+	signBLSVotes(b, voteSet, vals, blockID, indices...)
+	commit := voteSet.MakeExtendedCommit(DefaultFeatureParams()).ToCommit()
+	aggregated, err := commit.Aggregate(valSet)
+	require.NoError(b, err)
+
+	for name, c := range map[string]*Commit{"individual": commit, "aggregated": aggregated} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := valSet.VerifyCommit(voteSet.ChainID(), blockID, height, c); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(c.ToProto().Size()), "bytes")
+		})
+	}
+}
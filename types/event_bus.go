@@ -161,6 +161,18 @@ func (b *EventBus) PublishEventNewEvidence(evidence EventDataNewEvidence) error
 	return b.Publish(EventNewEvidence, evidence)
 }
 
+func (b *EventBus) PublishEventEvidenceAdded(data EventDataEvidence) error {
+	return b.Publish(EventEvidenceAdded, data)
+}
+
+func (b *EventBus) PublishEventEvidenceCommitted(data EventDataEvidence) error {
+	return b.Publish(EventEvidenceCommitted, data)
+}
+
+func (b *EventBus) PublishEventEvidenceExpired(data EventDataEvidence) error {
+	return b.Publish(EventEvidenceExpired, data)
+}
+
 func (b *EventBus) PublishEventVote(data EventDataVote) error {
 	return b.Publish(EventVote, data)
 }
@@ -178,6 +190,15 @@ func (b *EventBus) PublishEventPendingTx(data EventDataPendingTx) error {
 	})
 }
 
+func (b *EventBus) PublishEventRemovedTx(data EventDataRemovedTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+	return b.pubsub.PublishWithEvents(ctx, data, map[string][]string{
+		EventTypeKey: {EventRemovedTx},
+		TxHashKey:    {fmt.Sprintf("%X", Tx(data.Tx).Hash())},
+	})
+}
+
 // PublishEventTx publishes tx event with events from Result. Note it will add
 // predefined keys (EventTypeKey, TxHashKey). Existing events with the same keys
 // will be overwritten.
@@ -267,6 +288,18 @@ func (NopEventBus) PublishEventNewEvidence(EventDataNewEvidence) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventEvidenceAdded(EventDataEvidence) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventEvidenceCommitted(EventDataEvidence) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventEvidenceExpired(EventDataEvidence) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventVote(EventDataVote) error {
 	return nil
 }
@@ -275,6 +308,10 @@ func (NopEventBus) PublishEventPendingTx(EventDataPendingTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventRemovedTx(EventDataRemovedTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventTx(EventDataTx) error {
 	return nil
 }
@@ -52,6 +52,43 @@ func TestEventBusPublishEventPendingTx(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventRemovedTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	query := fmt.Sprintf("tm.event='RemovedTx' AND tx.hash='%X'", tx.Hash())
+	txsSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustCompile(query))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		msg := <-txsSub.Out()
+		edt := msg.Data().(EventDataRemovedTx)
+		assert.EqualValues(t, tx, edt.Tx)
+		assert.Equal(t, "expired_num_blocks", edt.Reason)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventRemovedTx(EventDataRemovedTx{
+		Tx:     tx,
+		Reason: "expired_num_blocks",
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a removed transaction after 1 sec.")
+	}
+}
+
 func TestEventBusPublishEventTx(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -20,7 +20,11 @@ const (
 	EventNewBlockHeader      = "NewBlockHeader"
 	EventNewBlockEvents      = "NewBlockEvents"
 	EventNewEvidence         = "NewEvidence"
+	EventEvidenceAdded       = "EvidenceAdded"
+	EventEvidenceCommitted   = "EvidenceCommitted"
+	EventEvidenceExpired     = "EvidenceExpired"
 	EventPendingTx           = "PendingTx"
+	EventRemovedTx           = "RemovedTx"
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
@@ -52,6 +56,8 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockHeader{}, "tendermint/event/NewBlockHeader")
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
+	cmtjson.RegisterType(EventDataEvidence{}, "tendermint/event/Evidence")
+	cmtjson.RegisterType(EventDataRemovedTx{}, "tendermint/event/RemovedTx")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
@@ -85,11 +91,26 @@ type EventDataNewEvidence struct {
 	Evidence Evidence `json:"evidence"`
 }
 
+// Evidence added to the evidence pool, committed, or expired before being
+// committed fires EventDataEvidence. Peer is the ID of the peer the evidence
+// was received from, empty if it was not received from a peer.
+type EventDataEvidence struct {
+	Evidence Evidence `json:"evidence"`
+	Peer     string   `json:"peer"`
+}
+
 // All txs fire EventDataPendingTx.
 type EventDataPendingTx struct {
 	Tx []byte `json:"tx"`
 }
 
+// Txs removed from the mempool without being committed fire
+// EventDataRemovedTx. Reason tells why the tx was removed.
+type EventDataRemovedTx struct {
+	Tx     []byte `json:"tx"`
+	Reason string `json:"reason"`
+}
+
 // All txs fire EventDataTx.
 type EventDataTx struct {
 	abci.TxResult
@@ -153,6 +174,9 @@ const (
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
+	EventQueryEvidenceAdded       = QueryForEvent(EventEvidenceAdded)
+	EventQueryEvidenceCommitted   = QueryForEvent(EventEvidenceCommitted)
+	EventQueryEvidenceExpired     = QueryForEvent(EventEvidenceExpired)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
@@ -160,6 +184,7 @@ var (
 	EventQueryNewEvidence         = QueryForEvent(EventNewEvidence)
 	EventQueryNewRound            = QueryForEvent(EventNewRound)
 	EventQueryNewRoundStep        = QueryForEvent(EventNewRoundStep)
+	EventQueryRemovedTx           = QueryForEvent(EventRemovedTx)
 	EventQueryPolka               = QueryForEvent(EventPolka)
 	EventQueryRelock              = QueryForEvent(EventRelock)
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutPropose)
@@ -192,3 +217,10 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(tx EventDataTx) error
 }
+
+// EvidenceEventPublisher publishes the events of the evidence pool.
+type EvidenceEventPublisher interface {
+	PublishEventEvidenceAdded(evidence EventDataEvidence) error
+	PublishEventEvidenceCommitted(evidence EventDataEvidence) error
+	PublishEventEvidenceExpired(evidence EventDataEvidence) error
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -92,6 +93,75 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 	}
 }
 
+func TestDuplicateProposalEvidence(t *testing.T) {
+	const height = int64(13)
+	ev, err := NewMockDuplicateProposalEvidence(height, cmttime.Now(), "mock-chain-id")
+	require.NoError(t, err)
+	assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+	assert.NotNil(t, ev.String())
+	assert.Equal(t, height, ev.Height())
+
+	abciEv := ev.ABCI()
+	require.Len(t, abciEv, 1)
+	assert.Equal(t, abci.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL, abciEv[0].Type)
+	assert.Equal(t, []byte(ev.ProposerAddress), abciEv[0].Validator.Address)
+	assert.Equal(t, height, abciEv[0].Height)
+}
+
+func TestDuplicateProposalEvidenceValidation(t *testing.T) {
+	val := NewMockPV()
+	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	const chainID = "mychain"
+
+	makeProposal := func(height int64, round int32, blockID BlockID) *Proposal {
+		proposal := NewProposal(height, round, -1, blockID, defaultVoteTime)
+		p := proposal.ToProto()
+		require.NoError(t, val.SignProposal(chainID, p))
+		proposal.Signature = p.Signature
+		return proposal
+	}
+
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*DuplicateProposalEvidence)
+		expectErr        bool
+	}{
+		{"Good DuplicateProposalEvidence", func(_ *DuplicateProposalEvidence) {}, false},
+		{"Nil proposal A", func(ev *DuplicateProposalEvidence) { ev.ProposalA = nil }, true},
+		{"Nil proposal B", func(ev *DuplicateProposalEvidence) { ev.ProposalB = nil }, true},
+		{"Invalid proposal", func(ev *DuplicateProposalEvidence) { ev.ProposalA.Signature = nil }, true},
+		{"Different heights", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalB = makeProposal(ev.ProposalA.Height+1, ev.ProposalA.Round, ev.ProposalB.BlockID)
+		}, true},
+		{"Different rounds", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalB = makeProposal(ev.ProposalA.Height, ev.ProposalA.Round+1, ev.ProposalB.BlockID)
+		}, true},
+		{"Same block IDs", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalB = makeProposal(ev.ProposalA.Height, ev.ProposalA.Round, ev.ProposalA.BlockID)
+		}, true},
+		{"Invalid proposer address", func(ev *DuplicateProposalEvidence) { ev.ProposerAddress = []byte("addr") }, true},
+		{"Invalid proposal order", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalA, ev.ProposalB = ev.ProposalB, ev.ProposalA
+		}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			proposal1 := makeProposal(math.MaxInt64, 1, blockID)
+			proposal2 := makeProposal(math.MaxInt64, 1, blockID2)
+			valSet := NewValidatorSet([]*Validator{val.ExtractIntoValidator(10)})
+			ev, err := NewDuplicateProposalEvidence(proposal1, proposal2, val.PrivKey.PubKey().Address(), defaultVoteTime, valSet)
+			require.NoError(t, err)
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+
+	_, err := NewDuplicateProposalEvidence(makeProposal(1, 0, blockID), makeProposal(1, 0, blockID2),
+		crypto.AddressHash([]byte("other")), defaultVoteTime, NewValidatorSet([]*Validator{val.ExtractIntoValidator(10)}))
+	require.Error(t, err)
+}
+
 func TestLightClientAttackEvidenceBasic(t *testing.T) {
 	height := int64(5)
 	commonHeight := height - 1
@@ -232,6 +302,10 @@ func TestMockEvidenceValidateBasic(t *testing.T) {
 	goodEvidence, err := NewMockDuplicateVoteEvidence(int64(1), cmttime.Now(), "mock-chain-id")
 	require.NoError(t, err)
 	require.NoError(t, goodEvidence.ValidateBasic())
+
+	goodProposalEvidence, err := NewMockDuplicateProposalEvidence(int64(1), cmttime.Now(), "mock-chain-id")
+	require.NoError(t, err)
+	require.NoError(t, goodProposalEvidence.ValidateBasic())
 }
 
 func makeHeaderRandom() *Header {
@@ -262,9 +336,12 @@ func TestEvidenceProto(t *testing.T) {
 	v := MakeVoteNoError(t, val, chainID, math.MaxInt32, math.MaxInt64, 1, 0x01, blockID, defaultVoteTime)
 	v2 := MakeVoteNoError(t, val, chainID, math.MaxInt32, math.MaxInt64, 2, 0x01, blockID2, defaultVoteTime)
 
-	// -------- SignedHeaders --------
+	// -------- Proposals --------
 	const height int64 = 37
+	dpe, err := NewMockDuplicateProposalEvidenceWithValidator(height, defaultVoteTime, val, chainID)
+	require.NoError(t, err)
 
+	// -------- SignedHeaders --------
 	var (
 		header1 = makeHeaderRandom()
 		header2 = makeHeaderRandom()
@@ -289,6 +366,9 @@ func TestEvidenceProto(t *testing.T) {
 		{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 		{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 		{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+		{"DuplicateProposalEvidence empty fail", &DuplicateProposalEvidence{}, false, true},
+		{"DuplicateProposalEvidence nil proposalB", &DuplicateProposalEvidence{ProposalA: dpe.ProposalA}, false, true},
+		{"DuplicateProposalEvidence success", dpe, false, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -319,6 +399,15 @@ func TestDuplicateVoteEvidenceJSON(t *testing.T) {
 	assert.Equal(t, wantJSON, string(js))
 }
 
+func TestDuplicateProposalEvidenceJSON(t *testing.T) {
+	var evidence DuplicateProposalEvidence
+	js, err := cmtjson.Marshal(evidence)
+	require.NoError(t, err)
+
+	wantJSON := `{"type":"tendermint/DuplicateProposalEvidence","value":{"proposal_a":null,"proposal_b":null,"proposer_address":"","total_voting_power":"0","validator_power":"0","timestamp":"0001-01-01T00:00:00Z"}}`
+	assert.Equal(t, wantJSON, string(js))
+}
+
 // Test that the new JSON tags are picked up correctly, see issue #3528.
 func TestLightClientAttackEvidenceJSON(t *testing.T) {
 	var evidence LightClientAttackEvidence
@@ -100,6 +100,7 @@ type VersionParams struct {
 type FeatureParams struct {
 	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
 	PbtsEnableHeight           int64 `json:"pbts_enable_height"`
+	ErasureCodingEnableHeight  int64 `json:"erasure_coding_enable_height"`
 }
 
 // VoteExtensionsEnabled returns true if vote extensions are enabled at height h
@@ -117,6 +118,14 @@ func (p FeatureParams) PbtsEnabled(h int64) bool {
 	return featureEnabled(enabledHeight, h, "PBTS")
 }
 
+// ErasureCodingEnabled returns true if the block parts are erasure-coded at
+// height h and false otherwise.
+func (p FeatureParams) ErasureCodingEnabled(h int64) bool {
+	enabledHeight := p.ErasureCodingEnableHeight
+
+	return featureEnabled(enabledHeight, h, "Erasure Coding")
+}
+
 // featureEnabled returns true if `enabledHeight` points to a height that is smaller than `currentHeight“.
 func featureEnabled(enableHeight int64, currentHeight int64, f string) bool {
 	if currentHeight < 1 {
@@ -219,6 +228,7 @@ func DefaultFeatureParams() FeatureParams {
 	return FeatureParams{
 		VoteExtensionsEnableHeight: 0,
 		PbtsEnableHeight:           0,
+		ErasureCodingEnableHeight:  0,
 	}
 }
 
@@ -296,6 +306,10 @@ func (params ConsensusParams) ValidateBasic() error {
 		return fmt.Errorf("Feature.PbtsEnableHeight cannot be negative. Got: %d", params.Feature.PbtsEnableHeight)
 	}
 
+	if params.Feature.ErasureCodingEnableHeight < 0 {
+		return fmt.Errorf("Feature.ErasureCodingEnableHeight cannot be negative. Got: %d", params.Feature.ErasureCodingEnableHeight)
+	}
+
 	// Synchrony params are only relevant when PBTS is enabled
 	if params.Feature.PbtsEnableHeight > 0 {
 		if params.Synchrony.MessageDelay <= 0 {
@@ -345,7 +359,7 @@ func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams,
 	return err
 }
 
-// validateUpdateFeatures validates the updated PBTSEnableHeight.
+// validateUpdateFeatures validates the updated feature enable heights.
 // | r | params...EnableHeight | updated...EnableHeight | result (nil == pass)
 // |  2 | *                    | < 0                    | EnableHeight must be positive
 // |  3 | <=0                  | 0                      | nil
@@ -371,6 +385,13 @@ func validateUpdateFeatures(params FeatureParams, updated cmtproto.FeatureParams
 			return err
 		}
 	}
+
+	if updated.ErasureCodingEnableHeight != nil {
+		err := validateUpdateFeatureEnableHeight(params.ErasureCodingEnableHeight, updated.ErasureCodingEnableHeight.Value, h, "Erasure Coding")
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -477,6 +498,10 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		if params2.Feature.PbtsEnableHeight != nil {
 			res.Feature.PbtsEnableHeight = params2.Feature.GetPbtsEnableHeight().Value
 		}
+
+		if params2.Feature.ErasureCodingEnableHeight != nil {
+			res.Feature.ErasureCodingEnableHeight = params2.Feature.GetErasureCodingEnableHeight().Value
+		}
 	}
 	if params2.Synchrony != nil {
 		if params2.Synchrony.MessageDelay != nil {
@@ -510,6 +535,7 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Feature: &cmtproto.FeatureParams{
 			PbtsEnableHeight:           &gogo.Int64Value{Value: params.Feature.PbtsEnableHeight},
 			VoteExtensionsEnableHeight: &gogo.Int64Value{Value: params.Feature.VoteExtensionsEnableHeight},
+			ErasureCodingEnableHeight:  &gogo.Int64Value{Value: params.Feature.ErasureCodingEnableHeight},
 		},
 		Synchrony: &cmtproto.SynchronyParams{
 			MessageDelay: &params.Synchrony.MessageDelay,
@@ -538,6 +564,7 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 		Feature: FeatureParams{
 			VoteExtensionsEnableHeight: pbParams.GetFeature().GetVoteExtensionsEnableHeight().GetValue(),
 			PbtsEnableHeight:           pbParams.GetFeature().GetPbtsEnableHeight().GetValue(),
+			ErasureCodingEnableHeight:  pbParams.GetFeature().GetErasureCodingEnableHeight().GetValue(),
 		},
 	}
 	if pbParams.GetSynchrony().GetMessageDelay() != nil {
@@ -28,6 +28,7 @@ type makeParamsArgs struct {
 	pubkeyTypes         []string
 	voteExtensionHeight int64
 	pbtsHeight          int64
+	erasureCodingHeight int64
 	precision           time.Duration
 	messageDelay        time.Duration
 }
@@ -53,6 +54,7 @@ func makeParams(args makeParamsArgs) ConsensusParams {
 		Feature: FeatureParams{
 			VoteExtensionsEnableHeight: args.voteExtensionHeight,
 			PbtsEnableHeight:           args.pbtsHeight,
+			ErasureCodingEnableHeight:  args.erasureCodingHeight,
 		},
 	}
 }
@@ -376,6 +378,27 @@ func TestConsensusParamsValidation(t *testing.T) {
 				}),
 			valid: true,
 		},
+		// erasure coding enable height
+		{
+			name: "erasure coding height -1",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:          1,
+					evidenceAge:         2,
+					erasureCodingHeight: -1,
+				}),
+			valid: false,
+		},
+		{
+			name: "erasure coding from height 100",
+			params: makeParams(
+				makeParamsArgs{
+					blockBytes:          1,
+					evidenceAge:         2,
+					erasureCodingHeight: 100,
+				}),
+			valid: true,
+		},
 	}
 	for _, tc := range testCases {
 		if tc.params.Validator.PubKeyTypes == nil {
@@ -679,6 +702,28 @@ func TestConsensusParamsUpdate_EnableHeight(t *testing.T) {
 		})
 	}
 
+	// Test erasure coding enabling
+	for _, tc := range testCases {
+		t.Run(tc.name+" Erasure Coding", func(*testing.T) {
+			initialParams := makeParams(makeParamsArgs{
+				erasureCodingHeight: tc.from,
+			})
+			update := &cmtproto.ConsensusParams{Feature: &cmtproto.FeatureParams{}}
+			if tc.to == nilTest {
+				update.Feature.ErasureCodingEnableHeight = nil
+			} else {
+				update.Feature = &cmtproto.FeatureParams{
+					ErasureCodingEnableHeight: &types.Int64Value{Value: tc.to},
+				}
+			}
+			if tc.expectedErr {
+				require.Error(t, initialParams.ValidateUpdate(update, tc.current))
+			} else {
+				require.NoError(t, initialParams.ValidateUpdate(update, tc.current))
+			}
+		})
+	}
+
 	// Test PBTS and VE enabling
 	for _, tc := range testCases {
 		t.Run(tc.name+"VE PBTS", func(*testing.T) {
@@ -727,6 +772,7 @@ func consensusParamsForTestProto() []ConsensusParams {
 		makeParams(makeParamsArgs{pbtsHeight: 100}),
 		makeParams(makeParamsArgs{voteExtensionHeight: 100, pbtsHeight: 42}),
 		makeParams(makeParamsArgs{pbtsHeight: 100}),
+		makeParams(makeParamsArgs{erasureCodingHeight: 100}),
 	}
 }
 
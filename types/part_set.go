@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/internal/bits"
+	"github.com/cometbft/cometbft/internal/reedsolomon"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -20,8 +22,13 @@ var (
 	ErrPartSetInvalidProof    = errors.New("error part set invalid proof")
 	ErrPartTooBig             = errors.New("error part size too big")
 	ErrPartInvalidSize        = errors.New("error inner part with invalid size")
+	ErrPartSetInvalidEncoding = errors.New("error part set invalid erasure coding")
 )
 
+// ErasureCodingPrefixSize is the size of the length of the data, which
+// prefixes it in the data parts of an erasure-coded part set.
+const ErasureCodingPrefixSize = 8
+
 // ErrInvalidPart is an error type for invalid parts.
 type ErrInvalidPart struct {
 	Reason error
@@ -116,22 +123,39 @@ func PartFromProto(pb *cmtproto.Part) (*Part, error) {
 type PartSetHeader struct {
 	Total uint32            `json:"total"`
 	Hash  cmtbytes.HexBytes `json:"hash"`
+	// Parity is the number of parity parts, the last of the Total parts, of an
+	// erasure-coded part set.
+	Parity uint32 `json:"parity,omitempty"`
 }
 
 // String returns a string representation of PartSetHeader.
 //
 // 1. total number of parts
-// 2. first 6 bytes of the hash.
+// 2. number of parity parts, if erasure-coded
+// 3. first 6 bytes of the hash.
 func (psh PartSetHeader) String() string {
+	if psh.IsErasureCoded() {
+		return fmt.Sprintf("%v(%v parity):%X", psh.Total, psh.Parity, cmtbytes.Fingerprint(psh.Hash))
+	}
 	return fmt.Sprintf("%v:%X", psh.Total, cmtbytes.Fingerprint(psh.Hash))
 }
 
 func (psh PartSetHeader) IsZero() bool {
-	return psh.Total == 0 && len(psh.Hash) == 0
+	return psh.Total == 0 && len(psh.Hash) == 0 && psh.Parity == 0
 }
 
 func (psh PartSetHeader) Equals(other PartSetHeader) bool {
-	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash)
+	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash) && psh.Parity == other.Parity
+}
+
+// IsErasureCoded returns true if the parts are extended with parity parts.
+func (psh PartSetHeader) IsErasureCoded() bool {
+	return psh.Parity > 0
+}
+
+// DataTotal returns the number of parts which are not parity parts.
+func (psh PartSetHeader) DataTotal() uint32 {
+	return psh.Total - psh.Parity
 }
 
 // ValidateBasic performs basic validation.
@@ -140,6 +164,9 @@ func (psh PartSetHeader) ValidateBasic() error {
 	if err := ValidateHash(psh.Hash); err != nil {
 		return fmt.Errorf("wrong Hash: %w", err)
 	}
+	if psh.IsErasureCoded() && (psh.Parity >= psh.Total || ParityTotal(psh.DataTotal()) != psh.Parity) {
+		return fmt.Errorf("wrong Parity: %d parity parts for %d parts", psh.Parity, psh.Total)
+	}
 	return nil
 }
 
@@ -150,8 +177,9 @@ func (psh *PartSetHeader) ToProto() cmtproto.PartSetHeader {
 	}
 
 	return cmtproto.PartSetHeader{
-		Total: psh.Total,
-		Hash:  psh.Hash,
+		Total:  psh.Total,
+		Hash:   psh.Hash,
+		Parity: psh.Parity,
 	}
 }
 
@@ -163,6 +191,7 @@ func PartSetHeaderFromProto(ppsh *cmtproto.PartSetHeader) (*PartSetHeader, error
 	psh := new(PartSetHeader)
 	psh.Total = ppsh.Total
 	psh.Hash = ppsh.Hash
+	psh.Parity = ppsh.Parity
 
 	return psh, psh.ValidateBasic()
 }
@@ -170,21 +199,23 @@ func PartSetHeaderFromProto(ppsh *cmtproto.PartSetHeader) (*PartSetHeader, error
 // ProtoPartSetHeaderIsZero is similar to the IsZero function for
 // PartSetHeader, but for the Protobuf representation.
 func ProtoPartSetHeaderIsZero(ppsh *cmtproto.PartSetHeader) bool {
-	return ppsh.Total == 0 && len(ppsh.Hash) == 0
+	return ppsh.Total == 0 && len(ppsh.Hash) == 0 && ppsh.Parity == 0
 }
 
 // -------------------------------------
 
 type PartSet struct {
-	total uint32
-	hash  []byte
+	total  uint32
+	parity uint32
+	hash   []byte
 
 	mtx           cmtsync.Mutex
 	parts         []*Part
 	partsBitArray *bits.BitArray
 	count         uint32
 	// a count of the total size (in bytes). Used to ensure that the
-	// part set doesn't exceed the maximum block bytes
+	// part set doesn't exceed the maximum block bytes. Once complete, the
+	// size of the data of an erasure-coded part set.
 	byteSize int64
 
 	// Workaround to prevent the consensus Reactor from reading from an
@@ -208,6 +239,51 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 		parts[i] = part
 		partsBytes[i] = part.Bytes
 	}
+	return newFullPartSet(parts, partsBytes, 0, int64(len(data)))
+}
+
+// NewErasureCodedPartSetFromData returns an immutable, full PartSet from the
+// data bytes, extended with Reed-Solomon parity parts: any of the parts, as
+// many as the data parts, suffice to reconstruct the data.
+//
+// The data prefixed with its length is split into "partSize" chunks, the last
+// one padded with zeros, which are extended with as many parity parts, within
+// the limit of reedsolomon.MaxShards parts. Data which fits in a single part,
+// or which can't be extended, is split as by NewPartSetFromData.
+// CONTRACT: partSize is greater than zero.
+func NewErasureCodedPartSetFromData(data []byte, partSize uint32) (*PartSet, error) {
+	dataTotal := (uint32(len(data)) + ErasureCodingPrefixSize + partSize - 1) / partSize
+	parity := ParityTotal(dataTotal)
+	if parity == 0 {
+		return NewPartSetFromData(data, partSize), nil
+	}
+
+	buf := make([]byte, dataTotal*partSize)
+	binary.BigEndian.PutUint64(buf, uint64(len(data)))
+	copy(buf[ErasureCodingPrefixSize:], data)
+	partsBytes := make([][]byte, dataTotal+parity)
+	for i := uint32(0); i < dataTotal; i++ {
+		partsBytes[i] = buf[i*partSize : (i+1)*partSize]
+	}
+	enc, err := reedsolomon.New(int(dataTotal), int(parity))
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(partsBytes); err != nil {
+		return nil, err
+	}
+
+	parts := make([]*Part, len(partsBytes))
+	for i, bz := range partsBytes {
+		parts[i] = &Part{Index: uint32(i), Bytes: bz}
+	}
+	return newFullPartSet(parts, partsBytes, parity, int64(len(data))), nil
+}
+
+// newFullPartSet returns a full PartSet of the given parts, computing their
+// merkle proofs.
+func newFullPartSet(parts []*Part, partsBytes [][]byte, parity uint32, byteSize int64) *PartSet {
+	total := uint32(len(parts))
 	// Compute merkle proofs
 	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
 	for i := uint32(0); i < total; i++ {
@@ -216,18 +292,56 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	partsBitArray := bits.NewBitArrayFromFn(int(total), func(int) bool { return true })
 	return &PartSet{
 		total:         total,
+		parity:        parity,
 		hash:          root,
 		parts:         parts,
 		partsBitArray: partsBitArray,
 		count:         total,
-		byteSize:      int64(len(data)),
+		byteSize:      byteSize,
+	}
+}
+
+// ParityTotal returns the number of parity parts extending dataTotal data
+// parts in an erasure-coded part set: as many as the data parts, within the
+// limit of reedsolomon.MaxShards parts, and none for a single data part.
+func ParityTotal(dataTotal uint32) uint32 {
+	if dataTotal < 2 || dataTotal >= reedsolomon.MaxShards {
+		return 0
+	}
+	if dataTotal > reedsolomon.MaxShards/2 {
+		return reedsolomon.MaxShards - dataTotal
+	}
+	return dataTotal
+}
+
+// ErasureCodedData returns the data encoded in the concatenated data parts of
+// an erasure-coded part set.
+func ErasureCodedData(bz []byte) ([]byte, error) {
+	size, err := erasureCodedDataSize(bz, int64(len(bz)))
+	if err != nil {
+		return nil, err
+	}
+	return bz[ErasureCodingPrefixSize : ErasureCodingPrefixSize+size], nil
+}
+
+// erasureCodedDataSize returns the size of the data prefixed by its length in
+// the given number of bytes.
+func erasureCodedDataSize(prefix []byte, bytesTotal int64) (int64, error) {
+	if len(prefix) < ErasureCodingPrefixSize {
+		return 0, ErrPartSetInvalidEncoding
+	}
+	size := binary.BigEndian.Uint64(prefix)
+	if size > uint64(bytesTotal-ErasureCodingPrefixSize) {
+		return 0, fmt.Errorf("%w: data size %d exceeds the %d bytes of the parts", ErrPartSetInvalidEncoding, size, bytesTotal)
 	}
+	return int64(size), nil
 }
 
 // NewPartSetFromHeader returns an empty PartSet ready to be populated.
 func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	return &PartSet{
 		total:         header.Total,
+		parity:        header.Parity,
 		hash:          header.Hash,
 		parts:         make([]*Part, header.Total),
 		partsBitArray: bits.NewBitArray(int(header.Total)),
@@ -241,8 +355,9 @@ func (ps *PartSet) Header() PartSetHeader {
 		return PartSetHeader{}
 	}
 	return PartSetHeader{
-		Total: ps.total,
-		Hash:  ps.hash,
+		Total:  ps.total,
+		Hash:   ps.hash,
+		Parity: ps.parity,
 	}
 }
 
@@ -294,6 +409,11 @@ func (ps *PartSet) Total() uint32 {
 	return ps.total
 }
 
+// AddPart adds a part to the set. An erasure-coded part set is completed as
+// soon as it has as many parts as the data parts, the others being
+// reconstructed from them. If they are not the Reed-Solomon encoding of the
+// data parts, the part is added but ErrPartSetInvalidEncoding is returned and
+// the part set is never completed.
 // CONTRACT: part is validated using ValidateBasic.
 func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	// TODO: remove this? would be preferable if this only returned (false, nil)
@@ -315,6 +435,12 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 		return false, nil
 	}
 
+	// The parts of an erasure-coded part set not completed by as many parts
+	// as the data parts were not encoded from them.
+	if ps.parity > 0 && ps.count >= ps.total-ps.parity {
+		return false, ErrPartSetInvalidEncoding
+	}
+
 	// The proof should be compatible with the number of parts.
 	if part.Proof.Total != int64(ps.total) {
 		return false, ErrPartSetInvalidProof
@@ -330,9 +456,65 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
 	ps.byteSize += int64(len(part.Bytes))
+
+	if ps.parity > 0 && ps.count == ps.total-ps.parity {
+		if err := ps.reconstruct(); err != nil {
+			return true, err
+		}
+	}
 	return true, nil
 }
 
+// reconstruct decodes the missing parts of an erasure-coded part set which
+// has as many parts as the data parts.
+func (ps *PartSet) reconstruct() error {
+	dataTotal := ps.total - ps.parity
+	partsBytes := make([][]byte, ps.total)
+	for i, part := range ps.parts {
+		if part != nil {
+			partsBytes[i] = part.Bytes
+		}
+	}
+	enc, err := reedsolomon.New(int(dataTotal), int(ps.parity))
+	if err != nil {
+		return err
+	}
+	if err := enc.Reconstruct(partsBytes); err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidEncoding, err)
+	}
+
+	// Unless the parts are the encoding of the data parts, the reconstructed
+	// parts differ from the original ones, and depend on the parts received.
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	if !bytes.Equal(root, ps.hash) {
+		return fmt.Errorf("%w: reconstructed parts hash to %X", ErrPartSetInvalidEncoding, root)
+	}
+	partSize := int64(len(partsBytes[0]))
+	var prefix []byte
+	for i := 0; len(prefix) < ErasureCodingPrefixSize && i < int(dataTotal); i++ {
+		prefix = append(prefix, partsBytes[i]...)
+	}
+	size, err := erasureCodedDataSize(prefix, int64(dataTotal)*partSize)
+	if err != nil {
+		return err
+	}
+	// The data must need all the data parts, as when split by
+	// NewErasureCodedPartSetFromData.
+	if size+ErasureCodingPrefixSize <= int64(dataTotal-1)*partSize {
+		return fmt.Errorf("%w: data size %d doesn't need %d parts", ErrPartSetInvalidEncoding, size, dataTotal)
+	}
+
+	for i, part := range ps.parts {
+		if part == nil {
+			ps.parts[i] = &Part{Index: uint32(i), Bytes: partsBytes[i], Proof: *proofs[i]}
+			ps.partsBitArray.SetIndex(i, true)
+		}
+	}
+	ps.count = ps.total
+	ps.byteSize = size
+	return nil
+}
+
 func (ps *PartSet) GetPart(index int) *Part {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
@@ -347,7 +529,15 @@ func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
-	return NewPartSetReader(ps.parts)
+	if ps.parity == 0 {
+		return NewPartSetReader(ps.parts)
+	}
+	// Strip the length prefix and the padding of the data.
+	r := NewPartSetReader(ps.parts[:ps.total-ps.parity])
+	if _, err := io.CopyN(io.Discard, r, ErasureCodingPrefixSize); err != nil {
+		panic(err)
+	}
+	return io.LimitReader(r, ps.byteSize)
 }
 
 func (ps *PartSet) IsLocked() bool {
@@ -76,6 +76,101 @@ func TestBasicPartSet(t *testing.T) {
 	assert.False(t, partSet2.IsLocked())
 }
 
+func TestErasureCodedPartSet(t *testing.T) {
+	// Data which doesn't fit in a single part with its length.
+	data := cmtrand.Bytes(testPartSize*10 - 1)
+	partSet, err := NewErasureCodedPartSetFromData(data, testPartSize)
+	require.NoError(t, err)
+
+	header := partSet.Header()
+	require.NoError(t, header.ValidateBasic())
+	assert.EqualValues(t, 22, header.Total)
+	assert.EqualValues(t, 11, header.Parity)
+	assert.EqualValues(t, len(data), partSet.ByteSize())
+	for i := 0; i < int(header.Total); i++ {
+		require.NoError(t, partSet.GetPart(i).ValidateBasic())
+	}
+	read, err := io.ReadAll(partSet.GetReader())
+	require.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	// Any parts, as many as the data parts, suffice.
+	partSet2 := NewPartSetFromHeader(header)
+	for i, index := range cmtrand.Perm(int(header.Total))[:header.DataTotal()] {
+		assert.False(t, partSet2.IsComplete())
+		added, err := partSet2.AddPart(partSet.GetPart(index))
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.EqualValues(t, i+1 == int(header.DataTotal()), partSet2.IsComplete())
+	}
+	assert.EqualValues(t, header.Total, partSet2.Count())
+	assert.EqualValues(t, len(data), partSet2.ByteSize())
+	for i := 0; i < int(header.Total); i++ {
+		assert.Equal(t, partSet.GetPart(i), partSet2.GetPart(i))
+	}
+	read, err = io.ReadAll(partSet2.GetReader())
+	require.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	// The data parts hold the data after its length.
+	var bz []byte
+	for i := 0; i < int(header.DataTotal()); i++ {
+		bz = append(bz, partSet.GetPart(i).Bytes...)
+	}
+	read, err = ErasureCodedData(bz)
+	require.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	// Data which fits in a single part is not erasure-coded.
+	partSet, err = NewErasureCodedPartSetFromData(data[:testPartSize-ErasureCodingPrefixSize], testPartSize)
+	require.NoError(t, err)
+	assert.Equal(t, NewPartSetFromData(data[:testPartSize-ErasureCodingPrefixSize], testPartSize).Header(), partSet.Header())
+}
+
+func TestErasureCodedPartSetInvalidEncoding(t *testing.T) {
+	partSet, err := NewErasureCodedPartSetFromData(cmtrand.Bytes(testPartSize*3), testPartSize)
+	require.NoError(t, err)
+	header := partSet.Header()
+	require.EqualValues(t, 4, header.Parity)
+
+	// Parts which are not the encoding of the data parts, with valid proofs.
+	partsBytes := make([][]byte, header.Total)
+	for i := range partsBytes {
+		partsBytes[i] = partSet.GetPart(i).Bytes
+	}
+	partsBytes[header.Total-1] = cmtrand.Bytes(testPartSize)
+	parts := make([]*Part, header.Total)
+	for i, bz := range partsBytes {
+		parts[i] = &Part{Index: uint32(i), Bytes: bz}
+	}
+	invalid := newFullPartSet(parts, partsBytes, header.Parity, 0)
+	header = invalid.Header()
+
+	// Reconstructed from the data parts, the parity parts differ.
+	partSet2 := NewPartSetFromHeader(header)
+	for i := 0; i < int(header.DataTotal()); i++ {
+		added, err := partSet2.AddPart(invalid.GetPart(i))
+		assert.True(t, added)
+		if i < int(header.DataTotal())-1 {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, ErrPartSetInvalidEncoding)
+		}
+	}
+	assert.False(t, partSet2.IsComplete())
+	added, err := partSet2.AddPart(invalid.GetPart(int(header.Total) - 1))
+	assert.False(t, added)
+	require.ErrorIs(t, err, ErrPartSetInvalidEncoding)
+
+	// Reconstructed with the invalid part, the other parts differ.
+	partSet2 = NewPartSetFromHeader(header)
+	for i := int(header.Total) - 1; i >= int(header.Parity); i-- {
+		_, err = partSet2.AddPart(invalid.GetPart(i))
+	}
+	require.ErrorIs(t, err, ErrPartSetInvalidEncoding)
+	assert.False(t, partSet2.IsComplete())
+}
+
 func TestWrongProof(t *testing.T) {
 	// Construct random data of size partSize * 100
 	data := cmtrand.Bytes(testPartSize * 100)
@@ -125,6 +220,9 @@ func TestPartSetHeaderValidateBasic(t *testing.T) {
 	}{
 		{"Good PartSet", func(_ *PartSetHeader) {}, false},
 		{"Invalid Hash", func(psHeader *PartSetHeader) { psHeader.Hash = make([]byte, 1) }, true},
+		{"Good Parity", func(psHeader *PartSetHeader) { psHeader.Parity = 50 }, false},
+		{"Invalid Parity", func(psHeader *PartSetHeader) { psHeader.Parity = 3 }, true},
+		{"Only Parity", func(psHeader *PartSetHeader) { psHeader.Parity = psHeader.Total }, true},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(t *testing.T) {
@@ -192,6 +290,10 @@ func TestParSetHeaderProtoBuf(t *testing.T) {
 			"success",
 			&PartSetHeader{Total: 1, Hash: []byte("hash")}, true,
 		},
+		{
+			"success erasure-coded",
+			&PartSetHeader{Total: 4, Hash: []byte("hash"), Parity: 2}, true,
+		},
 	}
 
 	for _, tc := range testCases {
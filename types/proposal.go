@@ -14,6 +14,7 @@ import (
 var (
 	ErrInvalidBlockPartSignature = errors.New("error invalid block part signature")
 	ErrInvalidBlockPartHash      = errors.New("error invalid block part hash")
+	ErrInvalidProposalSignature  = errors.New("error invalid proposal signature")
 )
 
 // Proposal defines a block proposal for the consensus.
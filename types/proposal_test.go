@@ -69,7 +69,7 @@ func TestProposalVerifySignature(t *testing.T) {
 
 	prop := NewProposal(
 		4, 2, 1,
-		BlockID{cmtrand.Bytes(tmhash.Size), PartSetHeader{777, cmtrand.Bytes(tmhash.Size)}}, cmttime.Now())
+		BlockID{cmtrand.Bytes(tmhash.Size), PartSetHeader{Total: 777, Hash: cmtrand.Bytes(tmhash.Size)}}, cmttime.Now())
 	p := prop.ToProto()
 	signBytes := ProposalSignBytes("test_chain_id", p)
 
@@ -157,7 +157,7 @@ func TestProposalValidateBasic(t *testing.T) {
 		{"Invalid POLRound", func(p *Proposal) { p.POLRound = -2 }, true},
 		{"POLRound == Round", func(p *Proposal) { p.POLRound = p.Round }, true},
 		{"Invalid BlockId", func(p *Proposal) {
-			p.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{111, []byte("blockparts")}}
+			p.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{Total: 111, Hash: []byte("blockparts")}}
 		}, true},
 		{"Invalid Signature", func(p *Proposal) {
 			p.Signature = make([]byte, 0)
@@ -53,8 +53,9 @@ func (tm2pb) BlockID(blockID BlockID) cmtproto.BlockID {
 
 func (tm2pb) PartSetHeader(header PartSetHeader) cmtproto.PartSetHeader {
 	return cmtproto.PartSetHeader{
-		Total: header.Total,
-		Hash:  header.Hash,
+		Total:  header.Total,
+		Hash:   header.Hash,
+		Parity: header.Parity,
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/batch"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmterrors "github.com/cometbft/cometbft/types/errors"
@@ -45,6 +46,11 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// only count the signatures that are for the block
 	count := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagCommit }
 
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit,
+			votingPowerNeeded, ignore, count, true, nil)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -126,6 +132,11 @@ func verifyCommitLightInternal(
 	// count all the remaining signatures
 	count := func(_ CommitSig) bool { return true }
 
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit,
+			votingPowerNeeded, ignore, count, true, verifiedSignatureCache)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -229,6 +240,15 @@ func verifyCommitLightTrustingInternal(
 	// count all the remaining signatures
 	count := func(_ CommitSig) bool { return true }
 
+	// As the validator set doesn't necessarily correspond with the validator
+	// set that signed the block, the aggregated signature can only be verified
+	// if all its signers are in the validator set, or if its verification with
+	// the validator set that signed the block is cached.
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit,
+			votingPowerNeeded, ignore, count, false, verifiedSignatureCache)
+	}
+
 	// attempt to batch verify commit. As the validator set doesn't necessarily
 	// correspond with the validator set that signed the block we need to look
 	// up by address rather than index.
@@ -403,6 +423,105 @@ func verifyCommitBatch(
 	return errors.New("BUG: batch verification failed with no invalid signatures")
 }
 
+// Aggregated Verification
+
+// verifyCommitAggregated verifies commits whose signatures are aggregated.
+// Unlike verifyCommitBatch and verifyCommitSingle, it always verifies the
+// signatures of all the validators that signed the commit, as they can only be
+// verified together: ignoreSig only excludes signatures from the tally.
+//
+// The aggregated signature is cached with a digest of the votes it signs, so
+// that a cache hit lets the votes be tallied with a validator set missing
+// some of the signers.
+func verifyCommitAggregated(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	lookUpByIndex bool,
+	verifiedSignatureCache SignatureCache,
+) error {
+	var (
+		val                *Validator
+		valIdx             int32
+		seenVals           = make(map[int32]int, len(commit.Signatures))
+		pubKeys            = make([]crypto.PubKey, 0, len(commit.Signatures))
+		voteSignBytes      = make([][]byte, 0, len(commit.Signatures))
+		digest             = tmhash.New()
+		unknownSigner      = -1
+		talliedVotingPower int64
+	)
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+
+		signBytes := commit.VoteSignBytes(chainID, int32(idx))
+		voteSignBytes = append(voteSignBytes, signBytes)
+		digest.Write(commitSig.ValidatorAddress)
+		digest.Write(signBytes)
+
+		// If the vals and commit have a 1-to-1 correspondence we can retrieve
+		// them by index else we need to retrieve them by address
+		if lookUpByIndex {
+			val = vals.Validators[idx]
+		} else {
+			valIdx, val = vals.GetByAddressMut(commitSig.ValidatorAddress)
+
+			// if the signature doesn't belong to anyone in the validator set
+			// then it is not tallied, but it can't be verified either
+			if val == nil {
+				if unknownSigner < 0 {
+					unknownSigner = idx
+				}
+				continue
+			}
+
+			// because we are getting validators by address we need to make sure
+			// that the same validator doesn't commit twice
+			if firstIndex, ok := seenVals[valIdx]; ok {
+				secondIndex := idx
+				return fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
+			}
+			seenVals[valIdx] = idx
+		}
+		pubKeys = append(pubKeys, val.PubKey)
+
+		// If this signature counts then add the voting power of the validator
+		// to the tally
+		if !ignoreSig(commitSig) && countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+	}
+
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	cacheKey, cacheValue := string(commit.AggregatedSignature), SignatureCacheValue{VoteSignBytes: digest.Sum(nil)}
+	if verifiedSignatureCache != nil {
+		cached, ok := verifiedSignatureCache.Get(cacheKey)
+		if ok && cached.ValidatorAddress == nil && bytes.Equal(cached.VoteSignBytes, cacheValue.VoteSignBytes) {
+			return nil
+		}
+	}
+
+	if unknownSigner >= 0 {
+		return fmt.Errorf("cannot verify the aggregated signature: signer %X (#%d) is not in the validator set",
+			commit.Signatures[unknownSigner].ValidatorAddress, unknownSigner)
+	}
+	if !bls12381.VerifyAggregateSignature(commit.AggregatedSignature, pubKeys, voteSignBytes, commit.BlockID.Hash) {
+		return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	if verifiedSignatureCache != nil {
+		verifiedSignatureCache.Add(cacheKey, cacheValue)
+	}
+
+	return nil
+}
+
 // Single Verification
 
 // verifyCommitSingle single verifies commits.
@@ -846,6 +846,20 @@ func (vals *ValidatorSet) AllKeysHaveSameType() bool {
 	return vals.allKeysHaveSameType
 }
 
+// AllKeysHaveType returns true if the set is not empty and all validators
+// have a public key of the given type.
+func (vals *ValidatorSet) AllKeysHaveType(keyType string) bool {
+	if vals.IsNilOrEmpty() || !vals.allKeysHaveSameType {
+		return false
+	}
+	for _, val := range vals.Validators {
+		if val.PubKey == nil || val.PubKey.Type() != keyType {
+			return false
+		}
+	}
+	return true
+}
+
 // -----------------
 
 // IsErrNotEnoughVotingPowerSigned returns true if err is
@@ -72,6 +72,10 @@ type VoteSet struct {
 	maj23         *BlockID               // First 2/3 majority seen
 	votesByBlock  map[string]*blockVotes // string(blockHash|blockParts) -> blockVotes
 	peerMaj23s    map[P2PID]BlockID      // Maj23 for each peer
+
+	// Aggregate of the signatures of the votes without a signature of their
+	// own, if the votes were added from an aggregated commit.
+	aggregatedSignature []byte
 }
 
 // NewVoteSet instantiates all fields of a new vote set. This constructor requires
@@ -208,7 +212,8 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 
 	// If we already know of this vote, return false.
 	if existing, ok := voteSet.getVote(valIndex, blockKey, &vote.BlockID); ok {
-		if bytes.Equal(existing.Signature, vote.Signature) {
+		// The votes of an aggregated commit have no signature of their own.
+		if bytes.Equal(existing.Signature, vote.Signature) || len(existing.Signature) == 0 {
 			return false, nil // duplicate
 		}
 		return false, fmt.Errorf("existing vote: %v; new vote: %v: %w", existing, vote, ErrVoteNonDeterministicSignature)
@@ -245,6 +250,35 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 	return added, nil
 }
 
+// addAggregatedCommit verifies the aggregated signature of commit, and adds
+// its votes, which have no signature of their own.
+func (voteSet *VoteSet) addAggregatedCommit(commit *Commit) error {
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	if voteSet.signedMsgType != PrecommitType || commit.Height != voteSet.height || commit.Round != voteSet.round {
+		return fmt.Errorf("expected %d/%d/%d, but got commit %d/%d: %w",
+			voteSet.height, voteSet.round, voteSet.signedMsgType,
+			commit.Height, commit.Round, ErrVoteUnexpectedStep)
+	}
+	if err := voteSet.valSet.VerifyCommit(voteSet.chainID, commit.BlockID, commit.Height, commit); err != nil {
+		return err
+	}
+
+	for idx, cs := range commit.Signatures {
+		if cs.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+		vote := commit.GetVote(int32(idx))
+		_, val := voteSet.valSet.GetByIndex(int32(idx))
+		if added, conflicting := voteSet.addVerifiedVote(vote, vote.BlockID.Key(), val.VotingPower); !added {
+			return fmt.Errorf("failed to add vote %v, conflicting with %v", vote, conflicting)
+		}
+	}
+	voteSet.aggregatedSignature = commit.AggregatedSignature
+	return nil
+}
+
 // getVote returns (vote, true) if vote exists for valIndex and blockKey.
 func (voteSet *VoteSet) getVote(valIndex int32, blockKey string, blockID *BlockID) (vote *Vote, ok bool) {
 	if existing := voteSet.votes[valIndex]; existing != nil && blockID.Equals(existing.BlockID) {
@@ -661,10 +695,11 @@ func (voteSet *VoteSet) MakeExtendedCommit(fp FeatureParams) *ExtendedCommit {
 	}
 
 	ec := &ExtendedCommit{
-		Height:             voteSet.GetHeight(),
-		Round:              voteSet.GetRound(),
-		BlockID:            *voteSet.maj23,
-		ExtendedSignatures: sigs,
+		Height:              voteSet.GetHeight(),
+		Round:               voteSet.GetRound(),
+		BlockID:             *voteSet.maj23,
+		ExtendedSignatures:  sigs,
+		AggregatedSignature: voteSet.aggregatedSignature,
 	}
 	if err := ec.EnsureExtensions(fp.VoteExtensionsEnabled(ec.Height)); err != nil {
 		panic(fmt.Errorf("problem with vote extension data when making extended commit of height %d; %w",